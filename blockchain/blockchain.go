@@ -21,6 +21,7 @@ import (
 const (
 	BlockGasTargetDivisor uint64 = 1024 // The bound divisor of the gas limit, used in update calculations
 	defaultCacheSize      int    = 100  // The default size for Blockchain LRU cache structures
+	maxFreezeBatch        uint64 = 100  // The maximum number of blocks moved into the ancient store per written block
 )
 
 var (
@@ -70,6 +71,10 @@ type Blockchain struct {
 
 	gpAverage *gasPriceAverage // A reference to the average gas price
 
+	// ancientThreshold is the number of the most recent blocks kept in the key-value database,
+	// older ones are moved into the ancient store. Zero disables moving the blocks
+	ancientThreshold uint64
+
 	writeLock sync.Mutex
 }
 
@@ -208,6 +213,11 @@ func NewBlockchain(
 		return nil, err
 	}
 
+	// Bring the database to the current schema before it is used
+	if err := migrateSchema(b.logger, db, schemaMigrations, SchemaVersion); err != nil {
+		return nil, err
+	}
+
 	// Push the initial event to the stream
 	b.stream.push(&Event{})
 
@@ -293,6 +303,12 @@ func (b *Blockchain) SetConsensus(c Verifier) {
 	b.consensus = c
 }
 
+// SetAncientThreshold sets the number of the most recent blocks kept in the key-value database.
+// Older blocks are moved into the ancient store, if the storage supports it
+func (b *Blockchain) SetAncientThreshold(threshold uint64) {
+	b.ancientThreshold = threshold
+}
+
 // setCurrentHeader sets the current header
 func (b *Blockchain) setCurrentHeader(h *types.Header, diff *big.Int) {
 	// Update the header (atomic)
@@ -901,6 +917,9 @@ func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) erro
 	// Update the average gas price
 	b.updateGasPriceAvgWithBlock(block)
 
+	// Move the finalized blocks out of the key-value database
+	b.freezeAncients(header.Number)
+
 	logArgs := []interface{}{
 		"number", header.Number,
 		"txs", len(block.Transactions),
@@ -966,6 +985,9 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	// Update the average gas price
 	b.updateGasPriceAvgWithBlock(block)
 
+	// Move the finalized blocks out of the key-value database
+	b.freezeAncients(header.Number)
+
 	logArgs := []interface{}{
 		"number", header.Number,
 		"txs", len(block.Transactions),
@@ -984,6 +1006,36 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	return nil
 }
 
+// freezeAncients moves the blocks older than the ancient threshold into the ancient store.
+// Blocks are final once written, as the supported consensus engines have instant finality
+func (b *Blockchain) freezeAncients(head uint64) {
+	if b.ancientThreshold == 0 || head <= b.ancientThreshold {
+		return
+	}
+
+	ancientDB, ok := b.db.(storage.AncientStorage)
+	if !ok {
+		return
+	}
+
+	// limit the number of moved blocks, so catching up doesn't stall the block import
+	limit := head - b.ancientThreshold
+	if ancients := ancientDB.Ancients(); limit > ancients+maxFreezeBatch {
+		limit = ancients + maxFreezeBatch
+	}
+
+	moved, err := ancientDB.Freeze(limit)
+	if err != nil {
+		b.logger.Error("failed to move blocks into the ancient store", "limit", limit, "err", err)
+
+		return
+	}
+
+	if moved > 0 {
+		b.logger.Debug("moved blocks into the ancient store", "count", moved, "ancients", limit)
+	}
+}
+
 // GetCachedReceipts retrieves cached receipts for given headerHash
 func (b *Blockchain) GetCachedReceipts(headerHash types.Hash) ([]*types.Receipt, error) {
 	receipts, found := b.receiptsCache.Get(headerHash)
@@ -0,0 +1,132 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+var (
+	ErrRewindNotBelowHead   = errors.New("rewind target is not below the current head")
+	ErrRewindBelowAncients  = errors.New("rewind target is in the ancient store")
+	ErrRewindStateNotFound  = errors.New("state of the rewind target not found")
+	ErrRewindTargetNotFound = errors.New("rewind target block not found")
+)
+
+// StateChecker reports if the state with the given root is present in the state storage
+type StateChecker func(root types.Hash) bool
+
+// Rewind moves the head of the chain stored in db back to the block with the given number.
+// The canonical hashes and the transaction lookups of the later blocks are removed,
+// so they can be synced again. The headers and bodies are kept as side chain data.
+// The state of the target has to be present, otherwise the node can't continue from it.
+// It returns the new head and the removed canonical headers, from the old head down
+func Rewind(db storage.Storage, number uint64, hasState StateChecker) (*types.Header, []*types.Header, error) {
+	headNumber, ok := db.ReadHeadNumber()
+	if !ok {
+		return nil, nil, fmt.Errorf("head number not found")
+	}
+
+	if number >= headNumber {
+		return nil, nil, fmt.Errorf("%w: target %d, head %d", ErrRewindNotBelowHead, number, headNumber)
+	}
+
+	if ancientDB, ok := db.(storage.AncientStorage); ok && number+1 < ancientDB.Ancients() {
+		return nil, nil, fmt.Errorf("%w: target %d, ancient blocks %d", ErrRewindBelowAncients, number, ancientDB.Ancients())
+	}
+
+	hash, ok := db.ReadCanonicalHash(number)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %d", ErrRewindTargetNotFound, number)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %d: %v", ErrRewindTargetNotFound, number, err) //nolint:errorlint
+	}
+
+	header.ComputeHash()
+
+	if hasState != nil && !hasState(header.StateRoot) {
+		return nil, nil, fmt.Errorf("%w: block %d, root %s", ErrRewindStateNotFound, number, header.StateRoot)
+	}
+
+	// move the head first, so an interrupted rewind leaves a consistent chain behind
+	if err := db.WriteHeadHash(header.Hash); err != nil {
+		return nil, nil, err
+	}
+
+	if err := db.WriteHeadNumber(header.Number); err != nil {
+		return nil, nil, err
+	}
+
+	removed := []*types.Header{}
+
+	// remove the canonical blocks above the target, including the ones past the head
+	// left behind by an interrupted write
+	for n := number + 1; ; n++ {
+		removedHash, ok := db.ReadCanonicalHash(n)
+		if !ok {
+			break
+		}
+
+		if removedHeader, err := db.ReadHeader(removedHash); err == nil {
+			removedHeader.ComputeHash()
+			removed = append(removed, removedHeader)
+		}
+
+		if body, err := db.ReadBody(removedHash); err == nil {
+			for _, tx := range body.Transactions {
+				if err := db.DeleteTxLookup(tx.Hash); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+
+		if err := db.DeleteCanonicalHash(n); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// order the removed headers from the old head down, like in the reorg event
+	for i, j := 0, len(removed)-1; i < j; i, j = i+1, j-1 {
+		removed[i], removed[j] = removed[j], removed[i]
+	}
+
+	return header, removed, nil
+}
+
+// SetHead rewinds the chain to the block with the given number
+// and notifies the subscribers about the new head
+func (b *Blockchain) SetHead(number uint64, hasState StateChecker) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	header, removed, err := Rewind(b.db, number, hasState)
+	if err != nil {
+		return err
+	}
+
+	diff, ok := b.readTotalDifficulty(header.Hash)
+	if !ok {
+		return fmt.Errorf("total difficulty of block %d not found", header.Number)
+	}
+
+	b.setCurrentHeader(header, diff)
+
+	b.logger.Info("chain rewound", "number", header.Number, "hash", header.Hash, "removed", len(removed))
+
+	evnt := &Event{Source: "rewind", Type: EventReorg}
+	for _, h := range removed {
+		evnt.AddOldHeader(h)
+	}
+
+	evnt.AddNewHeader(header)
+	evnt.SetDifficulty(diff)
+
+	b.dispatchEvent(evnt)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_SetHead(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	b := NewTestBlockchain(t, headers)
+
+	tx := &types.Transaction{Nonce: 1, GasPrice: big.NewInt(1), Value: big.NewInt(1)}
+	tx.ComputeHash()
+
+	txHash := tx.Hash
+	require.NoError(t, b.db.WriteBody(headers[8].Hash, &types.Body{
+		Transactions: []*types.Transaction{tx},
+	}))
+	require.NoError(t, b.db.WriteTxLookup(txHash, headers[8].Hash))
+
+	sub := b.SubscribeEvents()
+	defer sub.Close()
+
+	hasState := func(root types.Hash) bool {
+		return root == headers[5].StateRoot
+	}
+
+	// the target has to be below the head
+	assert.ErrorIs(t, b.SetHead(9, hasState), ErrRewindNotBelowHead)
+
+	// the state of the target has to be present
+	assert.ErrorIs(t, b.SetHead(4, func(types.Hash) bool { return false }), ErrRewindStateNotFound)
+	assert.Equal(t, uint64(9), b.Header().Number)
+
+	require.NoError(t, b.SetHead(5, hasState))
+
+	assert.Equal(t, headers[5].Hash, b.Header().Hash)
+
+	headHash, ok := b.db.ReadHeadHash()
+	assert.True(t, ok)
+	assert.Equal(t, headers[5].Hash, headHash)
+
+	for _, h := range headers[6:] {
+		_, ok := b.db.ReadCanonicalHash(h.Number)
+		assert.False(t, ok)
+	}
+
+	_, ok = b.db.ReadTxLookup(txHash)
+	assert.False(t, ok)
+
+	evnt := sub.GetEvent()
+	assert.Equal(t, EventReorg, evnt.Type)
+	assert.Equal(t, headers[5].Hash, evnt.Header().Hash)
+	assert.Len(t, evnt.OldChain, 4)
+
+	// the chain can continue from the new head
+	require.NoError(t, b.WriteHeaders(AppendNewTestheadersWithSeed(headers[:6], 2, 1)[6:]))
+	assert.Equal(t, uint64(7), b.Header().Number)
+}
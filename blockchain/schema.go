@@ -0,0 +1,115 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+)
+
+// SchemaVersion is the version of the blockchain storage schema used by this binary.
+// Databases created before the schema versioning are treated as version 0
+const SchemaVersion uint64 = 1
+
+var (
+	// ErrNewerSchema is returned when the database was written by a newer binary
+	ErrNewerSchema = errors.New("database schema is newer than supported")
+)
+
+// Migration upgrades the blockchain storage schema from the previous version to the Version
+type Migration struct {
+	// Version is the schema version after the migration
+	Version uint64
+	// Name is a short description of the migration
+	Name string
+	// Migrate performs the migration. It receives the progress saved by the interrupted run
+	// (nil on the first run), and it should save its progress periodically, so it can be resumed
+	Migrate func(db storage.Storage, progress []byte, saveProgress func([]byte) error) error
+}
+
+// schemaMigrations is the ordered list of the migrations, one per schema version.
+// A new migration has to be appended with the version following the last one,
+// together with bumping the SchemaVersion
+var schemaMigrations = []*Migration{
+	{
+		Version: 1,
+		Name:    "schema version marker",
+		// legacy databases are compatible with the first version
+		Migrate: func(storage.Storage, []byte, func([]byte) error) error {
+			return nil
+		},
+	},
+}
+
+// migrateSchema makes sure the database schema is at the target version,
+// running the migrations if the database is older.
+// It refuses databases written with a newer schema
+func migrateSchema(
+	logger hclog.Logger,
+	db storage.Storage,
+	migrations []*Migration,
+	target uint64,
+) error {
+	if err := validateMigrations(migrations, target); err != nil {
+		return err
+	}
+
+	version, ok := db.ReadSchemaVersion()
+	if !ok {
+		if _, initialized := db.ReadHeadHash(); !initialized {
+			// fresh database, there is nothing to migrate
+			return db.WriteSchemaVersion(target)
+		}
+
+		version = 0
+	}
+
+	if version > target {
+		return fmt.Errorf("%w: database version %d, supported version %d", ErrNewerSchema, version, target)
+	}
+
+	for _, migration := range migrations {
+		if migration.Version <= version {
+			continue
+		}
+
+		progress, resumed := db.ReadMigrationProgress(migration.Version)
+
+		logger.Info(
+			"running database migration",
+			"version", migration.Version,
+			"name", migration.Name,
+			"resumed", resumed,
+		)
+
+		saveProgress := func(progress []byte) error {
+			return db.WriteMigrationProgress(migration.Version, progress)
+		}
+
+		if err := migration.Migrate(db, progress, saveProgress); err != nil {
+			return fmt.Errorf("database migration to version %d (%s) failed: %w", migration.Version, migration.Name, err)
+		}
+
+		if err := db.WriteSchemaVersion(migration.Version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMigrations checks the migrations are ordered and lead to the target version
+func validateMigrations(migrations []*Migration, target uint64) error {
+	for i, migration := range migrations {
+		if migration.Version != uint64(i)+1 {
+			return fmt.Errorf("migration %s has version %d, expected %d", migration.Name, migration.Version, i+1)
+		}
+	}
+
+	if uint64(len(migrations)) != target {
+		return fmt.Errorf("migrations lead to version %d, expected %d", len(migrations), target)
+	}
+
+	return nil
+}
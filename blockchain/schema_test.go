@@ -0,0 +1,137 @@
+package blockchain
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/memory"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInitializedStorage(t *testing.T) storage.Storage {
+	t.Helper()
+
+	db, err := memory.NewMemoryStorage(nil)
+	require.NoError(t, err)
+
+	require.NoError(t, db.WriteHeadHash(types.StringToHash("1")))
+
+	return db
+}
+
+func TestMigrateSchema(t *testing.T) {
+	t.Parallel()
+
+	noop := func(storage.Storage, []byte, func([]byte) error) error { return nil }
+
+	t.Run("fresh database gets the current version", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := memory.NewMemoryStorage(nil)
+		require.NoError(t, err)
+
+		called := false
+		migrations := []*Migration{{Version: 1, Name: "first", Migrate: func(storage.Storage, []byte, func([]byte) error) error {
+			called = true
+
+			return nil
+		}}}
+
+		require.NoError(t, migrateSchema(hclog.NewNullLogger(), db, migrations, 1))
+
+		version, ok := db.ReadSchemaVersion()
+		require.True(t, ok)
+		assert.Equal(t, uint64(1), version)
+		assert.False(t, called)
+	})
+
+	t.Run("legacy database runs all migrations in order", func(t *testing.T) {
+		t.Parallel()
+
+		db := newInitializedStorage(t)
+		order := []uint64{}
+
+		migration := func(version uint64) *Migration {
+			return &Migration{Version: version, Migrate: func(storage.Storage, []byte, func([]byte) error) error {
+				order = append(order, version)
+
+				return nil
+			}}
+		}
+
+		require.NoError(t, migrateSchema(hclog.NewNullLogger(), db, []*Migration{migration(1), migration(2)}, 2))
+		assert.Equal(t, []uint64{1, 2}, order)
+
+		version, _ := db.ReadSchemaVersion()
+		assert.Equal(t, uint64(2), version)
+	})
+
+	t.Run("interrupted migration resumes from the saved progress", func(t *testing.T) {
+		t.Parallel()
+
+		db := newInitializedStorage(t)
+		errInterrupted := errors.New("interrupted")
+
+		var processed []uint64
+
+		migrations := []*Migration{
+			{Version: 1, Migrate: noop},
+			{Version: 2, Migrate: func(_ storage.Storage, progress []byte, save func([]byte) error) error {
+				next := uint64(0)
+				if progress != nil {
+					next = binary.BigEndian.Uint64(progress)
+				}
+
+				for ; next < 10; next++ {
+					// interrupt the first run in the middle
+					if next == 5 && progress == nil {
+						return errInterrupted
+					}
+
+					processed = append(processed, next)
+
+					if err := save(binary.BigEndian.AppendUint64(nil, next+1)); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}},
+		}
+
+		assert.ErrorIs(t, migrateSchema(hclog.NewNullLogger(), db, migrations, 2), errInterrupted)
+
+		version, _ := db.ReadSchemaVersion()
+		assert.Equal(t, uint64(1), version)
+
+		require.NoError(t, migrateSchema(hclog.NewNullLogger(), db, migrations, 2))
+		assert.Equal(t, []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, processed)
+
+		version, _ = db.ReadSchemaVersion()
+		assert.Equal(t, uint64(2), version)
+	})
+
+	t.Run("newer database is refused", func(t *testing.T) {
+		t.Parallel()
+
+		db := newInitializedStorage(t)
+		require.NoError(t, db.WriteSchemaVersion(5))
+
+		err := migrateSchema(hclog.NewNullLogger(), db, []*Migration{{Version: 1, Migrate: noop}}, 1)
+		assert.ErrorIs(t, err, ErrNewerSchema)
+	})
+
+	t.Run("unordered migrations are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		db := newInitializedStorage(t)
+
+		assert.Error(t, migrateSchema(hclog.NewNullLogger(), db, []*Migration{{Version: 2, Migrate: noop}}, 2))
+		assert.Error(t, migrateSchema(hclog.NewNullLogger(), db, []*Migration{{Version: 1, Migrate: noop}}, 2))
+	})
+}
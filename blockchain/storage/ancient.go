@@ -0,0 +1,161 @@
+//nolint:stylecheck
+package storage
+
+import (
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// AncientStore is an append-only store of the finalized canonical blocks,
+// items are stored by the block number
+type AncientStore interface {
+	// Ancients returns the number of blocks in the store
+	Ancients() uint64
+	// AppendBlock appends the encoded block data, number has to be equal to Ancients
+	AppendBlock(number uint64, hash, header, body, receipts []byte) error
+
+	ReadHash(number uint64) ([]byte, error)
+	ReadHeader(number uint64) ([]byte, error)
+	ReadBody(number uint64) ([]byte, error)
+	ReadReceipts(number uint64) ([]byte, error)
+
+	// TruncateHead removes the blocks starting from the given number
+	TruncateHead(number uint64) error
+
+	Sync() error
+	Close() error
+}
+
+// AncientStorage is a Storage which can move the finalized blocks into an ancient store.
+// Reads of the moved blocks fall through to the ancient store transparently
+type AncientStorage interface {
+	Storage
+
+	// SetAncientStore sets the ancient store used by the storage
+	SetAncientStore(ancients AncientStore)
+	// Ancients returns the number of blocks moved into the ancient store
+	Ancients() uint64
+	// Freeze moves the canonical blocks below the limit into the ancient store
+	// and returns the number of moved blocks
+	Freeze(limit uint64) (uint64, error)
+}
+
+// SetAncientStore sets the ancient store used by the storage
+func (s *KeyValueStorage) SetAncientStore(ancients AncientStore) {
+	s.ancients = ancients
+}
+
+// Ancients returns the number of blocks moved into the ancient store
+func (s *KeyValueStorage) Ancients() uint64 {
+	if s.ancients == nil {
+		return 0
+	}
+
+	return s.ancients.Ancients()
+}
+
+// Freeze moves the canonical headers, bodies, receipts and hashes of the blocks
+// below the limit from the key-value database into the ancient store.
+// Total difficulties and transaction lookups stay in the key-value database
+func (s *KeyValueStorage) Freeze(limit uint64) (uint64, error) {
+	if s.ancients == nil {
+		return 0, nil
+	}
+
+	from := s.ancients.Ancients()
+	if from >= limit {
+		return 0, nil
+	}
+
+	hashes := make([]types.Hash, 0, limit-from)
+
+	for number := from; number < limit; number++ {
+		hash, ok := s.get(CANONICAL, s.encodeUint(number))
+		if !ok {
+			return 0, fmt.Errorf("canonical hash of block %d not found", number)
+		}
+
+		header, ok := s.get(HEADER, hash)
+		if !ok {
+			return 0, fmt.Errorf("header of block %d not found", number)
+		}
+
+		// genesis has no body and receipts, store them as empty items
+		body, _ := s.get(BODY, hash)
+		receipts, _ := s.get(RECEIPTS, hash)
+
+		if err := s.ancients.AppendBlock(number, hash, header, body, receipts); err != nil {
+			return 0, err
+		}
+
+		hashes = append(hashes, types.BytesToHash(hash))
+	}
+
+	// make sure the blocks are persisted before they are removed from the database
+	if err := s.ancients.Sync(); err != nil {
+		return 0, err
+	}
+
+	for i, hash := range hashes {
+		number := from + uint64(i)
+
+		if err := s.set(ANCIENT_NUMBER, hash.Bytes(), s.encodeUint(number)); err != nil {
+			return 0, err
+		}
+
+		for _, key := range [][]byte{
+			append(append([]byte{}, CANONICAL...), s.encodeUint(number)...),
+			append(append([]byte{}, HEADER...), hash.Bytes()...),
+			append(append([]byte{}, BODY...), hash.Bytes()...),
+			append(append([]byte{}, RECEIPTS...), hash.Bytes()...),
+		} {
+			if err := s.db.Delete(key); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return uint64(len(hashes)), nil
+}
+
+// readAncientHash reads the canonical hash from the ancient store
+func (s *KeyValueStorage) readAncientHash(n uint64) (types.Hash, bool) {
+	if s.ancients == nil || n >= s.ancients.Ancients() {
+		return types.Hash{}, false
+	}
+
+	data, err := s.ancients.ReadHash(n)
+	if err != nil {
+		return types.Hash{}, false
+	}
+
+	return types.BytesToHash(data), true
+}
+
+// readAncientRLP reads and decodes the block item of the given hash from the ancient store
+func (s *KeyValueStorage) readAncientRLP(
+	hash types.Hash,
+	read func(AncientStore, uint64) ([]byte, error),
+	raw types.RLPUnmarshaler,
+) error {
+	if s.ancients == nil {
+		return ErrNotFound
+	}
+
+	numberData, ok := s.get(ANCIENT_NUMBER, hash.Bytes())
+	if !ok || len(numberData) != 8 {
+		return ErrNotFound
+	}
+
+	data, err := read(s.ancients, s.decodeUint(numberData))
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		return ErrNotFound
+	}
+
+	return s.decodeRLP(data, raw)
+}
@@ -0,0 +1,207 @@
+package ancient
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+const (
+	hashesTable   = "hashes"
+	headersTable  = "headers"
+	bodiesTable   = "bodies"
+	receiptsTable = "receipts"
+)
+
+// tableNames are the tables of the store, every block has an item in each of them
+var tableNames = []string{hashesTable, headersTable, bodiesTable, receiptsTable}
+
+// Store is an append-only flat file store of the finalized canonical blocks.
+// The blocks are stored in the order of their numbers starting from the genesis,
+// so the item number of every table is the block number
+type Store struct {
+	lock   sync.RWMutex
+	tables map[string]*table
+
+	// ancients is the number of blocks in the store
+	ancients uint64
+}
+
+// NewStore opens or creates the ancient store in the given directory
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+
+	s := &Store{tables: make(map[string]*table, len(tableNames))}
+
+	for _, name := range tableNames {
+		t, err := openTable(dir, name)
+		if err != nil {
+			_ = s.Close()
+
+			return nil, err
+		}
+
+		s.tables[name] = t
+	}
+
+	// tables can get out of sync on an unclean shutdown during the append,
+	// so keep only the blocks present in all of them
+	ancients := s.tables[hashesTable].Items()
+	for _, t := range s.tables {
+		if t.Items() < ancients {
+			ancients = t.Items()
+		}
+	}
+
+	if err := s.truncate(ancients); err != nil {
+		_ = s.Close()
+
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Ancients returns the number of blocks in the store
+func (s *Store) Ancients() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.ancients
+}
+
+// Size returns the total size of the data files
+func (s *Store) Size() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var size uint64
+	for _, t := range s.tables {
+		size += t.Size()
+	}
+
+	return size
+}
+
+// AppendBlock appends the encoded block data to the store.
+// The number has to be equal to the number of blocks already in the store
+func (s *Store) AppendBlock(number uint64, hash, header, body, receipts []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if number != s.ancients {
+		return fmt.Errorf("ancient block %d appended out of order, expected %d", number, s.ancients)
+	}
+
+	items := map[string][]byte{
+		hashesTable:   hash,
+		headersTable:  header,
+		bodiesTable:   body,
+		receiptsTable: receipts,
+	}
+
+	for _, name := range tableNames {
+		if err := s.tables[name].Append(items[name]); err != nil {
+			// roll back the partially appended block
+			if truncErr := s.truncate(number); truncErr != nil {
+				return fmt.Errorf("%w, failed to roll back: %v", err, truncErr)
+			}
+
+			return err
+		}
+	}
+
+	s.ancients++
+
+	return nil
+}
+
+// ReadHash returns the canonical hash of the block
+func (s *Store) ReadHash(number uint64) ([]byte, error) {
+	return s.retrieve(hashesTable, number)
+}
+
+// ReadHeader returns the encoded header of the block
+func (s *Store) ReadHeader(number uint64) ([]byte, error) {
+	return s.retrieve(headersTable, number)
+}
+
+// ReadBody returns the encoded body of the block
+func (s *Store) ReadBody(number uint64) ([]byte, error) {
+	return s.retrieve(bodiesTable, number)
+}
+
+// ReadReceipts returns the encoded receipts of the block
+func (s *Store) ReadReceipts(number uint64) ([]byte, error) {
+	return s.retrieve(receiptsTable, number)
+}
+
+// TruncateHead removes the blocks starting from the given number
+func (s *Store) TruncateHead(number uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if number >= s.ancients {
+		return nil
+	}
+
+	return s.truncate(number)
+}
+
+// Sync flushes the store to the disk
+func (s *Store) Sync() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, t := range s.tables {
+		if err := t.Sync(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes the store
+func (s *Store) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var closeErr error
+
+	for _, t := range s.tables {
+		if err := t.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
+}
+
+func (s *Store) retrieve(name string, number uint64) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if number >= s.ancients {
+		return nil, ErrOutOfBounds
+	}
+
+	return s.tables[name].Retrieve(number)
+}
+
+// truncate truncates all the tables to the given number of blocks
+func (s *Store) truncate(ancients uint64) error {
+	for _, t := range s.tables {
+		if t.Items() > ancients {
+			if err := t.truncate(ancients); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.ancients = ancients
+
+	return nil
+}
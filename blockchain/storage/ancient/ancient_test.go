@@ -0,0 +1,111 @@
+package ancient
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendBlocks(t *testing.T, s *Store, from, to uint64) {
+	t.Helper()
+
+	for i := from; i < to; i++ {
+		require.NoError(t, s.AppendBlock(
+			i,
+			[]byte(fmt.Sprintf("hash-%d", i)),
+			[]byte(fmt.Sprintf("header-%d", i)),
+			[]byte(fmt.Sprintf("body-%d", i)),
+			[]byte{},
+		))
+	}
+}
+
+func TestStore_AppendAndRead(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	s, err := NewStore(dir)
+	require.NoError(t, err)
+
+	appendBlocks(t, s, 0, 10)
+
+	assert.Error(t, s.AppendBlock(20, nil, nil, nil, nil))
+	assert.Equal(t, uint64(10), s.Ancients())
+
+	header, err := s.ReadHeader(7)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("header-7"), header)
+
+	receipts, err := s.ReadReceipts(7)
+	require.NoError(t, err)
+	assert.Empty(t, receipts)
+
+	_, err = s.ReadHash(10)
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+
+	require.NoError(t, s.Close())
+
+	// reopen
+	s, err = NewStore(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(10), s.Ancients())
+
+	body, err := s.ReadBody(9)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("body-9"), body)
+
+	require.NoError(t, s.TruncateHead(5))
+	assert.Equal(t, uint64(5), s.Ancients())
+
+	appendBlocks(t, s, 5, 6)
+
+	hash, err := s.ReadHash(5)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hash-5"), hash)
+
+	require.NoError(t, s.Close())
+}
+
+func TestStore_RepairOnOpen(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	s, err := NewStore(dir)
+	require.NoError(t, err)
+
+	appendBlocks(t, s, 0, 5)
+	require.NoError(t, s.Close())
+
+	// simulate an unclean shutdown in the middle of the block append:
+	// the headers table got one more item and the bodies data file is cut
+	headers, err := openTable(dir, headersTable)
+	require.NoError(t, err)
+	require.NoError(t, headers.Append([]byte("header-5")))
+	require.NoError(t, headers.Close())
+
+	bodiesData := filepath.Join(dir, bodiesTable+dataFileSuffix)
+	stat, err := os.Stat(bodiesData)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(bodiesData, stat.Size()-1))
+
+	s, err = NewStore(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(4), s.Ancients())
+
+	_, err = s.ReadHeader(4)
+	assert.ErrorIs(t, err, ErrOutOfBounds)
+
+	body, err := s.ReadBody(3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("body-3"), body)
+
+	require.NoError(t, s.Close())
+}
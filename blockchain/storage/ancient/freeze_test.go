@@ -0,0 +1,93 @@
+package ancient
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/memory"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyValueStorage_Freeze(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(nil)
+	require.NoError(t, err)
+
+	ancientDB, ok := db.(storage.AncientStorage)
+	require.True(t, ok)
+
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	ancientDB.SetAncientStore(store)
+
+	defer db.Close()
+
+	headers := make([]*types.Header, 10)
+
+	for i := range headers {
+		headers[i] = &types.Header{Number: uint64(i), ExtraData: []byte{byte(i)}}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash
+		}
+
+		headers[i].ComputeHash()
+
+		require.NoError(t, db.WriteCanonicalHeader(headers[i], big.NewInt(int64(i))))
+
+		// genesis has no body nor receipts
+		if i == 0 {
+			continue
+		}
+
+		require.NoError(t, db.WriteBody(headers[i].Hash, &types.Body{}))
+		require.NoError(t, db.WriteReceipts(headers[i].Hash, []*types.Receipt{{CumulativeGasUsed: uint64(i)}}))
+	}
+
+	moved, err := ancientDB.Freeze(6)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), moved)
+	assert.Equal(t, uint64(6), ancientDB.Ancients())
+
+	// nothing to move below the same limit again
+	moved, err = ancientDB.Freeze(6)
+	require.NoError(t, err)
+	assert.Zero(t, moved)
+
+	for _, header := range headers {
+		hash, ok := db.ReadCanonicalHash(header.Number)
+		require.True(t, ok)
+		assert.Equal(t, header.Hash, hash)
+
+		readHeader, err := db.ReadHeader(header.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, header.ExtraData, readHeader.ExtraData)
+
+		if header.Number == 0 {
+			_, err := db.ReadBody(header.Hash)
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+
+			continue
+		}
+
+		_, err = db.ReadBody(header.Hash)
+		assert.NoError(t, err)
+
+		receipts, err := db.ReadReceipts(header.Hash)
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, header.Number, receipts[0].CumulativeGasUsed)
+	}
+
+	// total difficulty stays in the key-value database
+	td, ok := db.ReadTotalDifficulty(headers[3].Hash)
+	require.True(t, ok)
+	assert.Equal(t, big.NewInt(3), td)
+
+	_, err = db.ReadHeader(types.StringToHash("unknown"))
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
@@ -0,0 +1,228 @@
+package ancient
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// indexEntrySize is the size of the index entry holding the end offset of an item
+	indexEntrySize = 8
+
+	dataFileSuffix  = ".dat"
+	indexFileSuffix = ".idx"
+)
+
+var (
+	// ErrOutOfBounds is returned when the requested item is not stored in the table
+	ErrOutOfBounds = errors.New("item out of bounds")
+)
+
+// table is an append-only flat file of items.
+// Items are concatenated in the data file and the index file holds
+// the end offset of each item in the data file as a big-endian uint64
+type table struct {
+	data  *os.File
+	index *os.File
+
+	// items is the number of items in the table
+	items uint64
+	// size is the size of the data file
+	size uint64
+}
+
+// openTable opens or creates the table with the given name in the directory.
+// Partially written items left by an unclean shutdown are discarded
+func openTable(dir, name string) (*table, error) {
+	data, err := os.OpenFile(filepath.Join(dir, name+dataFileSuffix), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := os.OpenFile(filepath.Join(dir, name+indexFileSuffix), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		_ = data.Close()
+
+		return nil, err
+	}
+
+	t := &table{data: data, index: index}
+
+	if err := t.repair(); err != nil {
+		_ = t.Close()
+
+		return nil, fmt.Errorf("failed to repair ancient table %s: %w", name, err)
+	}
+
+	return t, nil
+}
+
+// repair makes the index and the data file consistent with each other
+func (t *table) repair() error {
+	indexStat, err := t.index.Stat()
+	if err != nil {
+		return err
+	}
+
+	dataStat, err := t.data.Stat()
+	if err != nil {
+		return err
+	}
+
+	items := uint64(indexStat.Size()) / indexEntrySize
+	dataSize := uint64(dataStat.Size())
+
+	// drop the index entries pointing beyond the data file
+	for items > 0 {
+		end, err := t.readOffset(items - 1)
+		if err != nil {
+			return err
+		}
+
+		if end <= dataSize {
+			break
+		}
+
+		items--
+	}
+
+	return t.truncate(items)
+}
+
+// Items returns the number of items stored in the table
+func (t *table) Items() uint64 {
+	return t.items
+}
+
+// Size returns the size of the data file
+func (t *table) Size() uint64 {
+	return t.size
+}
+
+// Append appends the item to the end of the table
+func (t *table) Append(item []byte) error {
+	if _, err := t.data.WriteAt(item, int64(t.size)); err != nil {
+		return err
+	}
+
+	entry := make([]byte, indexEntrySize)
+	binary.BigEndian.PutUint64(entry, t.size+uint64(len(item)))
+
+	if _, err := t.index.WriteAt(entry, int64(t.items*indexEntrySize)); err != nil {
+		return err
+	}
+
+	t.items++
+	t.size += uint64(len(item))
+
+	return nil
+}
+
+// Retrieve returns the item with the given number
+func (t *table) Retrieve(number uint64) ([]byte, error) {
+	if number >= t.items {
+		return nil, ErrOutOfBounds
+	}
+
+	start, end, err := t.bounds(number)
+	if err != nil {
+		return nil, err
+	}
+
+	item := make([]byte, end-start)
+	if _, err := t.data.ReadAt(item, int64(start)); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// truncate drops all the items starting from the given number
+func (t *table) truncate(items uint64) error {
+	var size uint64
+
+	if items > 0 {
+		end, err := t.readOffset(items - 1)
+		if err != nil {
+			return err
+		}
+
+		size = end
+	}
+
+	if err := t.index.Truncate(int64(items * indexEntrySize)); err != nil {
+		return err
+	}
+
+	if err := t.data.Truncate(int64(size)); err != nil {
+		return err
+	}
+
+	t.items = items
+	t.size = size
+
+	return nil
+}
+
+// bounds returns the start and end offset of the item in the data file
+func (t *table) bounds(number uint64) (uint64, uint64, error) {
+	end, err := t.readOffset(number)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if number == 0 {
+		return 0, end, nil
+	}
+
+	start, err := t.readOffset(number - 1)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if start > end {
+		return 0, 0, fmt.Errorf("corrupted index entry for item %d", number)
+	}
+
+	return start, end, nil
+}
+
+// readOffset reads the end offset of the item from the index
+func (t *table) readOffset(number uint64) (uint64, error) {
+	entry := make([]byte, indexEntrySize)
+
+	if _, err := t.index.ReadAt(entry, int64(number*indexEntrySize)); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, ErrOutOfBounds
+		}
+
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(entry), nil
+}
+
+// Sync flushes the table files to the disk
+func (t *table) Sync() error {
+	if err := t.data.Sync(); err != nil {
+		return err
+	}
+
+	return t.index.Sync()
+}
+
+// Close closes the table files
+func (t *table) Close() error {
+	dataErr := t.data.Close()
+	indexErr := t.index.Close()
+
+	if dataErr != nil {
+		return dataErr
+	}
+
+	return indexErr
+}
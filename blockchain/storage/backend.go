@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Backend is the key-value database engine used for the data directory
+type Backend string
+
+const (
+	// LevelDBBackend stores the data using goleveldb
+	LevelDBBackend Backend = "leveldb"
+	// PebbleBackend stores the data using pebble
+	PebbleBackend Backend = "pebble"
+
+	// DefaultBackend is the backend used when none is configured
+	DefaultBackend = LevelDBBackend
+
+	// backendFileName is the name of the file in the data directory recording the backend
+	backendFileName = "DB_BACKEND"
+
+	// BlockchainDir is the data directory subfolder of the blockchain database
+	BlockchainDir = "blockchain"
+	// TrieDir is the data directory subfolder of the state trie database
+	TrieDir = "trie"
+	// AncientDir is the data directory subfolder of the ancient block store
+	AncientDir = "ancient"
+)
+
+// DatabaseDirs are the data directory subfolders holding the key-value databases
+var DatabaseDirs = []string{BlockchainDir, TrieDir}
+
+var (
+	// ErrUnknownBackend is returned when the backend name is not supported
+	ErrUnknownBackend = errors.New("unknown storage backend")
+	// ErrBackendMismatch is returned when the data directory was created with another backend
+	ErrBackendMismatch = errors.New("storage backend mismatch")
+)
+
+// ParseBackend parses the storage backend name. Empty name resolves to DefaultBackend
+func ParseBackend(name string) (Backend, error) {
+	switch backend := Backend(strings.ToLower(strings.TrimSpace(name))); backend {
+	case "":
+		return DefaultBackend, nil
+	case LevelDBBackend, PebbleBackend:
+		return backend, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownBackend, name)
+	}
+}
+
+// ReadBackend reads the backend recorded in the data directory.
+// Data directories created before the backend was recorded are reported as LevelDB,
+// the returned flag is false if the data directory holds no databases yet
+func ReadBackend(dataDir string) (Backend, bool, error) {
+	raw, err := os.ReadFile(filepath.Join(dataDir, backendFileName))
+	if err == nil {
+		backend, err := ParseBackend(string(raw))
+		if err != nil {
+			return "", false, err
+		}
+
+		return backend, true, nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", false, err
+	}
+
+	for _, dir := range DatabaseDirs {
+		entries, err := os.ReadDir(filepath.Join(dataDir, dir))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", false, err
+		}
+
+		if len(entries) > 0 {
+			return LevelDBBackend, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// WriteBackend records the backend in the data directory
+func WriteBackend(dataDir string, backend Backend) error {
+	if err := os.MkdirAll(dataDir, 0750); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dataDir, backendFileName), []byte(backend), 0600)
+}
+
+// CheckBackend makes sure the data directory is used with the same backend it was created with,
+// and records the backend if it is not recorded yet
+func CheckBackend(dataDir string, backend Backend) error {
+	recorded, exists, err := ReadBackend(dataDir)
+	if err != nil {
+		return err
+	}
+
+	if exists && recorded != backend {
+		return fmt.Errorf(
+			"%w: data directory %s uses %s, but %s is configured",
+			ErrBackendMismatch, dataDir, recorded, backend,
+		)
+	}
+
+	return WriteBackend(dataDir, backend)
+}
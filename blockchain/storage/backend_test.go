@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBackend(t *testing.T) {
+	t.Parallel()
+
+	backend, err := ParseBackend("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBackend, backend)
+
+	backend, err = ParseBackend("Pebble")
+	require.NoError(t, err)
+	assert.Equal(t, PebbleBackend, backend)
+
+	_, err = ParseBackend("rocksdb")
+	assert.ErrorIs(t, err, ErrUnknownBackend)
+}
+
+func TestCheckBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records backend for a new data directory", func(t *testing.T) {
+		t.Parallel()
+
+		dataDir := t.TempDir()
+
+		require.NoError(t, CheckBackend(dataDir, PebbleBackend))
+
+		backend, exists, err := ReadBackend(dataDir)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, PebbleBackend, backend)
+
+		assert.ErrorIs(t, CheckBackend(dataDir, LevelDBBackend), ErrBackendMismatch)
+	})
+
+	t.Run("treats existing databases without record as leveldb", func(t *testing.T) {
+		t.Parallel()
+
+		dataDir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dataDir, TrieDir), 0750))
+		require.NoError(t, CheckBackend(dataDir, PebbleBackend))
+		require.NoError(t, os.Remove(filepath.Join(dataDir, backendFileName)))
+
+		require.NoError(t, os.Mkdir(filepath.Join(dataDir, BlockchainDir), 0750))
+		require.NoError(t, os.WriteFile(filepath.Join(dataDir, BlockchainDir, "CURRENT"), []byte{}, 0600))
+
+		assert.ErrorIs(t, CheckBackend(dataDir, PebbleBackend), ErrBackendMismatch)
+		assert.NoError(t, CheckBackend(dataDir, LevelDBBackend))
+	})
+}
@@ -3,6 +3,7 @@ package storage
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -39,13 +40,21 @@ var (
 
 	// TX_LOOKUP_PREFIX is the prefix for transaction lookups
 	TX_LOOKUP_PREFIX = []byte("l")
+
+	// ANCIENT_NUMBER is the prefix for the numbers of blocks moved into the ancient store
+	ANCIENT_NUMBER = []byte("n")
+
+	// SCHEMA is the prefix for the database schema version and migrations
+	SCHEMA = []byte("v")
 )
 
 // Sub-prefixes
 var (
-	HASH   = []byte("hash")
-	NUMBER = []byte("number")
-	EMPTY  = []byte("empty")
+	HASH      = []byte("hash")
+	NUMBER    = []byte("number")
+	EMPTY     = []byte("empty")
+	VERSION   = []byte("version")
+	MIGRATION = []byte("migration")
 )
 
 // KV is a key value storage interface.
@@ -55,13 +64,15 @@ type KV interface {
 	Close() error
 	Set(p []byte, v []byte) error
 	Get(p []byte) ([]byte, bool, error)
+	Delete(p []byte) error
 }
 
 // KeyValueStorage is a generic storage for kv databases
 type KeyValueStorage struct {
-	logger hclog.Logger
-	db     KV
-	Db     KV
+	logger   hclog.Logger
+	db       KV
+	Db       KV
+	ancients AncientStore
 }
 
 func NewKeyValueStorage(logger hclog.Logger, db KV) Storage {
@@ -85,7 +96,7 @@ func (s *KeyValueStorage) decodeUint(b []byte) uint64 {
 func (s *KeyValueStorage) ReadCanonicalHash(n uint64) (types.Hash, bool) {
 	data, ok := s.get(CANONICAL, s.encodeUint(n))
 	if !ok {
-		return types.Hash{}, false
+		return s.readAncientHash(n)
 	}
 
 	return types.BytesToHash(data), true
@@ -96,6 +107,11 @@ func (s *KeyValueStorage) WriteCanonicalHash(n uint64, hash types.Hash) error {
 	return s.set(CANONICAL, s.encodeUint(n), hash.Bytes())
 }
 
+// DeleteCanonicalHash removes the hash of the number block from the canonical chain
+func (s *KeyValueStorage) DeleteCanonicalHash(n uint64) error {
+	return s.delete(CANONICAL, s.encodeUint(n))
+}
+
 // HEAD //
 
 // ReadHeadHash returns the hash of the head
@@ -132,6 +148,33 @@ func (s *KeyValueStorage) WriteHeadNumber(n uint64) error {
 	return s.set(HEAD, NUMBER, s.encodeUint(n))
 }
 
+// SCHEMA //
+
+// ReadSchemaVersion returns the schema version of the database
+func (s *KeyValueStorage) ReadSchemaVersion() (uint64, bool) {
+	data, ok := s.get(SCHEMA, VERSION)
+	if !ok || len(data) != 8 {
+		return 0, false
+	}
+
+	return s.decodeUint(data), true
+}
+
+// WriteSchemaVersion writes the schema version of the database
+func (s *KeyValueStorage) WriteSchemaVersion(version uint64) error {
+	return s.set(SCHEMA, VERSION, s.encodeUint(version))
+}
+
+// ReadMigrationProgress returns the progress saved by the interrupted migration to the given version
+func (s *KeyValueStorage) ReadMigrationProgress(version uint64) ([]byte, bool) {
+	return s.get(SCHEMA, append(append([]byte{}, MIGRATION...), s.encodeUint(version)...))
+}
+
+// WriteMigrationProgress saves the progress of the migration to the given version
+func (s *KeyValueStorage) WriteMigrationProgress(version uint64, progress []byte) error {
+	return s.set(SCHEMA, append(append([]byte{}, MIGRATION...), s.encodeUint(version)...), progress)
+}
+
 // FORK //
 
 // WriteForks writes the current forks
@@ -178,6 +221,10 @@ func (s *KeyValueStorage) ReadHeader(hash types.Hash) (*types.Header, error) {
 	header := &types.Header{}
 	err := s.readRLP(HEADER, hash.Bytes(), header)
 
+	if errors.Is(err, ErrNotFound) {
+		err = s.readAncientRLP(hash, AncientStore.ReadHeader, header)
+	}
+
 	return header, err
 }
 
@@ -218,6 +265,10 @@ func (s *KeyValueStorage) ReadBody(hash types.Hash) (*types.Body, error) {
 	body := &types.Body{}
 	err := s.readRLP(BODY, hash.Bytes(), body)
 
+	if errors.Is(err, ErrNotFound) {
+		err = s.readAncientRLP(hash, AncientStore.ReadBody, body)
+	}
+
 	return body, err
 }
 
@@ -235,6 +286,10 @@ func (s *KeyValueStorage) ReadReceipts(hash types.Hash) ([]*types.Receipt, error
 	receipts := &types.Receipts{}
 	err := s.readRLP(RECEIPTS, hash.Bytes(), receipts)
 
+	if errors.Is(err, ErrNotFound) {
+		err = s.readAncientRLP(hash, AncientStore.ReadReceipts, receipts)
+	}
+
 	return *receipts, err
 }
 
@@ -267,6 +322,11 @@ func (s *KeyValueStorage) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return types.BytesToHash(blockHash), true
 }
 
+// DeleteTxLookup removes the block hash mapping of the transaction
+func (s *KeyValueStorage) DeleteTxLookup(hash types.Hash) error {
+	return s.delete(TX_LOOKUP_PREFIX, hash.Bytes())
+}
+
 // WRITE OPERATIONS //
 
 func (s *KeyValueStorage) writeRLP(p, k []byte, raw types.RLPMarshaler) error {
@@ -294,6 +354,10 @@ func (s *KeyValueStorage) readRLP(p, k []byte, raw types.RLPUnmarshaler) error {
 		return ErrNotFound
 	}
 
+	return s.decodeRLP(data, raw)
+}
+
+func (s *KeyValueStorage) decodeRLP(data []byte, raw types.RLPUnmarshaler) error {
 	if obj, ok := raw.(types.RLPStoreUnmarshaler); ok {
 		// decode in the store format
 		if err := obj.UnmarshalStoreRLP(data); err != nil {
@@ -335,6 +399,12 @@ func (s *KeyValueStorage) set(p []byte, k []byte, v []byte) error {
 	return s.db.Set(p, v)
 }
 
+func (s *KeyValueStorage) delete(p []byte, k []byte) error {
+	p = append(p, k...)
+
+	return s.db.Delete(p)
+}
+
 func (s *KeyValueStorage) get(p []byte, k []byte) ([]byte, bool) {
 	p = append(p, k...)
 	data, ok, err := s.db.Get(p)
@@ -348,5 +418,11 @@ func (s *KeyValueStorage) get(p []byte, k []byte) ([]byte, bool) {
 
 // Close closes the connection with the db
 func (s *KeyValueStorage) Close() error {
+	if s.ancients != nil {
+		if err := s.ancients.Close(); err != nil {
+			s.logger.Error("failed to close ancient store", "err", err)
+		}
+	}
+
 	return s.db.Close()
 }
@@ -0,0 +1,130 @@
+package kvdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+)
+
+// convertBatchSize is the number of keys written per batch during the conversion
+const convertBatchSize = 10000
+
+// Copy copies all key-value pairs from the source to the destination database.
+// It returns the number of copied keys
+func Copy(src, dst Database) (uint64, error) {
+	var (
+		copied uint64
+		batch  = dst.NewBatch()
+	)
+
+	err := src.Iterate(nil, func(key, value []byte) error {
+		// both batch implementations copy the data, so the iterator slices can be passed as is
+		batch.Put(key, value)
+
+		copied++
+
+		if batch.Len() >= convertBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+
+			batch = dst.NewBatch()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if batch.Len() > 0 {
+		if err := batch.Write(); err != nil {
+			return 0, err
+		}
+	}
+
+	return copied, nil
+}
+
+// ConvertDataDir converts all databases of the data directory from the recorded backend
+// to the given one. The original databases are kept next to the converted ones
+// with the source backend name as a suffix. It returns the number of copied keys per database
+func ConvertDataDir(dataDir string, to storage.Backend) (map[string]uint64, error) {
+	from, exists, err := storage.ReadBackend(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("no databases found in data directory %s", dataDir)
+	}
+
+	if from == to {
+		return nil, fmt.Errorf("data directory %s already uses %s", dataDir, to)
+	}
+
+	copied := make(map[string]uint64, len(storage.DatabaseDirs))
+
+	for _, dir := range storage.DatabaseDirs {
+		srcPath := filepath.Join(dataDir, dir)
+		dstPath := srcPath + "." + string(to)
+
+		if _, err := os.Stat(srcPath); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if _, err := os.Stat(dstPath); err == nil {
+			return nil, fmt.Errorf("conversion target %s already exists", dstPath)
+		}
+
+		if copied[dir], err = convertDB(from, srcPath, to, dstPath); err != nil {
+			return nil, fmt.Errorf("failed to convert %s database: %w", dir, err)
+		}
+	}
+
+	// swap the databases only when all of them are converted
+	for dir := range copied {
+		srcPath := filepath.Join(dataDir, dir)
+
+		if err := os.Rename(srcPath, srcPath+"."+string(from)); err != nil {
+			return nil, err
+		}
+
+		if err := os.Rename(srcPath+"."+string(to), srcPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := storage.WriteBackend(dataDir, to); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
+
+// convertDB copies the database at srcPath into a new database at dstPath
+func convertDB(from storage.Backend, srcPath string, to storage.Backend, dstPath string) (uint64, error) {
+	src, err := Open(from, srcPath, true)
+	if err != nil {
+		return 0, err
+	}
+
+	defer src.Close()
+
+	dst, err := Open(to, dstPath, false)
+	if err != nil {
+		return 0, err
+	}
+
+	copied, err := Copy(src, dst)
+	if err != nil {
+		_ = dst.Close()
+
+		return 0, err
+	}
+
+	return copied, dst.Close()
+}
@@ -0,0 +1,253 @@
+package kvdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Database is a raw key-value database of a data directory,
+// used by the offline database tooling
+type Database interface {
+	// Get returns the value stored under the key
+	Get(key []byte) ([]byte, bool, error)
+	// Put stores the value under the key
+	Put(key, value []byte) error
+	// Delete removes the key
+	Delete(key []byte) error
+	// Iterate calls fn for each key-value pair with the given prefix in key order.
+	// The slices passed to fn are only valid until fn returns
+	Iterate(prefix []byte, fn func(key, value []byte) error) error
+	// NewBatch creates a write batch
+	NewBatch() Batch
+	// Compact compacts the whole key space
+	Compact() error
+	// Close closes the database
+	Close() error
+}
+
+// Batch is a set of writes applied atomically
+type Batch interface {
+	Put(key, value []byte)
+	Delete(key []byte)
+	// Len returns the number of operations in the batch
+	Len() int
+	// Write applies the batch to the database
+	Write() error
+}
+
+// Open opens the raw database at the given path using the given backend
+func Open(backend storage.Backend, path string, readOnly bool) (Database, error) {
+	switch backend {
+	case storage.LevelDBBackend:
+		db, err := leveldb.OpenFile(path, &opt.Options{ReadOnly: readOnly, ErrorIfMissing: readOnly})
+		if err != nil {
+			return nil, err
+		}
+
+		return &levelDB{db: db}, nil
+	case storage.PebbleBackend:
+		db, err := pebble.Open(path, &pebble.Options{ReadOnly: readOnly, ErrorIfNotExists: readOnly})
+		if err != nil {
+			return nil, err
+		}
+
+		return &pebbleDB{db: db}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrUnknownBackend, backend)
+	}
+}
+
+// levelDB is the goleveldb implementation of Database
+type levelDB struct {
+	db *leveldb.DB
+}
+
+func (l *levelDB) Get(key []byte) ([]byte, bool, error) {
+	value, err := l.db.Get(key, nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (l *levelDB) Put(key, value []byte) error {
+	return l.db.Put(key, value, nil)
+}
+
+func (l *levelDB) Delete(key []byte) error {
+	return l.db.Delete(key, nil)
+}
+
+func (l *levelDB) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	iter := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
+
+func (l *levelDB) NewBatch() Batch {
+	return &levelDBBatch{db: l.db, batch: new(leveldb.Batch)}
+}
+
+func (l *levelDB) Compact() error {
+	return l.db.CompactRange(util.Range{})
+}
+
+func (l *levelDB) Close() error {
+	return l.db.Close()
+}
+
+type levelDBBatch struct {
+	db    *leveldb.DB
+	batch *leveldb.Batch
+}
+
+func (b *levelDBBatch) Put(key, value []byte) {
+	b.batch.Put(key, value)
+}
+
+func (b *levelDBBatch) Delete(key []byte) {
+	b.batch.Delete(key)
+}
+
+func (b *levelDBBatch) Len() int {
+	return b.batch.Len()
+}
+
+func (b *levelDBBatch) Write() error {
+	return b.db.Write(b.batch, nil)
+}
+
+// pebbleDB is the pebble implementation of Database
+type pebbleDB struct {
+	db *pebble.DB
+}
+
+func (p *pebbleDB) Get(key []byte) ([]byte, bool, error) {
+	data, closer, err := p.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	defer closer.Close()
+
+	value := make([]byte, len(data))
+	copy(value, data)
+
+	return value, true, nil
+}
+
+func (p *pebbleDB) Put(key, value []byte) error {
+	return p.db.Set(key, value, pebble.Sync)
+}
+
+func (p *pebbleDB) Delete(key []byte) error {
+	return p.db.Delete(key, pebble.Sync)
+}
+
+func (p *pebbleDB) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	iter := p.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: upperBound(prefix),
+	})
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			_ = iter.Close()
+
+			return err
+		}
+	}
+
+	return iter.Close()
+}
+
+func (p *pebbleDB) NewBatch() Batch {
+	return &pebbleBatch{batch: p.db.NewBatch()}
+}
+
+func (p *pebbleDB) Compact() error {
+	// pebble compaction requires an explicit key range, so find the last key first
+	iter := p.db.NewIter(nil)
+
+	var first, last []byte
+
+	if iter.First() {
+		first = append([]byte{}, iter.Key()...)
+	}
+
+	if iter.Last() {
+		last = append([]byte{}, iter.Key()...)
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	return p.db.Compact(first, append(last, 0x00), true)
+}
+
+func (p *pebbleDB) Close() error {
+	return p.db.Close()
+}
+
+type pebbleBatch struct {
+	batch *pebble.Batch
+}
+
+func (b *pebbleBatch) Put(key, value []byte) {
+	_ = b.batch.Set(key, value, nil)
+}
+
+func (b *pebbleBatch) Delete(key []byte) {
+	_ = b.batch.Delete(key, nil)
+}
+
+func (b *pebbleBatch) Len() int {
+	return int(b.batch.Count())
+}
+
+func (b *pebbleBatch) Write() error {
+	return b.batch.Commit(pebble.Sync)
+}
+
+// upperBound returns the smallest key greater than all keys with the given prefix,
+// or nil if there is no such key
+func upperBound(prefix []byte) []byte {
+	limit := append([]byte{}, prefix...)
+
+	for i := len(limit) - 1; i >= 0; i-- {
+		if limit[i] < 0xff {
+			limit[i]++
+
+			return limit[:i+1]
+		}
+	}
+
+	return nil
+}
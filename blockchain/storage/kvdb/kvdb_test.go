@@ -0,0 +1,97 @@
+package kvdb
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range []storage.Backend{storage.LevelDBBackend, storage.PebbleBackend} {
+		backend := backend
+
+		t.Run(string(backend), func(t *testing.T) {
+			t.Parallel()
+
+			db, err := Open(backend, t.TempDir(), false)
+			require.NoError(t, err)
+
+			defer db.Close()
+
+			require.NoError(t, db.Put([]byte("a1"), []byte("1")))
+			require.NoError(t, db.Put([]byte("b1"), []byte("2")))
+
+			batch := db.NewBatch()
+			batch.Put([]byte("a2"), []byte("3"))
+			batch.Put([]byte("a3"), []byte("4"))
+			batch.Delete([]byte("a3"))
+			require.NoError(t, batch.Write())
+
+			keys := []string{}
+			require.NoError(t, db.Iterate([]byte("a"), func(key, _ []byte) error {
+				keys = append(keys, string(key))
+
+				return nil
+			}))
+			assert.Equal(t, []string{"a1", "a2"}, keys)
+
+			value, ok, err := db.Get([]byte("b1"))
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("2"), value)
+
+			require.NoError(t, db.Delete([]byte("b1")))
+
+			_, ok, err = db.Get([]byte("b1"))
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			assert.NoError(t, db.Compact())
+		})
+	}
+}
+
+func TestConvertDataDir(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+
+	require.NoError(t, storage.WriteBackend(dataDir, storage.LevelDBBackend))
+
+	for _, dir := range storage.DatabaseDirs {
+		db, err := Open(storage.LevelDBBackend, filepath.Join(dataDir, dir), false)
+		require.NoError(t, err)
+
+		for i := 0; i < 100; i++ {
+			require.NoError(t, db.Put([]byte(fmt.Sprintf("%s-%d", dir, i)), []byte{byte(i)}))
+		}
+
+		require.NoError(t, db.Close())
+	}
+
+	copied, err := ConvertDataDir(dataDir, storage.PebbleBackend)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{storage.BlockchainDir: 100, storage.TrieDir: 100}, copied)
+
+	backend, _, err := storage.ReadBackend(dataDir)
+	require.NoError(t, err)
+	assert.Equal(t, storage.PebbleBackend, backend)
+
+	db, err := Open(storage.PebbleBackend, filepath.Join(dataDir, storage.TrieDir), true)
+	require.NoError(t, err)
+
+	value, ok, err := db.Get([]byte("trie-42"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{42}, value)
+	require.NoError(t, db.Close())
+
+	_, err = ConvertDataDir(dataDir, storage.PebbleBackend)
+	assert.Error(t, err)
+}
@@ -59,6 +59,11 @@ func (l *levelDBKV) Get(p []byte) ([]byte, bool, error) {
 	return data, true, nil
 }
 
+// Delete removes the key from leveldb storage
+func (l *levelDBKV) Delete(p []byte) error {
+	return l.db.Delete(p, nil)
+}
+
 // Close closes the leveldb storage instance
 func (l *levelDBKV) Close() error {
 	return l.db.Close()
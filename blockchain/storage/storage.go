@@ -11,12 +11,18 @@ import (
 type Storage interface {
 	ReadCanonicalHash(n uint64) (types.Hash, bool)
 	WriteCanonicalHash(n uint64, hash types.Hash) error
+	DeleteCanonicalHash(n uint64) error
 
 	ReadHeadHash() (types.Hash, bool)
 	ReadHeadNumber() (uint64, bool)
 	WriteHeadHash(h types.Hash) error
 	WriteHeadNumber(uint64) error
 
+	ReadSchemaVersion() (uint64, bool)
+	WriteSchemaVersion(version uint64) error
+	ReadMigrationProgress(version uint64) ([]byte, bool)
+	WriteMigrationProgress(version uint64, progress []byte) error
+
 	WriteForks(forks []types.Hash) error
 	ReadForks() ([]types.Hash, error)
 
@@ -36,6 +42,7 @@ type Storage interface {
 
 	WriteTxLookup(hash types.Hash, blockHash types.Hash) error
 	ReadTxLookup(hash types.Hash) (types.Hash, bool)
+	DeleteTxLookup(hash types.Hash) error
 
 	Close() error
 }
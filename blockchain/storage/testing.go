@@ -48,6 +48,12 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("testReceipts", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("testSchema", func(t *testing.T) {
+		testSchema(t, m)
+	})
+	t.Run("testTxLookup", func(t *testing.T) {
+		testTxLookup(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -101,6 +107,17 @@ func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
 			t.Fatal("not match")
 		}
 	}
+
+	// remove the last canonical hash
+	last := cases[len(cases)-1].Number
+
+	if err := s.DeleteCanonicalHash(last); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := s.ReadCanonicalHash(last); ok {
+		t.Fatal("canonical hash not deleted")
+	}
 }
 
 func testDifficulty(t *testing.T, m PlaceholderStorage) {
@@ -442,14 +459,65 @@ func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 	}
 }
 
+func testTxLookup(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	txHash := types.StringToHash("1")
+	blockHash := types.StringToHash("2")
+
+	assert.NoError(t, s.WriteTxLookup(txHash, blockHash))
+
+	found, ok := s.ReadTxLookup(txHash)
+	assert.True(t, ok)
+	assert.Equal(t, blockHash, found)
+
+	assert.NoError(t, s.DeleteTxLookup(txHash))
+
+	_, ok = s.ReadTxLookup(txHash)
+	assert.False(t, ok)
+}
+
+func testSchema(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	_, ok := s.ReadSchemaVersion()
+	assert.False(t, ok)
+
+	assert.NoError(t, s.WriteSchemaVersion(3))
+
+	version, ok := s.ReadSchemaVersion()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), version)
+
+	_, ok = s.ReadMigrationProgress(3)
+	assert.False(t, ok)
+
+	assert.NoError(t, s.WriteMigrationProgress(3, []byte{0x1, 0x2}))
+
+	progress, ok := s.ReadMigrationProgress(3)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x1, 0x2}, progress)
+}
+
 // Storage delegators
 
 type readCanonicalHashDelegate func(uint64) (types.Hash, bool)
 type writeCanonicalHashDelegate func(uint64, types.Hash) error
+type deleteCanonicalHashDelegate func(uint64) error
 type readHeadHashDelegate func() (types.Hash, bool)
 type readHeadNumberDelegate func() (uint64, bool)
 type writeHeadHashDelegate func(types.Hash) error
 type writeHeadNumberDelegate func(uint64) error
+type readSchemaVersionDelegate func() (uint64, bool)
+type writeSchemaVersionDelegate func(uint64) error
+type readMigrationProgressDelegate func(uint64) ([]byte, bool)
+type writeMigrationProgressDelegate func(uint64, []byte) error
 type writeForksDelegate func([]types.Hash) error
 type readForksDelegate func() ([]types.Hash, error)
 type writeTotalDifficultyDelegate func(types.Hash, *big.Int) error
@@ -465,29 +533,36 @@ type writeReceiptsDelegate func(types.Hash, []*types.Receipt) error
 type readReceiptsDelegate func(types.Hash) ([]*types.Receipt, error)
 type writeTxLookupDelegate func(types.Hash, types.Hash) error
 type readTxLookupDelegate func(types.Hash) (types.Hash, bool)
+type deleteTxLookupDelegate func(types.Hash) error
 type closeDelegate func() error
 
 type MockStorage struct {
-	readCanonicalHashFn    readCanonicalHashDelegate
-	writeCanonicalHashFn   writeCanonicalHashDelegate
-	readHeadHashFn         readHeadHashDelegate
-	readHeadNumberFn       readHeadNumberDelegate
-	writeHeadHashFn        writeHeadHashDelegate
-	writeHeadNumberFn      writeHeadNumberDelegate
-	writeForksFn           writeForksDelegate
-	readForksFn            readForksDelegate
-	writeTotalDifficultyFn writeTotalDifficultyDelegate
-	readTotalDifficultyFn  readTotalDifficultyDelegate
-	writeHeaderFn          writeHeaderDelegate
-	readHeaderFn           readHeaderDelegate
-	writeCanonicalHeaderFn writeCanonicalHeaderDelegate
-	writeBodyFn            writeBodyDelegate
-	readBodyFn             readBodyDelegate
-	writeReceiptsFn        writeReceiptsDelegate
-	readReceiptsFn         readReceiptsDelegate
-	writeTxLookupFn        writeTxLookupDelegate
-	readTxLookupFn         readTxLookupDelegate
-	closeFn                closeDelegate
+	readCanonicalHashFn      readCanonicalHashDelegate
+	writeCanonicalHashFn     writeCanonicalHashDelegate
+	deleteCanonicalHashFn    deleteCanonicalHashDelegate
+	readHeadHashFn           readHeadHashDelegate
+	readHeadNumberFn         readHeadNumberDelegate
+	writeHeadHashFn          writeHeadHashDelegate
+	writeHeadNumberFn        writeHeadNumberDelegate
+	readSchemaVersionFn      readSchemaVersionDelegate
+	writeSchemaVersionFn     writeSchemaVersionDelegate
+	readMigrationProgressFn  readMigrationProgressDelegate
+	writeMigrationProgressFn writeMigrationProgressDelegate
+	writeForksFn             writeForksDelegate
+	readForksFn              readForksDelegate
+	writeTotalDifficultyFn   writeTotalDifficultyDelegate
+	readTotalDifficultyFn    readTotalDifficultyDelegate
+	writeHeaderFn            writeHeaderDelegate
+	readHeaderFn             readHeaderDelegate
+	writeCanonicalHeaderFn   writeCanonicalHeaderDelegate
+	writeBodyFn              writeBodyDelegate
+	readBodyFn               readBodyDelegate
+	writeReceiptsFn          writeReceiptsDelegate
+	readReceiptsFn           readReceiptsDelegate
+	writeTxLookupFn          writeTxLookupDelegate
+	readTxLookupFn           readTxLookupDelegate
+	deleteTxLookupFn         deleteTxLookupDelegate
+	closeFn                  closeDelegate
 }
 
 func NewMockStorage() *MockStorage {
@@ -518,6 +593,18 @@ func (m *MockStorage) HookWriteCanonicalHash(fn writeCanonicalHashDelegate) {
 	m.writeCanonicalHashFn = fn
 }
 
+func (m *MockStorage) DeleteCanonicalHash(n uint64) error {
+	if m.deleteCanonicalHashFn != nil {
+		return m.deleteCanonicalHashFn(n)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookDeleteCanonicalHash(fn deleteCanonicalHashDelegate) {
+	m.deleteCanonicalHashFn = fn
+}
+
 func (m *MockStorage) ReadHeadHash() (types.Hash, bool) {
 	if m.readHeadHashFn != nil {
 		return m.readHeadHashFn()
@@ -566,6 +653,54 @@ func (m *MockStorage) HookWriteHeadNumber(fn writeHeadNumberDelegate) {
 	m.writeHeadNumberFn = fn
 }
 
+func (m *MockStorage) ReadSchemaVersion() (uint64, bool) {
+	if m.readSchemaVersionFn != nil {
+		return m.readSchemaVersionFn()
+	}
+
+	return 0, false
+}
+
+func (m *MockStorage) HookReadSchemaVersion(fn readSchemaVersionDelegate) {
+	m.readSchemaVersionFn = fn
+}
+
+func (m *MockStorage) WriteSchemaVersion(version uint64) error {
+	if m.writeSchemaVersionFn != nil {
+		return m.writeSchemaVersionFn(version)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookWriteSchemaVersion(fn writeSchemaVersionDelegate) {
+	m.writeSchemaVersionFn = fn
+}
+
+func (m *MockStorage) ReadMigrationProgress(version uint64) ([]byte, bool) {
+	if m.readMigrationProgressFn != nil {
+		return m.readMigrationProgressFn(version)
+	}
+
+	return nil, false
+}
+
+func (m *MockStorage) HookReadMigrationProgress(fn readMigrationProgressDelegate) {
+	m.readMigrationProgressFn = fn
+}
+
+func (m *MockStorage) WriteMigrationProgress(version uint64, progress []byte) error {
+	if m.writeMigrationProgressFn != nil {
+		return m.writeMigrationProgressFn(version, progress)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookWriteMigrationProgress(fn writeMigrationProgressDelegate) {
+	m.writeMigrationProgressFn = fn
+}
+
 func (m *MockStorage) WriteForks(forks []types.Hash) error {
 	if m.writeForksFn != nil {
 		return m.writeForksFn(forks)
@@ -722,6 +857,18 @@ func (m *MockStorage) HookReadTxLookup(fn readTxLookupDelegate) {
 	m.readTxLookupFn = fn
 }
 
+func (m *MockStorage) DeleteTxLookup(hash types.Hash) error {
+	if m.deleteTxLookupFn != nil {
+		return m.deleteTxLookupFn(hash)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookDeleteTxLookup(fn deleteTxLookupDelegate) {
+	m.deleteTxLookupFn = fn
+}
+
 func (m *MockStorage) Close() error {
 	if m.closeFn != nil {
 		return m.closeFn()
@@ -17,6 +17,12 @@ type Params struct {
 	// AllowList configuration
 	ContractDeployerAllowList *AllowListConfig `json:"contractDeployerAllowList,omitempty"`
 	TransactionsAllowList     *AllowListConfig `json:"transactionsAllowList,omitempty"`
+
+	// BlockList configuration, the enabled addresses are the blocked ones
+	TransactionsBlockList *AllowListConfig `json:"transactionsBlockList,omitempty"`
+
+	// TxOrdering configures the order of the transactions in the built blocks
+	TxOrdering *TxOrderingConfig `json:"txOrdering,omitempty"`
 }
 
 type AllowListConfig struct {
@@ -27,6 +33,29 @@ type AllowListConfig struct {
 	EnabledAddresses []types.Address `json:"enabledAddresses,omitempty"`
 }
 
+const (
+	// PriceTxOrdering includes the transactions with the highest gas price first
+	PriceTxOrdering = "price"
+	// FIFOTxOrdering includes the transactions in the order they were received
+	FIFOTxOrdering = "fifo"
+	// RoundRobinTxOrdering includes one transaction of each sender per round
+	RoundRobinTxOrdering = "round-robin"
+	// PriorityTxOrdering includes the transactions of the priority senders first
+	PriorityTxOrdering = "priority"
+)
+
+type TxOrderingConfig struct {
+	// Strategy is the ordering strategy, the gas price ordering is used if empty
+	Strategy string `json:"strategy"`
+
+	// PrioritySenders is the list of senders preferred by the priority strategy
+	PrioritySenders []types.Address `json:"prioritySenders,omitempty"`
+
+	// ReservedGasShare is the percentage of the block gas limit
+	// which only the priority senders can use
+	ReservedGasShare uint64 `json:"reservedGasShare,omitempty"`
+}
+
 func (p *Params) GetEngine() string {
 	// We know there is already one
 	for k := range p.Engine {
@@ -52,6 +81,7 @@ type Forks struct {
 	EIP150         *Fork `json:"EIP150,omitempty"`
 	EIP158         *Fork `json:"EIP158,omitempty"`
 	EIP155         *Fork `json:"EIP155,omitempty"`
+	FeeDelegation  *Fork `json:"feeDelegation,omitempty"`
 }
 
 func (f *Forks) active(ff *Fork, block uint64) bool {
@@ -94,6 +124,10 @@ func (f *Forks) IsEIP155(block uint64) bool {
 	return f.active(f.EIP155, block)
 }
 
+func (f *Forks) IsFeeDelegation(block uint64) bool {
+	return f.active(f.FeeDelegation, block)
+}
+
 func (f *Forks) At(block uint64) ForksInTime {
 	return ForksInTime{
 		Homestead:      f.active(f.Homestead, block),
@@ -105,6 +139,7 @@ func (f *Forks) At(block uint64) ForksInTime {
 		EIP150:         f.active(f.EIP150, block),
 		EIP158:         f.active(f.EIP158, block),
 		EIP155:         f.active(f.EIP155, block),
+		FeeDelegation:  f.active(f.FeeDelegation, block),
 	}
 }
 
@@ -133,7 +168,8 @@ type ForksInTime struct {
 	London,
 	EIP150,
 	EIP158,
-	EIP155 bool
+	EIP155,
+	FeeDelegation bool
 }
 
 var AllForksEnabled = &Forks{
@@ -146,4 +182,5 @@ var AllForksEnabled = &Forks{
 	Petersburg:     NewFork(0),
 	Istanbul:       NewFork(0),
 	London:         NewFork(0),
+	FeeDelegation:  NewFork(0),
 }
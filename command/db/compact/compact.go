@@ -0,0 +1,43 @@
+package compact
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	compactCmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Compacts the key-value databases of the data directory to reclaim the space of deleted keys",
+		Run:   runCommand,
+	}
+
+	setFlags(compactCmd)
+	helper.SetRequiredFlags(compactCmd, params.getRequiredFlags())
+
+	return compactCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.compact(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,101 @@
+package compact
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/kvdb"
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+)
+
+var (
+	params = &compactParams{}
+)
+
+type compactParams struct {
+	dataDir string
+
+	backend storage.Backend
+	sizes   []*DatabaseSize
+}
+
+func (p *compactParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+	}
+}
+
+func (p *compactParams) compact() error {
+	var err error
+
+	if p.backend, err = dbHelper.ReadBackend(p.dataDir); err != nil {
+		return err
+	}
+
+	for _, dir := range storage.DatabaseDirs {
+		path := filepath.Join(p.dataDir, dir)
+
+		size := &DatabaseSize{Database: dir}
+
+		if size.Before, err = dirSize(path); err != nil {
+			return err
+		}
+
+		if err := compactDatabase(p.backend, path); err != nil {
+			return fmt.Errorf("failed to compact %s database: %w", dir, err)
+		}
+
+		if size.After, err = dirSize(path); err != nil {
+			return err
+		}
+
+		p.sizes = append(p.sizes, size)
+	}
+
+	return nil
+}
+
+func compactDatabase(backend storage.Backend, path string) error {
+	db, err := kvdb.Open(backend, path, false)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Compact(); err != nil {
+		_ = db.Close()
+
+		return err
+	}
+
+	return db.Close()
+}
+
+// dirSize returns the total size of the files in the directory
+func dirSize(path string) (uint64, error) {
+	var size uint64
+
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size, err
+}
+
+func (p *compactParams) getResult() command.CommandResult {
+	return &CompactResult{
+		DataDir:   p.dataDir,
+		Backend:   string(p.backend),
+		Databases: p.sizes,
+	}
+}
@@ -0,0 +1,39 @@
+package compact
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type DatabaseSize struct {
+	Database string `json:"database"`
+	Before   uint64 `json:"before"`
+	After    uint64 `json:"after"`
+}
+
+type CompactResult struct {
+	DataDir   string          `json:"dataDir"`
+	Backend   string          `json:"backend"`
+	Databases []*DatabaseSize `json:"databases"`
+}
+
+func (r *CompactResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Backend|%s", r.Backend),
+	}
+
+	for _, d := range r.Databases {
+		vals = append(vals, fmt.Sprintf("Size (%s)|%d -> %d bytes", d.Database, d.Before, d.After))
+	}
+
+	buffer.WriteString("\n[DB COMPACT]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,56 @@
+package convert
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	convertCmd := &cobra.Command{
+		Use: "convert",
+		Short: "Converts the databases of the data directory to another storage backend. " +
+			"The original databases are kept with the previous backend name as a suffix",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(convertCmd)
+	helper.SetRequiredFlags(convertCmd, params.getRequiredFlags())
+
+	return convertCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+
+	cmd.Flags().StringVar(
+		&params.toRaw,
+		toFlag,
+		"",
+		"the storage backend to convert to (leveldb or pebble)",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.convert(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
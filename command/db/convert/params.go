@@ -0,0 +1,56 @@
+package convert
+
+import (
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/kvdb"
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+)
+
+const (
+	toFlag = "to"
+)
+
+var (
+	params = &convertParams{}
+)
+
+type convertParams struct {
+	dataDir string
+	toRaw   string
+
+	to storage.Backend
+
+	copied map[string]uint64
+}
+
+func (p *convertParams) validateFlags() error {
+	var err error
+
+	p.to, err = storage.ParseBackend(p.toRaw)
+
+	return err
+}
+
+func (p *convertParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+		toFlag,
+	}
+}
+
+func (p *convertParams) convert() error {
+	var err error
+
+	p.copied, err = kvdb.ConvertDataDir(p.dataDir, p.to)
+
+	return err
+}
+
+func (p *convertParams) getResult() command.CommandResult {
+	return &ConvertResult{
+		DataDir: p.dataDir,
+		Backend: string(p.to),
+		Keys:    p.copied,
+	}
+}
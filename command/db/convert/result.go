@@ -0,0 +1,41 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type ConvertResult struct {
+	DataDir string            `json:"dataDir"`
+	Backend string            `json:"backend"`
+	Keys    map[string]uint64 `json:"keys"`
+}
+
+func (r *ConvertResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	dirs := make([]string, 0, len(r.Keys))
+	for dir := range r.Keys {
+		dirs = append(dirs, dir)
+	}
+
+	sort.Strings(dirs)
+
+	vals := []string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Backend|%s", r.Backend),
+	}
+
+	for _, dir := range dirs {
+		vals = append(vals, fmt.Sprintf("Copied keys (%s)|%d", dir, r.Keys[dir]))
+	}
+
+	buffer.WriteString("\n[DB CONVERT]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"github.com/plingatech/go-plgchain/command/db/compact"
+	"github.com/plingatech/go-plgchain/command/db/convert"
+	"github.com/plingatech/go-plgchain/command/db/inspect"
+	"github.com/plingatech/go-plgchain/command/db/repairhead"
+	"github.com/plingatech/go-plgchain/command/db/verify"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use: "db",
+		Short: "Top level command for offline maintenance of the node databases. " +
+			"The node using the data directory must be stopped. Only accepts subcommands.",
+	}
+
+	registerSubcommands(dbCmd)
+
+	return dbCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// db convert
+		convert.GetCommand(),
+		// db inspect
+		inspect.GetCommand(),
+		// db compact
+		compact.GetCommand(),
+		// db verify
+		verify.GetCommand(),
+		// db repair-head
+		repairhead.GetCommand(),
+	)
+}
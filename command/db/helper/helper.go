@@ -0,0 +1,155 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/server"
+	itrie "github.com/plingatech/go-plgchain/state/immutable-trie"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/go-plgchain/types/buildroot"
+)
+
+const (
+	DataDirFlag = "data-dir"
+)
+
+var (
+	errNoDatabases = errors.New("no databases found in the data directory")
+)
+
+// ReadBackend returns the storage backend of the data directory
+func ReadBackend(dataDir string) (storage.Backend, error) {
+	if _, err := os.Stat(dataDir); err != nil {
+		return "", fmt.Errorf("invalid data directory: %w", err)
+	}
+
+	backend, exists, err := storage.ReadBackend(dataDir)
+	if err != nil {
+		return "", err
+	}
+
+	if !exists {
+		return "", fmt.Errorf("%w: %s", errNoDatabases, dataDir)
+	}
+
+	return backend, nil
+}
+
+// OpenStorages opens the blockchain storage, including the ancient store,
+// and the state trie storage of the data directory
+func OpenStorages(dataDir string) (storage.Storage, itrie.Storage, error) {
+	backend, err := ReadBackend(dataDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger := hclog.NewNullLogger()
+
+	db, err := server.NewBlockchainStorage(backend, filepath.Join(dataDir, storage.BlockchainDir), logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open blockchain database: %w", err)
+	}
+
+	if err := server.SetupAncientStore(db, filepath.Join(dataDir, storage.AncientDir)); err != nil {
+		_ = db.Close()
+
+		return nil, nil, err
+	}
+
+	stateStorage, err := server.NewStateStorage(backend, filepath.Join(dataDir, storage.TrieDir), logger)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, nil, fmt.Errorf("failed to open trie database: %w", err)
+	}
+
+	return db, stateStorage, nil
+}
+
+// CheckBlock checks the canonical block with the given number is stored completely and consistently:
+// the canonical hash, the header linked to the parent, the total difficulty, the body matching the header,
+// the receipts of all transactions and the transaction lookups.
+// If stateStorage is set, it also checks the state root of the block is present.
+// It returns the header of the block, if found, and the list of found issues
+func CheckBlock(
+	db storage.Storage,
+	stateStorage itrie.Storage,
+	number uint64,
+	parent *types.Header,
+) (*types.Header, []string) {
+	issues := []string{}
+
+	hash, ok := db.ReadCanonicalHash(number)
+	if !ok {
+		return nil, append(issues, "canonical hash not found")
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, append(issues, fmt.Sprintf("header %s not found: %v", hash, err))
+	}
+
+	header.ComputeHash()
+
+	if header.Hash != hash {
+		issues = append(issues, fmt.Sprintf("header hash %s doesn't match canonical hash %s", header.Hash, hash))
+	}
+
+	if header.Number != number {
+		issues = append(issues, fmt.Sprintf("header has number %d", header.Number))
+	}
+
+	if parent != nil && header.ParentHash != parent.Hash {
+		issues = append(issues, fmt.Sprintf("parent hash %s doesn't match block %d hash %s",
+			header.ParentHash, parent.Number, parent.Hash))
+	}
+
+	if _, ok := db.ReadTotalDifficulty(hash); !ok {
+		issues = append(issues, "total difficulty not found")
+	}
+
+	if stateStorage != nil && header.StateRoot != types.EmptyRootHash {
+		if _, ok := stateStorage.Get(header.StateRoot.Bytes()); !ok {
+			issues = append(issues, fmt.Sprintf("state root %s not found", header.StateRoot))
+		}
+	}
+
+	// genesis has no body nor receipts
+	if number == 0 {
+		return header, issues
+	}
+
+	body, err := db.ReadBody(hash)
+	if err != nil {
+		return header, append(issues, fmt.Sprintf("body not found: %v", err))
+	}
+
+	if txRoot := buildroot.CalculateTransactionsRoot(body.Transactions); txRoot != header.TxRoot {
+		issues = append(issues, fmt.Sprintf("transactions root %s doesn't match header %s", txRoot, header.TxRoot))
+	}
+
+	receipts, err := db.ReadReceipts(hash)
+	if err != nil {
+		return header, append(issues, fmt.Sprintf("receipts not found: %v", err))
+	}
+
+	if len(receipts) != len(body.Transactions) {
+		issues = append(issues, fmt.Sprintf("%d receipts for %d transactions", len(receipts), len(body.Transactions)))
+	}
+
+	for _, tx := range body.Transactions {
+		blockHash, ok := db.ReadTxLookup(tx.Hash)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("lookup of transaction %s not found", tx.Hash))
+		} else if blockHash != hash {
+			issues = append(issues, fmt.Sprintf("lookup of transaction %s points to block %s", tx.Hash, blockHash))
+		}
+	}
+
+	return header, issues
+}
@@ -0,0 +1,82 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/memory"
+	itrie "github.com/plingatech/go-plgchain/state/immutable-trie"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestChain(t *testing.T, db storage.Storage, stateStorage itrie.Storage, length int) []*types.Header {
+	t.Helper()
+
+	headers := make([]*types.Header, 0, length)
+	parentHash := types.ZeroHash
+
+	for i := 0; i < length; i++ {
+		header := &types.Header{
+			Number:       uint64(i),
+			ParentHash:   parentHash,
+			StateRoot:    types.StringToHash("0x1"),
+			TxRoot:       types.EmptyRootHash,
+			ReceiptsRoot: types.EmptyRootHash,
+			Sha3Uncles:   types.EmptyUncleHash,
+		}
+		header.ComputeHash()
+
+		require.NoError(t, db.WriteHeader(header))
+		require.NoError(t, db.WriteCanonicalHash(header.Number, header.Hash))
+		require.NoError(t, db.WriteTotalDifficulty(header.Hash, big.NewInt(int64(i))))
+		require.NoError(t, db.WriteBody(header.Hash, &types.Body{}))
+		require.NoError(t, db.WriteReceipts(header.Hash, []*types.Receipt{}))
+
+		headers = append(headers, header)
+		parentHash = header.Hash
+	}
+
+	stateStorage.Put(types.StringToHash("0x1").Bytes(), []byte{0x1})
+
+	return headers
+}
+
+func TestCheckBlock(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	stateStorage := itrie.NewMemoryStorage()
+
+	headers := writeTestChain(t, db, stateStorage, 3)
+
+	var parent *types.Header
+
+	for i, expected := range headers {
+		header, issues := CheckBlock(db, stateStorage, uint64(i), parent)
+
+		assert.Empty(t, issues)
+		require.NotNil(t, header)
+		assert.Equal(t, expected.Hash, header.Hash)
+
+		parent = header
+	}
+
+	// the block is unknown
+	header, issues := CheckBlock(db, stateStorage, 3, parent)
+	assert.Nil(t, header)
+	assert.Len(t, issues, 1)
+
+	// the parent is not linked
+	_, issues = CheckBlock(db, stateStorage, 2, headers[0])
+	assert.Len(t, issues, 1)
+
+	// the state is missing
+	_, issues = CheckBlock(db, itrie.NewMemoryStorage(), 1, headers[0])
+	assert.Len(t, issues, 1)
+}
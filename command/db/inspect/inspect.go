@@ -0,0 +1,43 @@
+package inspect
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	inspectCmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Prints the number of keys and their total size per key category of the data directory databases",
+		Run:   runCommand,
+	}
+
+	setFlags(inspectCmd)
+	helper.SetRequiredFlags(inspectCmd, params.getRequiredFlags())
+
+	return inspectCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.inspect(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,165 @@
+package inspect
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/ancient"
+	"github.com/plingatech/go-plgchain/blockchain/storage/kvdb"
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+var (
+	params = &inspectParams{}
+)
+
+var (
+	// blockchainCategories are the key categories of the blockchain database by key prefix
+	blockchainCategories = map[string]string{
+		string(storage.DIFFICULTY):       "difficulties",
+		string(storage.HEADER):           "headers",
+		string(storage.HEAD):             "head",
+		string(storage.FORK):             "forks",
+		string(storage.CANONICAL):        "canonical hashes",
+		string(storage.BODY):             "bodies",
+		string(storage.RECEIPTS):         "receipts",
+		string(storage.SNAPSHOTS):        "snapshots",
+		string(storage.TX_LOOKUP_PREFIX): "transaction lookups",
+		string(storage.ANCIENT_NUMBER):   "ancient block numbers",
+		string(storage.SCHEMA):           "schema",
+	}
+
+	trieCodePrefix    = []byte("code")
+	trieSchemaVersion = []byte("schema-version")
+)
+
+type inspectParams struct {
+	dataDir string
+
+	backend    storage.Backend
+	categories []*Category
+	ancients   *Category
+}
+
+func (p *inspectParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+	}
+}
+
+func (p *inspectParams) inspect() error {
+	var err error
+
+	if p.backend, err = dbHelper.ReadBackend(p.dataDir); err != nil {
+		return err
+	}
+
+	blockchainStats, err := p.inspectDatabase(storage.BlockchainDir, blockchainCategory)
+	if err != nil {
+		return err
+	}
+
+	trieStats, err := p.inspectDatabase(storage.TrieDir, trieCategory)
+	if err != nil {
+		return err
+	}
+
+	p.categories = append(blockchainStats, trieStats...)
+
+	return p.inspectAncients()
+}
+
+// inspectDatabase iterates over all the keys of the database in the given data directory subfolder
+// and groups them by the category returned by categorize
+func (p *inspectParams) inspectDatabase(dir string, categorize func(key []byte) string) ([]*Category, error) {
+	db, err := kvdb.Open(p.backend, filepath.Join(p.dataDir, dir), true)
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.Close()
+
+	byName := map[string]*Category{}
+	categories := []*Category{}
+
+	err = db.Iterate(nil, func(key, value []byte) error {
+		name := categorize(key)
+
+		category, ok := byName[name]
+		if !ok {
+			category = &Category{Database: dir, Name: name}
+			byName[name] = category
+			categories = append(categories, category)
+		}
+
+		category.Keys++
+		category.Size += uint64(len(key) + len(value))
+
+		return nil
+	})
+
+	return categories, err
+}
+
+// inspectAncients reads the number of blocks and the size of the ancient store, if the data directory has one
+func (p *inspectParams) inspectAncients() error {
+	dir := filepath.Join(p.dataDir, storage.AncientDir)
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	store, err := ancient.NewStore(dir)
+	if err != nil {
+		return err
+	}
+
+	defer store.Close()
+
+	p.ancients = &Category{
+		Database: storage.AncientDir,
+		Name:     "blocks",
+		Keys:     store.Ancients(),
+		Size:     store.Size(),
+	}
+
+	return nil
+}
+
+func blockchainCategory(key []byte) string {
+	if len(key) > 0 {
+		if name, ok := blockchainCategories[string(key[:1])]; ok {
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
+func trieCategory(key []byte) string {
+	switch {
+	case bytes.Equal(key, trieSchemaVersion):
+		return "schema"
+	case len(key) == len(trieCodePrefix)+types.HashLength && bytes.HasPrefix(key, trieCodePrefix):
+		return "contract codes"
+	default:
+		return "trie nodes"
+	}
+}
+
+func (p *inspectParams) getResult() command.CommandResult {
+	categories := p.categories
+	if p.ancients != nil {
+		categories = append(categories, p.ancients)
+	}
+
+	return &InspectResult{
+		DataDir:    p.dataDir,
+		Backend:    string(p.backend),
+		Categories: categories,
+	}
+}
@@ -0,0 +1,49 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type Category struct {
+	Database string `json:"database"`
+	Name     string `json:"name"`
+	Keys     uint64 `json:"keys"`
+	Size     uint64 `json:"size"`
+}
+
+type InspectResult struct {
+	DataDir    string      `json:"dataDir"`
+	Backend    string      `json:"backend"`
+	Categories []*Category `json:"categories"`
+}
+
+func (r *InspectResult) GetOutput() string {
+	var (
+		buffer    bytes.Buffer
+		totalKeys uint64
+		totalSize uint64
+	)
+
+	vals := []string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Backend|%s", r.Backend),
+	}
+
+	for _, c := range r.Categories {
+		vals = append(vals, fmt.Sprintf("%s (%s)|%d items, %d bytes", c.Name, c.Database, c.Keys, c.Size))
+
+		totalKeys += c.Keys
+		totalSize += c.Size
+	}
+
+	vals = append(vals, fmt.Sprintf("Total|%d items, %d bytes", totalKeys, totalSize))
+
+	buffer.WriteString("\n[DB INSPECT]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
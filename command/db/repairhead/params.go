@@ -0,0 +1,104 @@
+package repairhead
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+const (
+	dryRunFlag = "dry-run"
+)
+
+var (
+	params = &repairHeadParams{}
+
+	errNoConsistentBlock = errors.New("genesis block is not consistent, the database can't be repaired")
+)
+
+type repairHeadParams struct {
+	dataDir string
+	dryRun  bool
+
+	previousHead *uint64
+	head         *types.Header
+	repaired     bool
+}
+
+func (p *repairHeadParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+	}
+}
+
+func (p *repairHeadParams) repairHead() error {
+	db, stateStorage, err := dbHelper.OpenStorages(p.dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = stateStorage.Close()
+		_ = db.Close()
+	}()
+
+	headHash, hasHeadHash := db.ReadHeadHash()
+
+	if number, ok := db.ReadHeadNumber(); ok {
+		p.previousHead = &number
+	}
+
+	// walk the canonical chain while the blocks are consistent,
+	// past the stored head number there is nothing to go back to
+	var parent *types.Header
+
+	for number := uint64(0); p.previousHead == nil || number <= *p.previousHead; number++ {
+		header, issues := dbHelper.CheckBlock(db, stateStorage, number, parent)
+		if header == nil || len(issues) > 0 {
+			break
+		}
+
+		parent = header
+	}
+
+	if parent == nil {
+		return errNoConsistentBlock
+	}
+
+	p.head = parent
+
+	if hasHeadHash && p.previousHead != nil && headHash == parent.Hash && *p.previousHead == parent.Number {
+		// the head is already consistent
+		return nil
+	}
+
+	if p.dryRun {
+		return nil
+	}
+
+	if err := db.WriteHeadHash(parent.Hash); err != nil {
+		return fmt.Errorf("failed to write head hash: %w", err)
+	}
+
+	if err := db.WriteHeadNumber(parent.Number); err != nil {
+		return fmt.Errorf("failed to write head number: %w", err)
+	}
+
+	p.repaired = true
+
+	return nil
+}
+
+func (p *repairHeadParams) getResult() command.CommandResult {
+	return &RepairHeadResult{
+		DataDir:      p.dataDir,
+		PreviousHead: p.previousHead,
+		Head:         p.head.Number,
+		HeadHash:     p.head.Hash.String(),
+		Repaired:     p.repaired,
+		DryRun:       p.dryRun,
+	}
+}
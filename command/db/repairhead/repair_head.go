@@ -0,0 +1,51 @@
+package repairhead
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	repairHeadCmd := &cobra.Command{
+		Use: "repair-head",
+		Short: "Moves the chain head back to the last block which is stored completely and consistently " +
+			"together with its state, so the node can start after an unclean shutdown",
+		Run: runCommand,
+	}
+
+	setFlags(repairHeadCmd)
+	helper.SetRequiredFlags(repairHeadCmd, params.getRequiredFlags())
+
+	return repairHeadCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.dryRun,
+		dryRunFlag,
+		false,
+		"only find the last consistent block without moving the head",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.repairHead(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,44 @@
+package repairhead
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type RepairHeadResult struct {
+	DataDir      string  `json:"dataDir"`
+	PreviousHead *uint64 `json:"previousHead,omitempty"`
+	Head         uint64  `json:"head"`
+	HeadHash     string  `json:"headHash"`
+	Repaired     bool    `json:"repaired"`
+	DryRun       bool    `json:"dryRun"`
+}
+
+func (r *RepairHeadResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	previousHead := "none"
+	if r.PreviousHead != nil {
+		previousHead = fmt.Sprintf("%d", *r.PreviousHead)
+	}
+
+	vals := []string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Previous head|%s", previousHead),
+		fmt.Sprintf("Last consistent block|%d", r.Head),
+		fmt.Sprintf("Last consistent block hash|%s", r.HeadHash),
+		fmt.Sprintf("Head moved|%t", r.Repaired),
+	}
+
+	if r.DryRun {
+		vals = append(vals, "Dry run|true")
+	}
+
+	buffer.WriteString("\n[DB REPAIR HEAD]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,105 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+const (
+	maxIssuesFlag = "max-issues"
+
+	defaultMaxIssues = 20
+)
+
+var (
+	params = &verifyParams{}
+
+	errHeadNotFound = errors.New("chain head not found")
+)
+
+type verifyParams struct {
+	dataDir   string
+	maxIssues uint64
+
+	head            uint64
+	verified        uint64
+	firstInvalid    *uint64
+	issues          []string
+	truncatedIssues bool
+}
+
+func (p *verifyParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+	}
+}
+
+func (p *verifyParams) verify() error {
+	db, stateStorage, err := dbHelper.OpenStorages(p.dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = stateStorage.Close()
+		_ = db.Close()
+	}()
+
+	headHash, ok := db.ReadHeadHash()
+	if !ok {
+		return errHeadNotFound
+	}
+
+	if p.head, ok = db.ReadHeadNumber(); !ok {
+		return errHeadNotFound
+	}
+
+	if canonicalHash, ok := db.ReadCanonicalHash(p.head); !ok || canonicalHash != headHash {
+		p.addIssue(p.head, fmt.Sprintf("head hash %s is not the canonical hash", headHash))
+	}
+
+	var parent *types.Header
+
+	for number := uint64(0); number <= p.head; number++ {
+		header, issues := dbHelper.CheckBlock(db, stateStorage, number, parent)
+
+		for _, issue := range issues {
+			p.addIssue(number, issue)
+		}
+
+		p.verified++
+		parent = header
+	}
+
+	return nil
+}
+
+func (p *verifyParams) addIssue(number uint64, issue string) {
+	if p.firstInvalid == nil || number < *p.firstInvalid {
+		n := number
+		p.firstInvalid = &n
+	}
+
+	if uint64(len(p.issues)) >= p.maxIssues {
+		p.truncatedIssues = true
+
+		return
+	}
+
+	p.issues = append(p.issues, fmt.Sprintf("block %d: %s", number, issue))
+}
+
+func (p *verifyParams) getResult() command.CommandResult {
+	return &VerifyResult{
+		DataDir:         p.dataDir,
+		Head:            p.head,
+		Verified:        p.verified,
+		FirstInvalid:    p.firstInvalid,
+		Issues:          p.issues,
+		TruncatedIssues: p.truncatedIssues,
+	}
+}
@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type VerifyResult struct {
+	DataDir         string   `json:"dataDir"`
+	Head            uint64   `json:"head"`
+	Verified        uint64   `json:"verified"`
+	FirstInvalid    *uint64  `json:"firstInvalid,omitempty"`
+	Issues          []string `json:"issues"`
+	TruncatedIssues bool     `json:"truncatedIssues"`
+}
+
+func (r *VerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Head|%d", r.Head),
+		fmt.Sprintf("Verified blocks|%d", r.Verified),
+	}
+
+	if r.FirstInvalid == nil {
+		vals = append(vals, "Status|consistent")
+	} else {
+		vals = append(vals,
+			"Status|inconsistent",
+			fmt.Sprintf("First inconsistent block|%d", *r.FirstInvalid),
+		)
+	}
+
+	buffer.WriteString("\n[DB VERIFY]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	if len(r.Issues) > 0 {
+		buffer.WriteString("\n[ISSUES]\n")
+
+		for _, issue := range r.Issues {
+			buffer.WriteString(issue)
+			buffer.WriteString("\n")
+		}
+
+		if r.TruncatedIssues {
+			buffer.WriteString("...\n")
+		}
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,51 @@
+package verify
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use: "verify",
+		Short: "Walks the canonical chain from the genesis to the head and checks every block " +
+			"is stored completely and consistently",
+		Run: runCommand,
+	}
+
+	setFlags(verifyCmd)
+	helper.SetRequiredFlags(verifyCmd, params.getRequiredFlags())
+
+	return verifyCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.maxIssues,
+		maxIssuesFlag,
+		defaultMaxIssues,
+		"the maximum number of reported issues",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.verify(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -177,6 +177,14 @@ func setFlags(cmd *cobra.Command) {
 			"the predefined period which determines block creation frequency",
 		)
 
+		cmd.Flags().DurationVar(
+			&params.maxBlockInterval,
+			maxBlockIntervalFlag,
+			0,
+			"the maximum period between blocks if there are no pending transactions "+
+				"(empty blocks are skipped up to this period, disabled if zero)",
+		)
+
 		cmd.Flags().StringVar(
 			&params.bridgeJSONRPCAddr,
 			bridgeFlag,
@@ -251,6 +259,23 @@ func setFlags(cmd *cobra.Command) {
 			"list of addresses to enable by default in the transactions allow list",
 		)
 	}
+
+	// Block list
+	{
+		cmd.Flags().StringArrayVar(
+			&params.transactionsBlockListAdmin,
+			transactionsBlockListAdminFlag,
+			[]string{},
+			"list of addresses to use as admin accounts in the transactions block list",
+		)
+
+		cmd.Flags().StringArrayVar(
+			&params.transactionsBlockListBlocked,
+			transactionsBlockListBlockedFlag,
+			[]string{},
+			"list of addresses blocked by default from sending or receiving transactions",
+		)
+	}
 }
 
 // setLegacyFlags sets the legacy flags to preserve backwards compatibility
@@ -55,6 +55,7 @@ var (
 	errValidatorsNotSpecified = errors.New("validator information not specified")
 	errUnsupportedConsensus   = errors.New("specified consensusRaw not supported")
 	errInvalidEpochSize       = errors.New("epoch size must be greater than 1")
+	errInvalidBlockInterval   = errors.New("max block interval must not be shorter than block time")
 	errInvalidTokenParams     = errors.New("native token params were not submitted in proper" +
 		" format <name:symbol:decimals count>")
 )
@@ -94,6 +95,7 @@ type genesisParams struct {
 	validatorSetSize        int
 	sprintSize              uint64
 	blockTime               time.Duration
+	maxBlockInterval        time.Duration
 	bridgeJSONRPCAddr       string
 	epochReward             uint64
 	eventTrackerStartBlocks []string
@@ -106,6 +108,10 @@ type genesisParams struct {
 	transactionsAllowListAdmin       []string
 	transactionsAllowListEnabled     []string
 
+	// blocklist
+	transactionsBlockListAdmin   []string
+	transactionsBlockListBlocked []string
+
 	mintableNativeToken  bool
 	nativeTokenConfigRaw string
 	nativeTokenConfig    *plgbft.TokenConfig
@@ -137,6 +143,11 @@ func (p *genesisParams) validateFlags() error {
 		return errInvalidEpochSize
 	}
 
+	// Check that empty blocks can be produced within the max block interval
+	if p.maxBlockInterval != 0 && p.maxBlockInterval < p.blockTime {
+		return errInvalidBlockInterval
+	}
+
 	// Validate min and max validators number
 	if err := command.ValidateMinMaxValidatorsNumber(p.minNumValidators, p.maxNumValidators); err != nil {
 		return err
@@ -309,13 +320,19 @@ func (p *genesisParams) initConsensusEngineConfig() {
 }
 
 func (p *genesisParams) initIBFTEngineMap(ibftType fork.IBFTType) {
+	engineConfig := map[string]interface{}{
+		fork.KeyType:          ibftType,
+		fork.KeyValidatorType: p.ibftValidatorType,
+		fork.KeyBlockTime:     p.blockTime,
+		ibft.KeyEpochSize:     p.epochSize,
+	}
+
+	if p.maxBlockInterval != 0 {
+		engineConfig[ibft.KeyMaxBlockInterval] = p.maxBlockInterval
+	}
+
 	p.consensusEngineConfig = map[string]interface{}{
-		string(server.IBFTConsensus): map[string]interface{}{
-			fork.KeyType:          ibftType,
-			fork.KeyValidatorType: p.ibftValidatorType,
-			fork.KeyBlockTime:     p.blockTime,
-			ibft.KeyEpochSize:     p.epochSize,
-		},
+		string(server.IBFTConsensus): engineConfig,
 	}
 }
 
@@ -27,6 +27,7 @@ const (
 	validatorSetSizeFlag   = "validator-set-size"
 	sprintSizeFlag         = "sprint-size"
 	blockTimeFlag          = "block-time"
+	maxBlockIntervalFlag   = "max-block-interval"
 	bridgeFlag             = "bridge-json-rpc"
 	trackerStartBlocksFlag = "tracker-start-blocks"
 	trieRootFlag           = "trieroot"
@@ -43,6 +44,8 @@ const (
 	contractDeployerAllowListEnabledFlag = "contract-deployer-allow-list-enabled"
 	transactionsAllowListAdminFlag       = "transactions-allow-list-admin"
 	transactionsAllowListEnabledFlag     = "transactions-allow-list-enabled"
+	transactionsBlockListAdminFlag       = "transactions-block-list-admin"
+	transactionsBlockListBlockedFlag     = "transactions-block-list-blocked"
 
 	bootnodePortStart = 30342
 )
@@ -90,6 +93,7 @@ func (p *genesisParams) generatePlgBftChainConfig(o command.OutputFormatter) err
 	plgBftConfig := &plgbft.PlgBFTConfig{
 		InitialValidatorSet: manifest.GenesisValidators,
 		BlockTime:           common.Duration{Duration: p.blockTime},
+		MaxBlockInterval:    common.Duration{Duration: p.maxBlockInterval},
 		EpochSize:           p.epochSize,
 		SprintSize:          p.sprintSize,
 		EpochReward:         p.epochReward,
@@ -226,6 +230,15 @@ func (p *genesisParams) generatePlgBftChainConfig(o command.OutputFormatter) err
 		}
 	}
 
+	if len(p.transactionsBlockListAdmin) != 0 {
+		// only enable block list if there is at least one address as **admin**, otherwise
+		// the block list could never be updated
+		chainConfig.Params.TransactionsBlockList = &chain.AllowListConfig{
+			AdminAddresses:   stringSliceToAddressSlice(p.transactionsBlockListAdmin),
+			EnabledAddresses: stringSliceToAddressSlice(p.transactionsBlockListBlocked),
+		}
+	}
+
 	return helper.WriteGenesisConfigToDisk(chainConfig, params.genesisPath)
 }
 
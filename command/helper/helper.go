@@ -11,6 +11,7 @@ import (
 	"github.com/plingatech/go-plgchain/chain"
 	"github.com/plingatech/go-plgchain/command"
 	ibftOp "github.com/plingatech/go-plgchain/consensus/ibft/proto"
+	plgbftOp "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
 	"github.com/plingatech/go-plgchain/helper/common"
 	"github.com/plingatech/go-plgchain/server"
 	"github.com/plingatech/go-plgchain/server/proto"
@@ -136,6 +137,19 @@ func GetIBFTOperatorClientConnection(address string) (
 	return ibftOp.NewIbftOperatorClient(conn), nil
 }
 
+// GetPlgbftOperatorClientConnection returns the PlgBFT operator client connection
+func GetPlgbftOperatorClientConnection(address string) (
+	plgbftOp.PlgbftOperatorClient,
+	error,
+) {
+	conn, err := GetGRPCConnection(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return plgbftOp.NewPlgbftOperatorClient(conn), nil
+}
+
 // GetGRPCConnection returns a grpc client connection
 func GetGRPCConnection(address string) (*grpc.ClientConn, error) {
 	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -3,6 +3,7 @@ package ibft
 import (
 	"github.com/plingatech/go-plgchain/command/helper"
 	"github.com/plingatech/go-plgchain/command/ibft/candidates"
+	"github.com/plingatech/go-plgchain/command/ibft/liveness"
 	"github.com/plingatech/go-plgchain/command/ibft/propose"
 	"github.com/plingatech/go-plgchain/command/ibft/quorum"
 	"github.com/plingatech/go-plgchain/command/ibft/snapshot"
@@ -38,5 +39,7 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		_switch.GetCommand(),
 		// ibft quorum
 		quorum.GetCommand(),
+		// ibft liveness
+		liveness.GetCommand(),
 	)
 }
@@ -0,0 +1,43 @@
+package liveness
+
+import (
+	"context"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	ibftOp "github.com/plingatech/go-plgchain/consensus/ibft/proto"
+)
+
+const (
+	epochFlag = "epoch"
+)
+
+var (
+	params = &livenessParams{}
+)
+
+type livenessParams struct {
+	epoch uint64
+
+	liveness *ibftOp.LivenessResp
+}
+
+func (p *livenessParams) initLiveness(grpcAddress string) error {
+	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	liveness, err := ibftClient.Liveness(
+		context.Background(),
+		&ibftOp.LivenessReq{
+			Epoch: p.epoch,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.liveness = liveness
+
+	return nil
+}
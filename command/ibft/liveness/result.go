@@ -0,0 +1,77 @@
+package liveness
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	ibftOp "github.com/plingatech/go-plgchain/consensus/ibft/proto"
+)
+
+type IBFTValidatorLiveness struct {
+	Address         string `json:"address"`
+	BlocksSigned    uint64 `json:"blocks_signed"`
+	BlocksMissed    uint64 `json:"blocks_missed"`
+	ProposalsMade   uint64 `json:"proposals_made"`
+	ProposalsMissed uint64 `json:"proposals_missed"`
+}
+
+type IBFTLivenessResult struct {
+	WindowFrom    uint64                  `json:"window_from"`
+	WindowTo      uint64                  `json:"window_to"`
+	Window        []IBFTValidatorLiveness `json:"window"`
+	Epoch         uint64                  `json:"epoch"`
+	EpochLiveness []IBFTValidatorLiveness `json:"epoch_liveness"`
+}
+
+func newIBFTLivenessResult(resp *ibftOp.LivenessResp) *IBFTLivenessResult {
+	return &IBFTLivenessResult{
+		WindowFrom:    resp.WindowFrom,
+		WindowTo:      resp.WindowTo,
+		Window:        newIBFTValidatorsLiveness(resp.Window),
+		Epoch:         resp.Epoch,
+		EpochLiveness: newIBFTValidatorsLiveness(resp.EpochLiveness),
+	}
+}
+
+func newIBFTValidatorsLiveness(protoLiveness []*ibftOp.LivenessResp_ValidatorLiveness) []IBFTValidatorLiveness {
+	res := make([]IBFTValidatorLiveness, len(protoLiveness))
+
+	for i, v := range protoLiveness {
+		res[i] = IBFTValidatorLiveness{
+			Address:         v.Address,
+			BlocksSigned:    v.BlocksSigned,
+			BlocksMissed:    v.BlocksMissed,
+			ProposalsMade:   v.ProposalsMade,
+			ProposalsMissed: v.ProposalsMissed,
+		}
+	}
+
+	return res
+}
+
+func (r *IBFTLivenessResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("\n[LIVENESS OF BLOCKS %d - %d]\n", r.WindowFrom, r.WindowTo))
+	buffer.WriteString(helper.FormatList(formatValidatorsLiveness(r.Window)))
+	buffer.WriteString("\n")
+
+	buffer.WriteString(fmt.Sprintf("\n[LIVENESS OF EPOCH %d]\n", r.Epoch))
+	buffer.WriteString(helper.FormatList(formatValidatorsLiveness(r.EpochLiveness)))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+func formatValidatorsLiveness(validators []IBFTValidatorLiveness) []string {
+	rows := make([]string, len(validators)+1)
+	rows[0] = "ADDRESS|BLOCKS SIGNED|BLOCKS MISSED|PROPOSALS MADE|PROPOSALS MISSED"
+
+	for i, v := range validators {
+		rows[i+1] = fmt.Sprintf("%s|%d|%d|%d|%d",
+			v.Address, v.BlocksSigned, v.BlocksMissed, v.ProposalsMade, v.ProposalsMissed)
+	}
+
+	return rows
+}
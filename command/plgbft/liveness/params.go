@@ -0,0 +1,43 @@
+package liveness
+
+import (
+	"context"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	plgbftOp "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
+)
+
+const (
+	epochFlag = "epoch"
+)
+
+var (
+	params = &livenessParams{}
+)
+
+type livenessParams struct {
+	epoch uint64
+
+	liveness *plgbftOp.PlgbftLivenessResp
+}
+
+func (p *livenessParams) initLiveness(grpcAddress string) error {
+	plgbftClient, err := helper.GetPlgbftOperatorClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	liveness, err := plgbftClient.Liveness(
+		context.Background(),
+		&plgbftOp.PlgbftLivenessReq{
+			Epoch: p.epoch,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.liveness = liveness
+
+	return nil
+}
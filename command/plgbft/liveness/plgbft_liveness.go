@@ -0,0 +1,43 @@
+package liveness
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	livenessCmd := &cobra.Command{
+		Use:   "liveness",
+		Short: "Returns the signed and missed blocks and proposals of the validators over the latest blocks and epoch",
+		Run:   runCommand,
+	}
+
+	helper.RegisterGRPCAddressFlag(livenessCmd)
+
+	setFlags(livenessCmd)
+
+	return livenessCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(
+		&params.epoch,
+		epochFlag,
+		0,
+		"the epoch to query the liveness of (the current epoch if not specified)",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initLiveness(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newPlgbftLivenessResult(params.liveness))
+}
@@ -0,0 +1,79 @@
+package liveness
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	plgbftOp "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
+)
+
+type PlgbftValidatorLiveness struct {
+	Address         string `json:"address"`
+	BlocksSigned    uint64 `json:"blocks_signed"`
+	BlocksMissed    uint64 `json:"blocks_missed"`
+	ProposalsMade   uint64 `json:"proposals_made"`
+	ProposalsMissed uint64 `json:"proposals_missed"`
+}
+
+type PlgbftLivenessResult struct {
+	WindowFrom    uint64                    `json:"window_from"`
+	WindowTo      uint64                    `json:"window_to"`
+	Window        []PlgbftValidatorLiveness `json:"window"`
+	Epoch         uint64                    `json:"epoch"`
+	EpochLiveness []PlgbftValidatorLiveness `json:"epoch_liveness"`
+}
+
+func newPlgbftLivenessResult(resp *plgbftOp.PlgbftLivenessResp) *PlgbftLivenessResult {
+	return &PlgbftLivenessResult{
+		WindowFrom:    resp.WindowFrom,
+		WindowTo:      resp.WindowTo,
+		Window:        newPlgbftValidatorsLiveness(resp.Window),
+		Epoch:         resp.Epoch,
+		EpochLiveness: newPlgbftValidatorsLiveness(resp.EpochLiveness),
+	}
+}
+
+func newPlgbftValidatorsLiveness(
+	protoLiveness []*plgbftOp.PlgbftLivenessResp_ValidatorLiveness,
+) []PlgbftValidatorLiveness {
+	res := make([]PlgbftValidatorLiveness, len(protoLiveness))
+
+	for i, v := range protoLiveness {
+		res[i] = PlgbftValidatorLiveness{
+			Address:         v.Address,
+			BlocksSigned:    v.BlocksSigned,
+			BlocksMissed:    v.BlocksMissed,
+			ProposalsMade:   v.ProposalsMade,
+			ProposalsMissed: v.ProposalsMissed,
+		}
+	}
+
+	return res
+}
+
+func (r *PlgbftLivenessResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("\n[LIVENESS OF BLOCKS %d - %d]\n", r.WindowFrom, r.WindowTo))
+	buffer.WriteString(helper.FormatList(formatValidatorsLiveness(r.Window)))
+	buffer.WriteString("\n")
+
+	buffer.WriteString(fmt.Sprintf("\n[LIVENESS OF EPOCH %d]\n", r.Epoch))
+	buffer.WriteString(helper.FormatList(formatValidatorsLiveness(r.EpochLiveness)))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+func formatValidatorsLiveness(validators []PlgbftValidatorLiveness) []string {
+	rows := make([]string, len(validators)+1)
+	rows[0] = "ADDRESS|BLOCKS SIGNED|BLOCKS MISSED|PROPOSALS MADE|PROPOSALS MISSED"
+
+	for i, v := range validators {
+		rows[i+1] = fmt.Sprintf("%s|%d|%d|%d|%d",
+			v.Address, v.BlocksSigned, v.BlocksMissed, v.ProposalsMade, v.ProposalsMissed)
+	}
+
+	return rows
+}
@@ -1,8 +1,14 @@
 package plgbft
 
 import (
+	"github.com/plingatech/go-plgchain/command/plgbft/liveness"
+	"github.com/plingatech/go-plgchain/command/plgbft/status"
+	"github.com/plingatech/go-plgchain/command/sidechain/claimrewards"
+	"github.com/plingatech/go-plgchain/command/sidechain/delegation"
 	"github.com/plingatech/go-plgchain/command/sidechain/registration"
+	"github.com/plingatech/go-plgchain/command/sidechain/rewards"
 	"github.com/plingatech/go-plgchain/command/sidechain/staking"
+	"github.com/plingatech/go-plgchain/command/sidechain/undelegation"
 	"github.com/plingatech/go-plgchain/command/sidechain/unstaking"
 	"github.com/plingatech/go-plgchain/command/sidechain/validators"
 
@@ -24,6 +30,12 @@ func GetCommand() *cobra.Command {
 		validators.GetCommand(),
 		whitelist.GetCommand(),
 		registration.GetCommand(),
+		status.GetCommand(),
+		liveness.GetCommand(),
+		delegation.GetCommand(),
+		undelegation.GetCommand(),
+		rewards.GetCommand(),
+		claimrewards.GetCommand(),
 	)
 
 	return plgbftCmd
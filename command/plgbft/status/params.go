@@ -0,0 +1,43 @@
+package status
+
+import (
+	"context"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	plgbftOp "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
+)
+
+const (
+	proposersFlag = "proposers"
+)
+
+var (
+	params = &statusParams{}
+)
+
+type statusParams struct {
+	proposers uint64
+
+	status *plgbftOp.PlgbftStatusResp
+}
+
+func (p *statusParams) initStatus(grpcAddress string) error {
+	plgbftClient, err := helper.GetPlgbftOperatorClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	status, err := plgbftClient.Status(
+		context.Background(),
+		&plgbftOp.PlgbftStatusReq{
+			Proposers: p.proposers,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.status = status
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package status
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Returns the current epoch, validator set, next proposers and state of the PlgBFT client",
+		Run:   runCommand,
+	}
+
+	helper.RegisterGRPCAddressFlag(statusCmd)
+
+	setFlags(statusCmd)
+
+	return statusCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(
+		&params.proposers,
+		proposersFlag,
+		5,
+		"the number of the next proposers to calculate, at most 100",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initStatus(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newPlgbftStatusResult(params.status))
+}
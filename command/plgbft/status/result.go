@@ -0,0 +1,166 @@
+package status
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	plgbftOp "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
+)
+
+type PlgbftStatusValidator struct {
+	Address     string `json:"address"`
+	VotingPower string `json:"voting_power"`
+	Active      bool   `json:"active"`
+}
+
+type PlgbftStatusCommitment struct {
+	Epoch   uint64 `json:"epoch"`
+	StartID uint64 `json:"start_id"`
+	EndID   uint64 `json:"end_id"`
+	Root    string `json:"root"`
+}
+
+type PlgbftStatusBucket struct {
+	Name       string `json:"name"`
+	Keys       uint64 `json:"keys"`
+	Depth      uint64 `json:"depth"`
+	InUseBytes uint64 `json:"in_use_bytes"`
+}
+
+type PlgbftStatusResult struct {
+	ValidatorKey        string                   `json:"validator_key"`
+	BlockNumber         uint64                   `json:"block_number"`
+	Epoch               uint64                   `json:"epoch"`
+	FirstBlockInEpoch   uint64                   `json:"first_block_in_epoch"`
+	EpochSize           uint64                   `json:"epoch_size"`
+	SprintSize          uint64                   `json:"sprint_size"`
+	Validators          []PlgbftStatusValidator  `json:"validators"`
+	NextProposers       []string                 `json:"next_proposers"`
+	LastCheckpointBlock uint64                   `json:"last_checkpoint_block"`
+	PendingCommitments  []PlgbftStatusCommitment `json:"pending_commitments"`
+	Buckets             []PlgbftStatusBucket     `json:"buckets"`
+}
+
+func newPlgbftStatusResult(resp *plgbftOp.PlgbftStatusResp) *PlgbftStatusResult {
+	res := &PlgbftStatusResult{
+		ValidatorKey:        resp.Key,
+		BlockNumber:         resp.BlockNumber,
+		Epoch:               resp.Epoch,
+		FirstBlockInEpoch:   resp.FirstBlockInEpoch,
+		EpochSize:           resp.EpochSize,
+		SprintSize:          resp.SprintSize,
+		Validators:          make([]PlgbftStatusValidator, len(resp.Validators)),
+		NextProposers:       resp.NextProposers,
+		LastCheckpointBlock: resp.LastCheckpointBlock,
+		PendingCommitments:  make([]PlgbftStatusCommitment, len(resp.PendingCommitments)),
+		Buckets:             make([]PlgbftStatusBucket, len(resp.Buckets)),
+	}
+
+	for i, v := range resp.Validators {
+		res.Validators[i] = PlgbftStatusValidator{
+			Address:     v.Address,
+			VotingPower: v.VotingPower,
+			Active:      v.Active,
+		}
+	}
+
+	for i, c := range resp.PendingCommitments {
+		res.PendingCommitments[i] = PlgbftStatusCommitment{
+			Epoch:   c.Epoch,
+			StartID: c.StartId,
+			EndID:   c.EndId,
+			Root:    c.Root,
+		}
+	}
+
+	for i, b := range resp.Buckets {
+		res.Buckets[i] = PlgbftStatusBucket{
+			Name:       b.Name,
+			Keys:       b.Keys,
+			Depth:      b.Depth,
+			InUseBytes: b.InUseBytes,
+		}
+	}
+
+	return res
+}
+
+func (r *PlgbftStatusResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PLGBFT STATUS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Validator key|%s", r.ValidatorKey),
+		fmt.Sprintf("Block|%d", r.BlockNumber),
+		fmt.Sprintf("Epoch|%d", r.Epoch),
+		fmt.Sprintf("First block in epoch|%d", r.FirstBlockInEpoch),
+		fmt.Sprintf("Epoch size|%d", r.EpochSize),
+		fmt.Sprintf("Sprint size|%d", r.SprintSize),
+		fmt.Sprintf("Last checkpoint block|%d", r.LastCheckpointBlock),
+	}))
+	buffer.WriteString("\n")
+
+	r.writeValidators(&buffer)
+	r.writeNextProposers(&buffer)
+	r.writePendingCommitments(&buffer)
+	r.writeBuckets(&buffer)
+
+	return buffer.String()
+}
+
+func (r *PlgbftStatusResult) writeValidators(buffer *bytes.Buffer) {
+	validators := make([]string, len(r.Validators)+1)
+	validators[0] = "ADDRESS|VOTING POWER|ACTIVE"
+
+	for i, v := range r.Validators {
+		validators[i+1] = fmt.Sprintf("%s|%s|%t", v.Address, v.VotingPower, v.Active)
+	}
+
+	buffer.WriteString("\n[VALIDATORS]\n")
+	buffer.WriteString(helper.FormatList(validators))
+	buffer.WriteString("\n")
+}
+
+func (r *PlgbftStatusResult) writeNextProposers(buffer *bytes.Buffer) {
+	proposers := make([]string, len(r.NextProposers)+1)
+	proposers[0] = "ROUND|PROPOSER"
+
+	for round, proposer := range r.NextProposers {
+		proposers[round+1] = fmt.Sprintf("%d|%s", round, proposer)
+	}
+
+	buffer.WriteString("\n[NEXT PROPOSERS]\n")
+	buffer.WriteString(helper.FormatList(proposers))
+	buffer.WriteString("\n")
+}
+
+func (r *PlgbftStatusResult) writePendingCommitments(buffer *bytes.Buffer) {
+	commitments := make([]string, len(r.PendingCommitments)+1)
+	commitments[0] = "No pending commitments"
+
+	if len(r.PendingCommitments) > 0 {
+		commitments[0] = "EPOCH|START ID|END ID|ROOT"
+
+		for i, c := range r.PendingCommitments {
+			commitments[i+1] = fmt.Sprintf("%d|%d|%d|%s", c.Epoch, c.StartID, c.EndID, c.Root)
+		}
+	}
+
+	buffer.WriteString("\n[PENDING COMMITMENTS]\n")
+	buffer.WriteString(helper.FormatList(commitments))
+	buffer.WriteString("\n")
+}
+
+func (r *PlgbftStatusResult) writeBuckets(buffer *bytes.Buffer) {
+	buckets := make([]string, len(r.Buckets)+1)
+	buckets[0] = "BUCKET|KEYS|DEPTH|IN USE BYTES"
+
+	for i, b := range r.Buckets {
+		buckets[i+1] = fmt.Sprintf("%s|%d|%d|%d", b.Name, b.Keys, b.Depth, b.InUseBytes)
+	}
+
+	buffer.WriteString("\n[STATE BUCKETS]\n")
+	buffer.WriteString(helper.FormatList(buckets))
+	buffer.WriteString("\n")
+}
@@ -89,3 +89,31 @@ func (r *SecretsInitResult) GetOutput() string {
 
 	return buffer.String()
 }
+
+type RotateBlsKeyResult struct {
+	Address      types.Address `json:"address"`
+	BLSPubkey    string        `json:"bls_pubkey"`
+	BLSSignature string        `json:"bls_signature"`
+	Generated    bool          `json:"generated"`
+}
+
+func (r *RotateBlsKeyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := make([]string, 0, 3)
+	vals = append(vals, fmt.Sprintf("Public key (address)|%s", r.Address.String()))
+	vals = append(vals, fmt.Sprintf("New BLS Public key|%s", r.BLSPubkey))
+	vals = append(vals, fmt.Sprintf("BLS Signature|%s", r.BLSSignature))
+
+	if r.Generated {
+		buffer.WriteString("\n[BLS KEY GENERATED]\n")
+	} else {
+		buffer.WriteString("\n[BLS KEY RESUBMITTED, IT IS NOT ACTIVATED YET]\n")
+	}
+
+	buffer.WriteString("\n[BLS KEY ROTATION]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\nThe new BLS key is activated at the end of the current epoch\n")
+
+	return buffer.String()
+}
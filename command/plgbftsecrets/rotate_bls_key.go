@@ -0,0 +1,325 @@
+package plgbftsecrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/contracts"
+	"github.com/plingatech/go-plgchain/helper/hex"
+	"github.com/plingatech/go-plgchain/secrets"
+	secretsHelper "github.com/plingatech/go-plgchain/secrets/helper"
+	"github.com/plingatech/go-plgchain/txrelayer"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/spf13/cobra"
+	"github.com/umbracle/ethgo"
+)
+
+var (
+	errUnknownRegisteredBlsKey = errors.New("BLS key registered in the validator set matches none of the account keys")
+)
+
+type rotateBlsKeyParams struct {
+	accountDir    string
+	accountConfig string
+	jsonRPC       string
+
+	insecureLocalStore bool
+
+	chainID int64
+}
+
+var rotateParams = &rotateBlsKeyParams{}
+
+func getRotateBlsKeyCommand() *cobra.Command {
+	rotateCmd := &cobra.Command{
+		Use: "rotate-bls-key",
+		Short: "Generates a new validator BLS key and submits it to the validator set, " +
+			"which activates it at the end of the current epoch",
+		PreRunE: runRotatePreRun,
+		RunE:    runRotateCommand,
+	}
+
+	rotateParams.setFlags(rotateCmd)
+
+	return rotateCmd
+}
+
+func (rp *rotateBlsKeyParams) setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&rp.accountDir,
+		AccountDirFlag,
+		"",
+		AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&rp.accountConfig,
+		AccountConfigFlag,
+		"",
+		AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&rp.insecureLocalStore,
+		insecureLocalStoreFlag,
+		false,
+		"the flag indicating should the secrets stored on the local storage be encrypted",
+	)
+
+	cmd.Flags().Int64Var(
+		&rp.chainID,
+		chainIDFlag,
+		command.DefaultChainID,
+		"the ID of the chain",
+	)
+
+	helper.RegisterJSONRPCFlag(cmd)
+	cmd.MarkFlagsMutuallyExclusive(AccountDirFlag, AccountConfigFlag)
+}
+
+func (rp *rotateBlsKeyParams) validateFlags() error {
+	if rp.accountDir == "" && rp.accountConfig == "" {
+		return ErrInvalidParams
+	}
+
+	return nil
+}
+
+func runRotatePreRun(cmd *cobra.Command, _ []string) error {
+	rotateParams.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return rotateParams.validateFlags()
+}
+
+func runRotateCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	secretsManager, err := GetSecretsManager(
+		rotateParams.accountDir, rotateParams.accountConfig, rotateParams.insecureLocalStore)
+	if err != nil {
+		return err
+	}
+
+	account, err := wallet.NewAccountFromSecret(secretsManager)
+	if err != nil {
+		return err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(rotateParams.jsonRPC))
+	if err != nil {
+		return err
+	}
+
+	registeredKey, err := getRegisteredBlsKey(txRelayer, account.Ecdsa.Address())
+	if err != nil {
+		return err
+	}
+
+	result := &RotateBlsKeyResult{Address: types.Address(account.Ecdsa.Address())}
+
+	// secrets replaced by the rotation, restored if the validator set rejects the new key
+	var replaced blsSecrets
+
+	switch {
+	case equalBlsKeys(account.Bls.PublicKey(), registeredKey):
+		if replaced, err = readBlsSecrets(secretsManager); err != nil {
+			return err
+		}
+
+		if err := rotateBlsKey(secretsManager, account, rotateParams.chainID); err != nil {
+			return err
+		}
+
+		result.Generated = true
+	case account.PreviousBls != nil && equalBlsKeys(account.PreviousBls.PublicKey(), registeredKey):
+		// the key has been rotated already, but it is not activated yet, so it is submitted again
+		// instead of replacing the key registered in the validator set
+	default:
+		return errUnknownRegisteredBlsKey
+	}
+
+	signature, err := secretsHelper.LoadBLSSignature(secretsManager)
+	if err != nil {
+		return err
+	}
+
+	// the transaction may still be included if sending it fails,
+	// so the rotated key is kept and submitted again by the next run of the command
+	receipt, err := updateBlsKey(txRelayer, account, signature)
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status != uint64(types.ReceiptSuccess) {
+		if replaced != nil {
+			if err := replaced.restore(secretsManager); err != nil {
+				return fmt.Errorf("update BLS key transaction failed and the replaced BLS key is not restored: %w", err)
+			}
+		}
+
+		return errors.New("update BLS key transaction failed")
+	}
+
+	result.BLSPubkey = hex.EncodeToString(account.Bls.PublicKey().Marshal())
+	result.BLSSignature = signature
+
+	outputter.WriteCommandResult(result)
+
+	return nil
+}
+
+// rotateBlsKey replaces the BLS key of the account and its proof of possession signature
+func rotateBlsKey(secretsManager secrets.SecretsManager, account *wallet.Account, chainID int64) error {
+	if err := account.RotateBls(secretsManager); err != nil {
+		return fmt.Errorf("error rotating BLS key: %w", err)
+	}
+
+	if secretsManager.HasSecret(secrets.ValidatorBLSSignature) {
+		if err := secretsManager.RemoveSecret(secrets.ValidatorBLSSignature); err != nil {
+			return err
+		}
+	}
+
+	if _, err := secretsHelper.InitValidatorBLSSignature(secretsManager, account, chainID); err != nil {
+		return fmt.Errorf("%w: error initializing validator-bls-signature", err)
+	}
+
+	return nil
+}
+
+// blsSecrets are the BLS secrets of the account by their names, the missing ones are not present
+type blsSecrets map[string][]byte
+
+// blsSecretNames are the names of the secrets changed by the BLS key rotation
+var blsSecretNames = []string{
+	secrets.ValidatorBLSKey,
+	secrets.ValidatorBLSKeyPrevious,
+	secrets.ValidatorBLSSignature,
+}
+
+// readBlsSecrets reads the BLS secrets of the account
+func readBlsSecrets(secretsManager secrets.SecretsManager) (blsSecrets, error) {
+	values := make(blsSecrets, len(blsSecretNames))
+
+	for _, name := range blsSecretNames {
+		if !secretsManager.HasSecret(name) {
+			continue
+		}
+
+		value, err := secretsManager.GetSecret(name)
+		if err != nil {
+			return nil, err
+		}
+
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// restore writes the BLS secrets back, removing the ones which were not present
+func (s blsSecrets) restore(secretsManager secrets.SecretsManager) error {
+	for _, name := range blsSecretNames {
+		if secretsManager.HasSecret(name) {
+			if err := secretsManager.RemoveSecret(name); err != nil {
+				return err
+			}
+		}
+
+		value, ok := s[name]
+		if !ok {
+			continue
+		}
+
+		if err := secretsManager.SetSecret(name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getRegisteredBlsKey queries the BLS key of the validator registered in the validator set
+func getRegisteredBlsKey(txRelayer txrelayer.TxRelayer, validatorAddr ethgo.Address) (*bls.PublicKey, error) {
+	getValidatorFn := contractsapi.ChildValidatorSet.Abi.GetMethod("getValidator")
+
+	input, err := getValidatorFn.Encode([]interface{}{validatorAddr})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := txRelayer.Call(ethgo.Address(contracts.SystemCaller),
+		ethgo.Address(contracts.ValidatorSetContract), input)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse, err := hex.DecodeHex(response)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode hex response, %w", err)
+	}
+
+	output, err := getValidatorFn.Outputs.Decode(rawResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	outputMap, ok := output.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("could not convert decoded outputs to map")
+	}
+
+	blsKey, ok := outputMap["blsKey"].([4]*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode blskey")
+	}
+
+	return bls.UnmarshalPublicKeyFromBigInt(blsKey)
+}
+
+// updateBlsKey submits the BLS key of the account to the validator set
+func updateBlsKey(sender txrelayer.TxRelayer, account *wallet.Account, signature string) (*ethgo.Receipt, error) {
+	rawSignature, err := hex.DecodeHex(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	blsSignature, err := bls.UnmarshalSignature(rawSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	sigMarshal, err := blsSignature.ToBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("update BLS key failed: %w", err)
+	}
+
+	updateBlsKeyFn := &contractsapi.UpdateBlsKeyChildValidatorSetFn{
+		Signature: sigMarshal,
+		Pubkey:    account.Bls.PublicKey().ToBigInt(),
+	}
+
+	input, err := updateBlsKeyFn.EncodeAbi()
+	if err != nil {
+		return nil, fmt.Errorf("update BLS key failed: %w", err)
+	}
+
+	txn := &ethgo.Transaction{
+		Input: input,
+		To:    (*ethgo.Address)(&contracts.ValidatorSetContract),
+	}
+
+	return sender.SendTransaction(txn, account.Ecdsa)
+}
+
+func equalBlsKeys(a, b *bls.PublicKey) bool {
+	return bytes.Equal(a.Marshal(), b.Marshal())
+}
@@ -0,0 +1,101 @@
+package plgbftsecrets
+
+import (
+	"encoding/hex"
+	"path"
+	"testing"
+
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/secrets"
+	"github.com/plingatech/go-plgchain/secrets/helper"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rotateBlsKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	sm, err := helper.SetupLocalSecretsManager(dir)
+	require.NoError(t, err)
+
+	ip := &initParams{
+		generatesAccount: true,
+		chainID:          1,
+	}
+
+	_, err = ip.initKeys(sm)
+	require.NoError(t, err)
+
+	account, err := wallet.NewAccountFromSecret(sm)
+	require.NoError(t, err)
+
+	oldBlsKey := account.Bls
+
+	require.NoError(t, rotateBlsKey(sm, account, ip.chainID))
+	assert.True(t, fileExists(path.Join(dir, "consensus/validator-bls-previous.key")))
+
+	// the replaced key is kept until the new one is activated
+	loaded, err := wallet.NewAccountFromSecret(sm)
+	require.NoError(t, err)
+	assert.True(t, equalBlsKeys(account.Bls.PublicKey(), loaded.Bls.PublicKey()))
+	assert.True(t, equalBlsKeys(oldBlsKey.PublicKey(), loaded.PreviousBls.PublicKey()))
+	assert.False(t, equalBlsKeys(oldBlsKey.PublicKey(), loaded.Bls.PublicKey()))
+
+	// proof of possession is signed by the new key
+	signature, err := sm.GetSecret(secrets.ValidatorBLSSignature)
+	require.NoError(t, err)
+
+	expected, err := helper.MakeKOSKSignature(
+		account.Bls, types.Address(account.Ecdsa.Address()), ip.chainID, bls.DomainValidatorSet)
+	require.NoError(t, err)
+
+	expectedRaw, err := expected.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(expectedRaw), string(signature))
+}
+
+func Test_blsSecrets_restore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	sm, err := helper.SetupLocalSecretsManager(dir)
+	require.NoError(t, err)
+
+	ip := &initParams{
+		generatesAccount: true,
+		chainID:          1,
+	}
+
+	_, err = ip.initKeys(sm)
+	require.NoError(t, err)
+
+	account, err := wallet.NewAccountFromSecret(sm)
+	require.NoError(t, err)
+
+	replaced, err := readBlsSecrets(sm)
+	require.NoError(t, err)
+	require.NotContains(t, replaced, secrets.ValidatorBLSKeyPrevious)
+
+	signature, err := sm.GetSecret(secrets.ValidatorBLSSignature)
+	require.NoError(t, err)
+
+	require.NoError(t, rotateBlsKey(sm, account, ip.chainID))
+
+	// the rejected rotation is undone
+	require.NoError(t, replaced.restore(sm))
+	assert.False(t, fileExists(path.Join(dir, "consensus/validator-bls-previous.key")))
+
+	loaded, err := wallet.NewAccountFromSecret(sm)
+	require.NoError(t, err)
+	assert.Nil(t, loaded.PreviousBls)
+	assert.True(t, equalBlsKeys(account.PreviousBls.PublicKey(), loaded.Bls.PublicKey()))
+
+	restoredSignature, err := sm.GetSecret(secrets.ValidatorBLSSignature)
+	require.NoError(t, err)
+	assert.Equal(t, signature, restoredSignature)
+}
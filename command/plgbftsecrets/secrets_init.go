@@ -19,6 +19,8 @@ func GetCommand() *cobra.Command {
 
 	basicParams.setFlags(secretsInitCmd)
 
+	secretsInitCmd.AddCommand(getRotateBlsKeyCommand())
+
 	return secretsInitCmd
 }
 
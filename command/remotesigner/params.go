@@ -0,0 +1,107 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
+	"github.com/plingatech/go-plgchain/remotesigner"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcAddressFlag = "grpc-address"
+	protectionFlag  = "protection-db"
+	tlsCertFlag     = "tls-cert"
+	tlsKeyFlag      = "tls-key"
+	tlsCAFlag       = "tls-ca"
+
+	defaultGRPCAddress = "127.0.0.1:9633"
+
+	// protectionFileName is the name of the slashing protection file in the data directory
+	protectionFileName = "slashing_protection.json"
+)
+
+var (
+	params = &remoteSignerParams{}
+)
+
+var (
+	errProtectionPathMissing = errors.New("the slashing protection file has to be set " +
+		"if the secrets are not stored in the data directory")
+)
+
+type remoteSignerParams struct {
+	dataDir        string
+	configPath     string
+	grpcAddress    string
+	protectionPath string
+	tls            remotesigner.TLSConfig
+}
+
+func (p *remoteSignerParams) validateFlags() error {
+	if p.dataDir == "" && p.configPath == "" {
+		return plgbftsecrets.ErrInvalidParams
+	}
+
+	if p.protectionPath == "" {
+		if p.dataDir == "" {
+			return errProtectionPathMissing
+		}
+
+		p.protectionPath = filepath.Join(p.dataDir, protectionFileName)
+	}
+
+	return nil
+}
+
+func (p *remoteSignerParams) runSigner(outputter command.OutputFormatter) error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "plgchain",
+		Level: hclog.Info,
+	})
+
+	secretsManager, err := plgbftsecrets.GetSecretsManager(p.dataDir, p.configPath, true)
+	if err != nil {
+		return err
+	}
+
+	signer, err := remotesigner.NewLocalSignerFromSecrets(secretsManager)
+	if err != nil {
+		return err
+	}
+
+	protection, err := remotesigner.NewSlashingProtection(p.protectionPath)
+	if err != nil {
+		return err
+	}
+
+	creds, err := remotesigner.ServerCredentials(p.grpcAddress, &p.tls)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", p.grpcAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", p.grpcAddress, err)
+	}
+
+	grpcServer := grpc.NewServer(grpc.Creds(creds))
+	remotesigner.NewServer(logger, signer, protection).Register(grpcServer)
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.Error("remote signer gRPC server failed", "error", err)
+		}
+	}()
+
+	logger.Info("remote signer started", "address", p.grpcAddress, "validator", signer.Address(),
+		"slashing protection", p.protectionPath, "tls", p.tls.Enabled())
+
+	return helper.HandleSignals(grpcServer.GracefulStop, outputter)
+}
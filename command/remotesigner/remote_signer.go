@@ -0,0 +1,87 @@
+package remotesigner
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	remoteSignerCmd := &cobra.Command{
+		Use: "remote-signer",
+		Short: "Runs the reference signing process, which holds the validator keys " +
+			"and signs for the node started with the --remote-signer flag",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(remoteSignerCmd)
+
+	return remoteSignerCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		plgbftsecrets.AccountDirFlag,
+		"",
+		plgbftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.configPath,
+		plgbftsecrets.AccountConfigFlag,
+		"",
+		plgbftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.grpcAddress,
+		grpcAddressFlag,
+		defaultGRPCAddress,
+		"the address the signing service listens on",
+	)
+
+	cmd.Flags().StringVar(
+		&params.protectionPath,
+		protectionFlag,
+		"",
+		"the file storing the slashing protection data, defaults to the file in the data directory",
+	)
+
+	cmd.Flags().StringVar(
+		&params.tls.CertFile,
+		tlsCertFlag,
+		"",
+		"the TLS certificate of the signing service, the service only listens on a loopback address without TLS",
+	)
+
+	cmd.Flags().StringVar(
+		&params.tls.KeyFile,
+		tlsKeyFlag,
+		"",
+		"the private key of the TLS certificate of the signing service",
+	)
+
+	cmd.Flags().StringVar(
+		&params.tls.CAFile,
+		tlsCAFlag,
+		"",
+		"the certificate authority verifying the certificates of the nodes, enables the mutual TLS",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(plgbftsecrets.AccountDirFlag, plgbftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+
+	if err := params.runSigner(outputter); err != nil {
+		outputter.SetError(err)
+		outputter.WriteOutput()
+	}
+}
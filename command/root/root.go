@@ -8,6 +8,7 @@ import (
 
 	"github.com/plingatech/go-plgchain/command/backup"
 	"github.com/plingatech/go-plgchain/command/bridge"
+	"github.com/plingatech/go-plgchain/command/db"
 	"github.com/plingatech/go-plgchain/command/genesis"
 	"github.com/plingatech/go-plgchain/command/helper"
 	"github.com/plingatech/go-plgchain/command/ibft"
@@ -18,6 +19,7 @@ import (
 	"github.com/plingatech/go-plgchain/command/plgbftmanifest"
 	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
 	"github.com/plingatech/go-plgchain/command/regenesis"
+	"github.com/plingatech/go-plgchain/command/remotesigner"
 	"github.com/plingatech/go-plgchain/command/rootchain"
 	"github.com/plingatech/go-plgchain/command/secrets"
 	"github.com/plingatech/go-plgchain/command/server"
@@ -65,6 +67,8 @@ func (rc *RootCommand) registerSubCommands() {
 		plgbftmanifest.GetCommand(),
 		bridge.GetCommand(),
 		regenesis.GetCommand(),
+		db.GetCommand(),
+		remotesigner.GetCommand(),
 	)
 }
 
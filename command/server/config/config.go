@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/hcl"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
 	"github.com/plingatech/go-plgchain/network"
 	"gopkg.in/yaml.v3"
 )
@@ -16,6 +17,8 @@ type Config struct {
 	GenesisPath              string     `json:"chain_config" yaml:"chain_config"`
 	SecretsConfigPath        string     `json:"secrets_config" yaml:"secrets_config"`
 	DataDir                  string     `json:"data_dir" yaml:"data_dir"`
+	StorageBackend           string     `json:"storage_backend" yaml:"storage_backend"`
+	AncientThreshold         uint64     `json:"ancient_threshold" yaml:"ancient_threshold"`
 	BlockGasTarget           string     `json:"block_gas_target" yaml:"block_gas_target"`
 	GRPCAddr                 string     `json:"grpc_addr" yaml:"grpc_addr"`
 	JSONRPCAddr              string     `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
@@ -29,10 +32,16 @@ type Config struct {
 	LogFilePath              string     `json:"log_to" yaml:"log_to"`
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	JSONRPCAdmin             bool       `json:"json_rpc_admin" yaml:"json_rpc_admin"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
 
 	Relayer               bool   `json:"relayer" yaml:"relayer"`
 	NumBlockConfirmations uint64 `json:"num_block_confirmations" yaml:"num_block_confirmations"`
+
+	RoundTimeout *RoundTimeout `json:"round_timeout" yaml:"round_timeout"`
+
+	RemoteSignerAddr string           `json:"remote_signer_addr" yaml:"remote_signer_addr"`
+	RemoteSignerTLS  *RemoteSignerTLS `json:"remote_signer_tls" yaml:"remote_signer_tls"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -56,6 +65,26 @@ type TxPool struct {
 	PriceLimit         uint64 `json:"price_limit" yaml:"price_limit"`
 	MaxSlots           uint64 `json:"max_slots" yaml:"max_slots"`
 	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	PriceBump          uint64 `json:"price_bump" yaml:"price_bump"`
+	LocalSlots         uint64 `json:"local_slots" yaml:"local_slots"`
+	NoJournal          bool   `json:"no_journal" yaml:"no_journal"`
+	RejournalInterval  uint64 `json:"rejournal_interval" yaml:"rejournal_interval"`
+	Lifetime           uint64 `json:"lifetime" yaml:"lifetime"`
+	AnnounceThreshold  uint64 `json:"announce_threshold" yaml:"announce_threshold"`
+}
+
+// RemoteSignerTLS defines the TLS files of the connection to the remote signer
+type RemoteSignerTLS struct {
+	CertFile string `json:"cert_file" yaml:"cert_file"`
+	KeyFile  string `json:"key_file" yaml:"key_file"`
+	CAFile   string `json:"ca_file" yaml:"ca_file"`
+}
+
+// RoundTimeout defines the IBFT round timeout configuration params
+type RoundTimeout struct {
+	Base       uint64  `json:"base" yaml:"base"`
+	Multiplier float64 `json:"multiplier" yaml:"multiplier"`
+	Max        uint64  `json:"max" yaml:"max"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -75,9 +104,16 @@ const (
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
 
+	// DefaultAncientThreshold is the number of the most recent blocks kept in the key-value database,
+	// older blocks are moved into the ancient store. Zero disables moving the blocks
+	DefaultAncientThreshold uint64 = 0
+
 	// DefaultNumBlockConfirmations minimal number of child blocks required for the parent block to be considered final
 	// on ethereum epoch lasts for 32 blocks. more details: https://www.alchemy.com/overviews/ethereum-commitment-levels
 	DefaultNumBlockConfirmations uint64 = 64
+
+	// DefaultRoundTimeoutMultiplier is the default exponential backoff factor of the IBFT round timeouts
+	DefaultRoundTimeoutMultiplier float64 = 2
 )
 
 // DefaultConfig returns the default server configuration
@@ -85,9 +121,11 @@ func DefaultConfig() *Config {
 	defaultNetworkConfig := network.DefaultConfig()
 
 	return &Config{
-		GenesisPath:    "./genesis.json",
-		DataDir:        "",
-		BlockGasTarget: "0x0", // Special value signaling the parent gas limit should be applied
+		GenesisPath:      "./genesis.json",
+		DataDir:          "",
+		StorageBackend:   string(storage.DefaultBackend),
+		AncientThreshold: DefaultAncientThreshold,
+		BlockGasTarget:   "0x0", // Special value signaling the parent gas limit should be applied
 		Network: &Network{
 			NoDiscover:       defaultNetworkConfig.NoDiscover,
 			MaxPeers:         defaultNetworkConfig.MaxPeers,
@@ -104,6 +142,12 @@ func DefaultConfig() *Config {
 			PriceLimit:         0,
 			MaxSlots:           4096,
 			MaxAccountEnqueued: 128,
+			PriceBump:          10,
+			LocalSlots:         0,
+			NoJournal:          false,
+			RejournalInterval:  3600,
+			Lifetime:           10800,
+			AnnounceThreshold:  4096,
 		},
 		LogLevel:    "INFO",
 		RestoreFile: "",
@@ -115,6 +159,12 @@ func DefaultConfig() *Config {
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
 		Relayer:                  false,
 		NumBlockConfirmations:    DefaultNumBlockConfirmations,
+		RoundTimeout: &RoundTimeout{
+			Base:       0,
+			Multiplier: DefaultRoundTimeoutMultiplier,
+			Max:        0,
+		},
+		RemoteSignerTLS: &RemoteSignerTLS{},
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"math"
 	"net"
 
+	"github.com/plingatech/go-plgchain/blockchain/storage"
 	"github.com/plingatech/go-plgchain/command/server/config"
 
 	"github.com/plingatech/go-plgchain/network/common"
@@ -20,6 +21,7 @@ import (
 
 var (
 	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errInvalidLocalSlots      = errors.New("local slots must be lower than max slots, lower the --local-slots value")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -49,6 +51,14 @@ func (p *serverParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initStorageBackend(); err != nil {
+		return err
+	}
+
+	if err := p.initTxPoolSlots(); err != nil {
+		return err
+	}
+
 	if p.isDevMode {
 		p.initDevMode()
 	}
@@ -69,6 +79,24 @@ func (p *serverParams) initDataDirLocation() error {
 	return nil
 }
 
+func (p *serverParams) initStorageBackend() error {
+	var err error
+
+	p.storageBackend, err = storage.ParseBackend(p.rawConfig.StorageBackend)
+
+	return err
+}
+
+func (p *serverParams) initTxPoolSlots() error {
+	// remote transactions would never be accepted otherwise
+	if p.rawConfig.TxPool.LocalSlots > 0 &&
+		p.rawConfig.TxPool.LocalSlots >= p.rawConfig.TxPool.MaxSlots {
+		return errInvalidLocalSlots
+	}
+
+	return nil
+}
+
 func (p *serverParams) initLogFileLocation() {
 	if p.isLogFileLocationSet() {
 		p.logFileLocation = p.rawConfig.LogFilePath
@@ -3,12 +3,16 @@ package server
 import (
 	"errors"
 	"net"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
 	"github.com/plingatech/go-plgchain/chain"
 	"github.com/plingatech/go-plgchain/command/server/config"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	"github.com/plingatech/go-plgchain/network"
+	"github.com/plingatech/go-plgchain/remotesigner"
 	"github.com/plingatech/go-plgchain/secrets"
 	"github.com/plingatech/go-plgchain/server"
 )
@@ -17,6 +21,8 @@ const (
 	configFlag                   = "config"
 	genesisPathFlag              = "chain"
 	dataDirFlag                  = "data-dir"
+	storageBackendFlag           = "storage-backend"
+	ancientThresholdFlag         = "ancient-threshold"
 	libp2pAddressFlag            = "libp2p"
 	prometheusAddressFlag        = "prometheus"
 	natFlag                      = "nat"
@@ -28,8 +34,15 @@ const (
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCAdminFlag             = "json-rpc-admin"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
+	priceBumpFlag                = "price-bump"
+	localSlotsFlag               = "local-slots"
+	noJournalFlag                = "no-journal"
+	rejournalIntervalFlag        = "rejournal-interval"
+	txLifetimeFlag               = "tx-lifetime"
+	txAnnounceThresholdFlag      = "tx-announce-threshold"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
 	restoreFlag                  = "restore"
@@ -40,6 +53,15 @@ const (
 
 	relayerFlag               = "relayer"
 	numBlockConfirmationsFlag = "num-block-confirmations"
+
+	roundTimeoutBaseFlag       = "round-timeout-base"
+	roundTimeoutMultiplierFlag = "round-timeout-multiplier"
+	roundTimeoutMaxFlag        = "round-timeout-max"
+
+	remoteSignerFlag        = "remote-signer"
+	remoteSignerTLSCertFlag = "remote-signer-tls-cert"
+	remoteSignerTLSKeyFlag  = "remote-signer-tls-key"
+	remoteSignerTLSCAFlag   = "remote-signer-tls-ca"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -55,9 +77,11 @@ const (
 var (
 	params = &serverParams{
 		rawConfig: &config.Config{
-			Telemetry: &config.Telemetry{},
-			Network:   &config.Network{},
-			TxPool:    &config.TxPool{},
+			Telemetry:       &config.Telemetry{},
+			Network:         &config.Network{},
+			TxPool:          &config.TxPool{},
+			RoundTimeout:    &config.RoundTimeout{},
+			RemoteSignerTLS: &config.RemoteSignerTLS{},
 		},
 	}
 )
@@ -85,8 +109,9 @@ type serverParams struct {
 
 	ibftBaseTimeoutLegacy uint64
 
-	genesisConfig *chain.Chain
-	secretsConfig *secrets.SecretsManagerConfig
+	genesisConfig  *chain.Chain
+	secretsConfig  *secrets.SecretsManagerConfig
+	storageBackend storage.Backend
 
 	logFileLocation string
 
@@ -154,6 +179,7 @@ func (p *serverParams) generateConfig() *server.Config {
 			AccessControlAllowOrigin: p.corsAllowedOrigins,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			EnableAdmin:              p.rawConfig.JSONRPCAdmin,
 		},
 		GRPCAddr:   p.grpcAddress,
 		LibP2PAddr: p.libp2pAddress,
@@ -172,10 +198,18 @@ func (p *serverParams) generateConfig() *server.Config {
 			Chain:            p.genesisConfig,
 		},
 		DataDir:            p.rawConfig.DataDir,
+		StorageBackend:     p.storageBackend,
+		AncientThreshold:   p.rawConfig.AncientThreshold,
 		Seal:               p.rawConfig.ShouldSeal,
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
+		PriceBump:          p.rawConfig.TxPool.PriceBump,
+		LocalSlots:         p.rawConfig.TxPool.LocalSlots,
+		NoJournal:          p.rawConfig.TxPool.NoJournal,
+		RejournalInterval:  time.Duration(p.rawConfig.TxPool.RejournalInterval) * time.Second,
+		TxLifetime:         time.Duration(p.rawConfig.TxPool.Lifetime) * time.Second,
+		AnnounceThreshold:  p.rawConfig.TxPool.AnnounceThreshold,
 		SecretsManager:     p.secretsConfig,
 		RestoreFile:        p.getRestoreFilePath(),
 		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
@@ -184,5 +218,18 @@ func (p *serverParams) generateConfig() *server.Config {
 
 		Relayer:               p.relayer,
 		NumBlockConfirmations: p.rawConfig.NumBlockConfirmations,
+
+		RoundTimeout: &roundchange.Timeout{
+			Base:       time.Duration(p.rawConfig.RoundTimeout.Base) * time.Second,
+			Multiplier: p.rawConfig.RoundTimeout.Multiplier,
+			Max:        time.Duration(p.rawConfig.RoundTimeout.Max) * time.Second,
+		},
+
+		RemoteSignerAddr: p.rawConfig.RemoteSignerAddr,
+		RemoteSignerTLS: &remotesigner.TLSConfig{
+			CertFile: p.rawConfig.RemoteSignerTLS.CertFile,
+			KeyFile:  p.rawConfig.RemoteSignerTLS.KeyFile,
+			CAFile:   p.rawConfig.RemoteSignerTLS.CAFile,
+		},
 	}
 }
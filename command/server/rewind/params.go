@@ -0,0 +1,73 @@
+package rewind
+
+import (
+	"github.com/plingatech/go-plgchain/blockchain"
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	itrie "github.com/plingatech/go-plgchain/state/immutable-trie"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+const (
+	toFlag = "to"
+)
+
+var (
+	params = &rewindParams{}
+)
+
+type rewindParams struct {
+	dataDir string
+	to      uint64
+
+	previousHead uint64
+	head         *types.Header
+	removed      int
+}
+
+func (p *rewindParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+		toFlag,
+	}
+}
+
+func (p *rewindParams) rewind() error {
+	db, stateStorage, err := dbHelper.OpenStorages(p.dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = stateStorage.Close()
+		_ = db.Close()
+	}()
+
+	p.previousHead, _ = db.ReadHeadNumber()
+
+	state := itrie.NewState(stateStorage)
+
+	head, removed, err := blockchain.Rewind(db, p.to, func(root types.Hash) bool {
+		_, err := state.NewSnapshotAt(root)
+
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.head = head
+	p.removed = len(removed)
+
+	return nil
+}
+
+func (p *rewindParams) getResult() command.CommandResult {
+	return &RewindResult{
+		PreviousHead: p.previousHead,
+		Head:         p.head.Number,
+		HeadHash:     p.head.Hash.String(),
+		StateRoot:    p.head.StateRoot.String(),
+		Removed:      p.removed,
+	}
+}
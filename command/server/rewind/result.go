@@ -0,0 +1,32 @@
+package rewind
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type RewindResult struct {
+	PreviousHead uint64 `json:"previousHead"`
+	Head         uint64 `json:"head"`
+	HeadHash     string `json:"headHash"`
+	StateRoot    string `json:"stateRoot"`
+	Removed      int    `json:"removed"`
+}
+
+func (r *RewindResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SERVER REWIND]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Previous head|%d", r.PreviousHead),
+		fmt.Sprintf("Head|%d", r.Head),
+		fmt.Sprintf("Head hash|%s", r.HeadHash),
+		fmt.Sprintf("State root|%s", r.StateRoot),
+		fmt.Sprintf("Removed canonical blocks|%d", r.Removed),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
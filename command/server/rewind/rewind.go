@@ -0,0 +1,51 @@
+package rewind
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	dbHelper "github.com/plingatech/go-plgchain/command/db/helper"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	rewindCmd := &cobra.Command{
+		Use: "rewind",
+		Short: "Rewinds the chain of the stopped node to the given block, so the later blocks are synced again. " +
+			"The state of the block has to be present",
+		Run: runCommand,
+	}
+
+	setFlags(rewindCmd)
+	helper.SetRequiredFlags(rewindCmd, params.getRequiredFlags())
+
+	return rewindCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.to,
+		toFlag,
+		0,
+		"the number of the block which becomes the new head",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.rewind(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
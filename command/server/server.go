@@ -3,10 +3,12 @@ package server
 import (
 	"fmt"
 
+	"github.com/plingatech/go-plgchain/blockchain/storage"
 	"github.com/plingatech/go-plgchain/command"
 	"github.com/plingatech/go-plgchain/command/helper"
 	"github.com/plingatech/go-plgchain/command/server/config"
 	"github.com/plingatech/go-plgchain/command/server/export"
+	"github.com/plingatech/go-plgchain/command/server/rewind"
 	"github.com/plingatech/go-plgchain/server"
 	"github.com/spf13/cobra"
 )
@@ -33,6 +35,8 @@ func registerSubcommands(baseCmd *cobra.Command) {
 	baseCmd.AddCommand(
 		// server export
 		export.GetCommand(),
+		// server rewind
+		rewind.GetCommand(),
 	)
 }
 
@@ -67,6 +71,25 @@ func setFlags(cmd *cobra.Command) {
 		"the data directory used for storing Go Plgchain client data",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.StorageBackend,
+		storageBackendFlag,
+		defaultConfig.StorageBackend,
+		fmt.Sprintf(
+			"the key-value database used for the data directory (%s or %s). "+
+				"It must match the backend the data directory was created with",
+			storage.LevelDBBackend, storage.PebbleBackend,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.AncientThreshold,
+		ancientThresholdFlag,
+		defaultConfig.AncientThreshold,
+		"the number of the most recent blocks kept in the key-value database, "+
+			"older blocks are moved into the append-only ancient store. Value of 0 disables it",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.Network.Libp2pAddr,
 		libp2pAddressFlag,
@@ -185,6 +208,48 @@ func setFlags(cmd *cobra.Command) {
 		"maximum number of enqueued transactions per account",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.PriceBump,
+		priceBumpFlag,
+		defaultConfig.TxPool.PriceBump,
+		"minimum gas price increase in percent for replacing a pool transaction with the same nonce",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.LocalSlots,
+		localSlotsFlag,
+		defaultConfig.TxPool.LocalSlots,
+		"number of txpool slots reserved for transactions submitted through the local endpoints (0 disables the reservation)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.TxPool.NoJournal,
+		noJournalFlag,
+		defaultConfig.TxPool.NoJournal,
+		"disable the journal keeping the local transactions across node restarts",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.RejournalInterval,
+		rejournalIntervalFlag,
+		defaultConfig.TxPool.RejournalInterval,
+		"period in seconds of rewriting the local transactions journal",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.Lifetime,
+		txLifetimeFlag,
+		defaultConfig.TxPool.Lifetime,
+		"maximum time in seconds a remote transaction can spend in the txpool, 0 disables the expiry",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.AnnounceThreshold,
+		txAnnounceThresholdFlag,
+		defaultConfig.TxPool.AnnounceThreshold,
+		"size in bytes above which transactions are announced by hash instead of gossiped in full",
+	)
+
 	cmd.Flags().StringArrayVar(
 		&params.corsAllowedOrigins,
 		corsOriginFlag,
@@ -207,6 +272,14 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCAdmin,
+		jsonRPCAdminFlag,
+		defaultConfig.JSONRPCAdmin,
+		"enables the json-rpc admin methods which change the node state (e.g. debug_setHead). "+
+			"Only enable it when the json-rpc address is not publicly reachable",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -228,6 +301,57 @@ func setFlags(cmd *cobra.Command) {
 		"minimal number of child blocks required for the parent block to be considered final",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.RoundTimeout.Base,
+		roundTimeoutBaseFlag,
+		defaultConfig.RoundTimeout.Base,
+		"the timeout of the first IBFT round in seconds, which should exceed the block time "+
+			"(the default timeouts of IBFT are used if zero)",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.RoundTimeout.Multiplier,
+		roundTimeoutMultiplierFlag,
+		defaultConfig.RoundTimeout.Multiplier,
+		"the factor by which the timeout of each next IBFT round grows",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.RoundTimeout.Max,
+		roundTimeoutMaxFlag,
+		defaultConfig.RoundTimeout.Max,
+		"the maximum timeout of the IBFT round in seconds, the timeouts are unbounded if zero",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.RemoteSignerAddr,
+		remoteSignerFlag,
+		defaultConfig.RemoteSignerAddr,
+		"the address of the remote signer holding the validator keys, the keys are loaded "+
+			"from the secrets manager if not set. Without TLS, only a loopback address is allowed",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.RemoteSignerTLS.CertFile,
+		remoteSignerTLSCertFlag,
+		defaultConfig.RemoteSignerTLS.CertFile,
+		"the TLS certificate presented to the remote signer requiring the mutual TLS",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.RemoteSignerTLS.KeyFile,
+		remoteSignerTLSKeyFlag,
+		defaultConfig.RemoteSignerTLS.KeyFile,
+		"the private key of the TLS certificate presented to the remote signer",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.RemoteSignerTLS.CAFile,
+		remoteSignerTLSCAFlag,
+		defaultConfig.RemoteSignerTLS.CAFile,
+		"the certificate authority verifying the remote signer, the system roots are used if not set",
+	)
+
 	setLegacyFlags(cmd)
 
 	setDevFlags(cmd)
@@ -0,0 +1,182 @@
+package claimrewards
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/txrelayer"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/spf13/cobra"
+)
+
+var params claimRewardsParams
+
+func GetCommand() *cobra.Command {
+	claimRewardsCmd := &cobra.Command{
+		Use: "claim-rewards",
+		Short: "Claims the validator reward of the account, or its delegator reward " +
+			"when the validator address is provided",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	helper.RegisterJSONRPCFlag(claimRewardsCmd)
+	setFlags(claimRewardsCmd)
+
+	return claimRewardsCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		plgbftsecrets.AccountDirFlag,
+		"",
+		plgbftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		plgbftsecrets.AccountConfigFlag,
+		"",
+		plgbftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.validator,
+		sidechainHelper.ValidatorFlag,
+		"",
+		"address of the validator to claim the delegator reward from",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.restake,
+		sidechainHelper.RestakeFlag,
+		false,
+		"indicates if the claimed delegator reward is delegated to the validator instead of registered for withdrawal",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(plgbftsecrets.AccountDirFlag, plgbftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := claimRewards()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+func claimRewards() (*ClaimRewardsResult, error) {
+	account, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ClaimRewardsResult{
+		Address: account.Ecdsa.Address().String(),
+		Amount:  big.NewInt(0),
+	}
+
+	if params.validator == "" {
+		return result, claimValidatorReward(txRelayer, account, result)
+	}
+
+	result.Validator = params.validatorAddr.String()
+
+	return result, claimDelegatorReward(txRelayer, account, result)
+}
+
+// claimValidatorReward registers the validator reward of the account for withdrawal
+func claimValidatorReward(txRelayer txrelayer.TxRelayer, account *wallet.Account,
+	result *ClaimRewardsResult) error {
+	input, err := (&contractsapi.ClaimValidatorRewardChildValidatorSetFn{}).EncodeAbi()
+	if err != nil {
+		return fmt.Errorf("failed to encode input parameters for claimValidatorReward fn: %w", err)
+	}
+
+	receipt, err := sidechainHelper.SendValidatorSetTransaction(txRelayer, account, input, nil)
+	if err != nil {
+		return fmt.Errorf("claim validator reward %w", err)
+	}
+
+	var rewardClaimedEvent contractsapi.ValidatorRewardClaimedEvent
+
+	// no event is emitted when there is no reward to claim
+	for _, log := range receipt.Logs {
+		doesMatch, err := rewardClaimedEvent.ParseLog(log)
+		if err != nil {
+			return err
+		}
+
+		if doesMatch {
+			result.Amount = rewardClaimedEvent.Amount
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// claimDelegatorReward either restakes the delegator reward or registers it for withdrawal
+func claimDelegatorReward(txRelayer txrelayer.TxRelayer, account *wallet.Account,
+	result *ClaimRewardsResult) error {
+	claimFn := &contractsapi.ClaimDelegatorRewardChildValidatorSetFn{
+		Validator: types.Address(params.validatorAddr),
+		Restake:   params.restake,
+	}
+
+	input, err := claimFn.EncodeAbi()
+	if err != nil {
+		return fmt.Errorf("failed to encode input parameters for claimDelegatorReward fn: %w", err)
+	}
+
+	receipt, err := sidechainHelper.SendValidatorSetTransaction(txRelayer, account, input, nil)
+	if err != nil {
+		return fmt.Errorf("claim delegator reward %w", err)
+	}
+
+	var rewardClaimedEvent contractsapi.DelegatorRewardClaimedEvent
+
+	// no event is emitted when there is no reward to claim
+	for _, log := range receipt.Logs {
+		doesMatch, err := rewardClaimedEvent.ParseLog(log)
+		if err != nil {
+			return err
+		}
+
+		if doesMatch {
+			result.Amount = rewardClaimedEvent.Amount
+			result.Restake = rewardClaimedEvent.Restake
+
+			break
+		}
+	}
+
+	return nil
+}
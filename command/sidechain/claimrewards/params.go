@@ -0,0 +1,75 @@
+package claimrewards
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/umbracle/ethgo"
+)
+
+type claimRewardsParams struct {
+	accountDir    string
+	accountConfig string
+	jsonRPC       string
+	validator     string
+	restake       bool
+
+	validatorAddr ethgo.Address
+}
+
+func (cp *claimRewardsParams) validateFlags() (err error) {
+	if err = sidechainHelper.ValidateSecretFlags(cp.accountDir, cp.accountConfig); err != nil {
+		return err
+	}
+
+	if cp.validator == "" {
+		if cp.restake {
+			return fmt.Errorf("only delegator rewards can be restaked, validator address must be provided")
+		}
+
+		return nil
+	}
+
+	cp.validatorAddr, err = sidechainHelper.ParseAddress(cp.validator)
+
+	return err
+}
+
+type ClaimRewardsResult struct {
+	Address   string   `json:"address"`
+	Validator string   `json:"validator,omitempty"`
+	Amount    *big.Int `json:"amount"`
+	Restake   bool     `json:"restake"`
+}
+
+func (cr *ClaimRewardsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	if cr.Validator == "" {
+		buffer.WriteString("\n[VALIDATOR REWARD CLAIMED]\n")
+	} else {
+		buffer.WriteString("\n[DELEGATOR REWARD CLAIMED]\n")
+	}
+
+	vals := make([]string, 0, 4)
+	vals = append(vals, fmt.Sprintf("Address|%s", cr.Address))
+
+	if cr.Validator != "" {
+		vals = append(vals, fmt.Sprintf("Delegated To|%s", cr.Validator))
+	}
+
+	vals = append(vals, fmt.Sprintf("Amount Claimed|%s", cr.Amount))
+	vals = append(vals, fmt.Sprintf("Reward Restaked|%t", cr.Restake))
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	if !cr.Restake && cr.Amount.Sign() > 0 {
+		buffer.WriteString("Claimed reward is registered for withdrawal\n")
+	}
+
+	return buffer.String()
+}
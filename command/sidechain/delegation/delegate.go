@@ -0,0 +1,162 @@
+package delegation
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	"github.com/plingatech/go-plgchain/txrelayer"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/spf13/cobra"
+)
+
+var params delegateParams
+
+func GetCommand() *cobra.Command {
+	delegateCmd := &cobra.Command{
+		Use:     "delegate",
+		Short:   "Delegates the amount sent in the transaction to the validator",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	helper.RegisterJSONRPCFlag(delegateCmd)
+	setFlags(delegateCmd)
+
+	return delegateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		plgbftsecrets.AccountDirFlag,
+		"",
+		plgbftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		plgbftsecrets.AccountConfigFlag,
+		"",
+		plgbftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.validator,
+		sidechainHelper.ValidatorFlag,
+		"",
+		"address of the validator to delegate to",
+	)
+
+	cmd.Flags().StringVar(
+		&params.amount,
+		sidechainHelper.AmountFlag,
+		"",
+		"amount to delegate (in wei)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.restake,
+		sidechainHelper.RestakeFlag,
+		false,
+		"indicates if the pending delegator reward, claimed before the delegation, is delegated as well",
+	)
+
+	_ = cmd.MarkFlagRequired(sidechainHelper.ValidatorFlag)
+	_ = cmd.MarkFlagRequired(sidechainHelper.AmountFlag)
+	cmd.MarkFlagsMutuallyExclusive(plgbftsecrets.AccountDirFlag, plgbftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := delegate()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+func delegate() (*DelegateResult, error) {
+	account, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond))
+	if err != nil {
+		return nil, err
+	}
+
+	delegateFn := &contractsapi.DelegateChildValidatorSetFn{
+		Validator: types.Address(params.validatorAddr),
+		Restake:   params.restake,
+	}
+
+	input, err := delegateFn.EncodeAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode input parameters for delegate fn: %w", err)
+	}
+
+	receipt, err := sidechainHelper.SendValidatorSetTransaction(txRelayer, account, input, params.amountValue)
+	if err != nil {
+		return nil, fmt.Errorf("delegate %w", err)
+	}
+
+	result := &DelegateResult{
+		Delegator:     account.Ecdsa.Address().String(),
+		Validator:     params.validatorAddr.String(),
+		ClaimedReward: big.NewInt(0),
+	}
+
+	var (
+		delegatedEvent     contractsapi.DelegatedEvent
+		rewardClaimedEvent contractsapi.DelegatorRewardClaimedEvent
+	)
+
+	for _, log := range receipt.Logs {
+		doesMatch, err := rewardClaimedEvent.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		if doesMatch {
+			result.ClaimedReward = rewardClaimedEvent.Amount
+			result.Restake = rewardClaimedEvent.Restake
+
+			continue
+		}
+
+		doesMatch, err = delegatedEvent.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		// a restaked reward is delegated before the sent amount, so the last event is the one of interest
+		if doesMatch {
+			result.Amount = delegatedEvent.Amount
+		}
+	}
+
+	if result.Amount == nil {
+		return nil, fmt.Errorf("could not find an appropriate log in receipt that delegation happened")
+	}
+
+	return result, nil
+}
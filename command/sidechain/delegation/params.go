@@ -0,0 +1,66 @@
+package delegation
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/umbracle/ethgo"
+)
+
+type delegateParams struct {
+	accountDir    string
+	accountConfig string
+	jsonRPC       string
+	validator     string
+	amount        string
+	restake       bool
+
+	validatorAddr ethgo.Address
+	amountValue   *big.Int
+}
+
+func (dp *delegateParams) validateFlags() (err error) {
+	if err = sidechainHelper.ValidateSecretFlags(dp.accountDir, dp.accountConfig); err != nil {
+		return err
+	}
+
+	if dp.validatorAddr, err = sidechainHelper.ParseAddress(dp.validator); err != nil {
+		return err
+	}
+
+	if dp.amountValue, err = types.ParseUint256orHex(&dp.amount); err != nil || dp.amountValue.Sign() <= 0 {
+		return fmt.Errorf("provided amount '%s' isn't valid", dp.amount)
+	}
+
+	return nil
+}
+
+type DelegateResult struct {
+	Delegator     string   `json:"delegator"`
+	Validator     string   `json:"validator"`
+	Amount        *big.Int `json:"amount"`
+	ClaimedReward *big.Int `json:"claimedReward"`
+	Restake       bool     `json:"restake"`
+}
+
+func (dr *DelegateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DELEGATED AMOUNT]\n")
+
+	vals := make([]string, 0, 5)
+	vals = append(vals, fmt.Sprintf("Delegator Address|%s", dr.Delegator))
+	vals = append(vals, fmt.Sprintf("Validator Address|%s", dr.Validator))
+	vals = append(vals, fmt.Sprintf("Amount Delegated|%s", dr.Amount))
+	vals = append(vals, fmt.Sprintf("Reward Claimed|%s", dr.ClaimedReward))
+	vals = append(vals, fmt.Sprintf("Reward Restaked|%t", dr.Restake))
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -18,9 +18,11 @@ import (
 )
 
 const (
-	SelfFlag   = "self"
-	EtherFlag  = "ether"
-	AmountFlag = "amount"
+	SelfFlag      = "self"
+	EtherFlag     = "ether"
+	AmountFlag    = "amount"
+	ValidatorFlag = "validator"
+	RestakeFlag   = "restake"
 
 	DefaultGasPrice = 1879048192 // 0x70000000
 )
@@ -101,25 +103,85 @@ func GetValidatorInfo(validatorAddr ethgo.Address, txRelayer txrelayer.TxRelayer
 	}, nil
 }
 
+// ParseAddress decodes the given hex encoded address and checks its length
+func ParseAddress(addr string) (ethgo.Address, error) {
+	raw, err := hex.DecodeHex(addr)
+	if err != nil {
+		return ethgo.ZeroAddress, fmt.Errorf("provided address '%s' isn't valid: %w", addr, err)
+	}
+
+	if len(raw) != types.AddressLength {
+		return ethgo.ZeroAddress, fmt.Errorf("provided address '%s' isn't valid: invalid length", addr)
+	}
+
+	return ethgo.BytesToAddress(raw), nil
+}
+
 // GetDelegatorReward queries delegator reward for given validator and delegator addresses
 func GetDelegatorReward(validatorAddr ethgo.Address, delegatorAddr ethgo.Address,
 	txRelayer txrelayer.TxRelayer) (*big.Int, error) {
-	input, err := contractsapi.ChildValidatorSet.Abi.Methods["getDelegatorReward"].Encode(
-		[]interface{}{validatorAddr, delegatorAddr})
+	getDelegatorRewardFn := &contractsapi.GetDelegatorRewardChildValidatorSetFn{
+		Validator: types.Address(validatorAddr),
+		Delegator: types.Address(delegatorAddr),
+	}
+
+	input, err := getDelegatorRewardFn.EncodeAbi()
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode input parameters for getDelegatorReward fn: %w", err)
 	}
 
+	return callRewardFn(input, txRelayer)
+}
+
+// GetValidatorReward queries validator reward for given validator address
+func GetValidatorReward(validatorAddr ethgo.Address, txRelayer txrelayer.TxRelayer) (*big.Int, error) {
+	getValidatorRewardFn := &contractsapi.GetValidatorRewardChildValidatorSetFn{
+		Validator: types.Address(validatorAddr),
+	}
+
+	input, err := getValidatorRewardFn.EncodeAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode input parameters for getValidatorReward fn: %w", err)
+	}
+
+	return callRewardFn(input, txRelayer)
+}
+
+// SendValidatorSetTransaction sends the transaction calling ChildValidatorSet smart contract
+// and checks whether it has been executed successfully
+func SendValidatorSetTransaction(txRelayer txrelayer.TxRelayer, account *wallet.Account,
+	input []byte, value *big.Int) (*ethgo.Receipt, error) {
+	txn := &ethgo.Transaction{
+		From:     account.Ecdsa.Address(),
+		Input:    input,
+		To:       (*ethgo.Address)(&contracts.ValidatorSetContract),
+		Value:    value,
+		GasPrice: DefaultGasPrice,
+	}
+
+	receipt, err := txRelayer.SendTransaction(txn, account.Ecdsa)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == uint64(types.ReceiptFailed) {
+		return nil, fmt.Errorf("transaction failed on block %d", receipt.BlockNumber)
+	}
+
+	return receipt, nil
+}
+
+func callRewardFn(input []byte, txRelayer txrelayer.TxRelayer) (*big.Int, error) {
 	response, err := txRelayer.Call(ethgo.Address(contracts.SystemCaller),
 		ethgo.Address(contracts.ValidatorSetContract), input)
 	if err != nil {
 		return nil, err
 	}
 
-	delegatorReward, err := types.ParseUint256orHex(&response)
+	reward, err := types.ParseUint256orHex(&response)
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode hex response, %w", err)
 	}
 
-	return delegatorReward, nil
+	return reward, nil
 }
@@ -0,0 +1,61 @@
+package rewards
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/umbracle/ethgo"
+)
+
+type rewardsParams struct {
+	accountDir    string
+	accountConfig string
+	jsonRPC       string
+	validator     string
+
+	validatorAddr ethgo.Address
+}
+
+func (rp *rewardsParams) validateFlags() (err error) {
+	if err = sidechainHelper.ValidateSecretFlags(rp.accountDir, rp.accountConfig); err != nil {
+		return err
+	}
+
+	if rp.validator != "" {
+		if rp.validatorAddr, err = sidechainHelper.ParseAddress(rp.validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type RewardsResult struct {
+	Address         string   `json:"address"`
+	ValidatorReward *big.Int `json:"validatorReward"`
+	Validator       string   `json:"validator,omitempty"`
+	DelegatorReward *big.Int `json:"delegatorReward,omitempty"`
+}
+
+func (rr *RewardsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PENDING REWARDS]\n")
+
+	vals := make([]string, 0, 4)
+	vals = append(vals, fmt.Sprintf("Address|%s", rr.Address))
+	vals = append(vals, fmt.Sprintf("Validator Reward|%s", rr.ValidatorReward))
+
+	if rr.DelegatorReward != nil {
+		vals = append(vals, fmt.Sprintf("Delegated To|%s", rr.Validator))
+		vals = append(vals, fmt.Sprintf("Delegator Reward|%s", rr.DelegatorReward))
+	}
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
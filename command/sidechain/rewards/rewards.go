@@ -0,0 +1,106 @@
+package rewards
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/plingatech/go-plgchain/txrelayer"
+	"github.com/spf13/cobra"
+)
+
+var params rewardsParams
+
+func GetCommand() *cobra.Command {
+	rewardsCmd := &cobra.Command{
+		Use:     "rewards",
+		Short:   "Queries the pending validator and delegator rewards of the account",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	helper.RegisterJSONRPCFlag(rewardsCmd)
+	setFlags(rewardsCmd)
+
+	return rewardsCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		plgbftsecrets.AccountDirFlag,
+		"",
+		plgbftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		plgbftsecrets.AccountConfigFlag,
+		"",
+		plgbftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.validator,
+		sidechainHelper.ValidatorFlag,
+		"",
+		"address of the validator to query the delegator reward for",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(plgbftsecrets.AccountDirFlag, plgbftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := queryRewards()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+func queryRewards() (*RewardsResult, error) {
+	account, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC))
+	if err != nil {
+		return nil, err
+	}
+
+	address := account.Ecdsa.Address()
+
+	validatorReward, err := sidechainHelper.GetValidatorReward(address, txRelayer)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RewardsResult{
+		Address:         address.String(),
+		ValidatorReward: validatorReward,
+	}
+
+	if params.validator != "" {
+		result.Validator = params.validatorAddr.String()
+
+		if result.DelegatorReward, err = sidechainHelper.GetDelegatorReward(
+			params.validatorAddr, address, txRelayer); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package undelegation
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/umbracle/ethgo"
+)
+
+type undelegateParams struct {
+	accountDir    string
+	accountConfig string
+	jsonRPC       string
+	validator     string
+	amount        string
+
+	validatorAddr ethgo.Address
+	amountValue   *big.Int
+}
+
+func (up *undelegateParams) validateFlags() (err error) {
+	if err = sidechainHelper.ValidateSecretFlags(up.accountDir, up.accountConfig); err != nil {
+		return err
+	}
+
+	if up.validatorAddr, err = sidechainHelper.ParseAddress(up.validator); err != nil {
+		return err
+	}
+
+	if up.amountValue, err = types.ParseUint256orHex(&up.amount); err != nil || up.amountValue.Sign() <= 0 {
+		return fmt.Errorf("provided amount '%s' isn't valid", up.amount)
+	}
+
+	return nil
+}
+
+type UndelegateResult struct {
+	Delegator     string   `json:"delegator"`
+	Validator     string   `json:"validator"`
+	Amount        *big.Int `json:"amount"`
+	ClaimedReward *big.Int `json:"claimedReward"`
+}
+
+func (ur *UndelegateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[UNDELEGATED AMOUNT]\n")
+
+	vals := make([]string, 0, 4)
+	vals = append(vals, fmt.Sprintf("Delegator Address|%s", ur.Delegator))
+	vals = append(vals, fmt.Sprintf("Validator Address|%s", ur.Validator))
+	vals = append(vals, fmt.Sprintf("Amount Undelegated|%s", ur.Amount))
+	vals = append(vals, fmt.Sprintf("Reward Claimed|%s", ur.ClaimedReward))
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+	buffer.WriteString("Undelegated amount and claimed reward are registered for withdrawal\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,153 @@
+package undelegation
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/command/plgbftsecrets"
+	sidechainHelper "github.com/plingatech/go-plgchain/command/sidechain"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	"github.com/plingatech/go-plgchain/txrelayer"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/spf13/cobra"
+)
+
+var params undelegateParams
+
+func GetCommand() *cobra.Command {
+	undelegateCmd := &cobra.Command{
+		Use:     "undelegate",
+		Short:   "Undelegates the amount from the validator and registers it for withdrawal",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	helper.RegisterJSONRPCFlag(undelegateCmd)
+	setFlags(undelegateCmd)
+
+	return undelegateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		plgbftsecrets.AccountDirFlag,
+		"",
+		plgbftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		plgbftsecrets.AccountConfigFlag,
+		"",
+		plgbftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.validator,
+		sidechainHelper.ValidatorFlag,
+		"",
+		"address of the validator to undelegate from",
+	)
+
+	cmd.Flags().StringVar(
+		&params.amount,
+		sidechainHelper.AmountFlag,
+		"",
+		"amount to undelegate (in wei)",
+	)
+
+	_ = cmd.MarkFlagRequired(sidechainHelper.ValidatorFlag)
+	_ = cmd.MarkFlagRequired(sidechainHelper.AmountFlag)
+	cmd.MarkFlagsMutuallyExclusive(plgbftsecrets.AccountDirFlag, plgbftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := undelegate()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+func undelegate() (*UndelegateResult, error) {
+	account, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond))
+	if err != nil {
+		return nil, err
+	}
+
+	undelegateFn := &contractsapi.UndelegateChildValidatorSetFn{
+		Validator: types.Address(params.validatorAddr),
+		Amount:    params.amountValue,
+	}
+
+	input, err := undelegateFn.EncodeAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode input parameters for undelegate fn: %w", err)
+	}
+
+	receipt, err := sidechainHelper.SendValidatorSetTransaction(txRelayer, account, input, nil)
+	if err != nil {
+		return nil, fmt.Errorf("undelegate %w", err)
+	}
+
+	result := &UndelegateResult{
+		Delegator:     account.Ecdsa.Address().String(),
+		Validator:     params.validatorAddr.String(),
+		ClaimedReward: big.NewInt(0),
+	}
+
+	var (
+		undelegatedEvent   contractsapi.UndelegatedEvent
+		rewardClaimedEvent contractsapi.DelegatorRewardClaimedEvent
+	)
+
+	for _, log := range receipt.Logs {
+		doesMatch, err := rewardClaimedEvent.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		if doesMatch {
+			result.ClaimedReward = rewardClaimedEvent.Amount
+
+			continue
+		}
+
+		doesMatch, err = undelegatedEvent.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		if doesMatch {
+			result.Amount = undelegatedEvent.Amount
+		}
+	}
+
+	if result.Amount == nil {
+		return nil, fmt.Errorf("could not find an appropriate log in receipt that undelegation happened")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,41 @@
+package clearaccount
+
+import (
+	"context"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	txpoolOp "github.com/plingatech/go-plgchain/txpool/proto"
+)
+
+var (
+	params = &clearParams{}
+)
+
+const (
+	addressFlag = "address"
+)
+
+type clearParams struct {
+	address string
+}
+
+func (p *clearParams) getRequiredFlags() []string {
+	return []string{
+		addressFlag,
+	}
+}
+
+func (p *clearParams) validateFlags() error {
+	return (&txpoolOp.ClearAccountReq{Address: p.address}).ValidateAll()
+}
+
+func (p *clearParams) clearAccount(grpcAddress string) (*txpoolOp.ClearAccountResp, error) {
+	client, err := helper.GetTxPoolClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ClearAccount(context.Background(), &txpoolOp.ClearAccountReq{
+		Address: p.address,
+	})
+}
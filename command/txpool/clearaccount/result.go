@@ -0,0 +1,32 @@
+package clearaccount
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type TxPoolClearResult struct {
+	Address string   `json:"address"`
+	Dropped []string `json:"dropped"`
+}
+
+func (r *TxPoolClearResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL CLEAR]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Account|%s", r.Address),
+		fmt.Sprintf("Dropped transactions|%d", len(r.Dropped)),
+	}))
+
+	if len(r.Dropped) > 0 {
+		buffer.WriteString("\n\n[LIST OF DROPPED TRANSACTIONS]\n")
+		buffer.WriteString(helper.FormatList(r.Dropped))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
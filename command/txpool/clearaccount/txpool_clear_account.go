@@ -0,0 +1,51 @@
+package clearaccount
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	txPoolClearCmd := &cobra.Command{
+		Use:     "clear",
+		Short:   "Removes all the transactions of the account from the transaction pool",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(txPoolClearCmd)
+	helper.SetRequiredFlags(txPoolClearCmd, params.getRequiredFlags())
+
+	return txPoolClearCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.address,
+		addressFlag,
+		"",
+		"the address of the account to clear",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	resp, err := params.clearAccount(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(&TxPoolClearResult{
+		Address: params.address,
+		Dropped: resp.TxHashes,
+	})
+}
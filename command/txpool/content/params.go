@@ -0,0 +1,89 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	txpoolOp "github.com/plingatech/go-plgchain/txpool/proto"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+var (
+	params = &contentParams{}
+)
+
+const (
+	addressFlag = "address"
+)
+
+type contentParams struct {
+	address string
+}
+
+func (p *contentParams) getRequiredFlags() []string {
+	return []string{
+		addressFlag,
+	}
+}
+
+func (p *contentParams) validateFlags() error {
+	return (&txpoolOp.ContentFromReq{Address: p.address}).ValidateAll()
+}
+
+func (p *contentParams) getContent(grpcAddress string) (*TxPoolContentResult, error) {
+	client, err := helper.GetTxPoolClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContentFrom(context.Background(), &txpoolOp.ContentFromReq{
+		Address: p.address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := decodeTxs(resp.Pending)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := decodeTxs(resp.Queued)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxPoolContentResult{
+		Address: p.address,
+		Pending: pending,
+		Queued:  queued,
+	}, nil
+}
+
+func decodeTxs(raws [][]byte) ([]*TxPoolContentTx, error) {
+	txs := make([]*TxPoolContentTx, len(raws))
+
+	for i, raw := range raws {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, fmt.Errorf("failed to decode transaction: %w", err)
+		}
+
+		to := ""
+		if tx.To != nil {
+			to = tx.To.String()
+		}
+
+		txs[i] = &TxPoolContentTx{
+			Hash:     tx.Hash.String(),
+			Nonce:    tx.Nonce,
+			To:       to,
+			Value:    tx.Value.String(),
+			Gas:      tx.Gas,
+			GasPrice: tx.GasPrice.String(),
+		}
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,60 @@
+package content
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type TxPoolContentTx struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	To       string `json:"to"`
+	Value    string `json:"value"`
+	Gas      uint64 `json:"gas"`
+	GasPrice string `json:"gasPrice"`
+}
+
+type TxPoolContentResult struct {
+	Address string             `json:"address"`
+	Pending []*TxPoolContentTx `json:"pending"`
+	Queued  []*TxPoolContentTx `json:"queued"`
+}
+
+func (r *TxPoolContentResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL CONTENT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Account|%s", r.Address),
+		fmt.Sprintf("Pending transactions|%d", len(r.Pending)),
+		fmt.Sprintf("Queued transactions|%d", len(r.Queued)),
+	}))
+
+	if len(r.Pending) > 0 {
+		buffer.WriteString("\n\n[PENDING TRANSACTIONS]\n")
+		buffer.WriteString(formatTxs(r.Pending))
+	}
+
+	if len(r.Queued) > 0 {
+		buffer.WriteString("\n\n[QUEUED TRANSACTIONS]\n")
+		buffer.WriteString(formatTxs(r.Queued))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+func formatTxs(txs []*TxPoolContentTx) string {
+	rows := make([]string, len(txs)+1)
+	rows[0] = "Nonce|Hash|To|Value|Gas|Gas Price"
+
+	for i, tx := range txs {
+		rows[i+1] = fmt.Sprintf("%d|%s|%s|%s|%d|%s",
+			tx.Nonce, tx.Hash, tx.To, tx.Value, tx.Gas, tx.GasPrice)
+	}
+
+	return helper.FormatList(rows)
+}
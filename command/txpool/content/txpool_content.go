@@ -0,0 +1,48 @@
+package content
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	txPoolContentCmd := &cobra.Command{
+		Use:     "content",
+		Short:   "Lists the transactions of the account in the transaction pool",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(txPoolContentCmd)
+	helper.SetRequiredFlags(txPoolContentCmd, params.getRequiredFlags())
+
+	return txPoolContentCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.address,
+		addressFlag,
+		"",
+		"the address of the account",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.getContent(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
@@ -0,0 +1,41 @@
+package drop
+
+import (
+	"context"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+	txpoolOp "github.com/plingatech/go-plgchain/txpool/proto"
+)
+
+var (
+	params = &dropParams{}
+)
+
+const (
+	hashFlag = "hash"
+)
+
+type dropParams struct {
+	hash string
+}
+
+func (p *dropParams) getRequiredFlags() []string {
+	return []string{
+		hashFlag,
+	}
+}
+
+func (p *dropParams) validateFlags() error {
+	return (&txpoolOp.DropTxnReq{Hash: p.hash}).ValidateAll()
+}
+
+func (p *dropParams) dropTxn(grpcAddress string) (*txpoolOp.DropTxnResp, error) {
+	client, err := helper.GetTxPoolClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DropTxn(context.Background(), &txpoolOp.DropTxnReq{
+		Hash: p.hash,
+	})
+}
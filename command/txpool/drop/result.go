@@ -0,0 +1,30 @@
+package drop
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type TxPoolDropResult struct {
+	Dropped []string `json:"dropped"`
+}
+
+func (r *TxPoolDropResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL DROP]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Dropped transactions|%d", len(r.Dropped)),
+	}))
+
+	if len(r.Dropped) > 0 {
+		buffer.WriteString("\n\n[LIST OF DROPPED TRANSACTIONS]\n")
+		buffer.WriteString(helper.FormatList(r.Dropped))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
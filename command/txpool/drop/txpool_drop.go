@@ -0,0 +1,51 @@
+package drop
+
+import (
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	txPoolDropCmd := &cobra.Command{
+		Use: "drop",
+		Short: "Removes the transaction from the transaction pool, together with " +
+			"the transactions of the same account having a higher nonce",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(txPoolDropCmd)
+	helper.SetRequiredFlags(txPoolDropCmd, params.getRequiredFlags())
+
+	return txPoolDropCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.hash,
+		hashFlag,
+		"",
+		"the hash of the transaction to drop",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	resp, err := params.dropTxn(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(&TxPoolDropResult{
+		Dropped: resp.TxHashes,
+	})
+}
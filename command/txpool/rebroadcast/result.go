@@ -0,0 +1,24 @@
+package rebroadcast
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/command/helper"
+)
+
+type TxPoolRebroadcastResult struct {
+	Transactions uint64 `json:"transactions"`
+}
+
+func (r *TxPoolRebroadcastResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL REBROADCAST]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Number of gossiped transactions|%d", r.Transactions),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
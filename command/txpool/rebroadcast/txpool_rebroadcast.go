@@ -0,0 +1,47 @@
+package rebroadcast
+
+import (
+	"context"
+
+	"github.com/plingatech/go-plgchain/command"
+	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/spf13/cobra"
+
+	txpoolOp "github.com/plingatech/go-plgchain/txpool/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebroadcast",
+		Short: "Gossips the pending transactions of the transaction pool to the network again",
+		Run:   runCommand,
+	}
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	resp, err := rebroadcast(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(&TxPoolRebroadcastResult{
+		Transactions: resp.Count,
+	})
+}
+
+func rebroadcast(grpcAddress string) (*txpoolOp.RebroadcastResp, error) {
+	client, err := helper.GetTxPoolClientConnection(
+		grpcAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Rebroadcast(context.Background(), &empty.Empty{})
+}
@@ -14,6 +14,9 @@ var (
 	droppedFlag        = "dropped"
 	prunedPromotedFlag = "pruned-promoted"
 	prunedEnqueuedFlag = "pruned-enqueued"
+	replacedFlag       = "replaced"
+	evictedFlag        = "evicted"
+	expiredFlag        = "expired"
 )
 
 type subscribeParams struct {
@@ -31,6 +34,9 @@ func (sp *subscribeParams) initEventMap() {
 		proto.EventType_DEMOTED:         &falseRaw,
 		proto.EventType_PRUNED_PROMOTED: &falseRaw,
 		proto.EventType_PRUNED_ENQUEUED: &falseRaw,
+		proto.EventType_REPLACED:        &falseRaw,
+		proto.EventType_EVICTED:         &falseRaw,
+		proto.EventType_EXPIRED:         &falseRaw,
 	}
 }
 
@@ -65,5 +71,8 @@ func (sp *subscribeParams) setAllEvents() {
 		proto.EventType_DEMOTED,
 		proto.EventType_PRUNED_PROMOTED,
 		proto.EventType_PRUNED_ENQUEUED,
+		proto.EventType_REPLACED,
+		proto.EventType_EVICTED,
+		proto.EventType_EXPIRED,
 	}
 }
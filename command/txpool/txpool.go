@@ -2,6 +2,10 @@ package txpool
 
 import (
 	"github.com/plingatech/go-plgchain/command/helper"
+	"github.com/plingatech/go-plgchain/command/txpool/clearaccount"
+	"github.com/plingatech/go-plgchain/command/txpool/content"
+	"github.com/plingatech/go-plgchain/command/txpool/drop"
+	"github.com/plingatech/go-plgchain/command/txpool/rebroadcast"
 	"github.com/plingatech/go-plgchain/command/txpool/status"
 	"github.com/plingatech/go-plgchain/command/txpool/subscribe"
 	"github.com/spf13/cobra"
@@ -26,5 +30,13 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		status.GetCommand(),
 		// txpool subscribe
 		subscribe.GetCommand(),
+		// txpool drop
+		drop.GetCommand(),
+		// txpool clear
+		clearaccount.GetCommand(),
+		// txpool rebroadcast
+		rebroadcast.GetCommand(),
+		// txpool content
+		content.GetCommand(),
 	)
 }
@@ -7,8 +7,10 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/blockchain"
 	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	"github.com/plingatech/go-plgchain/helper/progress"
 	"github.com/plingatech/go-plgchain/network"
+	"github.com/plingatech/go-plgchain/remotesigner"
 	"github.com/plingatech/go-plgchain/secrets"
 	"github.com/plingatech/go-plgchain/state"
 	"github.com/plingatech/go-plgchain/txpool"
@@ -75,6 +77,11 @@ type Params struct {
 	BlockTime      uint64
 
 	NumBlockConfirmations uint64
+
+	RoundTimeout *roundchange.Timeout
+
+	// RemoteSigner signs by the validator keys if they are held by the remote signer
+	RemoteSigner remotesigner.Signer
 }
 
 // Factory is the factory function to create a discovery consensus
@@ -0,0 +1,136 @@
+package emptyblock
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// pendingTxsPollInterval is the interval in which the skipper checks whether the block should be produced
+const pendingTxsPollInterval = 100 * time.Millisecond
+
+// ErrInvalidMaxBlockInterval is returned if the maximum block interval is shorter than the block time
+var ErrInvalidMaxBlockInterval = errors.New("max block interval must not be shorter than block time")
+
+// Params are the parameters of the skipper in effect at a block
+type Params struct {
+	// MaxBlockInterval is the maximum period between two blocks, zero disables skipping
+	MaxBlockInterval time.Duration
+
+	// BlockTime is the period needed to produce a block once its production starts
+	BlockTime time.Duration
+}
+
+// Skipper delays the production of empty blocks. The block on top of the parent is produced
+// once there are pending transactions, the maximum block interval since the parent passes,
+// or a consensus message of the block is received from another validator
+type Skipper struct {
+	// paramsAt returns the parameters in effect at the block with the given number
+	paramsAt func(number uint64) Params
+
+	// hasPendingTxs returns true if there are transactions to be included in the block
+	hasPendingTxs func() bool
+
+	// latestMessageHeight is the greatest height of the received consensus messages
+	latestMessageHeight uint64
+}
+
+// NewSkipper creates a new skipper of the empty blocks. Skipping is disabled if the maximum block interval is zero
+func NewSkipper(maxBlockInterval, blockTime time.Duration, hasPendingTxs func() bool) (*Skipper, error) {
+	params := Params{
+		MaxBlockInterval: maxBlockInterval,
+		BlockTime:        blockTime,
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return NewForkSkipper(func(uint64) Params { return params }, hasPendingTxs), nil
+}
+
+// NewForkSkipper creates a new skipper of the empty blocks with the parameters resolved per block,
+// so they can change at the given heights. The caller validates the parameters
+func NewForkSkipper(paramsAt func(number uint64) Params, hasPendingTxs func() bool) *Skipper {
+	return &Skipper{
+		paramsAt:      paramsAt,
+		hasPendingTxs: hasPendingTxs,
+	}
+}
+
+// Validate checks that the maximum block interval is not shorter than the block time
+func (p Params) Validate() error {
+	if p.MaxBlockInterval != 0 && p.MaxBlockInterval < p.BlockTime {
+		return ErrInvalidMaxBlockInterval
+	}
+
+	return nil
+}
+
+// Enabled returns true if empty blocks are skipped at the block with the given number
+func (s *Skipper) Enabled(number uint64) bool {
+	return s != nil && s.paramsAt(number).MaxBlockInterval > 0
+}
+
+// OnMessage notifies the skipper that a consensus message of the given height is received
+func (s *Skipper) OnMessage(height uint64) {
+	if s == nil {
+		return
+	}
+
+	for {
+		latest := atomic.LoadUint64(&s.latestMessageHeight)
+		if height <= latest || atomic.CompareAndSwapUint64(&s.latestMessageHeight, latest, height) {
+			return
+		}
+	}
+}
+
+// Wait returns a channel which is closed once the block on top of the given parent should be produced.
+// The returned stop function aborts the waiting
+func (s *Skipper) Wait(parent *types.Header) (<-chan struct{}, func()) {
+	var (
+		produceCh = make(chan struct{})
+		stopCh    = make(chan struct{})
+	)
+
+	// block production takes the block time, so it starts in advance to meet the maximum block interval
+	params := s.paramsAt(parent.Number + 1)
+	deadline := time.Unix(int64(parent.Timestamp), 0).Add(params.MaxBlockInterval - params.BlockTime)
+
+	go func() {
+		ticker := time.NewTicker(pendingTxsPollInterval)
+		defer ticker.Stop()
+
+		for {
+			if s.shouldProduce(parent.Number+1, deadline) {
+				close(produceCh)
+
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+
+	var stopped uint32
+
+	return produceCh, func() {
+		if atomic.CompareAndSwapUint32(&stopped, 0, 1) {
+			close(stopCh)
+		}
+	}
+}
+
+// shouldProduce returns true if the block with the given number should be produced
+func (s *Skipper) shouldProduce(number uint64, deadline time.Time) bool {
+	return !time.Now().Before(deadline) ||
+		atomic.LoadUint64(&s.latestMessageHeight) >= number ||
+		s.hasPendingTxs()
+}
@@ -0,0 +1,155 @@
+package emptyblock
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSkipper(t *testing.T) {
+	t.Parallel()
+
+	skipper, err := NewSkipper(0, time.Second, nil)
+	require.NoError(t, err)
+	assert.False(t, skipper.Enabled(1))
+
+	_, err = NewSkipper(time.Second, 2*time.Second, nil)
+	assert.ErrorIs(t, err, ErrInvalidMaxBlockInterval)
+
+	skipper, err = NewSkipper(time.Minute, time.Second, nil)
+	require.NoError(t, err)
+	assert.True(t, skipper.Enabled(1))
+}
+
+func TestNewForkSkipper(t *testing.T) {
+	t.Parallel()
+
+	// skipping is enabled from the block 10, with the max block interval of the block being produced
+	skipper := NewForkSkipper(func(number uint64) Params {
+		if number < 10 {
+			return Params{BlockTime: time.Second}
+		}
+
+		return Params{MaxBlockInterval: time.Hour, BlockTime: time.Second}
+	}, func() bool { return false })
+
+	assert.False(t, skipper.Enabled(9))
+	assert.True(t, skipper.Enabled(10))
+
+	var nilSkipper *Skipper
+	assert.False(t, nilSkipper.Enabled(10))
+
+	// the parent was sealed more than an hour ago
+	produceCh, stop := skipper.Wait(&types.Header{
+		Number:    9,
+		Timestamp: uint64(time.Now().Add(-time.Hour).Unix()),
+	})
+	defer stop()
+
+	select {
+	case <-produceCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("block should be produced once max block interval passes")
+	}
+}
+
+func TestSkipper_Wait(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 2 * time.Second
+
+	now := uint64(time.Now().Unix())
+
+	t.Run("pending transactions", func(t *testing.T) {
+		t.Parallel()
+
+		var pendingTxs uint32
+
+		skipper, err := NewSkipper(time.Hour, time.Second, func() bool {
+			return atomic.LoadUint32(&pendingTxs) > 0
+		})
+		require.NoError(t, err)
+
+		produceCh, stop := skipper.Wait(&types.Header{Number: 1, Timestamp: now})
+		defer stop()
+
+		select {
+		case <-produceCh:
+			t.Fatal("empty block should be skipped")
+		case <-time.After(3 * pendingTxsPollInterval):
+		}
+
+		atomic.StoreUint32(&pendingTxs, 1)
+
+		select {
+		case <-produceCh:
+		case <-time.After(timeout):
+			t.Fatal("block should be produced once transactions are pending")
+		}
+	})
+
+	t.Run("consensus message", func(t *testing.T) {
+		t.Parallel()
+
+		skipper, err := NewSkipper(time.Hour, time.Second, func() bool { return false })
+		require.NoError(t, err)
+
+		produceCh, stop := skipper.Wait(&types.Header{Number: 1, Timestamp: now})
+		defer stop()
+
+		// messages of the previous heights are ignored
+		skipper.OnMessage(1)
+
+		select {
+		case <-produceCh:
+			t.Fatal("empty block should be skipped")
+		case <-time.After(3 * pendingTxsPollInterval):
+		}
+
+		skipper.OnMessage(2)
+
+		select {
+		case <-produceCh:
+		case <-time.After(timeout):
+			t.Fatal("block should be produced once other validators start producing it")
+		}
+	})
+
+	t.Run("max block interval", func(t *testing.T) {
+		t.Parallel()
+
+		skipper, err := NewSkipper(time.Hour, time.Second, func() bool { return false })
+		require.NoError(t, err)
+
+		produceCh, stop := skipper.Wait(&types.Header{Number: 1, Timestamp: now - uint64(time.Hour.Seconds())})
+		defer stop()
+
+		select {
+		case <-produceCh:
+		case <-time.After(timeout):
+			t.Fatal("block should be produced once max block interval passes")
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		t.Parallel()
+
+		skipper, err := NewSkipper(time.Hour, time.Second, func() bool { return false })
+		require.NoError(t, err)
+
+		produceCh, stop := skipper.Wait(&types.Header{Number: 1, Timestamp: now})
+
+		stop()
+		stop()
+
+		select {
+		case <-produceCh:
+			t.Fatal("block should not be produced after waiting is stopped")
+		case <-time.After(3 * pendingTxsPollInterval):
+		}
+	})
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/plingatech/go-plgchain/consensus"
 	"github.com/plingatech/go-plgchain/consensus/ibft/signer"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	"github.com/plingatech/go-plgchain/helper/hex"
 	"github.com/plingatech/go-plgchain/state"
+	"github.com/plingatech/go-plgchain/txpool"
 	"github.com/plingatech/go-plgchain/types"
 	"github.com/plingatech/plg-ibft/messages"
 	"github.com/plingatech/plg-ibft/messages/proto"
@@ -30,7 +32,7 @@ func (i *backendIBFT) BuildProposal(view *proto.View) []byte {
 		return nil
 	}
 
-	block, err := i.buildBlock(latestHeader)
+	block, err := i.buildBlock(latestHeader, view.Round)
 	if err != nil {
 		i.logger.Error("cannot build block", "num", view.Height, "err", err)
 
@@ -52,6 +54,8 @@ func (i *backendIBFT) InsertProposal(
 		return
 	}
 
+	i.roundTracker.OnPhase(roundchange.PhaseFin, newBlock.Number(), proposal.Round)
+
 	committedSealsMap := make(map[types.Address][]byte, len(committedSeals))
 
 	for _, cm := range committedSeals {
@@ -106,6 +110,10 @@ func (i *backendIBFT) InsertProposal(
 
 	i.updateMetrics(newBlock)
 
+	if err := i.trackLiveness(newBlock.Header); err != nil {
+		i.logger.Error("failed to track validators liveness", "height", newBlock.Number(), "err", err)
+	}
+
 	i.logger.Info(
 		"block committed",
 		"number", newBlock.Number(),
@@ -174,8 +182,8 @@ func (i *backendIBFT) HasQuorum(
 	}
 }
 
-// buildBlock builds the block, based on the passed in snapshot and parent header
-func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
+// buildBlock builds the block proposed in the given round, based on the passed in snapshot and parent header
+func (i *backendIBFT) buildBlock(parent *types.Header, round uint64) (*types.Block, error) {
 	header := &types.Header{
 		ParentHash: parent.Hash,
 		Number:     parent.Number + 1,
@@ -244,7 +252,7 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 	})
 
 	// write the seal of the block after all the fields are completed
-	header, err = i.currentSigner.WriteProposerSeal(header)
+	header, err = i.currentSigner.WriteProposerSeal(header, round)
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +341,8 @@ func (i *backendIBFT) writeTransactions(
 
 	i.txpool.Prepare()
 
+	reservation := txpool.NewGasReservation(i.blockchain.Config().TxOrdering, gasLimit)
+
 write:
 	for {
 		select {
@@ -344,6 +354,7 @@ write:
 				i.txpool.Peek(),
 				transition,
 				gasLimit,
+				reservation,
 			)
 
 			if !ok {
@@ -374,11 +385,17 @@ func (i *backendIBFT) writeTransaction(
 	tx *types.Transaction,
 	transition transitionInterface,
 	gasLimit uint64,
+	reservation *txpool.GasReservation,
 ) (*txExeResult, bool) {
 	if tx == nil {
 		return nil, false
 	}
 
+	// the transaction is left in the pool for the next block
+	if !reservation.Allows(tx) {
+		return &txExeResult{tx, skip}, true
+	}
+
 	if tx.ExceedsBlockGasLimit(gasLimit) {
 		i.txpool.Drop(tx)
 
@@ -411,6 +428,7 @@ func (i *backendIBFT) writeTransaction(
 	}
 
 	i.txpool.Pop(tx)
+	reservation.Use(tx)
 
 	return &txExeResult{tx, success}, true
 }
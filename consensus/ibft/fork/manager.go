@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/consensus/ibft/hook"
 	"github.com/plingatech/go-plgchain/consensus/ibft/signer"
+	"github.com/plingatech/go-plgchain/remotesigner"
 	"github.com/plingatech/go-plgchain/secrets"
 	"github.com/plingatech/go-plgchain/state"
 	"github.com/plingatech/go-plgchain/types"
@@ -61,6 +62,7 @@ type ForkManager struct {
 	blockchain     store.HeaderGetter
 	executor       contract.Executor
 	secretsManager secrets.SecretsManager
+	remoteSigner   remotesigner.Signer
 
 	// configuration
 	forks     IBFTForks
@@ -79,6 +81,7 @@ func NewForkManager(
 	blockchain store.HeaderGetter,
 	executor contract.Executor,
 	secretManager secrets.SecretsManager,
+	remoteSigner remotesigner.Signer,
 	filePath string,
 	epochSize uint64,
 	ibftConfig map[string]interface{},
@@ -93,6 +96,7 @@ func NewForkManager(
 		blockchain:      blockchain,
 		executor:        executor,
 		secretsManager:  secretManager,
+		remoteSigner:    remoteSigner,
 		filePath:        filePath,
 		epochSize:       epochSize,
 		forks:           forks,
@@ -238,7 +242,17 @@ func (m *ForkManager) initializeKeyManager(valType validators.ValidatorType) err
 		return nil
 	}
 
-	keyManager, err := signer.NewKeyManagerFromType(m.secretsManager, valType)
+	var (
+		keyManager signer.KeyManager
+		err        error
+	)
+
+	if m.remoteSigner != nil {
+		keyManager, err = signer.NewRemoteKeyManager(m.remoteSigner, valType)
+	} else {
+		keyManager, err = signer.NewKeyManagerFromType(m.secretsManager, valType)
+	}
+
 	if err != nil {
 		return err
 	}
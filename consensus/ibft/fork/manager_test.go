@@ -76,6 +76,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			"",
 			0,
 			map[string]interface{}{},
@@ -105,6 +106,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			secretManager,
+			nil,
 			"",
 			epochSize,
 			map[string]interface{}{
@@ -153,6 +155,7 @@ func TestNewForkManager(t *testing.T) {
 			blockchain,
 			nil,
 			secretManager,
+			nil,
 			dirPath,
 			epochSize,
 			map[string]interface{}{
@@ -234,6 +237,7 @@ func TestNewForkManager(t *testing.T) {
 			blockchain,
 			nil,
 			secretManager,
+			nil,
 			dirPath,
 			epochSize,
 			map[string]interface{}{
@@ -279,6 +283,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			secretManager,
+			nil,
 			"",
 			epochSize,
 			map[string]interface{}{
@@ -1,6 +1,7 @@
 package ibft
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -9,9 +10,13 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/blockchain"
 	"github.com/plingatech/go-plgchain/consensus"
+	"github.com/plingatech/go-plgchain/consensus/emptyblock"
 	"github.com/plingatech/go-plgchain/consensus/ibft/fork"
 	"github.com/plingatech/go-plgchain/consensus/ibft/proto"
 	"github.com/plingatech/go-plgchain/consensus/ibft/signer"
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
+	"github.com/plingatech/go-plgchain/helper/common"
 	"github.com/plingatech/go-plgchain/helper/progress"
 	"github.com/plingatech/go-plgchain/network"
 	"github.com/plingatech/go-plgchain/secrets"
@@ -27,6 +32,9 @@ const (
 	IbftKeyName      = "validator.key"
 	KeyEpochSize     = "epochSize"
 
+	// KeyMaxBlockInterval is the maximum period between blocks if empty blocks are skipped
+	KeyMaxBlockInterval = "maxBlockInterval"
+
 	ibftProto = "/ibft/0.2"
 
 	// consensusMetrics is a prefix used for consensus-related metrics
@@ -40,6 +48,7 @@ var (
 	ErrInvalidSha3Uncles            = errors.New("invalid sha3 uncles")
 	ErrWrongDifficulty              = errors.New("wrong difficulty")
 	ErrParentCommittedSealsNotFound = errors.New("parent committed seals not found")
+	ErrInvalidMaxBlockInterval      = errors.New("invalid max block interval provided")
 )
 
 type txPoolInterface interface {
@@ -83,6 +92,7 @@ type backendIBFT struct {
 	currentSigner     signer.Signer         // Signer at current sequence
 	currentValidators validators.Validators // signer at current sequence
 	currentHooks      fork.HooksInterface   // Hooks at current sequence
+	livenessTracker   *liveness.Tracker     // Liveness of the validators
 
 	// Configurations
 	config             *consensus.Config // Consensus configuration
@@ -90,6 +100,9 @@ type backendIBFT struct {
 	quorumSizeBlockNum uint64
 	blockTime          time.Duration // Minimum block generation time in seconds
 
+	emptyBlockSkipper *emptyblock.Skipper  // Delays empty blocks up to the max block interval
+	roundTracker      *roundchange.Tracker // Applies the round timeouts and tracks the rounds of the sequence
+
 	// Channels
 	closeCh chan struct{} // Channel for closing
 }
@@ -122,6 +135,29 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		quorumSizeBlockNum = uint64(readBlockNum)
 	}
 
+	var maxBlockInterval common.Duration
+
+	if rawMaxBlockInterval, ok := params.Config.Config[KeyMaxBlockInterval]; ok {
+		// Empty blocks are skipped up to the max block interval
+		raw, err := json.Marshal(rawMaxBlockInterval)
+		if err != nil {
+			return nil, ErrInvalidMaxBlockInterval
+		}
+
+		if err := json.Unmarshal(raw, &maxBlockInterval); err != nil {
+			return nil, ErrInvalidMaxBlockInterval
+		}
+	}
+
+	blockTime := time.Duration(params.BlockTime) * time.Second
+
+	emptyBlockSkipper, err := emptyblock.NewSkipper(maxBlockInterval.Duration, blockTime, func() bool {
+		return params.TxPool.Length() > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	logger := params.Logger.Named("ibft")
 
 	forkManager, err := fork.NewForkManager(
@@ -129,6 +165,7 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		params.Blockchain,
 		params.Executor,
 		params.SecretsManager,
+		params.RemoteSigner,
 		params.Config.Path,
 		epochSize,
 		params.Config.Config,
@@ -159,12 +196,20 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		config:             params.Config,
 		epochSize:          epochSize,
 		quorumSizeBlockNum: quorumSizeBlockNum,
-		blockTime:          time.Duration(params.BlockTime) * time.Second,
+		blockTime:          blockTime,
+		emptyBlockSkipper:  emptyBlockSkipper,
 
 		// Channels
 		closeCh: make(chan struct{}),
 	}
 
+	p.roundTracker, err = roundchange.NewTracker(params.RoundTimeout, func(extension time.Duration) {
+		p.consensus.ExtendRoundTimeout(extension)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Istanbul requires a different header hash function
 	p.SetHeaderHash()
 
@@ -188,6 +233,14 @@ func (i *backendIBFT) Initialize() error {
 		return err
 	}
 
+	// restore the liveness of the validators
+	livenessSnapshot, err := loadLivenessSnapshot(i.livenessPath())
+	if err != nil {
+		i.logger.Warn("Liveness file is broken, start tracking from scratch", "filepath", i.livenessPath(), "err", err)
+	}
+
+	i.livenessTracker = liveness.NewTracker(liveness.DefaultWindowSize, livenessSnapshot)
+
 	if err := i.updateCurrentModules(i.blockchain.Header().Number + 1); err != nil {
 		return err
 	}
@@ -200,7 +253,8 @@ func (i *backendIBFT) Initialize() error {
 		i,
 	)
 
-	// Ensure consensus takes into account user configured block production time
+	// Ensure consensus takes into account user configured block production time,
+	// the round tracker overrides it if the round timeouts are configured
 	i.consensus.ExtendRoundTimeout(i.blockTime)
 
 	return nil
@@ -213,6 +267,10 @@ func (i *backendIBFT) startSyncing() {
 			i.logger.Error("failed to call PostInsertBlock", "height", fullBlock.Block.Header.Number, "error", err)
 		}
 
+		if err := i.trackLiveness(fullBlock.Block.Header); err != nil {
+			i.logger.Error("failed to track validators liveness", "height", fullBlock.Block.Header.Number, "err", err)
+		}
+
 		if err := i.updateCurrentModules(fullBlock.Block.Number() + 1); err != nil {
 			i.logger.Error("failed to update sub modules", "height", fullBlock.Block.Number()+1, "err", err)
 		}
@@ -303,7 +361,27 @@ func (i *backendIBFT) startConsensus() {
 
 		i.txpool.SetSealing(isValidator)
 
+		// empty blocks are skipped until there are transactions to include,
+		// except for the epoch ending blocks which are produced on schedule
+		if isValidator && i.emptyBlockSkipper.Enabled(pending) && !i.IsLastOfEpoch(pending) {
+			produceCh, stopWaiting := i.emptyBlockSkipper.Wait(i.blockchain.Header())
+
+			select {
+			case <-produceCh:
+			case <-syncerBlockCh:
+				stopWaiting()
+
+				continue
+			case <-i.closeCh:
+				stopWaiting()
+
+				return
+			}
+		}
+
 		if isValidator {
+			i.roundTracker.StartSequence(pending)
+
 			sequenceCh = i.consensus.runSequence(pending)
 		}
 
@@ -527,6 +605,12 @@ func (i *backendIBFT) Close() error {
 		}
 	}
 
+	if i.livenessTracker != nil {
+		if err := saveLivenessSnapshot(i.livenessPath(), i.livenessTracker.Snapshot()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,146 @@
+package ibft
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	"github.com/plingatech/go-plgchain/helper/common"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/go-plgchain/validators"
+)
+
+const (
+	// livenessFilename is the name of the file the validators liveness is stored in
+	livenessFilename = "liveness"
+)
+
+// trackLiveness records which validators signed and proposed the given block
+func (i *backendIBFT) trackLiveness(header *types.Header) error {
+	headerSigner, validators, _, err := getModulesFromForkManager(i.forkManager, header.Number)
+	if err != nil {
+		return err
+	}
+
+	extra, err := headerSigner.GetIBFTExtra(header)
+	if err != nil {
+		return err
+	}
+
+	hash, err := i.calculateProposalHash(headerSigner, header, extra.RoundNumber)
+	if err != nil {
+		return err
+	}
+
+	sealers, err := headerSigner.GetCommittedSealers(hash, extra.CommittedSeals, validators)
+	if err != nil {
+		return err
+	}
+
+	proposer, err := headerSigner.EcrecoverFromHeader(header)
+	if err != nil {
+		return err
+	}
+
+	parentHeader, ok := i.blockchain.GetHeaderByNumber(header.Number - 1)
+	if !ok {
+		return fmt.Errorf("header %d not found", header.Number-1)
+	}
+
+	lastProposer, err := i.extractProposer(parentHeader)
+	if err != nil {
+		return err
+	}
+
+	i.livenessTracker.Track(newLivenessBlock(
+		header.Number,
+		i.GetEpoch(header.Number),
+		validators,
+		sealers,
+		proposer,
+		lastProposer,
+		extra.RoundNumber,
+	))
+
+	return nil
+}
+
+// newLivenessBlock creates the liveness record of the block
+// from its committed sealers and the proposers of the rounds needed to seal it
+func newLivenessBlock(
+	number, epoch uint64,
+	vals validators.Validators,
+	sealers []types.Address,
+	proposer, lastProposer types.Address,
+	round *uint64,
+) *liveness.Block {
+	block := &liveness.Block{
+		Number:   number,
+		Epoch:    epoch,
+		Proposer: proposer,
+	}
+
+	signed := make(map[types.Address]bool, len(sealers))
+	for _, sealer := range sealers {
+		signed[sealer] = true
+	}
+
+	for idx := 0; idx < vals.Len(); idx++ {
+		addr := vals.At(uint64(idx)).Addr()
+
+		if signed[addr] {
+			block.Signers = append(block.Signers, addr)
+		} else {
+			block.Absentees = append(block.Absentees, addr)
+		}
+	}
+
+	// the round is not recorded in the legacy headers
+	if round == nil || vals.Len() == 0 {
+		return block
+	}
+
+	// proposers of the previous rounds failed to get their proposals committed
+	for r := uint64(0); r < *round; r++ {
+		block.MissedProposers = append(block.MissedProposers, CalcProposer(vals, r, lastProposer).Addr())
+	}
+
+	return block
+}
+
+// loadLivenessSnapshot loads the liveness snapshot from file
+// return nil if the file doesn't exist
+func loadLivenessSnapshot(path string) (*liveness.Snapshot, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot *liveness.Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// saveLivenessSnapshot writes the liveness snapshot to file
+func saveLivenessSnapshot(path string, snapshot *liveness.Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return common.SaveFileSafe(path, data, 0660)
+}
+
+// livenessPath returns the path of the file the validators liveness is stored in
+func (i *backendIBFT) livenessPath() string {
+	return filepath.Join(i.config.Path, livenessFilename)
+}
@@ -0,0 +1,53 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/go-plgchain/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLivenessBlock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+		addr3 = types.StringToAddress("3")
+		addr4 = types.StringToAddress("4")
+
+		vals = validators.NewECDSAValidatorSet(
+			validators.NewECDSAValidator(addr1),
+			validators.NewECDSAValidator(addr2),
+			validators.NewECDSAValidator(addr3),
+			validators.NewECDSAValidator(addr4),
+		)
+	)
+
+	t.Run("should record signers, absentees and proposers of the previous rounds", func(t *testing.T) {
+		t.Parallel()
+
+		round := uint64(2)
+
+		// addr1 proposed the parent block, so addr2 and addr3 were the proposers of rounds 0 and 1
+		block := newLivenessBlock(10, 1, vals, []types.Address{addr4, addr1}, addr4, addr1, &round)
+
+		assert.Equal(t, uint64(10), block.Number)
+		assert.Equal(t, uint64(1), block.Epoch)
+		assert.Equal(t, addr4, block.Proposer)
+		assert.Equal(t, []types.Address{addr1, addr4}, block.Signers)
+		assert.Equal(t, []types.Address{addr2, addr3}, block.Absentees)
+		assert.Equal(t, []types.Address{addr2, addr3}, block.MissedProposers)
+	})
+
+	t.Run("should not record missed proposers for legacy headers", func(t *testing.T) {
+		t.Parallel()
+
+		block := newLivenessBlock(10, 1, vals, []types.Address{addr1, addr2, addr3, addr4}, addr2, addr1, nil)
+
+		assert.Equal(t, []types.Address{addr1, addr2, addr3, addr4}, block.Signers)
+		assert.Empty(t, block.Absentees)
+		assert.Empty(t, block.MissedProposers)
+	})
+}
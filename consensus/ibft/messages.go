@@ -3,6 +3,7 @@ package ibft
 import (
 	"google.golang.org/protobuf/proto"
 
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	protoIBFT "github.com/plingatech/plg-ibft/messages/proto"
 )
 
@@ -24,6 +25,8 @@ func (i *backendIBFT) BuildPrePrepareMessage(
 	certificate *protoIBFT.RoundChangeCertificate,
 	view *protoIBFT.View,
 ) *protoIBFT.Message {
+	i.roundTracker.OnPhase(roundchange.PhasePrepare, view.Height, view.Round)
+
 	proposedBlock := &protoIBFT.Proposal{
 		RawProposal: rawProposal,
 		Round:       view.Round,
@@ -52,6 +55,8 @@ func (i *backendIBFT) BuildPrePrepareMessage(
 }
 
 func (i *backendIBFT) BuildPrepareMessage(proposalHash []byte, view *protoIBFT.View) *protoIBFT.Message {
+	i.roundTracker.OnPhase(roundchange.PhasePrepare, view.Height, view.Round)
+
 	msg := &protoIBFT.Message{
 		View: view,
 		From: i.ID(),
@@ -67,7 +72,9 @@ func (i *backendIBFT) BuildPrepareMessage(proposalHash []byte, view *protoIBFT.V
 }
 
 func (i *backendIBFT) BuildCommitMessage(proposalHash []byte, view *protoIBFT.View) *protoIBFT.Message {
-	committedSeal, err := i.currentSigner.CreateCommittedSeal(proposalHash)
+	i.roundTracker.OnPhase(roundchange.PhaseCommit, view.Height, view.Round)
+
+	committedSeal, err := i.currentSigner.CreateCommittedSeal(proposalHash, view.Height, view.Round)
 	if err != nil {
 		i.logger.Error("Unable to build commit message, %v", err)
 
@@ -94,6 +101,9 @@ func (i *backendIBFT) BuildRoundChangeMessage(
 	certificate *protoIBFT.PreparedCertificate,
 	view *protoIBFT.View,
 ) *protoIBFT.Message {
+	// the message is built once the round timer expires, right before the timer of the new round starts
+	i.roundTracker.OnRoundTimeout(view.Height, view.Round)
+
 	msg := &protoIBFT.Message{
 		View: view,
 		From: i.ID(),
@@ -7,6 +7,7 @@ import (
 
 	"github.com/plingatech/go-plgchain/consensus/ibft/proto"
 	"github.com/plingatech/go-plgchain/consensus/ibft/signer"
+	"github.com/plingatech/go-plgchain/consensus/liveness"
 	"github.com/plingatech/go-plgchain/crypto"
 	"github.com/plingatech/go-plgchain/types"
 	"github.com/plingatech/go-plgchain/validators"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	ErrVotingNotSupported = errors.New("voting is not supported")
-	ErrHeaderNotFound     = errors.New("header not found")
+	ErrVotingNotSupported  = errors.New("voting is not supported")
+	ErrHeaderNotFound      = errors.New("header not found")
+	ErrLivenessNotTracking = errors.New("liveness is not being tracked")
 )
 
 type operator struct {
@@ -120,6 +122,35 @@ func (o *operator) Candidates(ctx context.Context, req *empty.Empty) (*proto.Can
 	}, nil
 }
 
+// Liveness returns the liveness of the validators over the sliding window of the latest blocks and in the given epoch
+func (o *operator) Liveness(ctx context.Context, req *proto.LivenessReq) (*proto.LivenessResp, error) {
+	tracker := o.ibft.livenessTracker
+	if tracker == nil {
+		return nil, ErrLivenessNotTracking
+	}
+
+	epoch := req.Epoch
+	if epoch == 0 {
+		epoch = o.ibft.GetEpoch(o.ibft.blockchain.Header().Number)
+	}
+
+	// no block of the current epoch may be inserted yet
+	epochStats, ok := tracker.Epoch(epoch)
+	if !ok && req.Epoch != 0 {
+		return nil, fmt.Errorf("liveness of epoch %d is not tracked", epoch)
+	}
+
+	windowFrom, windowTo, windowStats := tracker.Window()
+
+	return &proto.LivenessResp{
+		WindowFrom:    windowFrom,
+		WindowTo:      windowTo,
+		Window:        livenessToProtoLiveness(windowStats),
+		Epoch:         epoch,
+		EpochLiveness: livenessToProtoLiveness(epochStats),
+	}, nil
+}
+
 // parseCandidate parses proto.Candidate and maps to validator
 func (o *operator) parseCandidate(req *proto.Candidate) (validators.Validator, error) {
 	signer, err := o.getLatestSigner()
@@ -229,6 +260,22 @@ func candidatesToProtoCandidates(candidates []*store.Candidate) []*proto.Candida
 	return protoCandidates
 }
 
+func livenessToProtoLiveness(stats []*liveness.ValidatorStats) []*proto.LivenessResp_ValidatorLiveness {
+	protoLiveness := make([]*proto.LivenessResp_ValidatorLiveness, len(stats))
+
+	for idx, s := range stats {
+		protoLiveness[idx] = &proto.LivenessResp_ValidatorLiveness{
+			Address:         s.Address.String(),
+			BlocksSigned:    s.BlocksSigned,
+			BlocksMissed:    s.BlocksMissed,
+			ProposalsMade:   s.ProposalsMade,
+			ProposalsMissed: s.ProposalsMissed,
+		}
+	}
+
+	return protoLiveness
+}
+
 // getVotes gets votes from validator store only if store supports voting
 func getVotes(validatorStore store.ValidatorStore, height uint64) ([]*store.Vote, error) {
 	votableStore, ok := validatorStore.(Votable)
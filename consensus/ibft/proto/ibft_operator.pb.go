@@ -68,6 +68,133 @@ func (x *IbftStatusResp) GetKey() string {
 	return ""
 }
 
+type LivenessReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of the epoch to query, the current epoch if not specified
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *LivenessReq) Reset() {
+	*x = LivenessReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivenessReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessReq) ProtoMessage() {}
+
+func (x *LivenessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessReq.ProtoReflect.Descriptor instead.
+func (*LivenessReq) Descriptor() ([]byte, []int) {
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LivenessReq) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type LivenessResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WindowFrom    uint64                            `protobuf:"varint,1,opt,name=window_from,json=windowFrom,proto3" json:"window_from,omitempty"`
+	WindowTo      uint64                            `protobuf:"varint,2,opt,name=window_to,json=windowTo,proto3" json:"window_to,omitempty"`
+	Window        []*LivenessResp_ValidatorLiveness `protobuf:"bytes,3,rep,name=window,proto3" json:"window,omitempty"`
+	Epoch         uint64                            `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EpochLiveness []*LivenessResp_ValidatorLiveness `protobuf:"bytes,5,rep,name=epoch_liveness,json=epochLiveness,proto3" json:"epoch_liveness,omitempty"`
+}
+
+func (x *LivenessResp) Reset() {
+	*x = LivenessResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivenessResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessResp) ProtoMessage() {}
+
+func (x *LivenessResp) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessResp.ProtoReflect.Descriptor instead.
+func (*LivenessResp) Descriptor() ([]byte, []int) {
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LivenessResp) GetWindowFrom() uint64 {
+	if x != nil {
+		return x.WindowFrom
+	}
+	return 0
+}
+
+func (x *LivenessResp) GetWindowTo() uint64 {
+	if x != nil {
+		return x.WindowTo
+	}
+	return 0
+}
+
+func (x *LivenessResp) GetWindow() []*LivenessResp_ValidatorLiveness {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *LivenessResp) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LivenessResp) GetEpochLiveness() []*LivenessResp_ValidatorLiveness {
+	if x != nil {
+		return x.EpochLiveness
+	}
+	return nil
+}
+
 type SnapshotReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -80,7 +207,7 @@ type SnapshotReq struct {
 func (x *SnapshotReq) Reset() {
 	*x = SnapshotReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[1]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -93,7 +220,7 @@ func (x *SnapshotReq) String() string {
 func (*SnapshotReq) ProtoMessage() {}
 
 func (x *SnapshotReq) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[1]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,7 +233,7 @@ func (x *SnapshotReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotReq.ProtoReflect.Descriptor instead.
 func (*SnapshotReq) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{1}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SnapshotReq) GetLatest() bool {
@@ -137,7 +264,7 @@ type Snapshot struct {
 func (x *Snapshot) Reset() {
 	*x = Snapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[2]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -150,7 +277,7 @@ func (x *Snapshot) String() string {
 func (*Snapshot) ProtoMessage() {}
 
 func (x *Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[2]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -163,7 +290,7 @@ func (x *Snapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{2}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Snapshot) GetValidators() []*Snapshot_Validator {
@@ -206,7 +333,7 @@ type ProposeReq struct {
 func (x *ProposeReq) Reset() {
 	*x = ProposeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[3]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -219,7 +346,7 @@ func (x *ProposeReq) String() string {
 func (*ProposeReq) ProtoMessage() {}
 
 func (x *ProposeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[3]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +359,7 @@ func (x *ProposeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeReq.ProtoReflect.Descriptor instead.
 func (*ProposeReq) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{3}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProposeReq) GetAddress() string {
@@ -260,7 +387,7 @@ type CandidatesResp struct {
 func (x *CandidatesResp) Reset() {
 	*x = CandidatesResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[4]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -273,7 +400,7 @@ func (x *CandidatesResp) String() string {
 func (*CandidatesResp) ProtoMessage() {}
 
 func (x *CandidatesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[4]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +413,7 @@ func (x *CandidatesResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CandidatesResp.ProtoReflect.Descriptor instead.
 func (*CandidatesResp) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{4}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CandidatesResp) GetCandidates() []*Candidate {
@@ -309,7 +436,7 @@ type Candidate struct {
 func (x *Candidate) Reset() {
 	*x = Candidate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[5]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +449,7 @@ func (x *Candidate) String() string {
 func (*Candidate) ProtoMessage() {}
 
 func (x *Candidate) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[5]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +462,7 @@ func (x *Candidate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Candidate.ProtoReflect.Descriptor instead.
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{5}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Candidate) GetAddress() string {
@@ -359,6 +486,85 @@ func (x *Candidate) GetAuth() bool {
 	return false
 }
 
+type LivenessResp_ValidatorLiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BlocksSigned    uint64 `protobuf:"varint,2,opt,name=blocks_signed,json=blocksSigned,proto3" json:"blocks_signed,omitempty"`
+	BlocksMissed    uint64 `protobuf:"varint,3,opt,name=blocks_missed,json=blocksMissed,proto3" json:"blocks_missed,omitempty"`
+	ProposalsMade   uint64 `protobuf:"varint,4,opt,name=proposals_made,json=proposalsMade,proto3" json:"proposals_made,omitempty"`
+	ProposalsMissed uint64 `protobuf:"varint,5,opt,name=proposals_missed,json=proposalsMissed,proto3" json:"proposals_missed,omitempty"`
+}
+
+func (x *LivenessResp_ValidatorLiveness) Reset() {
+	*x = LivenessResp_ValidatorLiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivenessResp_ValidatorLiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessResp_ValidatorLiveness) ProtoMessage() {}
+
+func (x *LivenessResp_ValidatorLiveness) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessResp_ValidatorLiveness.ProtoReflect.Descriptor instead.
+func (*LivenessResp_ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *LivenessResp_ValidatorLiveness) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LivenessResp_ValidatorLiveness) GetBlocksSigned() uint64 {
+	if x != nil {
+		return x.BlocksSigned
+	}
+	return 0
+}
+
+func (x *LivenessResp_ValidatorLiveness) GetBlocksMissed() uint64 {
+	if x != nil {
+		return x.BlocksMissed
+	}
+	return 0
+}
+
+func (x *LivenessResp_ValidatorLiveness) GetProposalsMade() uint64 {
+	if x != nil {
+		return x.ProposalsMade
+	}
+	return 0
+}
+
+func (x *LivenessResp_ValidatorLiveness) GetProposalsMissed() uint64 {
+	if x != nil {
+		return x.ProposalsMissed
+	}
+	return 0
+}
+
 type Snapshot_Validator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -372,7 +578,7 @@ type Snapshot_Validator struct {
 func (x *Snapshot_Validator) Reset() {
 	*x = Snapshot_Validator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[6]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +591,7 @@ func (x *Snapshot_Validator) String() string {
 func (*Snapshot_Validator) ProtoMessage() {}
 
 func (x *Snapshot_Validator) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[6]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +604,7 @@ func (x *Snapshot_Validator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Snapshot_Validator.ProtoReflect.Descriptor instead.
 func (*Snapshot_Validator) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{2, 0}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *Snapshot_Validator) GetType() string {
@@ -435,7 +641,7 @@ type Snapshot_Vote struct {
 func (x *Snapshot_Vote) Reset() {
 	*x = Snapshot_Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[7]
+		mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -448,7 +654,7 @@ func (x *Snapshot_Vote) String() string {
 func (*Snapshot_Vote) ProtoMessage() {}
 
 func (x *Snapshot_Vote) ProtoReflect() protoreflect.Message {
-	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[7]
+	mi := &file_consensus_ibft_proto_ibft_operator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +667,7 @@ func (x *Snapshot_Vote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Snapshot_Vote.ProtoReflect.Descriptor instead.
 func (*Snapshot_Vote) Descriptor() ([]byte, []int) {
-	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{2, 1}
+	return file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *Snapshot_Vote) GetValidator() string {
@@ -495,60 +701,93 @@ var file_consensus_ibft_proto_ibft_operator_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x0e, 0x49,
 	0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
-	0x3d, 0x0a, 0x0b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xbc,
-	0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
-	0x27, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x56, 0x6f, 0x74,
-	0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x54, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x3a, 0x0a,
-	0x0a, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x3f, 0x0a, 0x0e, 0x43, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x0a, 0x63,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a,
-	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x09, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x61, 0x75, 0x74, 0x68, 0x32, 0xde, 0x01, 0x0a, 0x0c, 0x49, 0x62, 0x66, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x0d,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x34, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x17, 0x5a, 0x15, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
-	0x73, 0x75, 0x73, 0x2f, 0x69, 0x62, 0x66, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x23, 0x0a, 0x0b, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0xb5, 0x03, 0x0a, 0x0c, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x54, 0x6f, 0x12, 0x3a, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x1a, 0xc9, 0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69,
+	0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x4d, 0x61, 0x64,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x0b,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xbc, 0x02, 0x0a, 0x08,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x54, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x3f, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x32, 0x8d, 0x02, 0x0a, 0x0c, 0x49, 0x62, 0x66, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x30, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x0d, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x2d, 0x0a, 0x08, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x0f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x42, 0x17, 0x5a, 0x15, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f,
+	0x69, 0x62, 0x66, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -563,35 +802,42 @@ func file_consensus_ibft_proto_ibft_operator_proto_rawDescGZIP() []byte {
 	return file_consensus_ibft_proto_ibft_operator_proto_rawDescData
 }
 
-var file_consensus_ibft_proto_ibft_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_consensus_ibft_proto_ibft_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_consensus_ibft_proto_ibft_operator_proto_goTypes = []interface{}{
-	(*IbftStatusResp)(nil),     // 0: v1.IbftStatusResp
-	(*SnapshotReq)(nil),        // 1: v1.SnapshotReq
-	(*Snapshot)(nil),           // 2: v1.Snapshot
-	(*ProposeReq)(nil),         // 3: v1.ProposeReq
-	(*CandidatesResp)(nil),     // 4: v1.CandidatesResp
-	(*Candidate)(nil),          // 5: v1.Candidate
-	(*Snapshot_Validator)(nil), // 6: v1.Snapshot.Validator
-	(*Snapshot_Vote)(nil),      // 7: v1.Snapshot.Vote
-	(*emptypb.Empty)(nil),      // 8: google.protobuf.Empty
+	(*IbftStatusResp)(nil),                 // 0: v1.IbftStatusResp
+	(*LivenessReq)(nil),                    // 1: v1.LivenessReq
+	(*LivenessResp)(nil),                   // 2: v1.LivenessResp
+	(*SnapshotReq)(nil),                    // 3: v1.SnapshotReq
+	(*Snapshot)(nil),                       // 4: v1.Snapshot
+	(*ProposeReq)(nil),                     // 5: v1.ProposeReq
+	(*CandidatesResp)(nil),                 // 6: v1.CandidatesResp
+	(*Candidate)(nil),                      // 7: v1.Candidate
+	(*LivenessResp_ValidatorLiveness)(nil), // 8: v1.LivenessResp.ValidatorLiveness
+	(*Snapshot_Validator)(nil),             // 9: v1.Snapshot.Validator
+	(*Snapshot_Vote)(nil),                  // 10: v1.Snapshot.Vote
+	(*emptypb.Empty)(nil),                  // 11: google.protobuf.Empty
 }
 var file_consensus_ibft_proto_ibft_operator_proto_depIdxs = []int32{
-	6, // 0: v1.Snapshot.validators:type_name -> v1.Snapshot.Validator
-	7, // 1: v1.Snapshot.votes:type_name -> v1.Snapshot.Vote
-	5, // 2: v1.CandidatesResp.candidates:type_name -> v1.Candidate
-	1, // 3: v1.IbftOperator.GetSnapshot:input_type -> v1.SnapshotReq
-	5, // 4: v1.IbftOperator.Propose:input_type -> v1.Candidate
-	8, // 5: v1.IbftOperator.Candidates:input_type -> google.protobuf.Empty
-	8, // 6: v1.IbftOperator.Status:input_type -> google.protobuf.Empty
-	2, // 7: v1.IbftOperator.GetSnapshot:output_type -> v1.Snapshot
-	8, // 8: v1.IbftOperator.Propose:output_type -> google.protobuf.Empty
-	4, // 9: v1.IbftOperator.Candidates:output_type -> v1.CandidatesResp
-	0, // 10: v1.IbftOperator.Status:output_type -> v1.IbftStatusResp
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: v1.LivenessResp.window:type_name -> v1.LivenessResp.ValidatorLiveness
+	8,  // 1: v1.LivenessResp.epoch_liveness:type_name -> v1.LivenessResp.ValidatorLiveness
+	9,  // 2: v1.Snapshot.validators:type_name -> v1.Snapshot.Validator
+	10, // 3: v1.Snapshot.votes:type_name -> v1.Snapshot.Vote
+	7,  // 4: v1.CandidatesResp.candidates:type_name -> v1.Candidate
+	3,  // 5: v1.IbftOperator.GetSnapshot:input_type -> v1.SnapshotReq
+	7,  // 6: v1.IbftOperator.Propose:input_type -> v1.Candidate
+	11, // 7: v1.IbftOperator.Candidates:input_type -> google.protobuf.Empty
+	11, // 8: v1.IbftOperator.Status:input_type -> google.protobuf.Empty
+	1,  // 9: v1.IbftOperator.Liveness:input_type -> v1.LivenessReq
+	4,  // 10: v1.IbftOperator.GetSnapshot:output_type -> v1.Snapshot
+	11, // 11: v1.IbftOperator.Propose:output_type -> google.protobuf.Empty
+	6,  // 12: v1.IbftOperator.Candidates:output_type -> v1.CandidatesResp
+	0,  // 13: v1.IbftOperator.Status:output_type -> v1.IbftStatusResp
+	2,  // 14: v1.IbftOperator.Liveness:output_type -> v1.LivenessResp
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_consensus_ibft_proto_ibft_operator_proto_init() }
@@ -613,7 +859,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotReq); i {
+			switch v := v.(*LivenessReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -625,7 +871,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Snapshot); i {
+			switch v := v.(*LivenessResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -637,7 +883,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposeReq); i {
+			switch v := v.(*SnapshotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -649,7 +895,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CandidatesResp); i {
+			switch v := v.(*Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -661,7 +907,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Candidate); i {
+			switch v := v.(*ProposeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -673,7 +919,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Snapshot_Validator); i {
+			switch v := v.(*CandidatesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -685,6 +931,42 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			}
 		}
 		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LivenessResp_ValidatorLiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot_Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_ibft_proto_ibft_operator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Snapshot_Vote); i {
 			case 0:
 				return &v.state
@@ -703,7 +985,7 @@ func file_consensus_ibft_proto_ibft_operator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_consensus_ibft_proto_ibft_operator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -137,6 +137,286 @@ var _ interface {
 	ErrorName() string
 } = IbftStatusRespValidationError{}
 
+// Validate checks the field values on LivenessReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *LivenessReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LivenessReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in LivenessReqMultiError, or nil if
+// none found.
+func (m *LivenessReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LivenessReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Epoch
+
+	if len(errors) > 0 {
+		return LivenessReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// LivenessReqMultiError is an error wrapping multiple validation errors
+// returned by LivenessReq.ValidateAll() if the designated constraints aren't
+// met.
+type LivenessReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LivenessReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LivenessReqMultiError) AllErrors() []error { return m }
+
+// LivenessReqValidationError is the validation error returned by
+// LivenessReq.Validate if the designated constraints aren't met.
+type LivenessReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LivenessReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LivenessReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LivenessReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LivenessReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LivenessReqValidationError) ErrorName() string {
+	return "LivenessReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LivenessReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLivenessReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LivenessReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LivenessReqValidationError{}
+
+// Validate checks the field values on LivenessResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LivenessResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LivenessResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in LivenessRespMultiError, or nil if
+// none found.
+func (m *LivenessResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LivenessResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WindowFrom
+
+	// no validation rules for WindowTo
+
+	for idx, item := range m.GetWindow() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LivenessRespValidationError{
+						field:  fmt.Sprintf("Window[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LivenessRespValidationError{
+						field:  fmt.Sprintf("Window[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LivenessRespValidationError{
+					field:  fmt.Sprintf("Window[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Epoch
+
+	for idx, item := range m.GetEpochLiveness() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LivenessRespValidationError{
+						field:  fmt.Sprintf("EpochLiveness[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LivenessRespValidationError{
+						field:  fmt.Sprintf("EpochLiveness[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LivenessRespValidationError{
+					field:  fmt.Sprintf("EpochLiveness[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LivenessRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// LivenessRespMultiError is an error wrapping multiple validation errors
+// returned by LivenessResp.ValidateAll() if the designated constraints aren't
+// met.
+type LivenessRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LivenessRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LivenessRespMultiError) AllErrors() []error { return m }
+
+// LivenessRespValidationError is the validation error returned by
+// LivenessResp.Validate if the designated constraints aren't met.
+type LivenessRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LivenessRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LivenessRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LivenessRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LivenessRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LivenessRespValidationError) ErrorName() string {
+	return "LivenessRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LivenessRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLivenessResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LivenessRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LivenessRespValidationError{}
+
 // Validate checks the field values on SnapshotReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -753,6 +1033,120 @@ var _ interface {
 	ErrorName() string
 } = CandidateValidationError{}
 
+// Validate checks the field values on LivenessResp_ValidatorLiveness with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *LivenessResp_ValidatorLiveness) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LivenessResp_ValidatorLiveness with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LivenessResp_ValidatorLivenessMultiError, or nil if none found.
+func (m *LivenessResp_ValidatorLiveness) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LivenessResp_ValidatorLiveness) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for BlocksSigned
+
+	// no validation rules for BlocksMissed
+
+	// no validation rules for ProposalsMade
+
+	// no validation rules for ProposalsMissed
+
+	if len(errors) > 0 {
+		return LivenessResp_ValidatorLivenessMultiError(errors)
+	}
+
+	return nil
+}
+
+// LivenessResp_ValidatorLivenessMultiError is an error wrapping multiple
+// validation errors returned by LivenessResp_ValidatorLiveness.ValidateAll() if
+// the designated constraints aren't met.
+type LivenessResp_ValidatorLivenessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LivenessResp_ValidatorLivenessMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LivenessResp_ValidatorLivenessMultiError) AllErrors() []error { return m }
+
+// LivenessResp_ValidatorLivenessValidationError is the validation error
+// returned by LivenessResp_ValidatorLiveness.Validate if the designated
+// constraints aren't met.
+type LivenessResp_ValidatorLivenessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LivenessResp_ValidatorLivenessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LivenessResp_ValidatorLivenessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LivenessResp_ValidatorLivenessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LivenessResp_ValidatorLivenessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LivenessResp_ValidatorLivenessValidationError) ErrorName() string {
+	return "LivenessResp_ValidatorLivenessValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LivenessResp_ValidatorLivenessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLivenessResp_ValidatorLiveness.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LivenessResp_ValidatorLivenessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LivenessResp_ValidatorLivenessValidationError{}
+
 // Validate checks the field values on Snapshot_Validator with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -27,6 +27,7 @@ type IbftOperatorClient interface {
 	Propose(ctx context.Context, in *Candidate, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Candidates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CandidatesResp, error)
 	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IbftStatusResp, error)
+	Liveness(ctx context.Context, in *LivenessReq, opts ...grpc.CallOption) (*LivenessResp, error)
 }
 
 type ibftOperatorClient struct {
@@ -73,6 +74,15 @@ func (c *ibftOperatorClient) Status(ctx context.Context, in *emptypb.Empty, opts
 	return out, nil
 }
 
+func (c *ibftOperatorClient) Liveness(ctx context.Context, in *LivenessReq, opts ...grpc.CallOption) (*LivenessResp, error) {
+	out := new(LivenessResp)
+	err := c.cc.Invoke(ctx, "/v1.IbftOperator/Liveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IbftOperatorServer is the server API for IbftOperator service.
 // All implementations must embed UnimplementedIbftOperatorServer
 // for forward compatibility
@@ -81,6 +91,7 @@ type IbftOperatorServer interface {
 	Propose(context.Context, *Candidate) (*emptypb.Empty, error)
 	Candidates(context.Context, *emptypb.Empty) (*CandidatesResp, error)
 	Status(context.Context, *emptypb.Empty) (*IbftStatusResp, error)
+	Liveness(context.Context, *LivenessReq) (*LivenessResp, error)
 	mustEmbedUnimplementedIbftOperatorServer()
 }
 
@@ -100,6 +111,9 @@ func (UnimplementedIbftOperatorServer) Candidates(context.Context, *emptypb.Empt
 func (UnimplementedIbftOperatorServer) Status(context.Context, *emptypb.Empty) (*IbftStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedIbftOperatorServer) Liveness(context.Context, *LivenessReq) (*LivenessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liveness not implemented")
+}
 func (UnimplementedIbftOperatorServer) mustEmbedUnimplementedIbftOperatorServer() {}
 
 // UnsafeIbftOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +199,24 @@ func _IbftOperator_Status_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IbftOperator_Liveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LivenessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IbftOperatorServer).Liveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.IbftOperator/Liveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IbftOperatorServer).Liveness(ctx, req.(*LivenessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IbftOperator_ServiceDesc is the grpc.ServiceDesc for IbftOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +240,10 @@ var IbftOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _IbftOperator_Status_Handler,
 		},
+		{
+			MethodName: "Liveness",
+			Handler:    _IbftOperator_Liveness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "consensus/ibft/proto/ibft_operator.proto",
@@ -37,11 +37,11 @@ func TestSign_Sealer(t *testing.T) {
 		signer.NewECDSAKeyManagerFromKey(pool.get("A").priv),
 	)
 
-	badSealedBlock, _ := signerX.WriteProposerSeal(h)
+	badSealedBlock, _ := signerX.WriteProposerSeal(h, 0)
 	assert.Error(t, verifyProposerSeal(badSealedBlock, signerA, correctValset))
 
 	// seal the block with a validator
-	goodSealedBlock, _ := signerA.WriteProposerSeal(h)
+	goodSealedBlock, _ := signerA.WriteProposerSeal(h, 0)
 	assert.NoError(t, verifyProposerSeal(goodSealedBlock, signerA, correctValset))
 }
 
@@ -90,7 +90,7 @@ func TestSign_CommittedSeals(t *testing.T) {
 				),
 			)
 
-			seal, err := signer.CreateCommittedSeal(h.Hash.Bytes())
+			seal, err := signer.CreateCommittedSeal(h.Hash.Bytes(), h.Number, 0)
 
 			assert.NoError(t, err)
 
@@ -150,6 +150,42 @@ func (s *BLSKeyManager) VerifyCommittedSeals(
 	return verifyBLSCommittedSealsImpl(committedSeal, message, vals)
 }
 
+// GetCommittedSealers returns the validators whose seals are aggregated in the committed seals
+func (s *BLSKeyManager) GetCommittedSealers(
+	rawCommittedSeal Seals,
+	_ []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*AggregatedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	if vals.Type() != s.Type() {
+		return nil, ErrInvalidValidators
+	}
+
+	sealers := make([]types.Address, 0, vals.Len())
+
+	if committedSeal.Bitmap == nil {
+		return sealers, nil
+	}
+
+	for idx := 0; idx < committedSeal.Bitmap.BitLen(); idx++ {
+		if committedSeal.Bitmap.Bit(idx) == 0 {
+			continue
+		}
+
+		if idx >= vals.Len() {
+			return nil, ErrValidatorNotFound
+		}
+
+		sealers = append(sealers, vals.At(uint64(idx)).Addr())
+	}
+
+	return sealers, nil
+}
+
 func (s *BLSKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return crypto.Sign(s.ecdsaKey, msg)
 }
@@ -587,6 +587,76 @@ func TestBLSKeyManagerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestBLSKeyManagerGetCommittedSealers(t *testing.T) {
+	t.Parallel()
+
+	blsKeyManager1, _, _ := newTestBLSKeyManager(t)
+	blsKeyManager2, _, _ := newTestBLSKeyManager(t)
+
+	validatorSet := validators.NewBLSValidatorSet(
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager1),
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager2),
+	)
+
+	tests := []struct {
+		name              string
+		rawCommittedSeals Seals
+		validators        validators.Validators
+		expectedRes       []types.Address
+		expectedErr       error
+	}{
+		{
+			name:              "should return ErrInvalidCommittedSealType if rawCommittedSeal is not *AggregatedSeal",
+			rawCommittedSeals: &SerializedSeal{},
+			validators:        nil,
+			expectedRes:       nil,
+			expectedErr:       ErrInvalidCommittedSealType,
+		},
+		{
+			name:              "should return ErrInvalidValidators if rawValidators is not *BLSValidators",
+			rawCommittedSeals: &AggregatedSeal{},
+			validators:        validators.NewECDSAValidatorSet(),
+			expectedRes:       nil,
+			expectedErr:       ErrInvalidValidators,
+		},
+		{
+			name: "should return ErrValidatorNotFound if bitmap refers to non validator",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: big.NewInt(0).SetBit(new(big.Int), 2, 1),
+			},
+			validators:  validatorSet,
+			expectedRes: nil,
+			expectedErr: ErrValidatorNotFound,
+		},
+		{
+			name: "should return the validators in the bitmap of AggregatedSeal",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: big.NewInt(0).SetBit(new(big.Int), 1, 1),
+			},
+			validators:  validatorSet,
+			expectedRes: []types.Address{blsKeyManager2.Address()},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := blsKeyManager1.GetCommittedSealers(
+				test.rawCommittedSeals,
+				nil,
+				test.validators,
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			testHelper.AssertErrorMessageContains(t, test.expectedErr, err)
+		})
+	}
+}
+
 func TestBLSKeyManagerSignIBFTMessageAndEcrecover(t *testing.T) {
 	t.Parallel()
 
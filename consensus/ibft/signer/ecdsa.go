@@ -129,6 +129,39 @@ func (s *ECDSAKeyManager) VerifyCommittedSeals(
 	return s.verifyCommittedSealsImpl(committedSeal, digest, vals)
 }
 
+// GetCommittedSealers recovers the validators whose seals are included in the committed seals
+func (s *ECDSAKeyManager) GetCommittedSealers(
+	rawCommittedSeal Seals,
+	digest []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*SerializedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	if vals.Type() != s.Type() {
+		return nil, ErrInvalidValidators
+	}
+
+	sealers := make([]types.Address, 0, committedSeal.Num())
+
+	for _, seal := range *committedSeal {
+		addr, err := s.Ecrecover(seal, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if !vals.Includes(addr) {
+			return nil, ErrNonValidatorCommittedSeal
+		}
+
+		sealers = append(sealers, addr)
+	}
+
+	return sealers, nil
+}
+
 func (s *ECDSAKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return crypto.Sign(s.key, msg)
 }
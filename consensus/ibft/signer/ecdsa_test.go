@@ -424,6 +424,83 @@ func TestECDSAKeyManagerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestECDSAKeyManagerGetCommittedSealers(t *testing.T) {
+	t.Parallel()
+
+	ecdsaKeyManager1, _ := newTestECDSAKeyManager(t)
+	ecdsaKeyManager2, _ := newTestECDSAKeyManager(t)
+
+	msg := crypto.Keccak256(
+		wrapCommitHash(
+			hex.MustDecodeHex(testHeaderHashHex),
+		),
+	)
+
+	committedSeal1, err := ecdsaKeyManager1.SignCommittedSeal(msg)
+	assert.NoError(t, err)
+
+	committedSeal2, err := ecdsaKeyManager2.SignCommittedSeal(msg)
+	assert.NoError(t, err)
+
+	validatorSet := validators.NewECDSAValidatorSet(
+		validators.NewECDSAValidator(ecdsaKeyManager1.Address()),
+	)
+
+	tests := []struct {
+		name           string
+		committedSeals Seals
+		rawSet         validators.Validators
+		expectedRes    []types.Address
+		expectedErr    error
+	}{
+		{
+			name:           "should return ErrInvalidCommittedSealType if the Seals is not *SerializedSeal",
+			committedSeals: &AggregatedSeal{},
+			rawSet:         nil,
+			expectedRes:    nil,
+			expectedErr:    ErrInvalidCommittedSealType,
+		},
+		{
+			name:           "should return ErrInvalidValidators if the rawSet is not *validators.ECDSAValidators",
+			committedSeals: &SerializedSeal{},
+			rawSet:         validators.NewBLSValidatorSet(),
+			expectedRes:    nil,
+			expectedErr:    ErrInvalidValidators,
+		},
+		{
+			name:           "should return ErrNonValidatorCommittedSeal if the seal is signed by non validator",
+			committedSeals: &SerializedSeal{committedSeal2},
+			rawSet:         validatorSet,
+			expectedRes:    nil,
+			expectedErr:    ErrNonValidatorCommittedSeal,
+		},
+		{
+			name:           "should return the signers of CommittedSeals",
+			committedSeals: &SerializedSeal{committedSeal1},
+			rawSet:         validatorSet,
+			expectedRes:    []types.Address{ecdsaKeyManager1.Address()},
+			expectedErr:    nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := ecdsaKeyManager1.GetCommittedSealers(
+				test.committedSeals,
+				msg,
+				test.rawSet,
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			assert.ErrorIs(t, test.expectedErr, err)
+		})
+	}
+}
+
 func TestECDSAKeyManagerSignIBFTMessageAndEcrecover(t *testing.T) {
 	t.Parallel()
 
@@ -25,6 +25,8 @@ type KeyManager interface {
 	GenerateCommittedSeals(sealsByValidator map[types.Address][]byte, vals validators.Validators) (Seals, error)
 	// VerifyCommittedSeals verifies CommittedSeals
 	VerifyCommittedSeals(seals Seals, hash []byte, vals validators.Validators) (int, error)
+	// GetCommittedSealers returns the validators whose seals are included in CommittedSeals
+	GetCommittedSealers(seals Seals, hash []byte, vals validators.Validators) ([]types.Address, error)
 	// SignIBFTMessage signs for arbitrary bytes message
 	SignIBFTMessage(msg []byte) ([]byte, error)
 	// Ecrecover recovers address from signature and message
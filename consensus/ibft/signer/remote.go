@@ -0,0 +1,95 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/remotesigner"
+	"github.com/plingatech/go-plgchain/validators"
+)
+
+var (
+	errRemoteMessageDigest = errors.New("remote signer signs whole IBFT messages only")
+	errRemoteSealDigest    = errors.New("remote signer signs seals with their views only")
+)
+
+// ibftMessageSigner is implemented by the KeyManager which signs the whole IBFT message instead of its digest,
+// so that the signer is able to check the message against the slashing protection rules
+type ibftMessageSigner interface {
+	signIBFTMessagePayload(msg []byte) ([]byte, error)
+}
+
+// sealSigner is implemented by the KeyManager which signs the seals with their views instead of their digests,
+// so that the signer is able to check the seals against the slashing protection rules
+type sealSigner interface {
+	signProposerSealPayload(headerHash []byte, height, round uint64) ([]byte, error)
+	signCommittedSealPayload(proposalHash []byte, height, round uint64) ([]byte, error)
+}
+
+// RemoteKeyManager is a module that delegates signing by ECDSA key to the remote signer
+type RemoteKeyManager struct {
+	// ECDSAKeyManager without the key verifies the seals and the signatures
+	*ECDSAKeyManager
+
+	signer remotesigner.Signer
+}
+
+// NewRemoteKeyManager initializes RemoteKeyManager for the validators of the given type
+func NewRemoteKeyManager(signer remotesigner.Signer, validatorType validators.ValidatorType) (KeyManager, error) {
+	if validatorType != validators.ECDSAValidatorType {
+		return nil, fmt.Errorf("remote signer doesn't support validator type: %s", validatorType)
+	}
+
+	return &RemoteKeyManager{
+		ECDSAKeyManager: &ECDSAKeyManager{
+			address: signer.Address(),
+		},
+		signer: signer,
+	}, nil
+}
+
+// SignProposerSeal refuses to sign the digest of ProposerSeal, since the remote signer
+// has to check the seal against the slashing protection rules
+func (s *RemoteKeyManager) SignProposerSeal([]byte) ([]byte, error) {
+	return nil, errRemoteSealDigest
+}
+
+// SignCommittedSeal refuses to sign the digest of committed seal, since the remote signer
+// has to check the seal against the slashing protection rules
+func (s *RemoteKeyManager) SignCommittedSeal([]byte) ([]byte, error) {
+	return nil, errRemoteSealDigest
+}
+
+// SignIBFTMessage refuses to sign the digest of IBFT message, since the remote signer
+// has to check the message against the slashing protection rules
+func (s *RemoteKeyManager) SignIBFTMessage([]byte) ([]byte, error) {
+	return nil, errRemoteMessageDigest
+}
+
+func (s *RemoteKeyManager) signIBFTMessagePayload(msg []byte) ([]byte, error) {
+	return s.signer.Sign(&remotesigner.Request{
+		KeyType: remotesigner.KeyECDSA,
+		Kind:    remotesigner.KindIBFTMessage,
+		Data:    msg,
+	})
+}
+
+func (s *RemoteKeyManager) signProposerSealPayload(headerHash []byte, height, round uint64) ([]byte, error) {
+	return s.signer.Sign(&remotesigner.Request{
+		KeyType: remotesigner.KeyECDSA,
+		Kind:    remotesigner.KindProposerSeal,
+		Data:    headerHash,
+		Height:  height,
+		Round:   round,
+	})
+}
+
+func (s *RemoteKeyManager) signCommittedSealPayload(proposalHash []byte, height, round uint64) ([]byte, error) {
+	return s.signer.Sign(&remotesigner.Request{
+		KeyType: remotesigner.KeyECDSA,
+		Kind:    remotesigner.KindCommittedSeal,
+		Data:    proposalHash,
+		Height:  height,
+		Round:   round,
+	})
+}
@@ -0,0 +1,136 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/remotesigner"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/go-plgchain/validators"
+	protoIBFT "github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewRemoteKeyManager(t *testing.T) {
+	t.Parallel()
+
+	testKey, _ := newTestECDSAKey(t)
+	remoteSigner := remotesigner.NewLocalSigner(testKey, nil)
+
+	keyManager, err := NewRemoteKeyManager(remoteSigner, validators.ECDSAValidatorType)
+
+	assert.NoError(t, err)
+	assert.Equal(t, validators.ECDSAValidatorType, keyManager.Type())
+	assert.Equal(t, crypto.PubKeyToAddress(&testKey.PublicKey), keyManager.Address())
+
+	_, err = NewRemoteKeyManager(remoteSigner, validators.BLSValidatorType)
+
+	assert.Error(t, err)
+}
+
+// protectedTestSigner is the signer which checks the requests against the slashing protection
+// before signing them, as the signing server does
+type protectedTestSigner struct {
+	remotesigner.Signer
+
+	protection *remotesigner.SlashingProtection
+}
+
+func (s *protectedTestSigner) Sign(req *remotesigner.Request) ([]byte, error) {
+	if err := s.protection.Check(req); err != nil {
+		return nil, err
+	}
+
+	return s.Signer.Sign(req)
+}
+
+func TestRemoteKeyManagerSignSeals(t *testing.T) {
+	t.Parallel()
+
+	testKey, _ := newTestECDSAKey(t)
+	localSigner := NewSigner(NewECDSAKeyManagerFromKey(testKey), nil)
+
+	protection, err := remotesigner.NewSlashingProtection("")
+	assert.NoError(t, err)
+
+	keyManager, err := NewRemoteKeyManager(&protectedTestSigner{
+		Signer:     remotesigner.NewLocalSigner(testKey, nil),
+		protection: protection,
+	}, validators.ECDSAValidatorType)
+	assert.NoError(t, err)
+
+	remoteSigner := NewSigner(keyManager, nil)
+
+	newHeader := func(gasLimit uint64) *types.Header {
+		return &types.Header{
+			Number:    1,
+			GasLimit:  gasLimit,
+			ExtraData: getTestExtraBytes(ecdsaValidators, nil, testSerializedSeals1, nil, nil),
+		}
+	}
+
+	// the seals are the same as the seals created by the local key
+	header, err := remoteSigner.WriteProposerSeal(newHeader(1), 0)
+	assert.NoError(t, err)
+
+	expectedHeader, err := localSigner.WriteProposerSeal(newHeader(1), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHeader, header)
+
+	hash := crypto.Keccak256([]byte("proposal"))
+
+	committedSeal, err := remoteSigner.CreateCommittedSeal(hash, 1, 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, remoteSigner.VerifyCommittedSeal(
+		validators.NewECDSAValidatorSet(validators.NewECDSAValidator(keyManager.Address())),
+		keyManager.Address(),
+		committedSeal,
+		hash,
+	))
+
+	// conflicting seals of the same view are refused by the slashing protection
+	_, err = remoteSigner.WriteProposerSeal(newHeader(2), 0)
+	assert.ErrorIs(t, err, remotesigner.ErrDoubleSign)
+
+	_, err = remoteSigner.CreateCommittedSeal(crypto.Keccak256([]byte("other proposal")), 1, 0)
+	assert.ErrorIs(t, err, remotesigner.ErrDoubleSign)
+
+	_, err = remoteSigner.WriteProposerSeal(newHeader(2), 1)
+	assert.NoError(t, err)
+
+	// digests of the seals and the IBFT message can't be checked by the slashing protection
+	_, err = keyManager.SignProposerSeal(hash)
+	assert.ErrorIs(t, err, errRemoteSealDigest)
+
+	_, err = keyManager.SignCommittedSeal(hash)
+	assert.ErrorIs(t, err, errRemoteSealDigest)
+
+	_, err = keyManager.SignIBFTMessage(hash)
+	assert.ErrorIs(t, err, errRemoteMessageDigest)
+}
+
+func TestSignerSignIBFTMessageByRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	testKey, _ := newTestECDSAKey(t)
+
+	keyManager, err := NewRemoteKeyManager(remotesigner.NewLocalSigner(testKey, nil), validators.ECDSAValidatorType)
+	assert.NoError(t, err)
+
+	signer := NewSigner(keyManager, nil)
+
+	msg, err := proto.Marshal(&protoIBFT.Message{
+		View: &protoIBFT.View{Height: 1},
+		Type: protoIBFT.MessageType_PREPARE,
+	})
+	assert.NoError(t, err)
+
+	sig, err := signer.SignIBFTMessage(msg)
+	assert.NoError(t, err)
+
+	from, err := signer.EcrecoverFromIBFTMessage(sig, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, keyManager.Address(), from)
+}
@@ -34,11 +34,11 @@ type Signer interface {
 	GetValidators(*types.Header) (validators.Validators, error)
 
 	// ProposerSeal
-	WriteProposerSeal(*types.Header) (*types.Header, error)
+	WriteProposerSeal(header *types.Header, round uint64) (*types.Header, error)
 	EcrecoverFromHeader(*types.Header) (types.Address, error)
 
 	// CommittedSeal
-	CreateCommittedSeal([]byte) ([]byte, error)
+	CreateCommittedSeal(hash []byte, height, round uint64) ([]byte, error)
 	VerifyCommittedSeal(validators.Validators, types.Address, []byte, []byte) error
 
 	// CommittedSeals
@@ -53,6 +53,11 @@ type Signer interface {
 		validators validators.Validators,
 		quorumSize int,
 	) error
+	GetCommittedSealers(
+		hash types.Hash,
+		committedSeals Seals,
+		validators validators.Validators,
+	) ([]types.Address, error)
 
 	// ParentCommittedSeals
 	VerifyParentCommittedSeals(
@@ -137,16 +142,23 @@ func (s *SignerImpl) GetIBFTExtra(header *types.Header) (*IstanbulExtra, error)
 	return extra, nil
 }
 
-// WriteProposerSeal signs and set ProposerSeal into IBFT Extra of the header
-func (s *SignerImpl) WriteProposerSeal(header *types.Header) (*types.Header, error) {
+// WriteProposerSeal signs and set ProposerSeal into IBFT Extra of the header proposed in the given round
+func (s *SignerImpl) WriteProposerSeal(header *types.Header, round uint64) (*types.Header, error) {
 	hash, err := s.CalculateHeaderHash(header)
 	if err != nil {
 		return nil, err
 	}
 
-	seal, err := s.keyManager.SignProposerSeal(
-		crypto.Keccak256(hash.Bytes()),
-	)
+	var seal []byte
+
+	if sealSigner, ok := s.keyManager.(sealSigner); ok {
+		seal, err = sealSigner.signProposerSealPayload(hash.Bytes(), header.Number, round)
+	} else {
+		seal, err = s.keyManager.SignProposerSeal(
+			crypto.Keccak256(hash.Bytes()),
+		)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -169,8 +181,12 @@ func (s *SignerImpl) EcrecoverFromHeader(header *types.Header) (types.Address, e
 	return s.keyManager.Ecrecover(extra.ProposerSeal, crypto.Keccak256(header.Hash.Bytes()))
 }
 
-// CreateCommittedSeal returns CommittedSeal from given hash
-func (s *SignerImpl) CreateCommittedSeal(hash []byte) ([]byte, error) {
+// CreateCommittedSeal returns CommittedSeal from given hash committed in the given height and round
+func (s *SignerImpl) CreateCommittedSeal(hash []byte, height, round uint64) ([]byte, error) {
+	if sealSigner, ok := s.keyManager.(sealSigner); ok {
+		return sealSigner.signCommittedSealPayload(hash, height, round)
+	}
+
 	return s.keyManager.SignCommittedSeal(
 		// Of course, this keccaking of an extended array is not according to the IBFT 2.0 spec,
 		// but almost nothing in this legacy signing package is. This is kept
@@ -254,6 +270,23 @@ func (s *SignerImpl) VerifyCommittedSeals(
 	return nil
 }
 
+// GetCommittedSealers returns the validators whose seals are included in CommittedSeals
+func (s *SignerImpl) GetCommittedSealers(
+	hash types.Hash,
+	committedSeals Seals,
+	validators validators.Validators,
+) ([]types.Address, error) {
+	rawMsg := crypto.Keccak256(
+		wrapCommitHash(hash.Bytes()),
+	)
+
+	return s.keyManager.GetCommittedSealers(
+		committedSeals,
+		rawMsg,
+		validators,
+	)
+}
+
 // VerifyParentCommittedSeals verifies ParentCommittedSeals in IBFT Extra of the header
 func (s *SignerImpl) VerifyParentCommittedSeals(
 	parentHash types.Hash,
@@ -300,6 +333,10 @@ func (s *SignerImpl) VerifyParentCommittedSeals(
 
 // SignIBFTMessage signs arbitrary message
 func (s *SignerImpl) SignIBFTMessage(msg []byte) ([]byte, error) {
+	if messageSigner, ok := s.keyManager.(ibftMessageSigner); ok {
+		return messageSigner.signIBFTMessagePayload(msg)
+	}
+
 	return s.keyManager.SignIBFTMessage(crypto.Keccak256(msg))
 }
 
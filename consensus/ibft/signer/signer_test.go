@@ -576,7 +576,7 @@ func TestSignerWriteProposerSeal(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			UseIstanbulHeaderHashInTest(t, test.signer)
 
-			header, err := test.signer.WriteProposerSeal(test.header)
+			header, err := test.signer.WriteProposerSeal(test.header, 0)
 
 			assert.Equal(
 				t,
@@ -697,7 +697,7 @@ func TestSignerCreateCommittedSeal(t *testing.T) {
 		},
 	)
 
-	res, err := signer.CreateCommittedSeal(hash)
+	res, err := signer.CreateCommittedSeal(hash, 1, 0)
 
 	assert.Equal(t, sig, res)
 	assert.NoError(t, err)
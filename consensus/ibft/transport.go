@@ -49,6 +49,9 @@ func (i *backendIBFT) setupTransport() error {
 
 			i.consensus.AddMessage(msg)
 
+			// other validators started the sequence, so it must not be skipped
+			i.emptyBlockSkipper.OnMessage(msg.GetView().GetHeight())
+
 			i.logger.Debug(
 				"validator message received",
 				"type", msg.Type.String(),
@@ -0,0 +1,320 @@
+package liveness
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+const (
+	// DefaultWindowSize is the default number of the latest blocks in the sliding window
+	DefaultWindowSize = 100
+
+	// maxEpochs is the number of the latest epochs whose liveness is preserved
+	maxEpochs = 10
+
+	// livenessMetricsPrefix is a liveness-related metrics prefix
+	livenessMetricsPrefix = "consensus"
+)
+
+// Stats is the liveness of a single validator over a range of blocks
+type Stats struct {
+	BlocksSigned    uint64 `json:"blocksSigned"`
+	BlocksMissed    uint64 `json:"blocksMissed"`
+	ProposalsMade   uint64 `json:"proposalsMade"`
+	ProposalsMissed uint64 `json:"proposalsMissed"`
+}
+
+// isZero returns true if no activity is recorded in the stats
+func (s *Stats) isZero() bool {
+	return s.BlocksSigned == 0 && s.BlocksMissed == 0 && s.ProposalsMade == 0 && s.ProposalsMissed == 0
+}
+
+// ValidatorStats is the liveness of the validator with the given address
+type ValidatorStats struct {
+	Address types.Address
+	Stats
+}
+
+// Block is the liveness record of a single block
+type Block struct {
+	Number uint64 `json:"number"`
+	Epoch  uint64 `json:"epoch"`
+	// Signers are the validators whose seals are included in the block
+	Signers []types.Address `json:"signers"`
+	// Absentees are the validators whose seals are missing in the block
+	Absentees []types.Address `json:"absentees"`
+	// Proposer is the validator which proposed the block
+	Proposer types.Address `json:"proposer"`
+	// MissedProposers are the validators which failed to propose the block in the previous rounds
+	MissedProposers []types.Address `json:"missedProposers"`
+}
+
+// apply adds the block to the given stats, or subtracts it if add is false
+func (b *Block) apply(stats map[types.Address]*Stats, add bool) {
+	update := func(addr types.Address, fn func(s *Stats) *uint64) {
+		s, ok := stats[addr]
+		if !ok {
+			s = &Stats{}
+			stats[addr] = s
+		}
+
+		if counter := fn(s); add {
+			*counter++
+		} else if *counter > 0 {
+			*counter--
+		}
+
+		if s.isZero() {
+			delete(stats, addr)
+		}
+	}
+
+	for _, addr := range b.Signers {
+		update(addr, func(s *Stats) *uint64 { return &s.BlocksSigned })
+	}
+
+	for _, addr := range b.Absentees {
+		update(addr, func(s *Stats) *uint64 { return &s.BlocksMissed })
+	}
+
+	for _, addr := range b.MissedProposers {
+		update(addr, func(s *Stats) *uint64 { return &s.ProposalsMissed })
+	}
+
+	if b.Proposer != types.ZeroAddress {
+		update(b.Proposer, func(s *Stats) *uint64 { return &s.ProposalsMade })
+	}
+}
+
+// Snapshot is the persisted state of the tracker
+type Snapshot struct {
+	// Blocks are the records of the blocks in the sliding window
+	Blocks []*Block `json:"blocks"`
+	// Epochs are the liveness of the validators in the latest epochs
+	Epochs map[uint64]map[types.Address]*Stats `json:"epochs"`
+}
+
+// Tracker aggregates the liveness of the validators from the committed seals
+// and the proposers of the inserted blocks, over the sliding window of the latest blocks and per epoch
+type Tracker struct {
+	lock sync.RWMutex
+
+	windowSize uint64
+	blocks     []*Block
+	window     map[types.Address]*Stats
+	epochs     map[uint64]map[types.Address]*Stats
+}
+
+// NewTracker creates a new tracker with the given window size, restoring its state from the snapshot (if any)
+func NewTracker(windowSize uint64, snapshot *Snapshot) *Tracker {
+	if windowSize == 0 {
+		windowSize = DefaultWindowSize
+	}
+
+	t := &Tracker{
+		windowSize: windowSize,
+		window:     make(map[types.Address]*Stats),
+		epochs:     make(map[uint64]map[types.Address]*Stats),
+	}
+
+	if snapshot == nil {
+		return t
+	}
+
+	for epoch, stats := range snapshot.Epochs {
+		t.epochs[epoch] = copyStats(stats)
+	}
+
+	for _, block := range snapshot.Blocks {
+		t.blocks = append(t.blocks, block)
+		block.apply(t.window, true)
+	}
+
+	t.pruneWindow()
+
+	return t
+}
+
+// Track records the liveness of the given block. Blocks which are already tracked are ignored
+func (t *Tracker) Track(block *Block) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.blocks) > 0 && t.blocks[len(t.blocks)-1].Number >= block.Number {
+		return
+	}
+
+	t.blocks = append(t.blocks, block)
+	block.apply(t.window, true)
+
+	epochStats, ok := t.epochs[block.Epoch]
+	if !ok {
+		epochStats = make(map[types.Address]*Stats)
+		t.epochs[block.Epoch] = epochStats
+	}
+
+	block.apply(epochStats, true)
+
+	t.pruneWindow()
+	t.pruneEpochs()
+	t.updateMetrics(block)
+}
+
+// LastBlock returns the number of the latest tracked block
+func (t *Tracker) LastBlock() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if len(t.blocks) == 0 {
+		return 0
+	}
+
+	return t.blocks[len(t.blocks)-1].Number
+}
+
+// Window returns the liveness of the validators over the sliding window
+// alongside the numbers of the first and the last block in the window
+func (t *Tracker) Window() (uint64, uint64, []*ValidatorStats) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if len(t.blocks) == 0 {
+		return 0, 0, nil
+	}
+
+	return t.blocks[0].Number, t.blocks[len(t.blocks)-1].Number, sortedStats(t.window)
+}
+
+// Epoch returns the liveness of the validators in the given epoch
+func (t *Tracker) Epoch(epoch uint64) ([]*ValidatorStats, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	stats, ok := t.epochs[epoch]
+	if !ok {
+		return nil, false
+	}
+
+	return sortedStats(stats), true
+}
+
+// Snapshot returns the current state of the tracker which should be persisted
+func (t *Tracker) Snapshot() *Snapshot {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	snapshot := &Snapshot{
+		Blocks: make([]*Block, len(t.blocks)),
+		Epochs: make(map[uint64]map[types.Address]*Stats, len(t.epochs)),
+	}
+
+	// blocks are never modified after being tracked, so there is no need to copy them
+	copy(snapshot.Blocks, t.blocks)
+
+	for epoch, stats := range t.epochs {
+		snapshot.Epochs[epoch] = copyStats(stats)
+	}
+
+	return snapshot
+}
+
+// pruneWindow removes the blocks which are out of the sliding window
+func (t *Tracker) pruneWindow() {
+	if len(t.blocks) == 0 {
+		return
+	}
+
+	last := t.blocks[len(t.blocks)-1].Number
+
+	for len(t.blocks) > 0 && t.blocks[0].Number+t.windowSize <= last {
+		t.blocks[0].apply(t.window, false)
+		t.blocks = t.blocks[1:]
+	}
+}
+
+// pruneEpochs removes the liveness of the epochs older than the latest maxEpochs epochs
+func (t *Tracker) pruneEpochs() {
+	if len(t.epochs) <= maxEpochs {
+		return
+	}
+
+	epochs := make([]uint64, 0, len(t.epochs))
+	for epoch := range t.epochs {
+		epochs = append(epochs, epoch)
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	for _, epoch := range epochs[:len(epochs)-maxEpochs] {
+		delete(t.epochs, epoch)
+	}
+}
+
+// updateMetrics updates the liveness metrics over the sliding window
+// of the validators which are in the window or in the given block
+func (t *Tracker) updateMetrics(block *Block) {
+	validators := make(map[types.Address]struct{}, len(t.window))
+
+	for addr := range t.window {
+		validators[addr] = struct{}{}
+	}
+
+	for _, addr := range block.Signers {
+		validators[addr] = struct{}{}
+	}
+
+	for _, addr := range block.Absentees {
+		validators[addr] = struct{}{}
+	}
+
+	for addr := range validators {
+		stats, ok := t.window[addr]
+		if !ok {
+			stats = &Stats{}
+		}
+
+		labels := []metrics.Label{{Name: "validator", Value: addr.String()}}
+
+		metrics.SetGaugeWithLabels([]string{livenessMetricsPrefix, "liveness", "blocks_signed"},
+			float32(stats.BlocksSigned), labels)
+		metrics.SetGaugeWithLabels([]string{livenessMetricsPrefix, "liveness", "blocks_missed"},
+			float32(stats.BlocksMissed), labels)
+		metrics.SetGaugeWithLabels([]string{livenessMetricsPrefix, "liveness", "proposals_made"},
+			float32(stats.ProposalsMade), labels)
+		metrics.SetGaugeWithLabels([]string{livenessMetricsPrefix, "liveness", "proposals_missed"},
+			float32(stats.ProposalsMissed), labels)
+	}
+}
+
+// copyStats returns a deep copy of the given stats
+func copyStats(stats map[types.Address]*Stats) map[types.Address]*Stats {
+	res := make(map[types.Address]*Stats, len(stats))
+
+	for addr, s := range stats {
+		statsCopy := *s
+		res[addr] = &statsCopy
+	}
+
+	return res
+}
+
+// sortedStats returns a copy of the given stats sorted by the validator address
+func sortedStats(stats map[types.Address]*Stats) []*ValidatorStats {
+	res := make([]*ValidatorStats, 0, len(stats))
+
+	for addr, s := range stats {
+		res = append(res, &ValidatorStats{Address: addr, Stats: *s})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i].Address.Bytes(), res[j].Address.Bytes()) < 0
+	})
+
+	return res
+}
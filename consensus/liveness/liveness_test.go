@@ -0,0 +1,122 @@
+package liveness
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addr1 = types.StringToAddress("1")
+	addr2 = types.StringToAddress("2")
+	addr3 = types.StringToAddress("3")
+)
+
+func newTestBlock(number, epoch uint64) *Block {
+	// addr3 is down and addr1 proposes the blocks after addr3 misses its round
+	return &Block{
+		Number:          number,
+		Epoch:           epoch,
+		Signers:         []types.Address{addr1, addr2},
+		Absentees:       []types.Address{addr3},
+		Proposer:        addr1,
+		MissedProposers: []types.Address{addr3},
+	}
+}
+
+func TestTracker_Window(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker(3, nil)
+
+	from, to, stats := tracker.Window()
+	assert.Zero(t, from)
+	assert.Zero(t, to)
+	assert.Empty(t, stats)
+
+	for number := uint64(1); number <= 5; number++ {
+		tracker.Track(newTestBlock(number, 1))
+	}
+
+	// already tracked blocks are ignored
+	tracker.Track(newTestBlock(5, 1))
+
+	from, to, stats = tracker.Window()
+	assert.Equal(t, uint64(3), from)
+	assert.Equal(t, uint64(5), to)
+	assert.Equal(t, uint64(5), tracker.LastBlock())
+	assert.Equal(t, []*ValidatorStats{
+		{Address: addr1, Stats: Stats{BlocksSigned: 3, ProposalsMade: 3}},
+		{Address: addr2, Stats: Stats{BlocksSigned: 3}},
+		{Address: addr3, Stats: Stats{BlocksMissed: 3, ProposalsMissed: 3}},
+	}, stats)
+}
+
+func TestTracker_Epochs(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker(DefaultWindowSize, nil)
+
+	for epoch := uint64(1); epoch <= maxEpochs+2; epoch++ {
+		tracker.Track(newTestBlock(epoch*2-1, epoch))
+		tracker.Track(newTestBlock(epoch*2, epoch))
+	}
+
+	// the oldest epochs are pruned
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		_, ok := tracker.Epoch(epoch)
+		assert.False(t, ok)
+	}
+
+	stats, ok := tracker.Epoch(maxEpochs + 2)
+	require.True(t, ok)
+	assert.Equal(t, []*ValidatorStats{
+		{Address: addr1, Stats: Stats{BlocksSigned: 2, ProposalsMade: 2}},
+		{Address: addr2, Stats: Stats{BlocksSigned: 2}},
+		{Address: addr3, Stats: Stats{BlocksMissed: 2, ProposalsMissed: 2}},
+	}, stats)
+}
+
+func TestTracker_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker(3, nil)
+
+	for number := uint64(1); number <= 4; number++ {
+		tracker.Track(newTestBlock(number, number/3+1))
+	}
+
+	raw, err := json.Marshal(tracker.Snapshot())
+	require.NoError(t, err)
+
+	var snapshot *Snapshot
+
+	require.NoError(t, json.Unmarshal(raw, &snapshot))
+
+	restored := NewTracker(3, snapshot)
+
+	expectedFrom, expectedTo, expectedStats := tracker.Window()
+	from, to, stats := restored.Window()
+
+	assert.Equal(t, expectedFrom, from)
+	assert.Equal(t, expectedTo, to)
+	assert.Equal(t, expectedStats, stats)
+
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		expectedStats, _ := tracker.Epoch(epoch)
+		stats, ok := restored.Epoch(epoch)
+
+		assert.True(t, ok)
+		assert.Equal(t, expectedStats, stats)
+	}
+
+	// restored tracker with smaller window prunes the blocks out of it
+	restored = NewTracker(1, snapshot)
+
+	from, to, _ = restored.Window()
+	assert.Equal(t, uint64(4), from)
+	assert.Equal(t, uint64(4), to)
+}
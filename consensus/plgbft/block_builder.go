@@ -4,6 +4,7 @@ import (
 	"time"
 
 	hcf "github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/chain"
 	"github.com/plingatech/go-plgchain/consensus"
 	"github.com/plingatech/go-plgchain/state"
 	"github.com/plingatech/go-plgchain/txpool"
@@ -35,6 +36,12 @@ type BlockBuilderParams struct {
 
 	// txPoolInterface implementation
 	TxPool txPoolInterface
+
+	// PreCommitState is the consensus hook applied to the state before it is committed
+	PreCommitState func(*types.Header, *state.Transition) error
+
+	// TxOrdering is the transaction ordering config, reserving gas to the priority senders
+	TxOrdering *chain.TxOrderingConfig
 }
 
 func NewBlockBuilder(params *BlockBuilderParams) *BlockBuilder {
@@ -60,6 +67,9 @@ type BlockBuilder struct {
 
 	// state is in memory state transition
 	state *state.Transition
+
+	// reservation is the block gas reserved for the priority senders
+	reservation *txpool.GasReservation
 }
 
 // Init initializes block builder before adding transactions and actual block building
@@ -93,6 +103,7 @@ func (b *BlockBuilder) Reset() error {
 	b.state = transition
 	b.block = nil
 	b.txns = []*types.Transaction{}
+	b.reservation = txpool.NewGasReservation(b.params.TxOrdering, b.params.GasLimit)
 
 	return nil
 }
@@ -108,6 +119,12 @@ func (b *BlockBuilder) Build(handler func(h *types.Header)) (*types.FullBlock, e
 		handler(b.header)
 	}
 
+	if b.params.PreCommitState != nil {
+		if err := b.params.PreCommitState(b.header, b.state); err != nil {
+			return nil, err
+		}
+	}
+
 	_, b.header.StateRoot = b.state.Commit()
 	b.header.GasUsed = b.state.TotalGas()
 
@@ -184,6 +201,11 @@ func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, erro
 		return true, nil
 	}
 
+	// the transaction is left in the pool for the next block
+	if !b.reservation.Allows(tx) {
+		return false, nil
+	}
+
 	err := b.WriteTx(tx)
 	if err != nil {
 		if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
@@ -202,6 +224,7 @@ func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, erro
 
 	// remove tx from the pool and add it to the list of all block transactions
 	b.params.TxPool.Pop(tx)
+	b.reservation.Use(tx)
 
 	return false, nil
 }
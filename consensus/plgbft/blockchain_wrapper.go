@@ -67,6 +67,9 @@ var _ blockchainBackend = &blockchainWrapper{}
 type blockchainWrapper struct {
 	executor   *state.Executor
 	blockchain *blockchain.Blockchain
+
+	// preCommitState is the consensus hook applied to the state before it is committed
+	preCommitState func(*types.Header, *state.Transition) error
 }
 
 // CurrentHeader returns the header of blockchain block head
@@ -100,6 +103,12 @@ func (p *blockchainWrapper) ProcessBlock(parent *types.Header, block *types.Bloc
 		}
 	}
 
+	if p.preCommitState != nil {
+		if err := p.preCommitState(header, transition); err != nil {
+			return nil, err
+		}
+	}
+
 	if callback != nil {
 		if err := callback(transition); err != nil {
 			return nil, err
@@ -160,13 +169,15 @@ func (p *blockchainWrapper) NewBlockBuilder(
 	}
 
 	return NewBlockBuilder(&BlockBuilderParams{
-		BlockTime: blockTime,
-		Parent:    parent,
-		Coinbase:  coinbase,
-		Executor:  p.executor,
-		GasLimit:  gasLimit,
-		TxPool:    txPool,
-		Logger:    logger,
+		BlockTime:      blockTime,
+		Parent:         parent,
+		Coinbase:       coinbase,
+		Executor:       p.executor,
+		GasLimit:       gasLimit,
+		TxPool:         txPool,
+		Logger:         logger,
+		PreCommitState: p.preCommitState,
+		TxOrdering:     p.blockchain.Config().TxOrdering,
 	}), nil
 }
 
@@ -31,6 +31,7 @@ type CheckpointManager interface {
 	PostBlock(req *PostBlockRequest) error
 	BuildEventRoot(epoch uint64) (types.Hash, error)
 	GenerateExitProof(exitID uint64) (types.Proof, error)
+	LastCheckpointBlock() (uint64, error)
 }
 
 var _ CheckpointManager = (*dummyCheckpointManager)(nil)
@@ -44,6 +45,7 @@ func (d *dummyCheckpointManager) BuildEventRoot(epoch uint64) (types.Hash, error
 func (d *dummyCheckpointManager) GenerateExitProof(exitID uint64) (types.Proof, error) {
 	return types.Proof{}, nil
 }
+func (d *dummyCheckpointManager) LastCheckpointBlock() (uint64, error) { return 0, nil }
 
 var _ CheckpointManager = (*checkpointManager)(nil)
 
@@ -57,8 +59,9 @@ type checkpointManager struct {
 	consensusBackend plgbftBackend
 	// rootChainRelayer abstracts rootchain interaction logic (Call and SendTransaction invocations to the rootchain)
 	rootChainRelayer txrelayer.TxRelayer
-	// checkpointsOffset represents offset between checkpoint blocks (applicable only for non-epoch ending blocks)
-	checkpointsOffset uint64
+	// checkpointsOffset returns offset between checkpoint blocks in effect at the given block
+	// (applicable only for non-epoch ending blocks)
+	checkpointsOffset func(blockNumber uint64) uint64
 	// checkpointManagerAddr is address of CheckpointManager smart contract
 	checkpointManagerAddr types.Address
 	// lastSentBlock represents the last block on which a checkpoint transaction was sent
@@ -70,7 +73,7 @@ type checkpointManager struct {
 }
 
 // newCheckpointManager creates a new instance of checkpointManager
-func newCheckpointManager(key ethgo.Key, checkpointOffset uint64,
+func newCheckpointManager(key ethgo.Key, checkpointOffset func(blockNumber uint64) uint64,
 	checkpointManagerSC types.Address, txRelayer txrelayer.TxRelayer,
 	blockchain blockchainBackend, backend plgbftBackend, logger hclog.Logger,
 	state *State) *checkpointManager {
@@ -110,6 +113,11 @@ func (c *checkpointManager) getLatestCheckpointBlock() (uint64, error) {
 	return latestCheckpointBlockNum, nil
 }
 
+// LastCheckpointBlock returns the number of the latest block checkpointed on the rootchain
+func (c *checkpointManager) LastCheckpointBlock() (uint64, error) {
+	return c.getLatestCheckpointBlock()
+}
+
 // submitCheckpoint sends a transaction with checkpoint data to the rootchain
 func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOfEpoch bool) error {
 	lastCheckpointBlockNumber, err := c.getLatestCheckpointBlock()
@@ -266,7 +274,7 @@ func (c *checkpointManager) abiEncodeCheckpointBlock(blockNumber uint64, blockHa
 // which are offset by predefined count of blocks
 // or if given block is an epoch ending block
 func (c *checkpointManager) isCheckpointBlock(blockNumber uint64, isEpochEndingBlock bool) bool {
-	return isEpochEndingBlock || blockNumber == c.lastSentBlock+c.checkpointsOffset
+	return isEpochEndingBlock || blockNumber == c.lastSentBlock+c.checkpointsOffset(blockNumber)
 }
 
 // PostBlock is called on every insert of finalized block (either from consensus or syncer)
@@ -280,7 +280,7 @@ func TestCheckpointManager_IsCheckpointBlock(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), c.checkpointsOffset, types.ZeroAddress, nil, nil, nil, hclog.NewNullLogger(), nil)
+			checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), fixedCheckpointsOffset(c.checkpointsOffset), types.ZeroAddress, nil, nil, nil, hclog.NewNullLogger(), nil)
 			require.Equal(t, c.isCheckpointBlock, checkpointMgr.isCheckpointBlock(c.blockNumber, c.isEpochEndingBlock))
 		})
 	}
@@ -306,7 +306,7 @@ func TestCheckpointManager_PostBlock(t *testing.T) {
 	req := &PostBlockRequest{FullBlock: &types.FullBlock{Block: &types.Block{Header: &types.Header{Number: block}}, Receipts: receipts},
 		Epoch: epoch}
 
-	checkpointManager := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), 5, types.ZeroAddress,
+	checkpointManager := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), fixedCheckpointsOffset(5), types.ZeroAddress,
 		nil, nil, nil, hclog.NewNullLogger(), state)
 
 	t.Run("PostBlock - not epoch ending block", func(t *testing.T) {
@@ -403,7 +403,7 @@ func TestCheckpointManager_GenerateExitProof(t *testing.T) {
 	// create checkpoint manager and insert exit events
 	checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(
 		createTestKey(t)),
-		0,
+		fixedCheckpointsOffset(0),
 		types.ZeroAddress,
 		dummyTxRelayer,
 		nil,
@@ -536,3 +536,9 @@ func createTestLogForExitEvent(t *testing.T, exitEventID uint64) *types.Log {
 		Data:    encodedData,
 	}
 }
+
+func fixedCheckpointsOffset(offset uint64) func(uint64) uint64 {
+	return func(uint64) uint64 {
+		return offset
+	}
+}
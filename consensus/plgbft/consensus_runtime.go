@@ -9,9 +9,10 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/plingatech/go-plgchain/consensus/liveness"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
-	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	"github.com/plingatech/go-plgchain/txrelayer"
 	"github.com/plingatech/go-plgchain/types"
 
@@ -78,6 +79,7 @@ type runtimeConfig struct {
 	txPool                txPoolInterface
 	bridgeTopic           topic
 	numBlockConfirmations uint64
+	roundTracker          *roundchange.Tracker
 }
 
 // consensusRuntime is a struct that provides consensus runtime features like epoch, state and event management
@@ -112,6 +114,12 @@ type consensusRuntime struct {
 	// manager for state sync bridge transactions
 	stateSyncManager StateSyncManager
 
+	// livenessTracker aggregates the committed seals and the proposals of the validators
+	livenessTracker *liveness.Tracker
+
+	// doubleSignDetector detects the conflicting consensus messages signed by the validators
+	doubleSignDetector *doubleSignDetector
+
 	// logger instance
 	logger hcf.Logger
 }
@@ -123,11 +131,18 @@ func newConsensusRuntime(log hcf.Logger, config *runtimeConfig) (*consensusRunti
 		return nil, fmt.Errorf("failed to create consensus runtime, error while creating proposer calculator %w", err)
 	}
 
+	livenessSnapshot, err := config.State.LivenessStore.getLivenessSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consensus runtime, error while reading liveness snapshot %w", err)
+	}
+
 	runtime := &consensusRuntime{
 		state:              config.State,
 		config:             config,
 		lastBuiltBlock:     config.blockchain.CurrentHeader(),
 		proposerCalculator: proposerCalculator,
+		livenessTracker:    liveness.NewTracker(liveness.DefaultWindowSize, livenessSnapshot),
+		doubleSignDetector: newDoubleSignDetector(),
 		logger:             log.Named("consensus_runtime"),
 	}
 
@@ -151,6 +166,10 @@ func newConsensusRuntime(log hcf.Logger, config *runtimeConfig) (*consensusRunti
 // close is used to tear down allocated resources
 func (c *consensusRuntime) close() {
 	c.stateSyncManager.Close()
+
+	if err := c.persistLiveness(); err != nil {
+		c.logger.Error("failed to persist validators liveness", "err", err)
+	}
 }
 
 // initStateSyncManager initializes state sync manager
@@ -197,7 +216,9 @@ func (c *consensusRuntime) initCheckpointManager(logger hcf.Logger) error {
 
 		c.checkpointManager = newCheckpointManager(
 			wallet.NewEcdsaSigner(c.config.Key),
-			defaultCheckpointsOffset,
+			func(blockNumber uint64) uint64 {
+				return c.config.PlgBFTConfig.ParamsAt(blockNumber).CheckpointInterval
+			},
 			c.config.PlgBFTConfig.Bridge.CheckpointAddr,
 			txRelayer,
 			c.config.blockchain,
@@ -275,6 +296,16 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 		c.logger.Error("failed to post block in checkpoint manager", "err", err)
 	}
 
+	// record which validators signed and proposed the block (before proposer priorities are updated)
+	if err := c.trackLiveness(fullBlock.Block.Header, epoch, isEndOfEpoch); err != nil {
+		c.logger.Error("failed to track validators liveness", "err", err)
+	}
+
+	// messages of the heights out of the double sign lookback are not needed anymore
+	if number := fullBlock.Block.Number(); number >= doubleSignLookback {
+		c.doubleSignDetector.prune(number - doubleSignLookback + 1)
+	}
+
 	// update proposer priorities
 	if err := c.proposerCalculator.PostBlock(postBlock); err != nil {
 		c.logger.Error("Could not update proposer calculator", "err", err)
@@ -306,11 +337,13 @@ func (c *consensusRuntime) FSM() error {
 		return errNotAValidator
 	}
 
+	pendingBlockNumber := parent.Number + 1
+
 	blockBuilder, err := c.config.blockchain.NewBlockBuilder(
 		parent,
 		types.Address(c.config.Key.Address()),
 		c.config.txPool,
-		c.config.PlgBFTConfig.BlockTime.Duration,
+		c.config.PlgBFTConfig.ParamsAt(pendingBlockNumber).BlockTime,
 		c.logger,
 	)
 
@@ -322,7 +355,6 @@ func (c *consensusRuntime) FSM() error {
 	// TODO - recognize slashing occurred (to be fixed in EVM-519)
 	slash := false
 
-	pendingBlockNumber := parent.Number + 1
 	isEndOfSprint := slash || c.isFixedSizeOfSprintMet(pendingBlockNumber, epoch)
 	isEndOfEpoch := slash || c.isFixedSizeOfEpochMet(pendingBlockNumber, epoch)
 
@@ -362,6 +394,15 @@ func (c *consensusRuntime) FSM() error {
 		if err != nil {
 			return fmt.Errorf("cannot calculate commit epoch info: %w", err)
 		}
+
+		// slashing changes the commit epoch transaction, so it is enabled by a fork
+		if c.config.PlgBFTConfig.ParamsAt(pendingBlockNumber).DoubleSignerSlashing {
+			ff.doubleSignerSlashingInput, err = c.calculateDoubleSignerSlashingInput(
+				ff.commitEpochInput, epoch, pendingBlockNumber)
+			if err != nil {
+				return fmt.Errorf("cannot calculate double signer slashing info: %w", err)
+			}
+		}
 	}
 
 	c.logger.Info(
@@ -406,6 +447,15 @@ func (c *consensusRuntime) restartEpoch(header *types.Header) (*epochMetadata, e
 		return nil, fmt.Errorf("restart epoch - cannot get validators: %w", err)
 	}
 
+	// sign by the BLS key registered for the epoch, which switches to the rotated one at the epoch boundary.
+	// The keys rotated while the node is running are reloaded from the secrets by the key, or by the remote signer
+	if validator := validatorSet.GetValidatorMetadata(types.Address(c.config.Key.Address())); validator != nil {
+		if err := c.config.Key.UseBlsKey(validator.BlsKey); err != nil {
+			c.logger.Error("restart epoch - cannot use BLS key registered in the validator set, "+
+				"the validator signatures are invalid until the key is restored", "error", err)
+		}
+	}
+
 	updateEpochMetrics(epochMetadata{
 		Number:     epochNumber,
 		Validators: validatorSet,
@@ -572,12 +622,21 @@ func (c *consensusRuntime) isActiveValidator() bool {
 // isFixedSizeOfEpochMet checks if epoch reached its end that was configured by its default size
 // this is only true if no slashing occurred in the given epoch
 func (c *consensusRuntime) isFixedSizeOfEpochMet(blockNumber uint64, epoch *epochMetadata) bool {
-	return epoch.FirstBlockInEpoch+c.config.PlgBFTConfig.EpochSize-1 == blockNumber
+	return epoch.FirstBlockInEpoch+c.config.PlgBFTConfig.ParamsAt(epoch.FirstBlockInEpoch).EpochSize-1 == blockNumber
 }
 
 // isFixedSizeOfSprintMet checks if an end of an sprint is reached with the current block
 func (c *consensusRuntime) isFixedSizeOfSprintMet(blockNumber uint64, epoch *epochMetadata) bool {
-	return (blockNumber-epoch.FirstBlockInEpoch+1)%c.config.PlgBFTConfig.SprintSize == 0
+	return (blockNumber-epoch.FirstBlockInEpoch+1)%c.config.PlgBFTConfig.ParamsAt(blockNumber).SprintSize == 0
+}
+
+// isEndOfSprintOrEpoch checks if the block with the given number ends the current sprint or epoch
+func (c *consensusRuntime) isEndOfSprintOrEpoch(blockNumber uint64) bool {
+	c.lock.RLock()
+	epoch := c.epoch
+	c.lock.RUnlock()
+
+	return c.isFixedSizeOfSprintMet(blockNumber, epoch) || c.isFixedSizeOfEpochMet(blockNumber, epoch)
 }
 
 // getSystemState builds SystemState instance for the most current block header
@@ -713,6 +772,8 @@ func (c *consensusRuntime) InsertProposal(proposal *proto.Proposal, committedSea
 		return
 	}
 
+	c.config.roundTracker.OnPhase(roundchange.PhaseFin, fullBlock.Block.Number(), proposal.Round)
+
 	c.OnBlockInserted(fullBlock)
 }
 
@@ -753,7 +814,9 @@ func (c *consensusRuntime) HasQuorum(
 			return false
 		}
 
-		propAddress, err := c.fsm.proposerSnapshot.GetLatestProposer(messages[0].View.Round, height)
+		// the latest calculated proposer may be the one of another round (e.g. of the prepared certificate
+		// being verified), so the proposer of the round of the messages is taken instead
+		propAddress, err := c.fsm.proposerSnapshot.GetProposer(messages[0].View.Round, height)
 		if err != nil {
 			// This can happen if e.g. node runs sequence on lower height and proposer calculator updated
 			// to a newer count as a consequence of inserting block from syncer
@@ -790,6 +853,8 @@ func (c *consensusRuntime) BuildPrePrepareMessage(
 		return nil
 	}
 
+	c.config.roundTracker.OnPhase(roundchange.PhasePrepare, view.Height, view.Round)
+
 	block := types.Block{}
 	if err := block.UnmarshalRLP(rawProposal); err != nil {
 		c.logger.Error(fmt.Sprintf("cannot unmarshal RLP: %s", err))
@@ -841,6 +906,8 @@ func (c *consensusRuntime) BuildPrePrepareMessage(
 
 // BuildPrepareMessage builds a PREPARE message based on the passed in proposal
 func (c *consensusRuntime) BuildPrepareMessage(proposalHash []byte, view *proto.View) *proto.Message {
+	c.config.roundTracker.OnPhase(roundchange.PhasePrepare, view.Height, view.Round)
+
 	msg := proto.Message{
 		View: view,
 		From: c.ID(),
@@ -864,7 +931,9 @@ func (c *consensusRuntime) BuildPrepareMessage(proposalHash []byte, view *proto.
 
 // BuildCommitMessage builds a COMMIT message based on the passed in proposal
 func (c *consensusRuntime) BuildCommitMessage(proposalHash []byte, view *proto.View) *proto.Message {
-	committedSeal, err := c.config.Key.SignWithDomain(proposalHash, bls.DomainCheckpointManager)
+	c.config.roundTracker.OnPhase(roundchange.PhaseCommit, view.Height, view.Round)
+
+	committedSeal, err := c.config.Key.SignCommittedSeal(proposalHash, view.Height, view.Round)
 	if err != nil {
 		c.logger.Error("Cannot create committed seal message.", "error", err)
 
@@ -899,6 +968,9 @@ func (c *consensusRuntime) BuildRoundChangeMessage(
 	certificate *proto.PreparedCertificate,
 	view *proto.View,
 ) *proto.Message {
+	// the message is built once the round timer expires, right before the timer of the new round starts
+	c.config.roundTracker.OnRoundTimeout(view.Height, view.Round)
+
 	msg := proto.Message{
 		View: view,
 		From: c.ID(),
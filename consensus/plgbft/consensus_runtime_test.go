@@ -10,9 +10,9 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/consensus"
+	"github.com/plingatech/go-plgchain/consensus/liveness"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/bitmap"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
-	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
 	"github.com/plingatech/go-plgchain/contracts"
 	"github.com/plingatech/go-plgchain/helper/common"
@@ -215,6 +215,7 @@ func TestConsensusRuntime_OnBlockInserted_EndOfEpoch(t *testing.T) {
 		plgbftBackend: plgbftBackendMock,
 		txPool:        txPool,
 		State:         newTestState(t),
+		Key:           createTestKey(t),
 	}
 	runtime := &consensusRuntime{
 		proposerCalculator: NewProposerCalculatorFromSnapshot(snapshot, config, hclog.NewNullLogger()),
@@ -225,14 +226,17 @@ func TestConsensusRuntime_OnBlockInserted_EndOfEpoch(t *testing.T) {
 			Number:            currentEpochNumber,
 			FirstBlockInEpoch: header.Number - epochSize + 1,
 		},
-		lastBuiltBlock:    &types.Header{Number: header.Number - 1},
-		stateSyncManager:  &dummyStateSyncManager{},
-		checkpointManager: &dummyCheckpointManager{},
+		lastBuiltBlock:     &types.Header{Number: header.Number - 1},
+		stateSyncManager:   &dummyStateSyncManager{},
+		checkpointManager:  &dummyCheckpointManager{},
+		livenessTracker:    liveness.NewTracker(liveness.DefaultWindowSize, nil),
+		doubleSignDetector: newDoubleSignDetector(),
 	}
 	runtime.OnBlockInserted(&types.FullBlock{Block: builtBlock})
 
 	require.True(t, runtime.state.EpochStore.isEpochInserted(currentEpochNumber+1))
 	require.Equal(t, newEpochNumber, runtime.epoch.Number)
+	require.Equal(t, header.Number, runtime.livenessTracker.LastBlock())
 
 	blockchainMock.AssertExpectations(t)
 	systemStateMock.AssertExpectations(t)
@@ -687,7 +691,7 @@ func TestConsensusRuntime_TamperMessageContent(t *testing.T) {
 	}
 	sender := validatorAccounts.getValidator("A")
 	proposalHash := []byte{2, 4, 6, 8, 10}
-	proposalSignature, err := sender.Key().SignWithDomain(proposalHash, bls.DomainCheckpointManager)
+	proposalSignature, err := sender.Key().SignCommittedSeal(proposalHash, 0, 0)
 	require.NoError(t, err)
 
 	msg := &proto.Message{
@@ -926,6 +930,12 @@ func TestConsensusRuntime_HasQuorum(t *testing.T) {
 	// not enough quorum
 	assert.False(t, runtime.HasQuorum(lastBuildBlock.Number+1, messages[:1], proto.MessageType_PREPARE))
 
+	// the latest calculated proposer is the one of another round
+	_, err = snapshot.CalcProposer(0, lastBuildBlock.Number+1)
+	require.NoError(t, err)
+	assert.True(t, runtime.HasQuorum(lastBuildBlock.Number+1, messages, proto.MessageType_PREPARE))
+	assert.Equal(t, uint64(0), snapshot.Round)
+
 	// include proposer which is not allowed
 	messages = append(messages, &proto.Message{
 		From: proposer[:],
@@ -1000,7 +1010,7 @@ func TestConsensusRuntime_BuildCommitMessage(t *testing.T) {
 		},
 	}
 
-	committedSeal, err := key.SignWithDomain(proposalHash, bls.DomainCheckpointManager)
+	committedSeal, err := key.SignCommittedSeal(proposalHash, view.Height, view.Round)
 	require.NoError(t, err)
 
 	expected := proto.Message{
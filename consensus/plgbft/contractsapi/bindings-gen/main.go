@@ -51,9 +51,17 @@ func main() {
 			gensc.ChildValidatorSet,
 			[]string{
 				"commitEpoch",
+				"commitEpochWithDoubleSignerSlashing",
 				"initialize",
 				"addToWhitelist",
 				"register",
+				"updateBlsKey",
+				"delegate",
+				"undelegate",
+				"claimDelegatorReward",
+				"claimValidatorReward",
+				"getDelegatorReward",
+				"getValidatorReward",
 			},
 			[]string{
 				"NewValidator",
@@ -63,6 +71,9 @@ func main() {
 				"Undelegated",
 				"AddedToWhitelist",
 				"Withdrawal",
+				"DoubleSignerSlashed",
+				"DelegatorRewardClaimed",
+				"ValidatorRewardClaimed",
 			},
 		},
 		{
@@ -280,11 +291,13 @@ func generateType(generatedData *generatedData, name string, obj *abi.Type, res
 
 // generateNestedType generates code for nested types found in smart contracts structs
 func generateNestedType(generatedData *generatedData, name string, obj *abi.Type, res *[]string) (string, error) {
+	internalType := getInternalType(name, obj)
+
 	for _, s := range generatedData.structs {
-		if s == name {
+		if s == internalType {
 			// do not generate the same type again if it's already generated
 			// this happens when two functions use the same struct type as one of its parameters
-			return "*" + name, nil
+			return "*" + internalType, nil
 		}
 	}
 
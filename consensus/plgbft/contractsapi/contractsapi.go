@@ -186,6 +186,47 @@ func (c *CommitEpochChildValidatorSetFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ChildValidatorSet.Abi.Methods["commitEpoch"], buf, c)
 }
 
+type DoubleSignerSlashingInput struct {
+	EpochID                 *big.Int   `abi:"epochId"`
+	EventRoot               types.Hash `abi:"eventRoot"`
+	CurrentValidatorSetHash types.Hash `abi:"currentValidatorSetHash"`
+	NextValidatorSetHash    types.Hash `abi:"nextValidatorSetHash"`
+	BlockHash               types.Hash `abi:"blockHash"`
+	Bitmap                  []byte     `abi:"bitmap"`
+	Signature               []byte     `abi:"signature"`
+}
+
+var DoubleSignerSlashingInputABIType = abi.MustNewType("tuple(uint256 epochId,bytes32 eventRoot,bytes32 currentValidatorSetHash,bytes32 nextValidatorSetHash,bytes32 blockHash,bytes bitmap,bytes signature)")
+
+func (d *DoubleSignerSlashingInput) EncodeAbi() ([]byte, error) {
+	return DoubleSignerSlashingInputABIType.Encode(d)
+}
+
+func (d *DoubleSignerSlashingInput) DecodeAbi(buf []byte) error {
+	return decodeStruct(DoubleSignerSlashingInputABIType, buf, &d)
+}
+
+type CommitEpochWithDoubleSignerSlashingChildValidatorSetFn struct {
+	CurEpochID  *big.Int                     `abi:"curEpochId"`
+	BlockNumber *big.Int                     `abi:"blockNumber"`
+	PbftRound   *big.Int                     `abi:"pbftRound"`
+	Epoch       *Epoch                       `abi:"epoch"`
+	Uptime      *Uptime                      `abi:"uptime"`
+	Inputs      []*DoubleSignerSlashingInput `abi:"inputs"`
+}
+
+func (c *CommitEpochWithDoubleSignerSlashingChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["commitEpochWithDoubleSignerSlashing"].ID()
+}
+
+func (c *CommitEpochWithDoubleSignerSlashingChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["commitEpochWithDoubleSignerSlashing"].Encode(c)
+}
+
+func (c *CommitEpochWithDoubleSignerSlashingChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["commitEpochWithDoubleSignerSlashing"], buf, c)
+}
+
 type InitStruct struct {
 	EpochReward   *big.Int `abi:"epochReward"`
 	MinStake      *big.Int `abi:"minStake"`
@@ -272,6 +313,122 @@ func (r *RegisterChildValidatorSetFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ChildValidatorSet.Abi.Methods["register"], buf, r)
 }
 
+type UpdateBlsKeyChildValidatorSetFn struct {
+	Signature [2]*big.Int `abi:"signature"`
+	Pubkey    [4]*big.Int `abi:"pubkey"`
+}
+
+func (u *UpdateBlsKeyChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["updateBlsKey"].ID()
+}
+
+func (u *UpdateBlsKeyChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["updateBlsKey"].Encode(u)
+}
+
+func (u *UpdateBlsKeyChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["updateBlsKey"], buf, u)
+}
+
+type DelegateChildValidatorSetFn struct {
+	Validator types.Address `abi:"validator"`
+	Restake   bool          `abi:"restake"`
+}
+
+func (d *DelegateChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["delegate"].ID()
+}
+
+func (d *DelegateChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["delegate"].Encode(d)
+}
+
+func (d *DelegateChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["delegate"], buf, d)
+}
+
+type UndelegateChildValidatorSetFn struct {
+	Validator types.Address `abi:"validator"`
+	Amount    *big.Int      `abi:"amount"`
+}
+
+func (u *UndelegateChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["undelegate"].ID()
+}
+
+func (u *UndelegateChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["undelegate"].Encode(u)
+}
+
+func (u *UndelegateChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["undelegate"], buf, u)
+}
+
+type ClaimDelegatorRewardChildValidatorSetFn struct {
+	Validator types.Address `abi:"validator"`
+	Restake   bool          `abi:"restake"`
+}
+
+func (c *ClaimDelegatorRewardChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["claimDelegatorReward"].ID()
+}
+
+func (c *ClaimDelegatorRewardChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["claimDelegatorReward"].Encode(c)
+}
+
+func (c *ClaimDelegatorRewardChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["claimDelegatorReward"], buf, c)
+}
+
+type ClaimValidatorRewardChildValidatorSetFn struct {
+}
+
+func (c *ClaimValidatorRewardChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["claimValidatorReward"].ID()
+}
+
+func (c *ClaimValidatorRewardChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["claimValidatorReward"].Encode(c)
+}
+
+func (c *ClaimValidatorRewardChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["claimValidatorReward"], buf, c)
+}
+
+type GetDelegatorRewardChildValidatorSetFn struct {
+	Validator types.Address `abi:"validator"`
+	Delegator types.Address `abi:"delegator"`
+}
+
+func (g *GetDelegatorRewardChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["getDelegatorReward"].ID()
+}
+
+func (g *GetDelegatorRewardChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["getDelegatorReward"].Encode(g)
+}
+
+func (g *GetDelegatorRewardChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["getDelegatorReward"], buf, g)
+}
+
+type GetValidatorRewardChildValidatorSetFn struct {
+	Validator types.Address `abi:"validator"`
+}
+
+func (g *GetValidatorRewardChildValidatorSetFn) Sig() []byte {
+	return ChildValidatorSet.Abi.Methods["getValidatorReward"].ID()
+}
+
+func (g *GetValidatorRewardChildValidatorSetFn) EncodeAbi() ([]byte, error) {
+	return ChildValidatorSet.Abi.Methods["getValidatorReward"].Encode(g)
+}
+
+func (g *GetValidatorRewardChildValidatorSetFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildValidatorSet.Abi.Methods["getValidatorReward"], buf, g)
+}
+
 type NewValidatorEvent struct {
 	Validator types.Address `abi:"validator"`
 	BlsKey    [4]*big.Int   `abi:"blsKey"`
@@ -421,6 +578,72 @@ func (w *WithdrawalEvent) ParseLog(log *ethgo.Log) (bool, error) {
 	return true, decodeEvent(ChildValidatorSet.Abi.Events["Withdrawal"], log, w)
 }
 
+type DoubleSignerSlashedEvent struct {
+	Key       types.Address `abi:"key"`
+	Epoch     *big.Int      `abi:"epoch"`
+	PbftRound *big.Int      `abi:"pbftRound"`
+}
+
+func (*DoubleSignerSlashedEvent) Sig() ethgo.Hash {
+	return ChildValidatorSet.Abi.Events["DoubleSignerSlashed"].ID()
+}
+
+func (*DoubleSignerSlashedEvent) Encode(inputs interface{}) ([]byte, error) {
+	return ChildValidatorSet.Abi.Events["DoubleSignerSlashed"].Inputs.Encode(inputs)
+}
+
+func (d *DoubleSignerSlashedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildValidatorSet.Abi.Events["DoubleSignerSlashed"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildValidatorSet.Abi.Events["DoubleSignerSlashed"], log, d)
+}
+
+type DelegatorRewardClaimedEvent struct {
+	Delegator types.Address `abi:"delegator"`
+	Validator types.Address `abi:"validator"`
+	Restake   bool          `abi:"restake"`
+	Amount    *big.Int      `abi:"amount"`
+}
+
+func (*DelegatorRewardClaimedEvent) Sig() ethgo.Hash {
+	return ChildValidatorSet.Abi.Events["DelegatorRewardClaimed"].ID()
+}
+
+func (*DelegatorRewardClaimedEvent) Encode(inputs interface{}) ([]byte, error) {
+	return ChildValidatorSet.Abi.Events["DelegatorRewardClaimed"].Inputs.Encode(inputs)
+}
+
+func (d *DelegatorRewardClaimedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildValidatorSet.Abi.Events["DelegatorRewardClaimed"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildValidatorSet.Abi.Events["DelegatorRewardClaimed"], log, d)
+}
+
+type ValidatorRewardClaimedEvent struct {
+	Validator types.Address `abi:"validator"`
+	Amount    *big.Int      `abi:"amount"`
+}
+
+func (*ValidatorRewardClaimedEvent) Sig() ethgo.Hash {
+	return ChildValidatorSet.Abi.Events["ValidatorRewardClaimed"].ID()
+}
+
+func (*ValidatorRewardClaimedEvent) Encode(inputs interface{}) ([]byte, error) {
+	return ChildValidatorSet.Abi.Events["ValidatorRewardClaimed"].Inputs.Encode(inputs)
+}
+
+func (v *ValidatorRewardClaimedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildValidatorSet.Abi.Events["ValidatorRewardClaimed"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildValidatorSet.Abi.Events["ValidatorRewardClaimed"], log, v)
+}
+
 type SyncStateStateSenderFn struct {
 	Receiver types.Address `abi:"receiver"`
 	Data     []byte        `abi:"data"`
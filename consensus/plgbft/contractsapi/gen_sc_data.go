@@ -1,7 +1,7 @@
 package contractsapi
 
 // This is auto-generated file. DO NOT EDIT.
-var ChildValidatorSetArtifact string = "{\n  \"_format\": \"hh-sol-artifact-1\",\n  \"contractName\": \"ChildValidatorSet\",\n  \"sourceName\": \"contracts/child/ChildValidatorSet.sol\",\n  \"abi\": [\n    {\n      \"inputs\": [],\n      \"name\": \"AmountZero\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"Exists\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"signer\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"InvalidSignature\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"NoTokensDelegated\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"NotFound\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"src\",\n          \"type\": \"string\"\n        },\n        {\n          \"internalType\": \"string\",\n          \"name\": \"msg\",\n          \"type\": \"string\"\n        }\n      ],\n      \"name\": \"StakeRequirement\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"only\",\n          \"type\": \"string\"\n        }\n      ],\n      \"name\": \"Unauthorized\",\n      \"type\": \"error\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"AddedToWhitelist\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"oldCommission\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"newCommission\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"CommissionUpdated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Delegated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"bool\",\n          \"name\": \"restake\",\n          \"type\": \"bool\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"DelegatorRewardClaimed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"DelegatorRewardDistributed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"key\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"epoch\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"pbftRound\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"DoubleSignerSlashed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint8\",\n          \"name\": \"version\",\n          \"type\": \"uint8\"\n        }\n      ],\n      \"name\": \"Initialized\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"startBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"endBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"bytes32\",\n          \"name\": \"epochRoot\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"name\": \"NewEpoch\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"blsKey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"NewValidator\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"previousOwner\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"newOwner\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"OwnershipTransferStarted\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"previousOwner\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"newOwner\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"OwnershipTransferred\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"RemovedFromWhitelist\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Staked\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Undelegated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Unstaked\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"ValidatorRewardClaimed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"ValidatorRewardDistributed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Withdrawal\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"WithdrawalRegistered\",\n      \"type\": \"event\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"ACTIVE_VALIDATOR_SET_SIZE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"DOMAIN\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bytes32\",\n          \"name\": \"\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"DOUBLE_SIGNING_SLASHING_PERCENT\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"MAX_COMMISSION\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TOKEN_CONTRACT\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"SYSTEM\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"WITHDRAWAL_WAIT_PERIOD\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"acceptOwnership\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"whitelistAddreses\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"name\": \"addToWhitelist\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"bls\",\n      \"outputs\": [\n        {\n          \"internalType\": \"contract IBLS\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"restake\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"name\": \"claimDelegatorReward\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"claimValidatorReward\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"epochRoot\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct Epoch\",\n          \"name\": \"epoch\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"components\": [\n                {\n                  \"internalType\": \"address\",\n                  \"name\": \"validator\",\n                  \"type\": \"address\"\n                },\n                {\n                  \"internalType\": \"uint256\",\n                  \"name\": \"signedBlocks\",\n                  \"type\": \"uint256\"\n                }\n              ],\n              \"internalType\": \"struct UptimeData[]\",\n              \"name\": \"uptimeData\",\n              \"type\": \"tuple[]\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"totalBlocks\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct Uptime\",\n          \"name\": \"uptime\",\n          \"type\": \"tuple\"\n        }\n      ],\n      \"name\": \"commitEpoch\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"curEpochId\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"blockNumber\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"pbftRound\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"epochRoot\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct Epoch\",\n          \"name\": \"epoch\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"components\": [\n                {\n                  \"internalType\": \"address\",\n                  \"name\": \"validator\",\n                  \"type\": \"address\"\n                },\n                {\n                  \"internalType\": \"uint256\",\n                  \"name\": \"signedBlocks\",\n                  \"type\": \"uint256\"\n                }\n              ],\n              \"internalType\": \"struct UptimeData[]\",\n              \"name\": \"uptimeData\",\n              \"type\": \"tuple[]\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"totalBlocks\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct Uptime\",\n          \"name\": \"uptime\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"eventRoot\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"currentValidatorSetHash\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"nextValidatorSetHash\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"blockHash\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes\",\n              \"name\": \"bitmap\",\n              \"type\": \"bytes\"\n            },\n            {\n              \"internalType\": \"bytes\",\n              \"name\": \"signature\",\n              \"type\": \"bytes\"\n            }\n          ],\n          \"internalType\": \"struct IChildValidatorSetBase.DoubleSignerSlashingInput[]\",\n          \"name\": \"inputs\",\n          \"type\": \"tuple[]\"\n        }\n      ],\n      \"name\": \"commitEpochWithDoubleSignerSlashing\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"currentEpochId\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"restake\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"name\": \"delegate\",\n      \"outputs\": [],\n      \"stateMutability\": \"payable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"delegationOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"doubleSignerSlashes\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"epochEndBlocks\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"epochReward\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"epochSize\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"epochs\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"startBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"endBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"bytes32\",\n          \"name\": \"epochRoot\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"getCurrentValidatorSet\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"getDelegatorReward\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"blockNumber\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"getEpochByBlock\",\n      \"outputs\": [\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"epochRoot\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct Epoch\",\n          \"name\": \"\",\n          \"type\": \"tuple\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"getValidator\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"blsKey\",\n          \"type\": \"uint256[4]\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"stake\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"totalStake\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"commission\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"withdrawableRewards\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"active\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"getValidatorReward\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochReward\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"minStake\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"minDelegation\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochSize\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct IChildValidatorSetBase.InitStruct\",\n          \"name\": \"init\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"address\",\n              \"name\": \"addr\",\n              \"type\": \"address\"\n            },\n            {\n              \"internalType\": \"uint256[4]\",\n              \"name\": \"pubkey\",\n              \"type\": \"uint256[4]\"\n            },\n            {\n              \"internalType\": \"uint256[2]\",\n              \"name\": \"signature\",\n              \"type\": \"uint256[2]\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"stake\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct IChildValidatorSetBase.ValidatorInit[]\",\n          \"name\": \"validators\",\n          \"type\": \"tuple[]\"\n        },\n        {\n          \"internalType\": \"contract IBLS\",\n          \"name\": \"newBls\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"governance\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"initialize\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"minDelegation\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"minStake\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"owner\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"pendingOwner\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"pendingWithdrawals\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256[2]\",\n          \"name\": \"signature\",\n          \"type\": \"uint256[2]\"\n        },\n        {\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"pubkey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"register\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"whitelistAddreses\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"name\": \"removeFromWhitelist\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"renounceOwnership\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"newCommission\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"setCommission\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"n\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"sortedValidators\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"stake\",\n      \"outputs\": [],\n      \"stateMutability\": \"payable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"totalActiveStake\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"activeStake\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"totalDelegationOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"totalStake\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"totalStakeOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"newOwner\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"transferOwnership\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"undelegate\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"unstake\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"whitelist\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"withdraw\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"withdrawable\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    }\n  ],\n  \"bytecode\": \"0x608060405234801561001057600080fd5b50615f5b80620000216000396000f3fe6080604052600436106102ca5760003560e01c8063715018a61161017b578063a2562ddd116100d7578063e0563ab111610085578063e0563ab114610890578063e30c3978146108a6578063e3f56eaa146108c4578063ea0fee4f146108e4578063eacdc5ff146108f9578063f2fde38b1461090f578063f3f437031461092f57600080fd5b8063a2562ddd146107a4578063a2b915e2146107c4578063a77af229146107e4578063a90049d0146107f9578063ae97dde814610366578063c6b61e4c14610819578063ce513b6f1461087057600080fd5b8063947287cf11610134578063947287cf146106df57806395b0b027146106f557806397e5230d14610715578063982ef0a71461072c5780639a91e6d71461073f5780639b19251a14610754578063a15808791461078457600080fd5b8063715018a61461061057806378f619321461062557806379ba5097146106775780637f6497831461068c5780638b0e9f3f146106ac5780638da5cb5b146106c157600080fd5b80633a4b66f11161022a57806351351d53116101e357806351351d531461052b57806351cff8d91461054657806352a9674b14610566578063548db1741461059a5780635689762f146105ba578063572d356e146105da578063628da527146105f057600080fd5b80633a4b66f11461046b5780633b878c22146104735780633fd5000114610489578063410899c9146104a957806346df33d2146104c95780634d99dd161461050b57600080fd5b80632153f7fa116102875780632153f7fa1461039d578063284017f5146103bd57806328f73148146103e05780632e17de78146103f557806333378ada14610415578063355e6b4314610435578063375b3c0a1461045557600080fd5b80630209fdd0146102cf57806302985992146102fa5780631604e4161461031e5780631904bb2e146103345780631bce714d146103665780631f6590b81461037b575b600080fd5b3480156102db57600080fd5b506102e461094f565b6040516102f191906153b4565b60405180910390f35b34801561030657600080fd5b5061031060055481565b6040519081526020016102f1565b34801561032a57600080fd5b5061031060035481565b34801561034057600080fd5b5061035461034f366004615421565b610960565b6040516102f19695949392919061543e565b34801561037257600080fd5b50610310606481565b34801561038757600080fd5b5061039b610396366004615495565b610a33565b005b3480156103a957600080fd5b506102e46103b83660046154d7565b610b30565b3480156103c957600080fd5b506103d361202081565b6040516102f191906154f0565b3480156103ec57600080fd5b50610310610c3b565b34801561040157600080fd5b5061039b6104103660046154d7565b610d09565b34801561042157600080fd5b50610310610430366004615421565b610e2f565b34801561044157600080fd5b5061039b6104503660046154d7565b610e46565b34801561046157600080fd5b5061031060045481565b61039b610f11565b34801561047f57600080fd5b506103d361101081565b34801561049557600080fd5b506103106104a43660046154d7565b610ff0565b3480156104b557600080fd5b5061039b6104c4366004615516565b611011565b3480156104d557600080fd5b506104e96104e43660046154d7565b61121f565b60408051825181526020808401519082015291810151908201526060016102f1565b34801561051757600080fd5b5061039b61052636600461556d565b611297565b34801561053757600080fd5b506103d36002600160a01b0381565b34801561055257600080fd5b5061039b610561366004615421565b6113a2565b34801561057257600080fd5b506103107ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee81565b3480156105a657600080fd5b5061039b6105b53660046155dd565b6114d2565b3480156105c657600080fd5b506103106105d536600461561e565b61152b565b3480156105e657600080fd5b5061031060005481565b3480156105fc57600080fd5b5061031061060b36600461561e565b61154b565b34801561061c57600080fd5b5061039b611562565b34801561063157600080fd5b5061066761064036600461564c565b61013e60209081526000938452604080852082529284528284209052825290205460ff1681565b60405190151581526020016102f1565b34801561068357600080fd5b5061039b611576565b34801561069857600080fd5b5061039b6106a73660046155dd565b6115ed565b3480156106b857600080fd5b50600954610310565b3480156106cd57600080fd5b506076546001600160a01b03166103d3565b3480156106eb57600080fd5b5061031061520881565b34801561070157600080fd5b506006546103d3906001600160a01b031681565b34801561072157600080fd5b50610310620249f081565b61039b61073a366004615693565b611641565b34801561074b57600080fd5b5061039b61169d565b34801561076057600080fd5b5061066761076f366004615421565b60106020526000908152604090205460ff1681565b34801561079057600080fd5b5061039b61079f3660046156c1565b611702565b3480156107b057600080fd5b5061039b6107bf366004615770565b6119d0565b3480156107d057600080fd5b506103106107df366004615421565b611d5e565b3480156107f057600080fd5b50610310600a81565b34801561080557600080fd5b5061039b610814366004615693565b611d72565b34801561082557600080fd5b506108556108343660046154d7565b600f6020526000908152604090208054600182015460029092015490919083565b604080519384526020840192909252908201526060016102f1565b34801561087c57600080fd5b5061031061088b366004615421565b611e13565b34801561089c57600080fd5b506103d361203081565b3480156108b257600080fd5b5060a8546001600160a01b03166103d3565b3480156108d057600080fd5b506103106108df366004615421565b611e41565b3480156108f057600080fd5b50610310600181565b34801561090557600080fd5b5061031060015481565b34801561091b57600080fd5b5061039b61092a366004615421565b611e4e565b34801561093b57600080fd5b5061031061094a366004615421565b611ebf565b606061095b6064610b30565b905090565b610968615329565b6000808080808061097a600789611ee6565b604080516101208101909152908160a081018260048282826020028201915b8154815260200190600101908083116109995750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff1615156080909201919091528251908301519099509750909150610a029089611f04565b546020820151610a12919061581f565b94508060400151935080606001519250806080015191505091939550919395565b3360009081526010602052604090205460ff16610a845760405163973d02cb60e01b815260206004820152600960248201526815d2125511531254d560ba1b60448201526064015b60405180910390fd5b610a8f338383611f22565b604080516101208101909152610ae29033908060a0810185600482826080808284376000920182905250928452505060208201819052604082018190526060820152600160809091015260079190611fd7565b610aeb33612380565b336001600160a01b03167fcab5c47e498c5b85fd42d656842253cfe070f7648c3a198275347b47dec5d27b82604051610b249190615832565b60405180910390a25050565b60606000600760010154831115610b4957600854610b4b565b825b90506000816001600160401b03811115610b6757610b67615841565b604051908082528060200260200182016040528015610b90578160200160208202803683370190505b50905081600003610ba2579392505050565b6000610bae60076123c9565b90508082600081518110610bc457610bc4615857565b6001600160a01b039092166020928302919091019091015260015b83811015610c3157610bf260078361242a565b915081838281518110610c0757610c07615857565b6001600160a01b039092166020928302919091019091015280610c298161586d565b915050610bdf565b5090949350505050565b60008060076001015460641115610c5457600854610c57565b60645b905080600003610c6957600091505090565b6000610c7560076123c9565b9050610c82600782611f04565b54610c8e600783611ee6565b60040154610c9c919061581f565b610ca6908461581f565b925060015b82811015610d0357610cbe60078361242a565b9150610ccb600783611f04565b54610cd7600784611ee6565b60040154610ce5919061581f565b610cef908561581f565b935080610cfb8161586d565b915050610cab565b50505090565b6000610d16600c3361252c565b336000908152600a6020526040902060070154610d339190615886565b90506000610d4083612588565b905081811315610d63576040516396906e6360e01b8152600401610a7b906158c0565b6000610d6f8284615905565b905060045481128015610d8157508015155b15610d9f576040516396906e6360e01b8152600401610a7b90615945565b610da761169d565b610dc233610db784600019615968565b600c9190600061259b565b80600003610dea576000610dd7600733611ee6565b600701805460ff19169115159190911790555b610df433856126a2565b60405184815233907f0f5bb82176feb1b5e747e28471aa92156a04d9f3ab9f45f28e2d704232b93f759060200160405180910390a250505050565b6000610e3c600783611ee6565b6006015492915050565b610e51600733611ee6565b6007015460ff16610e755760405163973d02cb60e01b8152600401610a7b90615998565b6064811115610ebb5760405162461bcd60e51b815260206004820152601260248201527124a72b20a624a22fa1a7a6a6a4a9a9a4a7a760711b6044820152606401610a7b565b6000610ec8600733611ee6565b6005810154604080519182526020820185905291925033917f6e500db30ce535d38852e318f333e9be41a3fec6c65d234ebb06203c896db9a5910160405180910390a260050155565b610f1c600733611ee6565b6007015460ff16610f405760405163973d02cb60e01b8152600401610a7b90615998565b6000610f4d600c3361252c565b336000908152600a6020526040902060070154610f6a9190615886565b905060045481610f7934612588565b610f839190615886565b1215610fa2576040516396906e6360e01b8152600401610a7b906159bb565b610faa61169d565b610fb8600c3334600061259b565b60405134815233907f9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d9060200160405180910390a250565b6002818154811061100057600080fd5b600091825260209091200154905081565b336002600160a01b03146110385760405163973d02cb60e01b8152600401610a7b906159e9565b60018054600091826110498361586d565b91905055905080841461106e5760405162461bcd60e51b8152600401610a7b90615a0d565b82356020840135116110925760405162461bcd60e51b8152600401610a7b90615a3a565b6000546110a484356020860135615a67565b6110af90600161581f565b6110b99190615a90565b156111145760405162461bcd60e51b815260206004820152602560248201527f45504f43485f4d5553545f42455f444956495349424c455f42595f45504f43486044820152645f53495a4560d81b6064820152608401610a7b565b8235600f6000611125600185615a67565b8152602001908152602001600020600101546001611143919061581f565b146111605760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f602090815260408083209186013560018084018290558735845591870135600280850191909155805492830181559093527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01919091556111c8848461270e565b6111d06128df565b83602001358460000135867f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a876040013560405161121091815260200190565b60405180910390a45050505050565b6040805160608101825260008082526020820181905291810182905290611247600284612c12565b9050600f600061125883600161581f565b81526020019081526020016000206040518060600160405290816000820154815260200160018201548152602001600282015481525050915050919050565b60006112a4600784611f04565b905060006112b28233612cc7565b9050808311156112d5576040516396906e6360e01b8152600401610a7b90615aee565b6112e0823385612d13565b60006112ec8483615a67565b9050600554811080156112fe57508015155b1561131c576040516396906e6360e01b8152600401610a7b90615b25565b611327856000611d72565b600061133285612588565b905061135086600061134684600019615968565b600c92919061259b565b61135a33866126a2565b6040518581526001600160a01b0387169033907f4d10bd049775c77bd7f255195afba5088028ecb3c7c277d393ccff7934f2f92c9060200160405180910390a3505050505050565b6113aa612de8565b6001600160a01b0381166113c0576113c0615b48565b336000908152600e6020526040812060015490919081906113e2908490612e41565b80855560405182815291935091506001600160a01b0385169033907f2717ead6b9200dd235aad468c9809ea400fe33ac69b5bfaa6d3e90fc922b63989060200160405180910390a36000846001600160a01b03168360405160006040518083038185875af1925050503d8060008114611477576040519150601f19603f3d011682016040523d82523d6000602084013e61147c565b606091505b50509050806114c15760405162461bcd60e51b815260206004820152601160248201527015d2551211149055d05317d19052531151607a1b6044820152606401610a7b565b505050506114cf600160da55565b50565b6114da612eba565b60005b81811015611526576115148383838181106114fa576114fa615857565b905060200201602081019061150f9190615421565b612380565b8061151e8161586d565b9150506114dd565b505050565b60006115428261153c600786611f04565b90612f14565b90505b92915050565b60006115428261155c600786611f04565b90612cc7565b61156a612eba565b6115746000612f43565b565b60a85433906001600160a01b031681146115e45760405162461bcd60e51b815260206004820152602960248201527f4f776e61626c6532537465703a2063616c6c6572206973206e6f7420746865206044820152683732bb9037bbb732b960b91b6064820152608401610a7b565b6114cf81612f43565b6115f5612eba565b60005b818110156115265761162f83838381811061161557611615615857565b905060200201602081019061162a9190615421565b612f5c565b806116398161586d565b9150506115f8565b600061164e600784611f04565b6005549091503461165f8333612cc7565b611669919061581f565b1015611688576040516396906e6360e01b8152600401610a7b90615b5e565b6116928383611d72565b611526338434612fa8565b60006116aa600733611ee6565b600681015490915060008190036116bf575050565b600060068301556116d033826126a2565b60405181815233907f378c825c0ac073cbae19612eb73cde31c0972f0c8ba2dedb659840f32550df2b90602001610b24565b604354610100900460ff16158080156117225750604354600160ff909116105b8061173c5750303b15801561173c575060435460ff166001145b61179f5760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b6064820152608401610a7b565b6043805460ff1916600117905580156117c2576043805461ff0019166101001790555b336002600160a01b03146117e95760405163973d02cb60e01b8152600401610a7b906159e9565b6001805560608601356000556117fe82612f43565b611806613073565b853560035560208601356004556040860135600555600680546001600160a01b0319166001600160a01b03851617905560005b848110156119815760006040518060a0016040528088888581811061186057611860615857565b905061010002016020016004806020026040519081016040528092919082600460200280828437600092019190915250505081526020018888858181106118a9576118a9615857565b9050610100020160e00135815260200160008152602001600081526020016001151581525090506119058787848181106118e5576118e5615857565b6118fc926020610100909202019081019150615421565b60079083611fd7565b61196e87878481811061191a5761191a615857565b611931926020610100909202019081019150615421565b88888581811061194357611943615857565b9050610100020160a00189898681811061195f5761195f615857565b90506101000201602001611f22565b50806119798161586d565b915050611839565b5080156119c8576043805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b505050505050565b8060028110156119f25760405162461bcd60e51b8152600401610a7b90615b8f565b6119fc83836130a2565b611a3f5760405162461bcd60e51b8152602060048201526014602482015273424c4f434b484153485f4e4f545f554e4951554560601b6044820152606401610a7b565b60005b81811015611bd957611bd14689868685818110611a6157611a61615857565b9050602002810190611a739190615bb7565b608001358a888887818110611a8a57611a8a615857565b9050602002810190611a9c9190615bb7565b35898988818110611aaf57611aaf615857565b9050602002810190611ac19190615bb7565b602001358a8a89818110611ad757611ad7615857565b9050602002810190611ae99190615bb7565b604001358b8b8a818110611aff57611aff615857565b9050602002810190611b119190615bb7565b604080516020810199909952880196909652606087810195909552608087019390935260a086019190915260c085015260e084015201356101008201526101200160405160208183030381529060405280519060200120858584818110611b7a57611b7a615857565b9050602002810190611b8c9190615bb7565b611b9a9060c0810190615bd7565b878786818110611bac57611bac615857565b9050602002810190611bbe9190615bb7565b611bcc9060a0810190615bd7565b613158565b600101611a42565b506000606460076001015410611bf0576064611bf4565b6008545b90506000611c0182610b30565b90506000826001600160401b03811115611c1d57611c1d615841565b604051908082528060200260200182016040528015611c46578160200160208202803683370190505b50905060005b83811015611d44576000805b86811015611d3a57611c9b898983818110611c7557611c75615857565b9050602002810190611c879190615bb7565b611c959060a0810190615bd7565b8561325c565b15611cae5781611caa8161586d565b9250505b6001821115611d2857611cff858481518110611ccc57611ccc615857565b60200260200101518a8a84818110611ce657611ce6615857565b9050602002810190611cf89190615bb7565b358e6132c6565b6001848481518110611d1357611d13615857565b91151560209283029190910190910152611d3a565b80611d328161586d565b915050611c58565b5050600101611c4c565b50611d518b89898461349d565b5050505050505050505050565b6000611d6b600783611f04565b5492915050565b6000611d7f600784611f04565b90506000611d8d823361383a565b905080600003611d9d5750505050565b8215611db357611dae338583612fa8565b611dbd565b611dbd33826126a2565b821515846001600160a01b0316336001600160a01b03167f6b2cd13395c5d2d1f95934a6a8f884d2cb71e846eb99d6759f7e6a39c060b2d084604051611e0591815260200190565b60405180910390a450505050565b6001546001600160a01b0382166000908152600e602052604081209091611e3a9190612e41565b5092915050565b6000611545600783613881565b611e56612eba565b60a880546001600160a01b0383166001600160a01b03199091168117909155611e876076546001600160a01b031690565b6001600160a01b03167f38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e2270060405160405180910390a350565b6001546001600160a01b0382166000908152600e60205260408120909161154591906138b6565b6001600160a01b031660009081526003918201602052604090200190565b6001600160a01b031660009081526004919091016020526040902090565b60065460009081906001600160a01b031663ebbdac918585611f438961394c565b6040518463ffffffff1660e01b8152600401611f6193929190615c1d565b6040805180830381865afa158015611f7d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611fa19190615c61565b91509150801580611fb0575081155b15611fd05784604051633615713d60e21b8152600401610a7b91906154f0565b5050505050565b6001600160a01b038216611fed57611fed615b48565b611ff78383613a1c565b15612017578160405163cda981d560e01b8152600401610a7b91906154f0565b6001600160a01b0382166000908152600380850160205260409091208251839291909101906120499082906004615347565b50602082810151600483015560408301516005830155606083015160068301556080909201516007909101805460ff191691151591909117905581015160000361209257505050565b82546000906001600160a01b0316816120ab8686613881565b90505b6001600160a01b03821615612121578192506120ca8683613881565b8110156120f9576001600160a01b039182166000908152600387016020526040902060010154909116906120ae565b6001600160a01b039182166000908152600387016020526040902060020154909116906120ae565b6040518060a00160405280846001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160011515815260200185815250866003016000876001600160a01b03166001600160a01b0316815260200190815260200160002060008201518160000160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060208201518160010160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060408201518160020160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060608201518160020160146101000a81548160ff021916908315150217905550608082015181600301600082015181600001906004612256929190615347565b506020820151600482015560408201516005820155606082015160068201556080909101516007909101805460ff191691151591909117905550506001600160a01b0383166122bd5785546001600160a01b0319166001600160a01b03861617865561233a565b6122c78684613881565b811015612306576001600160a01b038381166000908152600388016020526040902060010180546001600160a01b03191691871691909117905561233a565b6001600160a01b038381166000908152600388016020526040902060020180546001600160a01b0319169187169190911790555b6123448686613a6d565b6001860180549060006123568361586d565b91905055508360200151866002016000828254612373919061581f565b9091555050505050505050565b6001600160a01b038116600081815260106020526040808220805460ff19169055517fcdd2e9b91a56913d370075169cefa1602ba36be5301664f752192bb1709df7579190a250565b80546001600160a01b03168015612425575b6001600160a01b0381811660009081526003840160205260409020600201541615612425576001600160a01b039081166000908152600383016020526040902060020154166123db565b919050565b60006001600160a01b038216612453576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206001015416156124aa576001600160a01b0380831660009081526003850160205260409020600101546124a391859116613dba565b9050611545565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061250257506001600160a01b0380821660009081526003850160205260409020600101548382169116145b15611545576001600160a01b038082166000908152600385016020526040902054919250166124c9565b6001600160a01b038116600090815260018301602052604081205461255357506000611545565b8261255e8184613e10565b8154811061256e5761256e615857565b906000526020600020906003020160010154905092915050565b6000818181121561154557611545615b48565b6001600160a01b0383166000908152600185016020526040812054908190036126465784546125cb90600161581f565b6001600160a01b03858116600081815260018981016020908152604080842087905580516060810182529485528482018a81529085018981528c548085018e558d8652929094209451600390920290940180546001600160a01b03191691909516178455915191830191909155516002909101559050611fd0565b6000856126538187613e10565b8154811061266357612663615857565b90600052602060002090600302019050838160010160008282546126879190615886565b92505081905550828160020160008282546123739190615886565b6126d381600180546126b4919061581f565b6001600160a01b0385166000908152600e602052604090209190613e46565b816001600160a01b03167f655c1cd0236fb6dc4916f34c8ff10e3b18fcaea5b344dfc16c36fbb1bdfc5df282604051610b2491815260200190565b6001805461271c9190615a67565b8135146127615760405162461bcd60e51b8152602060048201526013602482015272115413d0d217d393d517d0d3d3535255151151606a1b6044820152606401610a7b565b60006127706020830183615c90565b9150506064811180159061278657506008548111155b6127a25760405162461bcd60e51b8152600401610a7b90615b8f565b60006127ac610c3b565b90506000805460646127be9190615cd9565b6127cd86356020880135615a67565b6003546127da9190615cd9565b6127e5906064615cd9565b6127ef9190615cf0565b905060005b838110156119c857600061280b6020870187615c90565b8381811061281b5761281b615857565b9050604002018036038101906128319190615d3a565b805190915060009061284590600790611ee6565b90506000612857604089013587615cd9565b6020840151845161286a90600790611f04565b54600485015461287a919061581f565b6128849088615cd9565b61288e9190615cd9565b6128989190615cf0565b90506000806128ab856000015184613f64565b915091506128bd85600001518361409d565b84516128c99082614108565b5050505050806128d89061586d565b90506127f4565b600c60005b8154811015612aae57600082828154811061290157612901615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b03168084526001820154948401949094526002015490820152915061294d600782613a1c565b15612a9b57600061295f600783611ee6565b905061297d836020015182600401546129789190615886565b614152565b600482015560208301516009546129979161297891615886565b60095560006129a7600784613881565b905060006129b6600785614168565b90506001600160a01b038116158015906129d95750816129d7600783613881565b105b806129f85750816129f66129ee60078761242a565b600790613881565b115b15612a7e57612a08600785614263565b604080516101208101909152612a7e908590858160a081018260048282826020028201915b815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0384166000908152600d60205260408120555050505b505080612aa79061586d565b90506128e4565b5060005b8154811015612c09576000828281548110612acf57612acf615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b031680845260018201549484019490945260020154908201529150612b1b600782613a1c565b158015612b3f57506001600160a01b0381166000908152600d602052604090205415155b15612bf6576000612b51600783611ee6565b9050612b608360200151614152565b60048281019190915560408051610120810191829052835460a08201908152612bdb9386938692849290918491906001830160c08601808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0382166000908152600d6020526040812055505b505080612c029061586d565b9050612ab2565b506000600c5550565b81546000908103612c2557506000611545565b82546000905b80821015612c72576000612c3f8383614644565b60008781526020902090915085908201541115612c5e57809150612c6c565b612c6981600161581f565b92505b50612c2b565b600082118015612c9e575083612c9b86612c8d600186615a67565b600091825260209091200190565b54145b15612cb757612cae600183615a67565b92505050611545565b509050611545565b505092915050565b60008260010154600003612cdd57506000611545565b600183015483546001600160a01b0384166000908152600686016020526040902054612d099190615cd9565b6115429190615cf0565b8254600184015460009190612d289084615cd9565b612d329190615cf0565b6001600160a01b0384166000908152600686016020526040812080549293508392909190612d61908490615a67565b9250508190555080846001016000828254612d7c9190615a67565b90915550506002840154612d9a90612d95908390615cd9565b612588565b6001600160a01b038416600090815260048601602052604081208054909190612dc4908490615886565b9091555050835482908590600090612ddd908490615a67565b909155505050505050565b600260da5403612e3a5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c006044820152606401610a7b565b600260da55565b81546000905b8360010154811015612eac57600081815260028501602090815260409182902082518084019093528054835260010154908201819052841015612e8a5750612eac565b8051612e96908461581f565b9250508080612ea49061586d565b915050612e47565b9250929050565b600160da55565b6076546001600160a01b031633146115745760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610a7b565b6001600160a01b0381166000908152600583016020526040812054612f39848461465f565b6115429190615a67565b60a880546001600160a01b03191690556114cf816146d9565b6001600160a01b038116600081815260106020526040808220805460ff19166001179055517fa850ae9193f515cbae8d35e8925bd2be26627fc91bce650b8652ed254e9cab039190a250565b612fb3600783611ee6565b6007015460ff16612ffb5760405163973d02cb60e01b815260206004820152601160248201527024a72b20a624a22fab20a624a220aa27a960791b6044820152606401610a7b565b61300a82600061134684612588565b613021838261301a600786611f04565b919061472b565b816001600160a01b0316836001600160a01b03167fe5541a6b6103d4fa7e021ed54fad39c66f27a76bd13d374cf6240ae6bd0bb72b8360405161306691815260200190565b60405180910390a3505050565b604354610100900460ff1661309a5760405162461bcd60e51b8152600401610a7b90615d74565b61157461480b565b600081815b8181101561314d5760006130bc82600161581f565b90505b8281101561313a578585828181106130d9576130d9615857565b90506020028101906130eb9190615bb7565b6080013586868481811061310157613101615857565b90506020028101906131139190615bb7565b60800135036131285760009350505050611545565b806131328161586d565b9150506130bf565b50806131458161586d565b9150506130a7565b506001949350505050565b6000806120306001600160a01b0316620249f08888888888604051602001613184959493929190615de8565b60408051601f198184030181529082905261319e91615e45565b6000604051808303818686fa925050503d80600081146131da576040519150601f19603f3d011682016040523d82523d6000602084013e6131df565b606091505b50915091506000818060200190518101906131fa9190615e57565b90508280156132065750805b6132525760405162461bcd60e51b815260206004820152601d60248201527f5349474e41545552455f564552494649434154494f4e5f4641494c45440000006044820152606401610a7b565b5050505050505050565b60008061326a600884615cf0565b90506000613279600885615a90565b905084821061328d576000925050506132bf565b6000600160ff83161b8787858181106132a8576132a8615857565b9050013560f81c60f81b60f81c60ff161611925050505b9392505050565b600082815261013e6020908152604080832084845282528083206001600160a01b038716845290915290205460ff16156132ff57505050565b600082815261013e6020908152604080832084845282528083206001600160a01b03871684529091528120805460ff19166001179055613340600785611ee6565b6001600160a01b0385166000908152600b602052604090205490915060649061336b90600a90615cd9565b6133759190615cf0565b6001600160a01b0385166000908152600b60205260408120805490919061339d908490615a67565b9250508190555060006064600a83600401546133b99190615cd9565b6133c39190615cf0565b90506133d0600786614263565b808260040160008282546133e49190615a67565b909155505060408051610120810190915261345e908690848160a08101826004828282602002820191815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b8284866001600160a01b03167f2d7432ca38933aadba9547f33f0568008e240e52e66f1a16c6182e742bce3b1360405160405180910390a45050505050565b60018054600091826134ae8361586d565b9190505590508085146134d35760405162461bcd60e51b8152600401610a7b90615a0d565b83356020850135116134f75760405162461bcd60e51b8152600401610a7b90615a3a565b8335600f6000613508600185615a67565b8152602001908152602001600020600101546001613526919061581f565b146135435760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f6020908152604080832087830135600180830182905589358355928901356002808401919091558054938401815585527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace9092019190915591906135b090860186615c90565b915050606481118015906135c657506008548111155b6135e25760405162461bcd60e51b8152600401610a7b90615b8f565b60006135ec610c3b565b90506000805460646135fe9190615cd9565b61360d893560208b0135615a67565b60035461361a9190615cd9565b613625906064615cd9565b61362f9190615cf0565b905060005b838110156137de5786818151811061364e5761364e615857565b60200260200101516137ce57600061366960208a018a615c90565b8381811061367957613679615857565b90506040020180360381019061368f9190615d3a565b80519091506000906136a390600790611ee6565b905060006136b560408c013587615cd9565b602084015184516136c890600790611f04565b5460048501546136d8919061581f565b6136e29088615cd9565b6136ec9190615cd9565b6136f69190615cf0565b9050600080613709856000015184613f64565b9150915081846006016000828254613721919061581f565b909155505084516040518481526001600160a01b03909116907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2845161378190829061377b90600790611f04565b90614832565b84600001516001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac826040516137c091815260200190565b60405180910390a250505050505b6137d78161586d565b9050613634565b506137e76128df565b876020013588600001358a7f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a8b6040013560405161382791815260200190565b60405180910390a4505050505050505050565b60006138468383612f14565b6001600160a01b038316600090815260058501602052604081208054929350839290919061387590849061581f565b90915550909392505050565b6001600160a01b038116600090815260048301602090815260408083205460038601909252822060070154611542919061581f565b60018201546000908082036138cf576000915050611545565b60006138dc600183615a67565b90505b84548110612cbf57600081815260028601602090815260409182902082518084019093528054835260010154908201819052851061391d5750612cbf565b8051613929908561581f565b9350816000036139395750612cbf565b508061394481615e74565b9150506138df565b613954615381565b6006546040516bffffffffffffffffffffffff19606085901b1660208201524660348201526001600160a01b039091169063a850a909907ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee906054016040516020818303038152906040526040518363ffffffff1660e01b81526004016139dc929190615e8b565b6040805180830381865afa1580156139f8573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115459190615ec5565b60006001600160a01b03821615801590611542575082546001600160a01b03838116911614806115425750506001600160a01b03908116600090815260039290920160205260409091205416151590565b60005b82546001600160a01b03838116911614801590613aba57506001600160a01b0382811660009081526003850160205260408082205490921681522060020154600160a01b900460ff165b15613d8c576001600160a01b03808316600090815260038501602052604080822054831680835281832054841683529120600101549091168103613c42576001600160a01b0381811660009081526003860160205260408082205483168252808220600290810154909316808352912090910154909250600160a01b900460ff1615613ba3576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060020154811690841603613bd957809250613bd984846148af565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613c3d91869116614a62565b613d86565b6001600160a01b03818116600090815260038601602052604080822054831682528082206001015490921680825291902060020154909250600160a01b900460ff1615613cec576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060010154811690841603613d2257809250613d228484614a62565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613d86918691166148af565b50613a70565b505080546001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60005b6001600160a01b0382811660009081526003850160205260409020600201541615613e0a576001600160a01b03918216600090815260038401602052604090206002015490911690613dbd565b50919050565b6001600160a01b038116600090815260018301602052604081205490819003613e3b57613e3b615b48565b611542600182615a67565b81600003613e5657613e56615b48565b82546001840154818103613ead576040805180820182528581526020808201868152600085815260028a019092529281209151825591516001918201558601805491613ea18361586d565b91905055505050505050565b60006002860181613ebf600185615a67565b815260200190815260200160002060010154905080841015613ee357613ee3615b48565b83811015613f32576040805180820182528681526020808201878152600086815260028b019092529281209151825591516001918201558701805491613f288361586d565b91905055506119c8565b84600287016000613f44600186615a67565b81526020019081526020016000206000016000828254612373919061581f565b60008080613f73600786611ee6565b604080516101208101909152908160a081018260048282826020028201915b815481526020019060010190808311613f925750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff161515608090920191909152820151919250600090613ff69088611f04565b54905060008290036140115760008094509450505050612eac565b806000036140285785600094509450505050612eac565b6000614034828461581f565b61403e8489615cd9565b6140489190615cf0565b905060006140568289615a67565b90506000606482876040015161406c9190615cd9565b6140769190615cf0565b9050614082818461581f565b61408c8284615a67565b975097505050505050509250929050565b60006140aa600784611ee6565b9050818160060160008282546140c0919061581f565b90915550506040518281526001600160a01b038416907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2505050565b6141178161377b600785611f04565b816001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac82604051610b2491815260200190565b60008082121561416457614164615b48565b5090565b60006001600160a01b038216614191576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206002015416156141e1576001600160a01b0380831660009081526003850160205260409020600201546124a391859116614c15565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061423957506001600160a01b0380821660009081526003850160205260409020600201548382169116145b15611545576001600160a01b03808216600090815260038501602052604090205491925016614200565b6001600160a01b03811661427957614279615b48565b6142838282613a1c565b6142a25780604051634b2990ed60e11b8152600401610a7b91906154f0565b6001600160a01b038181166000908152600384016020526040812060010154909182911615806142ef57506001600160a01b03838116600090815260038601602052604090206002015416155b156142fb575081614367565b506001600160a01b038083166000908152600385016020526040902060020154165b6001600160a01b0381811660009081526003860160205260409020600101541615614367576001600160a01b0390811660009081526003850160205260409020600101541661431d565b6001600160a01b03818116600090815260038601602052604090206001015416156143b3576001600160a01b0380821660009081526003860160205260409020600101541691506143d6565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b038082166000908152600386016020526040808220548584168352912080546001600160a01b0319169190921690811790915580156144af576001600160a01b038082166000908152600387016020526040902060010154811690831603614477576001600160a01b038181166000908152600387016020526040902060010180546001600160a01b0319169185169190911790556144c9565b6001600160a01b038181166000908152600387016020526040902060020180546001600160a01b0319169185169190911790556144c9565b84546001600160a01b0319166001600160a01b0384161785555b6001600160a01b038281166000818152600388016020526040902060020154600160a01b900460ff1615918616146145a457614506868487614c65565b6001600160a01b03858116600090815260038801602052604080822060018082015488861680865284862092830180549288166001600160a01b03199384168117909155865284862080548316821790556002938401805494909301805494909716938216841787559285529284208054909316821790925590549152815460ff60a01b1916600160a01b9182900460ff1615159091021790559193915b80156145b4576145b48685614d5d565b6001600160a01b0383166000908152600387016020526040812080546001600160a01b0319908116825560018083018054909216909155600290910180546001600160a81b0319169055870180549161460c83615e74565b90915550506001600160a01b038316600090815260038701602052604081206007015460028801805491929091612373908490615a67565b60006146536002848418615cf0565b6115429084841661581f565b6001600160a01b03811660009081526006830160205260408120546002840154829161468f91612d959190615cd9565b6001600160a01b0384166000908152600486016020526040812054919250906146bc906129789084615886565b90506146d0670de0b6b3a764000082615cf0565b95945050505050565b607680546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600083600101546000148061473f57508354155b61476357835460018501546147549084615cd9565b61475e9190615cf0565b614765565b815b6001600160a01b038416600090815260068601602052604081208054929350839290919061479490849061581f565b92505081905550808460010160008282546147af919061581f565b90915550508354829085906000906147c890849061581f565b909155505060028401546147e190612d95908390615cd9565b6001600160a01b038416600090815260048601602052604081208054909190612ddd908490615905565b604354610100900460ff16612eb35760405162461bcd60e51b8152600401610a7b90615d74565b8060000361483e575050565b8160010154600003614872576003820154604051636130835f60e01b8152610a7b916001600160a01b0316906004016154f0565b6001820154614889670de0b6b3a764000083615cd9565b6148939190615cf0565b8260020160008282546148a6919061581f565b90915550505050565b6001600160a01b038082166000818152600385016020526040808220600281018054915482871680865293852060010154959094526001600160a01b031990911693851693841790559216908015614932576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b03191691841691821790556149835784546001600160a01b0319166001600160a01b038416178555614a18565b6001600160a01b0380831660009081526003870160205260409020600101548116908516036149e4576001600160a01b038281166000908152600387016020526040902060010180546001600160a01b031916918516919091179055614a18565b6001600160a01b038281166000908152600387016020526040902060020180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206001018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b6001600160a01b038082166000818152600385016020526040808220600181018054915482871680865293852060020154959094526001600160a01b031990911693851693841790559216908015614ae5576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b0319169184169182179055614b365784546001600160a01b0319166001600160a01b038416178555614bcb565b6001600160a01b038083166000908152600387016020526040902060020154811690851603614b97576001600160a01b038281166000908152600387016020526040902060020180546001600160a01b031916918516919091179055614bcb565b6001600160a01b038281166000908152600387016020526040902060010180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206002018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b60005b6001600160a01b0382811660009081526003850160205260409020600101541615613e0a576001600160a01b03918216600090815260038401602052604090206001015490911690614c18565b6001600160a01b038082166000908152600385016020526040808220548584168352912080546001600160a01b0319169190921690811790915580614cc25783546001600160a01b0319166001600160a01b038416178455614d57565b6001600160a01b038082166000908152600386016020526040902060010154811690831603614d23576001600160a01b038181166000908152600386016020526040902060010180546001600160a01b031916918516919091179055614d57565b6001600160a01b038181166000908152600386016020526040902060020180546001600160a01b0319169185169190911790555b50505050565b60005b82546001600160a01b03838116911614801590614da257506001600160a01b0382166000908152600384016020526040902060020154600160a01b900460ff16155b156152fe576001600160a01b038083166000818152600386016020526040808220548416808352912060010154909216900361506c576001600160a01b038181166000908152600386016020526040808220600290810154909316808352912090910154909250600160a01b900460ff1615614e89576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b179055614e6684826148af565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16158015614efc57506001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16155b15614f37576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16614fec576001600160a01b0380831660008181526003870160205260408082206001810154909416825281206002908101805460ff60a01b19908116909155929091529091018054909116600160a01b179055614fc98483614a62565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0380821660009081526003860160205260408082206002908101805487861685528385208301805460ff600160a01b9384900416151590920260ff60a01b1992831617815582548216909255905490941683529120018054909116905561505a84826148af565b83546001600160a01b031692506152f8565b6001600160a01b0381811660009081526003860160205260408082206001015490921680825291902060020154909250600160a01b900460ff161561511c576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b1790556150f98482614a62565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff1615801561518f57506001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16155b156151ca576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16615277576001600160a01b03808316600081815260038701602052604080822060029081018054909516835290822001805460ff60a01b19908116909155919052815416600160a01b17905561525484836148af565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038082166000908152600386016020526040808220600290810180548786168552838520808401805460ff600160a01b9485900416151590930260ff60a01b19938416179055825482169092556001909101549094168352912001805490911690556152ea8482614a62565b83546001600160a01b031692505b50614d60565b506001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60405180608001604052806004906020820280368337509192915050565b8260048101928215615375579160200282015b8281111561537557825182559160200191906001019061535a565b5061416492915061539f565b60405180604001604052806002906020820280368337509192915050565b5b8082111561416457600081556001016153a0565b6020808252825182820181905260009190848201906040850190845b818110156153f55783516001600160a01b0316835292840192918401916001016153d0565b50909695505050505050565b6001600160a01b03811681146114cf57600080fd5b803561242581615401565b60006020828403121561543357600080fd5b81356132bf81615401565b6101208101818860005b6004811015615467578151835260209283019290910190600101615448565b505050608082019690965260a081019490945260c084019290925260e0830152151561010090910152919050565b60008060c083850312156154a857600080fd5b60408301848111156154b957600080fd5b8392508460c0850111156154cc57600080fd5b809150509250929050565b6000602082840312156154e957600080fd5b5035919050565b6001600160a01b0391909116815260200190565b600060608284031215613e0a57600080fd5b600080600060a0848603121561552b57600080fd5b8335925061553c8560208601615504565b915060808401356001600160401b0381111561555757600080fd5b61556386828701615504565b9150509250925092565b6000806040838503121561558057600080fd5b823561558b81615401565b946020939093013593505050565b60008083601f8401126155ab57600080fd5b5081356001600160401b038111156155c257600080fd5b6020830191508360208260051b8501011115612eac57600080fd5b600080602083850312156155f057600080fd5b82356001600160401b0381111561560657600080fd5b61561285828601615599565b90969095509350505050565b6000806040838503121561563157600080fd5b823561563c81615401565b915060208301356154cc81615401565b60008060006060848603121561566157600080fd5b8335925060208401359150604084013561567a81615401565b809150509250925092565b80151581146114cf57600080fd5b600080604083850312156156a657600080fd5b82356156b181615401565b915060208301356154cc81615685565b600080600080600085870360e08112156156da57600080fd5b60808112156156e857600080fd5b5085945060808601356001600160401b038082111561570657600080fd5b818801915088601f83011261571a57600080fd5b81358181111561572957600080fd5b8960208260081b850101111561573e57600080fd5b60208301965080955050505061575660a08701615416565b915061576460c08701615416565b90509295509295909350565b6000806000806000806000610100888a03121561578c57600080fd5b8735965060208801359550604088013594506157ab8960608a01615504565b935060c08801356001600160401b03808211156157c757600080fd5b6157d38b838c01615504565b945060e08a01359150808211156157e957600080fd5b506157f68a828b01615599565b989b979a50959850939692959293505050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561154557611545615809565b60808181019083833792915050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161587f5761587f615809565b5060010190565b8082018281126000831280158216821582161715612cbf57612cbf615809565b6007815266756e7374616b6560c81b602082015260400190565b6040815260006158d2604083016158a6565b82810360208401526132bf816014815273494e53554646494349454e545f42414c414e434560601b602082015260400190565b8181036000831280158383131683831282161715611e3a57611e3a615809565b600d81526c5354414b455f544f4f5f4c4f5760981b602082015260400190565b604081526000615957604083016158a6565b82810360208401526132bf81615925565b80820260008212600160ff1b8414161561598457615984615809565b818105831482151761154557611545615809565b6020808252600990820152682b20a624a220aa27a960b91b604082015260600190565b6040815260056040820152647374616b6560d81b606082015260806020820152600061154560808301615925565b6020808252600a908201526914d654d5115350d0531360b21b604082015260600190565b60208082526013908201527215539156141150d5115117d15413d0d217d251606a1b604082015260600190565b6020808252601390820152721393d7d09313d0d2d4d7d0d3d3535255151151606a1b604082015260600190565b8181038181111561154557611545615809565b634e487b7160e01b600052601260045260246000fd5b600082615a9f57615a9f615a7a565b500690565b602080825260139082015272494e56414c49445f53544152545f424c4f434b60681b604082015260600190565b600a815269756e64656c656761746560b01b602082015260400190565b6040815260006158d260408301615ad1565b601281527144454c45474154494f4e5f544f4f5f4c4f5760701b602082015260400190565b604081526000615b3760408301615ad1565b82810360208401526132bf81615b00565b634e487b7160e01b600052600160045260246000fd5b60408152600860408201526764656c656761746560c01b606082015260806020820152600061154560808301615b00565b6020808252600e908201526d0929cac82989288be988a9c8ea8960931b604082015260600190565b6000823560de19833603018112615bcd57600080fd5b9190910192915050565b6000808335601e19843603018112615bee57600080fd5b8301803591506001600160401b03821115615c0857600080fd5b602001915036819003821315612eac57600080fd5b61010081016040858337608084604084013760c082018360005b6002811015615c56578151835260209283019290910190600101615c37565b505050949350505050565b60008060408385031215615c7457600080fd5b8251615c7f81615685565b60208401519092506154cc81615685565b6000808335601e19843603018112615ca757600080fd5b8301803591506001600160401b03821115615cc157600080fd5b6020019150600681901b3603821315612eac57600080fd5b808202811582820484141761154557611545615809565b600082615cff57615cff615a7a565b500490565b604080519081016001600160401b0381118282101715615d3457634e487b7160e01b600052604160045260246000fd5b60405290565b600060408284031215615d4c57600080fd5b615d54615d04565b8235615d5f81615401565b81526020928301359281019290925250919050565b6020808252602b908201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960408201526a6e697469616c697a696e6760a81b606082015260800190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b858152606060208201526000615e02606083018688615dbf565b8281036040840152615e15818587615dbf565b98975050505050505050565b60005b83811015615e3c578181015183820152602001615e24565b50506000910152565b60008251615bcd818460208701615e21565b600060208284031215615e6957600080fd5b81516132bf81615685565b600081615e8357615e83615809565b506000190190565b8281526040602082015260008251806040840152615eb0816060850160208701615e21565b601f01601f1916919091016060019392505050565b600060408284031215615ed757600080fd5b82601f830112615ee657600080fd5b615eee615d04565b806040840185811115615f0057600080fd5b845b81811015615f1a578051845260209384019301615f02565b50909594505050505056fea2646970667358221220a626fbc30de48fae03e0787e09215ca109af7c4393b840809136a8d3e07d3add64736f6c63430008110033\",\n  \"deployedBytecode\": \"0x6080604052600436106102ca5760003560e01c8063715018a61161017b578063a2562ddd116100d7578063e0563ab111610085578063e0563ab114610890578063e30c3978146108a6578063e3f56eaa146108c4578063ea0fee4f146108e4578063eacdc5ff146108f9578063f2fde38b1461090f578063f3f437031461092f57600080fd5b8063a2562ddd146107a4578063a2b915e2146107c4578063a77af229146107e4578063a90049d0146107f9578063ae97dde814610366578063c6b61e4c14610819578063ce513b6f1461087057600080fd5b8063947287cf11610134578063947287cf146106df57806395b0b027146106f557806397e5230d14610715578063982ef0a71461072c5780639a91e6d71461073f5780639b19251a14610754578063a15808791461078457600080fd5b8063715018a61461061057806378f619321461062557806379ba5097146106775780637f6497831461068c5780638b0e9f3f146106ac5780638da5cb5b146106c157600080fd5b80633a4b66f11161022a57806351351d53116101e357806351351d531461052b57806351cff8d91461054657806352a9674b14610566578063548db1741461059a5780635689762f146105ba578063572d356e146105da578063628da527146105f057600080fd5b80633a4b66f11461046b5780633b878c22146104735780633fd5000114610489578063410899c9146104a957806346df33d2146104c95780634d99dd161461050b57600080fd5b80632153f7fa116102875780632153f7fa1461039d578063284017f5146103bd57806328f73148146103e05780632e17de78146103f557806333378ada14610415578063355e6b4314610435578063375b3c0a1461045557600080fd5b80630209fdd0146102cf57806302985992146102fa5780631604e4161461031e5780631904bb2e146103345780631bce714d146103665780631f6590b81461037b575b600080fd5b3480156102db57600080fd5b506102e461094f565b6040516102f191906153b4565b60405180910390f35b34801561030657600080fd5b5061031060055481565b6040519081526020016102f1565b34801561032a57600080fd5b5061031060035481565b34801561034057600080fd5b5061035461034f366004615421565b610960565b6040516102f19695949392919061543e565b34801561037257600080fd5b50610310606481565b34801561038757600080fd5b5061039b610396366004615495565b610a33565b005b3480156103a957600080fd5b506102e46103b83660046154d7565b610b30565b3480156103c957600080fd5b506103d361202081565b6040516102f191906154f0565b3480156103ec57600080fd5b50610310610c3b565b34801561040157600080fd5b5061039b6104103660046154d7565b610d09565b34801561042157600080fd5b50610310610430366004615421565b610e2f565b34801561044157600080fd5b5061039b6104503660046154d7565b610e46565b34801561046157600080fd5b5061031060045481565b61039b610f11565b34801561047f57600080fd5b506103d361101081565b34801561049557600080fd5b506103106104a43660046154d7565b610ff0565b3480156104b557600080fd5b5061039b6104c4366004615516565b611011565b3480156104d557600080fd5b506104e96104e43660046154d7565b61121f565b60408051825181526020808401519082015291810151908201526060016102f1565b34801561051757600080fd5b5061039b61052636600461556d565b611297565b34801561053757600080fd5b506103d36002600160a01b0381565b34801561055257600080fd5b5061039b610561366004615421565b6113a2565b34801561057257600080fd5b506103107ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee81565b3480156105a657600080fd5b5061039b6105b53660046155dd565b6114d2565b3480156105c657600080fd5b506103106105d536600461561e565b61152b565b3480156105e657600080fd5b5061031060005481565b3480156105fc57600080fd5b5061031061060b36600461561e565b61154b565b34801561061c57600080fd5b5061039b611562565b34801561063157600080fd5b5061066761064036600461564c565b61013e60209081526000938452604080852082529284528284209052825290205460ff1681565b60405190151581526020016102f1565b34801561068357600080fd5b5061039b611576565b34801561069857600080fd5b5061039b6106a73660046155dd565b6115ed565b3480156106b857600080fd5b50600954610310565b3480156106cd57600080fd5b506076546001600160a01b03166103d3565b3480156106eb57600080fd5b5061031061520881565b34801561070157600080fd5b506006546103d3906001600160a01b031681565b34801561072157600080fd5b50610310620249f081565b61039b61073a366004615693565b611641565b34801561074b57600080fd5b5061039b61169d565b34801561076057600080fd5b5061066761076f366004615421565b60106020526000908152604090205460ff1681565b34801561079057600080fd5b5061039b61079f3660046156c1565b611702565b3480156107b057600080fd5b5061039b6107bf366004615770565b6119d0565b3480156107d057600080fd5b506103106107df366004615421565b611d5e565b3480156107f057600080fd5b50610310600a81565b34801561080557600080fd5b5061039b610814366004615693565b611d72565b34801561082557600080fd5b506108556108343660046154d7565b600f6020526000908152604090208054600182015460029092015490919083565b604080519384526020840192909252908201526060016102f1565b34801561087c57600080fd5b5061031061088b366004615421565b611e13565b34801561089c57600080fd5b506103d361203081565b3480156108b257600080fd5b5060a8546001600160a01b03166103d3565b3480156108d057600080fd5b506103106108df366004615421565b611e41565b3480156108f057600080fd5b50610310600181565b34801561090557600080fd5b5061031060015481565b34801561091b57600080fd5b5061039b61092a366004615421565b611e4e565b34801561093b57600080fd5b5061031061094a366004615421565b611ebf565b606061095b6064610b30565b905090565b610968615329565b6000808080808061097a600789611ee6565b604080516101208101909152908160a081018260048282826020028201915b8154815260200190600101908083116109995750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff1615156080909201919091528251908301519099509750909150610a029089611f04565b546020820151610a12919061581f565b94508060400151935080606001519250806080015191505091939550919395565b3360009081526010602052604090205460ff16610a845760405163973d02cb60e01b815260206004820152600960248201526815d2125511531254d560ba1b60448201526064015b60405180910390fd5b610a8f338383611f22565b604080516101208101909152610ae29033908060a0810185600482826080808284376000920182905250928452505060208201819052604082018190526060820152600160809091015260079190611fd7565b610aeb33612380565b336001600160a01b03167fcab5c47e498c5b85fd42d656842253cfe070f7648c3a198275347b47dec5d27b82604051610b249190615832565b60405180910390a25050565b60606000600760010154831115610b4957600854610b4b565b825b90506000816001600160401b03811115610b6757610b67615841565b604051908082528060200260200182016040528015610b90578160200160208202803683370190505b50905081600003610ba2579392505050565b6000610bae60076123c9565b90508082600081518110610bc457610bc4615857565b6001600160a01b039092166020928302919091019091015260015b83811015610c3157610bf260078361242a565b915081838281518110610c0757610c07615857565b6001600160a01b039092166020928302919091019091015280610c298161586d565b915050610bdf565b5090949350505050565b60008060076001015460641115610c5457600854610c57565b60645b905080600003610c6957600091505090565b6000610c7560076123c9565b9050610c82600782611f04565b54610c8e600783611ee6565b60040154610c9c919061581f565b610ca6908461581f565b925060015b82811015610d0357610cbe60078361242a565b9150610ccb600783611f04565b54610cd7600784611ee6565b60040154610ce5919061581f565b610cef908561581f565b935080610cfb8161586d565b915050610cab565b50505090565b6000610d16600c3361252c565b336000908152600a6020526040902060070154610d339190615886565b90506000610d4083612588565b905081811315610d63576040516396906e6360e01b8152600401610a7b906158c0565b6000610d6f8284615905565b905060045481128015610d8157508015155b15610d9f576040516396906e6360e01b8152600401610a7b90615945565b610da761169d565b610dc233610db784600019615968565b600c9190600061259b565b80600003610dea576000610dd7600733611ee6565b600701805460ff19169115159190911790555b610df433856126a2565b60405184815233907f0f5bb82176feb1b5e747e28471aa92156a04d9f3ab9f45f28e2d704232b93f759060200160405180910390a250505050565b6000610e3c600783611ee6565b6006015492915050565b610e51600733611ee6565b6007015460ff16610e755760405163973d02cb60e01b8152600401610a7b90615998565b6064811115610ebb5760405162461bcd60e51b815260206004820152601260248201527124a72b20a624a22fa1a7a6a6a4a9a9a4a7a760711b6044820152606401610a7b565b6000610ec8600733611ee6565b6005810154604080519182526020820185905291925033917f6e500db30ce535d38852e318f333e9be41a3fec6c65d234ebb06203c896db9a5910160405180910390a260050155565b610f1c600733611ee6565b6007015460ff16610f405760405163973d02cb60e01b8152600401610a7b90615998565b6000610f4d600c3361252c565b336000908152600a6020526040902060070154610f6a9190615886565b905060045481610f7934612588565b610f839190615886565b1215610fa2576040516396906e6360e01b8152600401610a7b906159bb565b610faa61169d565b610fb8600c3334600061259b565b60405134815233907f9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d9060200160405180910390a250565b6002818154811061100057600080fd5b600091825260209091200154905081565b336002600160a01b03146110385760405163973d02cb60e01b8152600401610a7b906159e9565b60018054600091826110498361586d565b91905055905080841461106e5760405162461bcd60e51b8152600401610a7b90615a0d565b82356020840135116110925760405162461bcd60e51b8152600401610a7b90615a3a565b6000546110a484356020860135615a67565b6110af90600161581f565b6110b99190615a90565b156111145760405162461bcd60e51b815260206004820152602560248201527f45504f43485f4d5553545f42455f444956495349424c455f42595f45504f43486044820152645f53495a4560d81b6064820152608401610a7b565b8235600f6000611125600185615a67565b8152602001908152602001600020600101546001611143919061581f565b146111605760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f602090815260408083209186013560018084018290558735845591870135600280850191909155805492830181559093527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01919091556111c8848461270e565b6111d06128df565b83602001358460000135867f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a876040013560405161121091815260200190565b60405180910390a45050505050565b6040805160608101825260008082526020820181905291810182905290611247600284612c12565b9050600f600061125883600161581f565b81526020019081526020016000206040518060600160405290816000820154815260200160018201548152602001600282015481525050915050919050565b60006112a4600784611f04565b905060006112b28233612cc7565b9050808311156112d5576040516396906e6360e01b8152600401610a7b90615aee565b6112e0823385612d13565b60006112ec8483615a67565b9050600554811080156112fe57508015155b1561131c576040516396906e6360e01b8152600401610a7b90615b25565b611327856000611d72565b600061133285612588565b905061135086600061134684600019615968565b600c92919061259b565b61135a33866126a2565b6040518581526001600160a01b0387169033907f4d10bd049775c77bd7f255195afba5088028ecb3c7c277d393ccff7934f2f92c9060200160405180910390a3505050505050565b6113aa612de8565b6001600160a01b0381166113c0576113c0615b48565b336000908152600e6020526040812060015490919081906113e2908490612e41565b80855560405182815291935091506001600160a01b0385169033907f2717ead6b9200dd235aad468c9809ea400fe33ac69b5bfaa6d3e90fc922b63989060200160405180910390a36000846001600160a01b03168360405160006040518083038185875af1925050503d8060008114611477576040519150601f19603f3d011682016040523d82523d6000602084013e61147c565b606091505b50509050806114c15760405162461bcd60e51b815260206004820152601160248201527015d2551211149055d05317d19052531151607a1b6044820152606401610a7b565b505050506114cf600160da55565b50565b6114da612eba565b60005b81811015611526576115148383838181106114fa576114fa615857565b905060200201602081019061150f9190615421565b612380565b8061151e8161586d565b9150506114dd565b505050565b60006115428261153c600786611f04565b90612f14565b90505b92915050565b60006115428261155c600786611f04565b90612cc7565b61156a612eba565b6115746000612f43565b565b60a85433906001600160a01b031681146115e45760405162461bcd60e51b815260206004820152602960248201527f4f776e61626c6532537465703a2063616c6c6572206973206e6f7420746865206044820152683732bb9037bbb732b960b91b6064820152608401610a7b565b6114cf81612f43565b6115f5612eba565b60005b818110156115265761162f83838381811061161557611615615857565b905060200201602081019061162a9190615421565b612f5c565b806116398161586d565b9150506115f8565b600061164e600784611f04565b6005549091503461165f8333612cc7565b611669919061581f565b1015611688576040516396906e6360e01b8152600401610a7b90615b5e565b6116928383611d72565b611526338434612fa8565b60006116aa600733611ee6565b600681015490915060008190036116bf575050565b600060068301556116d033826126a2565b60405181815233907f378c825c0ac073cbae19612eb73cde31c0972f0c8ba2dedb659840f32550df2b90602001610b24565b604354610100900460ff16158080156117225750604354600160ff909116105b8061173c5750303b15801561173c575060435460ff166001145b61179f5760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b6064820152608401610a7b565b6043805460ff1916600117905580156117c2576043805461ff0019166101001790555b336002600160a01b03146117e95760405163973d02cb60e01b8152600401610a7b906159e9565b6001805560608601356000556117fe82612f43565b611806613073565b853560035560208601356004556040860135600555600680546001600160a01b0319166001600160a01b03851617905560005b848110156119815760006040518060a0016040528088888581811061186057611860615857565b905061010002016020016004806020026040519081016040528092919082600460200280828437600092019190915250505081526020018888858181106118a9576118a9615857565b9050610100020160e00135815260200160008152602001600081526020016001151581525090506119058787848181106118e5576118e5615857565b6118fc926020610100909202019081019150615421565b60079083611fd7565b61196e87878481811061191a5761191a615857565b611931926020610100909202019081019150615421565b88888581811061194357611943615857565b9050610100020160a00189898681811061195f5761195f615857565b90506101000201602001611f22565b50806119798161586d565b915050611839565b5080156119c8576043805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b505050505050565b8060028110156119f25760405162461bcd60e51b8152600401610a7b90615b8f565b6119fc83836130a2565b611a3f5760405162461bcd60e51b8152602060048201526014602482015273424c4f434b484153485f4e4f545f554e4951554560601b6044820152606401610a7b565b60005b81811015611bd957611bd14689868685818110611a6157611a61615857565b9050602002810190611a739190615bb7565b608001358a888887818110611a8a57611a8a615857565b9050602002810190611a9c9190615bb7565b35898988818110611aaf57611aaf615857565b9050602002810190611ac19190615bb7565b602001358a8a89818110611ad757611ad7615857565b9050602002810190611ae99190615bb7565b604001358b8b8a818110611aff57611aff615857565b9050602002810190611b119190615bb7565b604080516020810199909952880196909652606087810195909552608087019390935260a086019190915260c085015260e084015201356101008201526101200160405160208183030381529060405280519060200120858584818110611b7a57611b7a615857565b9050602002810190611b8c9190615bb7565b611b9a9060c0810190615bd7565b878786818110611bac57611bac615857565b9050602002810190611bbe9190615bb7565b611bcc9060a0810190615bd7565b613158565b600101611a42565b506000606460076001015410611bf0576064611bf4565b6008545b90506000611c0182610b30565b90506000826001600160401b03811115611c1d57611c1d615841565b604051908082528060200260200182016040528015611c46578160200160208202803683370190505b50905060005b83811015611d44576000805b86811015611d3a57611c9b898983818110611c7557611c75615857565b9050602002810190611c879190615bb7565b611c959060a0810190615bd7565b8561325c565b15611cae5781611caa8161586d565b9250505b6001821115611d2857611cff858481518110611ccc57611ccc615857565b60200260200101518a8a84818110611ce657611ce6615857565b9050602002810190611cf89190615bb7565b358e6132c6565b6001848481518110611d1357611d13615857565b91151560209283029190910190910152611d3a565b80611d328161586d565b915050611c58565b5050600101611c4c565b50611d518b89898461349d565b5050505050505050505050565b6000611d6b600783611f04565b5492915050565b6000611d7f600784611f04565b90506000611d8d823361383a565b905080600003611d9d5750505050565b8215611db357611dae338583612fa8565b611dbd565b611dbd33826126a2565b821515846001600160a01b0316336001600160a01b03167f6b2cd13395c5d2d1f95934a6a8f884d2cb71e846eb99d6759f7e6a39c060b2d084604051611e0591815260200190565b60405180910390a450505050565b6001546001600160a01b0382166000908152600e602052604081209091611e3a9190612e41565b5092915050565b6000611545600783613881565b611e56612eba565b60a880546001600160a01b0383166001600160a01b03199091168117909155611e876076546001600160a01b031690565b6001600160a01b03167f38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e2270060405160405180910390a350565b6001546001600160a01b0382166000908152600e60205260408120909161154591906138b6565b6001600160a01b031660009081526003918201602052604090200190565b6001600160a01b031660009081526004919091016020526040902090565b60065460009081906001600160a01b031663ebbdac918585611f438961394c565b6040518463ffffffff1660e01b8152600401611f6193929190615c1d565b6040805180830381865afa158015611f7d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611fa19190615c61565b91509150801580611fb0575081155b15611fd05784604051633615713d60e21b8152600401610a7b91906154f0565b5050505050565b6001600160a01b038216611fed57611fed615b48565b611ff78383613a1c565b15612017578160405163cda981d560e01b8152600401610a7b91906154f0565b6001600160a01b0382166000908152600380850160205260409091208251839291909101906120499082906004615347565b50602082810151600483015560408301516005830155606083015160068301556080909201516007909101805460ff191691151591909117905581015160000361209257505050565b82546000906001600160a01b0316816120ab8686613881565b90505b6001600160a01b03821615612121578192506120ca8683613881565b8110156120f9576001600160a01b039182166000908152600387016020526040902060010154909116906120ae565b6001600160a01b039182166000908152600387016020526040902060020154909116906120ae565b6040518060a00160405280846001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160011515815260200185815250866003016000876001600160a01b03166001600160a01b0316815260200190815260200160002060008201518160000160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060208201518160010160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060408201518160020160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060608201518160020160146101000a81548160ff021916908315150217905550608082015181600301600082015181600001906004612256929190615347565b506020820151600482015560408201516005820155606082015160068201556080909101516007909101805460ff191691151591909117905550506001600160a01b0383166122bd5785546001600160a01b0319166001600160a01b03861617865561233a565b6122c78684613881565b811015612306576001600160a01b038381166000908152600388016020526040902060010180546001600160a01b03191691871691909117905561233a565b6001600160a01b038381166000908152600388016020526040902060020180546001600160a01b0319169187169190911790555b6123448686613a6d565b6001860180549060006123568361586d565b91905055508360200151866002016000828254612373919061581f565b9091555050505050505050565b6001600160a01b038116600081815260106020526040808220805460ff19169055517fcdd2e9b91a56913d370075169cefa1602ba36be5301664f752192bb1709df7579190a250565b80546001600160a01b03168015612425575b6001600160a01b0381811660009081526003840160205260409020600201541615612425576001600160a01b039081166000908152600383016020526040902060020154166123db565b919050565b60006001600160a01b038216612453576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206001015416156124aa576001600160a01b0380831660009081526003850160205260409020600101546124a391859116613dba565b9050611545565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061250257506001600160a01b0380821660009081526003850160205260409020600101548382169116145b15611545576001600160a01b038082166000908152600385016020526040902054919250166124c9565b6001600160a01b038116600090815260018301602052604081205461255357506000611545565b8261255e8184613e10565b8154811061256e5761256e615857565b906000526020600020906003020160010154905092915050565b6000818181121561154557611545615b48565b6001600160a01b0383166000908152600185016020526040812054908190036126465784546125cb90600161581f565b6001600160a01b03858116600081815260018981016020908152604080842087905580516060810182529485528482018a81529085018981528c548085018e558d8652929094209451600390920290940180546001600160a01b03191691909516178455915191830191909155516002909101559050611fd0565b6000856126538187613e10565b8154811061266357612663615857565b90600052602060002090600302019050838160010160008282546126879190615886565b92505081905550828160020160008282546123739190615886565b6126d381600180546126b4919061581f565b6001600160a01b0385166000908152600e602052604090209190613e46565b816001600160a01b03167f655c1cd0236fb6dc4916f34c8ff10e3b18fcaea5b344dfc16c36fbb1bdfc5df282604051610b2491815260200190565b6001805461271c9190615a67565b8135146127615760405162461bcd60e51b8152602060048201526013602482015272115413d0d217d393d517d0d3d3535255151151606a1b6044820152606401610a7b565b60006127706020830183615c90565b9150506064811180159061278657506008548111155b6127a25760405162461bcd60e51b8152600401610a7b90615b8f565b60006127ac610c3b565b90506000805460646127be9190615cd9565b6127cd86356020880135615a67565b6003546127da9190615cd9565b6127e5906064615cd9565b6127ef9190615cf0565b905060005b838110156119c857600061280b6020870187615c90565b8381811061281b5761281b615857565b9050604002018036038101906128319190615d3a565b805190915060009061284590600790611ee6565b90506000612857604089013587615cd9565b6020840151845161286a90600790611f04565b54600485015461287a919061581f565b6128849088615cd9565b61288e9190615cd9565b6128989190615cf0565b90506000806128ab856000015184613f64565b915091506128bd85600001518361409d565b84516128c99082614108565b5050505050806128d89061586d565b90506127f4565b600c60005b8154811015612aae57600082828154811061290157612901615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b03168084526001820154948401949094526002015490820152915061294d600782613a1c565b15612a9b57600061295f600783611ee6565b905061297d836020015182600401546129789190615886565b614152565b600482015560208301516009546129979161297891615886565b60095560006129a7600784613881565b905060006129b6600785614168565b90506001600160a01b038116158015906129d95750816129d7600783613881565b105b806129f85750816129f66129ee60078761242a565b600790613881565b115b15612a7e57612a08600785614263565b604080516101208101909152612a7e908590858160a081018260048282826020028201915b815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0384166000908152600d60205260408120555050505b505080612aa79061586d565b90506128e4565b5060005b8154811015612c09576000828281548110612acf57612acf615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b031680845260018201549484019490945260020154908201529150612b1b600782613a1c565b158015612b3f57506001600160a01b0381166000908152600d602052604090205415155b15612bf6576000612b51600783611ee6565b9050612b608360200151614152565b60048281019190915560408051610120810191829052835460a08201908152612bdb9386938692849290918491906001830160c08601808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0382166000908152600d6020526040812055505b505080612c029061586d565b9050612ab2565b506000600c5550565b81546000908103612c2557506000611545565b82546000905b80821015612c72576000612c3f8383614644565b60008781526020902090915085908201541115612c5e57809150612c6c565b612c6981600161581f565b92505b50612c2b565b600082118015612c9e575083612c9b86612c8d600186615a67565b600091825260209091200190565b54145b15612cb757612cae600183615a67565b92505050611545565b509050611545565b505092915050565b60008260010154600003612cdd57506000611545565b600183015483546001600160a01b0384166000908152600686016020526040902054612d099190615cd9565b6115429190615cf0565b8254600184015460009190612d289084615cd9565b612d329190615cf0565b6001600160a01b0384166000908152600686016020526040812080549293508392909190612d61908490615a67565b9250508190555080846001016000828254612d7c9190615a67565b90915550506002840154612d9a90612d95908390615cd9565b612588565b6001600160a01b038416600090815260048601602052604081208054909190612dc4908490615886565b9091555050835482908590600090612ddd908490615a67565b909155505050505050565b600260da5403612e3a5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c006044820152606401610a7b565b600260da55565b81546000905b8360010154811015612eac57600081815260028501602090815260409182902082518084019093528054835260010154908201819052841015612e8a5750612eac565b8051612e96908461581f565b9250508080612ea49061586d565b915050612e47565b9250929050565b600160da55565b6076546001600160a01b031633146115745760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610a7b565b6001600160a01b0381166000908152600583016020526040812054612f39848461465f565b6115429190615a67565b60a880546001600160a01b03191690556114cf816146d9565b6001600160a01b038116600081815260106020526040808220805460ff19166001179055517fa850ae9193f515cbae8d35e8925bd2be26627fc91bce650b8652ed254e9cab039190a250565b612fb3600783611ee6565b6007015460ff16612ffb5760405163973d02cb60e01b815260206004820152601160248201527024a72b20a624a22fab20a624a220aa27a960791b6044820152606401610a7b565b61300a82600061134684612588565b613021838261301a600786611f04565b919061472b565b816001600160a01b0316836001600160a01b03167fe5541a6b6103d4fa7e021ed54fad39c66f27a76bd13d374cf6240ae6bd0bb72b8360405161306691815260200190565b60405180910390a3505050565b604354610100900460ff1661309a5760405162461bcd60e51b8152600401610a7b90615d74565b61157461480b565b600081815b8181101561314d5760006130bc82600161581f565b90505b8281101561313a578585828181106130d9576130d9615857565b90506020028101906130eb9190615bb7565b6080013586868481811061310157613101615857565b90506020028101906131139190615bb7565b60800135036131285760009350505050611545565b806131328161586d565b9150506130bf565b50806131458161586d565b9150506130a7565b506001949350505050565b6000806120306001600160a01b0316620249f08888888888604051602001613184959493929190615de8565b60408051601f198184030181529082905261319e91615e45565b6000604051808303818686fa925050503d80600081146131da576040519150601f19603f3d011682016040523d82523d6000602084013e6131df565b606091505b50915091506000818060200190518101906131fa9190615e57565b90508280156132065750805b6132525760405162461bcd60e51b815260206004820152601d60248201527f5349474e41545552455f564552494649434154494f4e5f4641494c45440000006044820152606401610a7b565b5050505050505050565b60008061326a600884615cf0565b90506000613279600885615a90565b905084821061328d576000925050506132bf565b6000600160ff83161b8787858181106132a8576132a8615857565b9050013560f81c60f81b60f81c60ff161611925050505b9392505050565b600082815261013e6020908152604080832084845282528083206001600160a01b038716845290915290205460ff16156132ff57505050565b600082815261013e6020908152604080832084845282528083206001600160a01b03871684529091528120805460ff19166001179055613340600785611ee6565b6001600160a01b0385166000908152600b602052604090205490915060649061336b90600a90615cd9565b6133759190615cf0565b6001600160a01b0385166000908152600b60205260408120805490919061339d908490615a67565b9250508190555060006064600a83600401546133b99190615cd9565b6133c39190615cf0565b90506133d0600786614263565b808260040160008282546133e49190615a67565b909155505060408051610120810190915261345e908690848160a08101826004828282602002820191815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b8284866001600160a01b03167f2d7432ca38933aadba9547f33f0568008e240e52e66f1a16c6182e742bce3b1360405160405180910390a45050505050565b60018054600091826134ae8361586d565b9190505590508085146134d35760405162461bcd60e51b8152600401610a7b90615a0d565b83356020850135116134f75760405162461bcd60e51b8152600401610a7b90615a3a565b8335600f6000613508600185615a67565b8152602001908152602001600020600101546001613526919061581f565b146135435760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f6020908152604080832087830135600180830182905589358355928901356002808401919091558054938401815585527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace9092019190915591906135b090860186615c90565b915050606481118015906135c657506008548111155b6135e25760405162461bcd60e51b8152600401610a7b90615b8f565b60006135ec610c3b565b90506000805460646135fe9190615cd9565b61360d893560208b0135615a67565b60035461361a9190615cd9565b613625906064615cd9565b61362f9190615cf0565b905060005b838110156137de5786818151811061364e5761364e615857565b60200260200101516137ce57600061366960208a018a615c90565b8381811061367957613679615857565b90506040020180360381019061368f9190615d3a565b80519091506000906136a390600790611ee6565b905060006136b560408c013587615cd9565b602084015184516136c890600790611f04565b5460048501546136d8919061581f565b6136e29088615cd9565b6136ec9190615cd9565b6136f69190615cf0565b9050600080613709856000015184613f64565b9150915081846006016000828254613721919061581f565b909155505084516040518481526001600160a01b03909116907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2845161378190829061377b90600790611f04565b90614832565b84600001516001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac826040516137c091815260200190565b60405180910390a250505050505b6137d78161586d565b9050613634565b506137e76128df565b876020013588600001358a7f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a8b6040013560405161382791815260200190565b60405180910390a4505050505050505050565b60006138468383612f14565b6001600160a01b038316600090815260058501602052604081208054929350839290919061387590849061581f565b90915550909392505050565b6001600160a01b038116600090815260048301602090815260408083205460038601909252822060070154611542919061581f565b60018201546000908082036138cf576000915050611545565b60006138dc600183615a67565b90505b84548110612cbf57600081815260028601602090815260409182902082518084019093528054835260010154908201819052851061391d5750612cbf565b8051613929908561581f565b9350816000036139395750612cbf565b508061394481615e74565b9150506138df565b613954615381565b6006546040516bffffffffffffffffffffffff19606085901b1660208201524660348201526001600160a01b039091169063a850a909907ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee906054016040516020818303038152906040526040518363ffffffff1660e01b81526004016139dc929190615e8b565b6040805180830381865afa1580156139f8573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115459190615ec5565b60006001600160a01b03821615801590611542575082546001600160a01b03838116911614806115425750506001600160a01b03908116600090815260039290920160205260409091205416151590565b60005b82546001600160a01b03838116911614801590613aba57506001600160a01b0382811660009081526003850160205260408082205490921681522060020154600160a01b900460ff165b15613d8c576001600160a01b03808316600090815260038501602052604080822054831680835281832054841683529120600101549091168103613c42576001600160a01b0381811660009081526003860160205260408082205483168252808220600290810154909316808352912090910154909250600160a01b900460ff1615613ba3576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060020154811690841603613bd957809250613bd984846148af565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613c3d91869116614a62565b613d86565b6001600160a01b03818116600090815260038601602052604080822054831682528082206001015490921680825291902060020154909250600160a01b900460ff1615613cec576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060010154811690841603613d2257809250613d228484614a62565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613d86918691166148af565b50613a70565b505080546001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60005b6001600160a01b0382811660009081526003850160205260409020600201541615613e0a576001600160a01b03918216600090815260038401602052604090206002015490911690613dbd565b50919050565b6001600160a01b038116600090815260018301602052604081205490819003613e3b57613e3b615b48565b611542600182615a67565b81600003613e5657613e56615b48565b82546001840154818103613ead576040805180820182528581526020808201868152600085815260028a019092529281209151825591516001918201558601805491613ea18361586d565b91905055505050505050565b60006002860181613ebf600185615a67565b815260200190815260200160002060010154905080841015613ee357613ee3615b48565b83811015613f32576040805180820182528681526020808201878152600086815260028b019092529281209151825591516001918201558701805491613f288361586d565b91905055506119c8565b84600287016000613f44600186615a67565b81526020019081526020016000206000016000828254612373919061581f565b60008080613f73600786611ee6565b604080516101208101909152908160a081018260048282826020028201915b815481526020019060010190808311613f925750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff161515608090920191909152820151919250600090613ff69088611f04565b54905060008290036140115760008094509450505050612eac565b806000036140285785600094509450505050612eac565b6000614034828461581f565b61403e8489615cd9565b6140489190615cf0565b905060006140568289615a67565b90506000606482876040015161406c9190615cd9565b6140769190615cf0565b9050614082818461581f565b61408c8284615a67565b975097505050505050509250929050565b60006140aa600784611ee6565b9050818160060160008282546140c0919061581f565b90915550506040518281526001600160a01b038416907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2505050565b6141178161377b600785611f04565b816001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac82604051610b2491815260200190565b60008082121561416457614164615b48565b5090565b60006001600160a01b038216614191576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206002015416156141e1576001600160a01b0380831660009081526003850160205260409020600201546124a391859116614c15565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061423957506001600160a01b0380821660009081526003850160205260409020600201548382169116145b15611545576001600160a01b03808216600090815260038501602052604090205491925016614200565b6001600160a01b03811661427957614279615b48565b6142838282613a1c565b6142a25780604051634b2990ed60e11b8152600401610a7b91906154f0565b6001600160a01b038181166000908152600384016020526040812060010154909182911615806142ef57506001600160a01b03838116600090815260038601602052604090206002015416155b156142fb575081614367565b506001600160a01b038083166000908152600385016020526040902060020154165b6001600160a01b0381811660009081526003860160205260409020600101541615614367576001600160a01b0390811660009081526003850160205260409020600101541661431d565b6001600160a01b03818116600090815260038601602052604090206001015416156143b3576001600160a01b0380821660009081526003860160205260409020600101541691506143d6565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b038082166000908152600386016020526040808220548584168352912080546001600160a01b0319169190921690811790915580156144af576001600160a01b038082166000908152600387016020526040902060010154811690831603614477576001600160a01b038181166000908152600387016020526040902060010180546001600160a01b0319169185169190911790556144c9565b6001600160a01b038181166000908152600387016020526040902060020180546001600160a01b0319169185169190911790556144c9565b84546001600160a01b0319166001600160a01b0384161785555b6001600160a01b038281166000818152600388016020526040902060020154600160a01b900460ff1615918616146145a457614506868487614c65565b6001600160a01b03858116600090815260038801602052604080822060018082015488861680865284862092830180549288166001600160a01b03199384168117909155865284862080548316821790556002938401805494909301805494909716938216841787559285529284208054909316821790925590549152815460ff60a01b1916600160a01b9182900460ff1615159091021790559193915b80156145b4576145b48685614d5d565b6001600160a01b0383166000908152600387016020526040812080546001600160a01b0319908116825560018083018054909216909155600290910180546001600160a81b0319169055870180549161460c83615e74565b90915550506001600160a01b038316600090815260038701602052604081206007015460028801805491929091612373908490615a67565b60006146536002848418615cf0565b6115429084841661581f565b6001600160a01b03811660009081526006830160205260408120546002840154829161468f91612d959190615cd9565b6001600160a01b0384166000908152600486016020526040812054919250906146bc906129789084615886565b90506146d0670de0b6b3a764000082615cf0565b95945050505050565b607680546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600083600101546000148061473f57508354155b61476357835460018501546147549084615cd9565b61475e9190615cf0565b614765565b815b6001600160a01b038416600090815260068601602052604081208054929350839290919061479490849061581f565b92505081905550808460010160008282546147af919061581f565b90915550508354829085906000906147c890849061581f565b909155505060028401546147e190612d95908390615cd9565b6001600160a01b038416600090815260048601602052604081208054909190612ddd908490615905565b604354610100900460ff16612eb35760405162461bcd60e51b8152600401610a7b90615d74565b8060000361483e575050565b8160010154600003614872576003820154604051636130835f60e01b8152610a7b916001600160a01b0316906004016154f0565b6001820154614889670de0b6b3a764000083615cd9565b6148939190615cf0565b8260020160008282546148a6919061581f565b90915550505050565b6001600160a01b038082166000818152600385016020526040808220600281018054915482871680865293852060010154959094526001600160a01b031990911693851693841790559216908015614932576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b03191691841691821790556149835784546001600160a01b0319166001600160a01b038416178555614a18565b6001600160a01b0380831660009081526003870160205260409020600101548116908516036149e4576001600160a01b038281166000908152600387016020526040902060010180546001600160a01b031916918516919091179055614a18565b6001600160a01b038281166000908152600387016020526040902060020180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206001018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b6001600160a01b038082166000818152600385016020526040808220600181018054915482871680865293852060020154959094526001600160a01b031990911693851693841790559216908015614ae5576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b0319169184169182179055614b365784546001600160a01b0319166001600160a01b038416178555614bcb565b6001600160a01b038083166000908152600387016020526040902060020154811690851603614b97576001600160a01b038281166000908152600387016020526040902060020180546001600160a01b031916918516919091179055614bcb565b6001600160a01b038281166000908152600387016020526040902060010180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206002018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b60005b6001600160a01b0382811660009081526003850160205260409020600101541615613e0a576001600160a01b03918216600090815260038401602052604090206001015490911690614c18565b6001600160a01b038082166000908152600385016020526040808220548584168352912080546001600160a01b0319169190921690811790915580614cc25783546001600160a01b0319166001600160a01b038416178455614d57565b6001600160a01b038082166000908152600386016020526040902060010154811690831603614d23576001600160a01b038181166000908152600386016020526040902060010180546001600160a01b031916918516919091179055614d57565b6001600160a01b038181166000908152600386016020526040902060020180546001600160a01b0319169185169190911790555b50505050565b60005b82546001600160a01b03838116911614801590614da257506001600160a01b0382166000908152600384016020526040902060020154600160a01b900460ff16155b156152fe576001600160a01b038083166000818152600386016020526040808220548416808352912060010154909216900361506c576001600160a01b038181166000908152600386016020526040808220600290810154909316808352912090910154909250600160a01b900460ff1615614e89576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b179055614e6684826148af565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16158015614efc57506001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16155b15614f37576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16614fec576001600160a01b0380831660008181526003870160205260408082206001810154909416825281206002908101805460ff60a01b19908116909155929091529091018054909116600160a01b179055614fc98483614a62565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0380821660009081526003860160205260408082206002908101805487861685528385208301805460ff600160a01b9384900416151590920260ff60a01b1992831617815582548216909255905490941683529120018054909116905561505a84826148af565b83546001600160a01b031692506152f8565b6001600160a01b0381811660009081526003860160205260408082206001015490921680825291902060020154909250600160a01b900460ff161561511c576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b1790556150f98482614a62565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff1615801561518f57506001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16155b156151ca576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16615277576001600160a01b03808316600081815260038701602052604080822060029081018054909516835290822001805460ff60a01b19908116909155919052815416600160a01b17905561525484836148af565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038082166000908152600386016020526040808220600290810180548786168552838520808401805460ff600160a01b9485900416151590930260ff60a01b19938416179055825482169092556001909101549094168352912001805490911690556152ea8482614a62565b83546001600160a01b031692505b50614d60565b506001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60405180608001604052806004906020820280368337509192915050565b8260048101928215615375579160200282015b8281111561537557825182559160200191906001019061535a565b5061416492915061539f565b60405180604001604052806002906020820280368337509192915050565b5b8082111561416457600081556001016153a0565b6020808252825182820181905260009190848201906040850190845b818110156153f55783516001600160a01b0316835292840192918401916001016153d0565b50909695505050505050565b6001600160a01b03811681146114cf57600080fd5b803561242581615401565b60006020828403121561543357600080fd5b81356132bf81615401565b6101208101818860005b6004811015615467578151835260209283019290910190600101615448565b505050608082019690965260a081019490945260c084019290925260e0830152151561010090910152919050565b60008060c083850312156154a857600080fd5b60408301848111156154b957600080fd5b8392508460c0850111156154cc57600080fd5b809150509250929050565b6000602082840312156154e957600080fd5b5035919050565b6001600160a01b0391909116815260200190565b600060608284031215613e0a57600080fd5b600080600060a0848603121561552b57600080fd5b8335925061553c8560208601615504565b915060808401356001600160401b0381111561555757600080fd5b61556386828701615504565b9150509250925092565b6000806040838503121561558057600080fd5b823561558b81615401565b946020939093013593505050565b60008083601f8401126155ab57600080fd5b5081356001600160401b038111156155c257600080fd5b6020830191508360208260051b8501011115612eac57600080fd5b600080602083850312156155f057600080fd5b82356001600160401b0381111561560657600080fd5b61561285828601615599565b90969095509350505050565b6000806040838503121561563157600080fd5b823561563c81615401565b915060208301356154cc81615401565b60008060006060848603121561566157600080fd5b8335925060208401359150604084013561567a81615401565b809150509250925092565b80151581146114cf57600080fd5b600080604083850312156156a657600080fd5b82356156b181615401565b915060208301356154cc81615685565b600080600080600085870360e08112156156da57600080fd5b60808112156156e857600080fd5b5085945060808601356001600160401b038082111561570657600080fd5b818801915088601f83011261571a57600080fd5b81358181111561572957600080fd5b8960208260081b850101111561573e57600080fd5b60208301965080955050505061575660a08701615416565b915061576460c08701615416565b90509295509295909350565b6000806000806000806000610100888a03121561578c57600080fd5b8735965060208801359550604088013594506157ab8960608a01615504565b935060c08801356001600160401b03808211156157c757600080fd5b6157d38b838c01615504565b945060e08a01359150808211156157e957600080fd5b506157f68a828b01615599565b989b979a50959850939692959293505050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561154557611545615809565b60808181019083833792915050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161587f5761587f615809565b5060010190565b8082018281126000831280158216821582161715612cbf57612cbf615809565b6007815266756e7374616b6560c81b602082015260400190565b6040815260006158d2604083016158a6565b82810360208401526132bf816014815273494e53554646494349454e545f42414c414e434560601b602082015260400190565b8181036000831280158383131683831282161715611e3a57611e3a615809565b600d81526c5354414b455f544f4f5f4c4f5760981b602082015260400190565b604081526000615957604083016158a6565b82810360208401526132bf81615925565b80820260008212600160ff1b8414161561598457615984615809565b818105831482151761154557611545615809565b6020808252600990820152682b20a624a220aa27a960b91b604082015260600190565b6040815260056040820152647374616b6560d81b606082015260806020820152600061154560808301615925565b6020808252600a908201526914d654d5115350d0531360b21b604082015260600190565b60208082526013908201527215539156141150d5115117d15413d0d217d251606a1b604082015260600190565b6020808252601390820152721393d7d09313d0d2d4d7d0d3d3535255151151606a1b604082015260600190565b8181038181111561154557611545615809565b634e487b7160e01b600052601260045260246000fd5b600082615a9f57615a9f615a7a565b500690565b602080825260139082015272494e56414c49445f53544152545f424c4f434b60681b604082015260600190565b600a815269756e64656c656761746560b01b602082015260400190565b6040815260006158d260408301615ad1565b601281527144454c45474154494f4e5f544f4f5f4c4f5760701b602082015260400190565b604081526000615b3760408301615ad1565b82810360208401526132bf81615b00565b634e487b7160e01b600052600160045260246000fd5b60408152600860408201526764656c656761746560c01b606082015260806020820152600061154560808301615b00565b6020808252600e908201526d0929cac82989288be988a9c8ea8960931b604082015260600190565b6000823560de19833603018112615bcd57600080fd5b9190910192915050565b6000808335601e19843603018112615bee57600080fd5b8301803591506001600160401b03821115615c0857600080fd5b602001915036819003821315612eac57600080fd5b61010081016040858337608084604084013760c082018360005b6002811015615c56578151835260209283019290910190600101615c37565b505050949350505050565b60008060408385031215615c7457600080fd5b8251615c7f81615685565b60208401519092506154cc81615685565b6000808335601e19843603018112615ca757600080fd5b8301803591506001600160401b03821115615cc157600080fd5b6020019150600681901b3603821315612eac57600080fd5b808202811582820484141761154557611545615809565b600082615cff57615cff615a7a565b500490565b604080519081016001600160401b0381118282101715615d3457634e487b7160e01b600052604160045260246000fd5b60405290565b600060408284031215615d4c57600080fd5b615d54615d04565b8235615d5f81615401565b81526020928301359281019290925250919050565b6020808252602b908201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960408201526a6e697469616c697a696e6760a81b606082015260800190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b858152606060208201526000615e02606083018688615dbf565b8281036040840152615e15818587615dbf565b98975050505050505050565b60005b83811015615e3c578181015183820152602001615e24565b50506000910152565b60008251615bcd818460208701615e21565b600060208284031215615e6957600080fd5b81516132bf81615685565b600081615e8357615e83615809565b506000190190565b8281526040602082015260008251806040840152615eb0816060850160208701615e21565b601f01601f1916919091016060019392505050565b600060408284031215615ed757600080fd5b82601f830112615ee657600080fd5b615eee615d04565b806040840185811115615f0057600080fd5b845b81811015615f1a578051845260209384019301615f02565b50909594505050505056fea2646970667358221220a626fbc30de48fae03e0787e09215ca109af7c4393b840809136a8d3e07d3add64736f6c63430008110033\",\n  \"linkReferences\": {},\n  \"deployedLinkReferences\": {}\n}\n"
+var ChildValidatorSetArtifact string = "{\n  \"_format\": \"hh-sol-artifact-1\",\n  \"contractName\": \"ChildValidatorSet\",\n  \"sourceName\": \"contracts/child/ChildValidatorSet.sol\",\n  \"abi\": [\n    {\n      \"inputs\": [],\n      \"name\": \"AmountZero\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"Exists\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"signer\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"InvalidSignature\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"NoTokensDelegated\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"NotFound\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"src\",\n          \"type\": \"string\"\n        },\n        {\n          \"internalType\": \"string\",\n          \"name\": \"msg\",\n          \"type\": \"string\"\n        }\n      ],\n      \"name\": \"StakeRequirement\",\n      \"type\": \"error\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"only\",\n          \"type\": \"string\"\n        }\n      ],\n      \"name\": \"Unauthorized\",\n      \"type\": \"error\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"AddedToWhitelist\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"blsKey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"BlsKeyUpdateRequested\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"blsKey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"BlsKeyUpdated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"oldCommission\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"newCommission\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"CommissionUpdated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Delegated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"bool\",\n          \"name\": \"restake\",\n          \"type\": \"bool\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"DelegatorRewardClaimed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"DelegatorRewardDistributed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"key\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"epoch\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"pbftRound\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"DoubleSignerSlashed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint8\",\n          \"name\": \"version\",\n          \"type\": \"uint8\"\n        }\n      ],\n      \"name\": \"Initialized\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"startBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"endBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"bytes32\",\n          \"name\": \"epochRoot\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"name\": \"NewEpoch\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"blsKey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"NewValidator\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"previousOwner\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"newOwner\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"OwnershipTransferStarted\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"previousOwner\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"newOwner\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"OwnershipTransferred\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"RemovedFromWhitelist\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Staked\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Undelegated\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Unstaked\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"ValidatorRewardClaimed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"ValidatorRewardDistributed\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Withdrawal\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"WithdrawalRegistered\",\n      \"type\": \"event\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"ACTIVE_VALIDATOR_SET_SIZE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"DOMAIN\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bytes32\",\n          \"name\": \"\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"DOUBLE_SIGNING_SLASHING_PERCENT\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"MAX_COMMISSION\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TOKEN_CONTRACT\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"SYSTEM\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"WITHDRAWAL_WAIT_PERIOD\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"acceptOwnership\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"whitelistAddreses\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"name\": \"addToWhitelist\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"bls\",\n      \"outputs\": [\n        {\n          \"internalType\": \"contract IBLS\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"restake\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"name\": \"claimDelegatorReward\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"claimValidatorReward\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"epochRoot\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct Epoch\",\n          \"name\": \"epoch\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"components\": [\n                {\n                  \"internalType\": \"address\",\n                  \"name\": \"validator\",\n                  \"type\": \"address\"\n                },\n                {\n                  \"internalType\": \"uint256\",\n                  \"name\": \"signedBlocks\",\n                  \"type\": \"uint256\"\n                }\n              ],\n              \"internalType\": \"struct UptimeData[]\",\n              \"name\": \"uptimeData\",\n              \"type\": \"tuple[]\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"totalBlocks\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct Uptime\",\n          \"name\": \"uptime\",\n          \"type\": \"tuple\"\n        }\n      ],\n      \"name\": \"commitEpoch\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"curEpochId\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"blockNumber\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"pbftRound\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"epochRoot\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct Epoch\",\n          \"name\": \"epoch\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"components\": [\n                {\n                  \"internalType\": \"address\",\n                  \"name\": \"validator\",\n                  \"type\": \"address\"\n                },\n                {\n                  \"internalType\": \"uint256\",\n                  \"name\": \"signedBlocks\",\n                  \"type\": \"uint256\"\n                }\n              ],\n              \"internalType\": \"struct UptimeData[]\",\n              \"name\": \"uptimeData\",\n              \"type\": \"tuple[]\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"totalBlocks\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct Uptime\",\n          \"name\": \"uptime\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"eventRoot\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"currentValidatorSetHash\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"nextValidatorSetHash\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"blockHash\",\n              \"type\": \"bytes32\"\n            },\n            {\n              \"internalType\": \"bytes\",\n              \"name\": \"bitmap\",\n              \"type\": \"bytes\"\n            },\n            {\n              \"internalType\": \"bytes\",\n              \"name\": \"signature\",\n              \"type\": \"bytes\"\n            }\n          ],\n          \"internalType\": \"struct IChildValidatorSetBase.DoubleSignerSlashingInput[]\",\n          \"name\": \"inputs\",\n          \"type\": \"tuple[]\"\n        }\n      ],\n      \"name\": \"commitEpochWithDoubleSignerSlashing\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"currentEpochId\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"restake\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"name\": \"delegate\",\n      \"outputs\": [],\n      \"stateMutability\": \"payable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"delegationOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"doubleSignerSlashes\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"epochEndBlocks\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"epochReward\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"epochSize\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"epochs\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"startBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"endBlock\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"bytes32\",\n          \"name\": \"epochRoot\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"getCurrentValidatorSet\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"delegator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"getDelegatorReward\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"blockNumber\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"getEpochByBlock\",\n      \"outputs\": [\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endBlock\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"epochRoot\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct Epoch\",\n          \"name\": \"\",\n          \"type\": \"tuple\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"getValidator\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"blsKey\",\n          \"type\": \"uint256[4]\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"stake\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"totalStake\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"commission\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"withdrawableRewards\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"active\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"getValidatorReward\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochReward\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"minStake\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"minDelegation\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"epochSize\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct IChildValidatorSetBase.InitStruct\",\n          \"name\": \"init\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"address\",\n              \"name\": \"addr\",\n              \"type\": \"address\"\n            },\n            {\n              \"internalType\": \"uint256[4]\",\n              \"name\": \"pubkey\",\n              \"type\": \"uint256[4]\"\n            },\n            {\n              \"internalType\": \"uint256[2]\",\n              \"name\": \"signature\",\n              \"type\": \"uint256[2]\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"stake\",\n              \"type\": \"uint256\"\n            }\n          ],\n          \"internalType\": \"struct IChildValidatorSetBase.ValidatorInit[]\",\n          \"name\": \"validators\",\n          \"type\": \"tuple[]\"\n        },\n        {\n          \"internalType\": \"contract IBLS\",\n          \"name\": \"newBls\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"governance\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"initialize\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"minDelegation\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"minStake\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"owner\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"pendingBlsKeys\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"pendingOwner\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"pendingWithdrawals\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256[2]\",\n          \"name\": \"signature\",\n          \"type\": \"uint256[2]\"\n        },\n        {\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"pubkey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"register\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"whitelistAddreses\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"name\": \"removeFromWhitelist\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"renounceOwnership\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"newCommission\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"setCommission\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"n\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"sortedValidators\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address[]\",\n          \"name\": \"\",\n          \"type\": \"address[]\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"stake\",\n      \"outputs\": [],\n      \"stateMutability\": \"payable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"totalActiveStake\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"activeStake\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"totalDelegationOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"totalStake\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"totalStakeOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"newOwner\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"transferOwnership\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"validator\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"undelegate\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"unstake\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256[2]\",\n          \"name\": \"signature\",\n          \"type\": \"uint256[2]\"\n        },\n        {\n          \"internalType\": \"uint256[4]\",\n          \"name\": \"pubkey\",\n          \"type\": \"uint256[4]\"\n        }\n      ],\n      \"name\": \"updateBlsKey\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"whitelist\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"withdraw\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"withdrawable\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    }\n  ],\n  \"bytecode\": \"0x608060405234801561001057600080fd5b50615f5b80620000216000396000f3fe6080604052600436106102ca5760003560e01c8063715018a61161017b578063a2562ddd116100d7578063e0563ab111610085578063e0563ab114610890578063e30c3978146108a6578063e3f56eaa146108c4578063ea0fee4f146108e4578063eacdc5ff146108f9578063f2fde38b1461090f578063f3f437031461092f57600080fd5b8063a2562ddd146107a4578063a2b915e2146107c4578063a77af229146107e4578063a90049d0146107f9578063ae97dde814610366578063c6b61e4c14610819578063ce513b6f1461087057600080fd5b8063947287cf11610134578063947287cf146106df57806395b0b027146106f557806397e5230d14610715578063982ef0a71461072c5780639a91e6d71461073f5780639b19251a14610754578063a15808791461078457600080fd5b8063715018a61461061057806378f619321461062557806379ba5097146106775780637f6497831461068c5780638b0e9f3f146106ac5780638da5cb5b146106c157600080fd5b80633a4b66f11161022a57806351351d53116101e357806351351d531461052b57806351cff8d91461054657806352a9674b14610566578063548db1741461059a5780635689762f146105ba578063572d356e146105da578063628da527146105f057600080fd5b80633a4b66f11461046b5780633b878c22146104735780633fd5000114610489578063410899c9146104a957806346df33d2146104c95780634d99dd161461050b57600080fd5b80632153f7fa116102875780632153f7fa1461039d578063284017f5146103bd57806328f73148146103e05780632e17de78146103f557806333378ada14610415578063355e6b4314610435578063375b3c0a1461045557600080fd5b80630209fdd0146102cf57806302985992146102fa5780631604e4161461031e5780631904bb2e146103345780631bce714d146103665780631f6590b81461037b575b600080fd5b3480156102db57600080fd5b506102e461094f565b6040516102f191906153b4565b60405180910390f35b34801561030657600080fd5b5061031060055481565b6040519081526020016102f1565b34801561032a57600080fd5b5061031060035481565b34801561034057600080fd5b5061035461034f366004615421565b610960565b6040516102f19695949392919061543e565b34801561037257600080fd5b50610310606481565b34801561038757600080fd5b5061039b610396366004615495565b610a33565b005b3480156103a957600080fd5b506102e46103b83660046154d7565b610b30565b3480156103c957600080fd5b506103d361202081565b6040516102f191906154f0565b3480156103ec57600080fd5b50610310610c3b565b34801561040157600080fd5b5061039b6104103660046154d7565b610d09565b34801561042157600080fd5b50610310610430366004615421565b610e2f565b34801561044157600080fd5b5061039b6104503660046154d7565b610e46565b34801561046157600080fd5b5061031060045481565b61039b610f11565b34801561047f57600080fd5b506103d361101081565b34801561049557600080fd5b506103106104a43660046154d7565b610ff0565b3480156104b557600080fd5b5061039b6104c4366004615516565b611011565b3480156104d557600080fd5b506104e96104e43660046154d7565b61121f565b60408051825181526020808401519082015291810151908201526060016102f1565b34801561051757600080fd5b5061039b61052636600461556d565b611297565b34801561053757600080fd5b506103d36002600160a01b0381565b34801561055257600080fd5b5061039b610561366004615421565b6113a2565b34801561057257600080fd5b506103107ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee81565b3480156105a657600080fd5b5061039b6105b53660046155dd565b6114d2565b3480156105c657600080fd5b506103106105d536600461561e565b61152b565b3480156105e657600080fd5b5061031060005481565b3480156105fc57600080fd5b5061031061060b36600461561e565b61154b565b34801561061c57600080fd5b5061039b611562565b34801561063157600080fd5b5061066761064036600461564c565b61013e60209081526000938452604080852082529284528284209052825290205460ff1681565b60405190151581526020016102f1565b34801561068357600080fd5b5061039b611576565b34801561069857600080fd5b5061039b6106a73660046155dd565b6115ed565b3480156106b857600080fd5b50600954610310565b3480156106cd57600080fd5b506076546001600160a01b03166103d3565b3480156106eb57600080fd5b5061031061520881565b34801561070157600080fd5b506006546103d3906001600160a01b031681565b34801561072157600080fd5b50610310620249f081565b61039b61073a366004615693565b611641565b34801561074b57600080fd5b5061039b61169d565b34801561076057600080fd5b5061066761076f366004615421565b60106020526000908152604090205460ff1681565b34801561079057600080fd5b5061039b61079f3660046156c1565b611702565b3480156107b057600080fd5b5061039b6107bf366004615770565b6119d0565b3480156107d057600080fd5b506103106107df366004615421565b611d5e565b3480156107f057600080fd5b50610310600a81565b34801561080557600080fd5b5061039b610814366004615693565b611d72565b34801561082557600080fd5b506108556108343660046154d7565b600f6020526000908152604090208054600182015460029092015490919083565b604080519384526020840192909252908201526060016102f1565b34801561087c57600080fd5b5061031061088b366004615421565b611e13565b34801561089c57600080fd5b506103d361203081565b3480156108b257600080fd5b5060a8546001600160a01b03166103d3565b3480156108d057600080fd5b506103106108df366004615421565b611e41565b3480156108f057600080fd5b50610310600181565b34801561090557600080fd5b5061031060015481565b34801561091b57600080fd5b5061039b61092a366004615421565b611e4e565b34801561093b57600080fd5b5061031061094a366004615421565b611ebf565b606061095b6064610b30565b905090565b610968615329565b6000808080808061097a600789611ee6565b604080516101208101909152908160a081018260048282826020028201915b8154815260200190600101908083116109995750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff1615156080909201919091528251908301519099509750909150610a029089611f04565b546020820151610a12919061581f565b94508060400151935080606001519250806080015191505091939550919395565b3360009081526010602052604090205460ff16610a845760405163973d02cb60e01b815260206004820152600960248201526815d2125511531254d560ba1b60448201526064015b60405180910390fd5b610a8f338383611f22565b604080516101208101909152610ae29033908060a0810185600482826080808284376000920182905250928452505060208201819052604082018190526060820152600160809091015260079190611fd7565b610aeb33612380565b336001600160a01b03167fcab5c47e498c5b85fd42d656842253cfe070f7648c3a198275347b47dec5d27b82604051610b249190615832565b60405180910390a25050565b60606000600760010154831115610b4957600854610b4b565b825b90506000816001600160401b03811115610b6757610b67615841565b604051908082528060200260200182016040528015610b90578160200160208202803683370190505b50905081600003610ba2579392505050565b6000610bae60076123c9565b90508082600081518110610bc457610bc4615857565b6001600160a01b039092166020928302919091019091015260015b83811015610c3157610bf260078361242a565b915081838281518110610c0757610c07615857565b6001600160a01b039092166020928302919091019091015280610c298161586d565b915050610bdf565b5090949350505050565b60008060076001015460641115610c5457600854610c57565b60645b905080600003610c6957600091505090565b6000610c7560076123c9565b9050610c82600782611f04565b54610c8e600783611ee6565b60040154610c9c919061581f565b610ca6908461581f565b925060015b82811015610d0357610cbe60078361242a565b9150610ccb600783611f04565b54610cd7600784611ee6565b60040154610ce5919061581f565b610cef908561581f565b935080610cfb8161586d565b915050610cab565b50505090565b6000610d16600c3361252c565b336000908152600a6020526040902060070154610d339190615886565b90506000610d4083612588565b905081811315610d63576040516396906e6360e01b8152600401610a7b906158c0565b6000610d6f8284615905565b905060045481128015610d8157508015155b15610d9f576040516396906e6360e01b8152600401610a7b90615945565b610da761169d565b610dc233610db784600019615968565b600c9190600061259b565b80600003610dea576000610dd7600733611ee6565b600701805460ff19169115159190911790555b610df433856126a2565b60405184815233907f0f5bb82176feb1b5e747e28471aa92156a04d9f3ab9f45f28e2d704232b93f759060200160405180910390a250505050565b6000610e3c600783611ee6565b6006015492915050565b610e51600733611ee6565b6007015460ff16610e755760405163973d02cb60e01b8152600401610a7b90615998565b6064811115610ebb5760405162461bcd60e51b815260206004820152601260248201527124a72b20a624a22fa1a7a6a6a4a9a9a4a7a760711b6044820152606401610a7b565b6000610ec8600733611ee6565b6005810154604080519182526020820185905291925033917f6e500db30ce535d38852e318f333e9be41a3fec6c65d234ebb06203c896db9a5910160405180910390a260050155565b610f1c600733611ee6565b6007015460ff16610f405760405163973d02cb60e01b8152600401610a7b90615998565b6000610f4d600c3361252c565b336000908152600a6020526040902060070154610f6a9190615886565b905060045481610f7934612588565b610f839190615886565b1215610fa2576040516396906e6360e01b8152600401610a7b906159bb565b610faa61169d565b610fb8600c3334600061259b565b60405134815233907f9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d9060200160405180910390a250565b6002818154811061100057600080fd5b600091825260209091200154905081565b336002600160a01b03146110385760405163973d02cb60e01b8152600401610a7b906159e9565b60018054600091826110498361586d565b91905055905080841461106e5760405162461bcd60e51b8152600401610a7b90615a0d565b82356020840135116110925760405162461bcd60e51b8152600401610a7b90615a3a565b6000546110a484356020860135615a67565b6110af90600161581f565b6110b99190615a90565b156111145760405162461bcd60e51b815260206004820152602560248201527f45504f43485f4d5553545f42455f444956495349424c455f42595f45504f43486044820152645f53495a4560d81b6064820152608401610a7b565b8235600f6000611125600185615a67565b8152602001908152602001600020600101546001611143919061581f565b146111605760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f602090815260408083209186013560018084018290558735845591870135600280850191909155805492830181559093527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01919091556111c8848461270e565b6111d06128df565b83602001358460000135867f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a876040013560405161121091815260200190565b60405180910390a45050505050565b6040805160608101825260008082526020820181905291810182905290611247600284612c12565b9050600f600061125883600161581f565b81526020019081526020016000206040518060600160405290816000820154815260200160018201548152602001600282015481525050915050919050565b60006112a4600784611f04565b905060006112b28233612cc7565b9050808311156112d5576040516396906e6360e01b8152600401610a7b90615aee565b6112e0823385612d13565b60006112ec8483615a67565b9050600554811080156112fe57508015155b1561131c576040516396906e6360e01b8152600401610a7b90615b25565b611327856000611d72565b600061133285612588565b905061135086600061134684600019615968565b600c92919061259b565b61135a33866126a2565b6040518581526001600160a01b0387169033907f4d10bd049775c77bd7f255195afba5088028ecb3c7c277d393ccff7934f2f92c9060200160405180910390a3505050505050565b6113aa612de8565b6001600160a01b0381166113c0576113c0615b48565b336000908152600e6020526040812060015490919081906113e2908490612e41565b80855560405182815291935091506001600160a01b0385169033907f2717ead6b9200dd235aad468c9809ea400fe33ac69b5bfaa6d3e90fc922b63989060200160405180910390a36000846001600160a01b03168360405160006040518083038185875af1925050503d8060008114611477576040519150601f19603f3d011682016040523d82523d6000602084013e61147c565b606091505b50509050806114c15760405162461bcd60e51b815260206004820152601160248201527015d2551211149055d05317d19052531151607a1b6044820152606401610a7b565b505050506114cf600160da55565b50565b6114da612eba565b60005b81811015611526576115148383838181106114fa576114fa615857565b905060200201602081019061150f9190615421565b612380565b8061151e8161586d565b9150506114dd565b505050565b60006115428261153c600786611f04565b90612f14565b90505b92915050565b60006115428261155c600786611f04565b90612cc7565b61156a612eba565b6115746000612f43565b565b60a85433906001600160a01b031681146115e45760405162461bcd60e51b815260206004820152602960248201527f4f776e61626c6532537465703a2063616c6c6572206973206e6f7420746865206044820152683732bb9037bbb732b960b91b6064820152608401610a7b565b6114cf81612f43565b6115f5612eba565b60005b818110156115265761162f83838381811061161557611615615857565b905060200201602081019061162a9190615421565b612f5c565b806116398161586d565b9150506115f8565b600061164e600784611f04565b6005549091503461165f8333612cc7565b611669919061581f565b1015611688576040516396906e6360e01b8152600401610a7b90615b5e565b6116928383611d72565b611526338434612fa8565b60006116aa600733611ee6565b600681015490915060008190036116bf575050565b600060068301556116d033826126a2565b60405181815233907f378c825c0ac073cbae19612eb73cde31c0972f0c8ba2dedb659840f32550df2b90602001610b24565b604354610100900460ff16158080156117225750604354600160ff909116105b8061173c5750303b15801561173c575060435460ff166001145b61179f5760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b6064820152608401610a7b565b6043805460ff1916600117905580156117c2576043805461ff0019166101001790555b336002600160a01b03146117e95760405163973d02cb60e01b8152600401610a7b906159e9565b6001805560608601356000556117fe82612f43565b611806613073565b853560035560208601356004556040860135600555600680546001600160a01b0319166001600160a01b03851617905560005b848110156119815760006040518060a0016040528088888581811061186057611860615857565b905061010002016020016004806020026040519081016040528092919082600460200280828437600092019190915250505081526020018888858181106118a9576118a9615857565b9050610100020160e00135815260200160008152602001600081526020016001151581525090506119058787848181106118e5576118e5615857565b6118fc926020610100909202019081019150615421565b60079083611fd7565b61196e87878481811061191a5761191a615857565b611931926020610100909202019081019150615421565b88888581811061194357611943615857565b9050610100020160a00189898681811061195f5761195f615857565b90506101000201602001611f22565b50806119798161586d565b915050611839565b5080156119c8576043805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b505050505050565b8060028110156119f25760405162461bcd60e51b8152600401610a7b90615b8f565b6119fc83836130a2565b611a3f5760405162461bcd60e51b8152602060048201526014602482015273424c4f434b484153485f4e4f545f554e4951554560601b6044820152606401610a7b565b60005b81811015611bd957611bd14689868685818110611a6157611a61615857565b9050602002810190611a739190615bb7565b608001358a888887818110611a8a57611a8a615857565b9050602002810190611a9c9190615bb7565b35898988818110611aaf57611aaf615857565b9050602002810190611ac19190615bb7565b602001358a8a89818110611ad757611ad7615857565b9050602002810190611ae99190615bb7565b604001358b8b8a818110611aff57611aff615857565b9050602002810190611b119190615bb7565b604080516020810199909952880196909652606087810195909552608087019390935260a086019190915260c085015260e084015201356101008201526101200160405160208183030381529060405280519060200120858584818110611b7a57611b7a615857565b9050602002810190611b8c9190615bb7565b611b9a9060c0810190615bd7565b878786818110611bac57611bac615857565b9050602002810190611bbe9190615bb7565b611bcc9060a0810190615bd7565b613158565b600101611a42565b506000606460076001015410611bf0576064611bf4565b6008545b90506000611c0182610b30565b90506000826001600160401b03811115611c1d57611c1d615841565b604051908082528060200260200182016040528015611c46578160200160208202803683370190505b50905060005b83811015611d44576000805b86811015611d3a57611c9b898983818110611c7557611c75615857565b9050602002810190611c879190615bb7565b611c959060a0810190615bd7565b8561325c565b15611cae5781611caa8161586d565b9250505b6001821115611d2857611cff858481518110611ccc57611ccc615857565b60200260200101518a8a84818110611ce657611ce6615857565b9050602002810190611cf89190615bb7565b358e6132c6565b6001848481518110611d1357611d13615857565b91151560209283029190910190910152611d3a565b80611d328161586d565b915050611c58565b5050600101611c4c565b50611d518b89898461349d565b5050505050505050505050565b6000611d6b600783611f04565b5492915050565b6000611d7f600784611f04565b90506000611d8d823361383a565b905080600003611d9d5750505050565b8215611db357611dae338583612fa8565b611dbd565b611dbd33826126a2565b821515846001600160a01b0316336001600160a01b03167f6b2cd13395c5d2d1f95934a6a8f884d2cb71e846eb99d6759f7e6a39c060b2d084604051611e0591815260200190565b60405180910390a450505050565b6001546001600160a01b0382166000908152600e602052604081209091611e3a9190612e41565b5092915050565b6000611545600783613881565b611e56612eba565b60a880546001600160a01b0383166001600160a01b03199091168117909155611e876076546001600160a01b031690565b6001600160a01b03167f38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e2270060405160405180910390a350565b6001546001600160a01b0382166000908152600e60205260408120909161154591906138b6565b6001600160a01b031660009081526003918201602052604090200190565b6001600160a01b031660009081526004919091016020526040902090565b60065460009081906001600160a01b031663ebbdac918585611f438961394c565b6040518463ffffffff1660e01b8152600401611f6193929190615c1d565b6040805180830381865afa158015611f7d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611fa19190615c61565b91509150801580611fb0575081155b15611fd05784604051633615713d60e21b8152600401610a7b91906154f0565b5050505050565b6001600160a01b038216611fed57611fed615b48565b611ff78383613a1c565b15612017578160405163cda981d560e01b8152600401610a7b91906154f0565b6001600160a01b0382166000908152600380850160205260409091208251839291909101906120499082906004615347565b50602082810151600483015560408301516005830155606083015160068301556080909201516007909101805460ff191691151591909117905581015160000361209257505050565b82546000906001600160a01b0316816120ab8686613881565b90505b6001600160a01b03821615612121578192506120ca8683613881565b8110156120f9576001600160a01b039182166000908152600387016020526040902060010154909116906120ae565b6001600160a01b039182166000908152600387016020526040902060020154909116906120ae565b6040518060a00160405280846001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160011515815260200185815250866003016000876001600160a01b03166001600160a01b0316815260200190815260200160002060008201518160000160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060208201518160010160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060408201518160020160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060608201518160020160146101000a81548160ff021916908315150217905550608082015181600301600082015181600001906004612256929190615347565b506020820151600482015560408201516005820155606082015160068201556080909101516007909101805460ff191691151591909117905550506001600160a01b0383166122bd5785546001600160a01b0319166001600160a01b03861617865561233a565b6122c78684613881565b811015612306576001600160a01b038381166000908152600388016020526040902060010180546001600160a01b03191691871691909117905561233a565b6001600160a01b038381166000908152600388016020526040902060020180546001600160a01b0319169187169190911790555b6123448686613a6d565b6001860180549060006123568361586d565b91905055508360200151866002016000828254612373919061581f565b9091555050505050505050565b6001600160a01b038116600081815260106020526040808220805460ff19169055517fcdd2e9b91a56913d370075169cefa1602ba36be5301664f752192bb1709df7579190a250565b80546001600160a01b03168015612425575b6001600160a01b0381811660009081526003840160205260409020600201541615612425576001600160a01b039081166000908152600383016020526040902060020154166123db565b919050565b60006001600160a01b038216612453576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206001015416156124aa576001600160a01b0380831660009081526003850160205260409020600101546124a391859116613dba565b9050611545565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061250257506001600160a01b0380821660009081526003850160205260409020600101548382169116145b15611545576001600160a01b038082166000908152600385016020526040902054919250166124c9565b6001600160a01b038116600090815260018301602052604081205461255357506000611545565b8261255e8184613e10565b8154811061256e5761256e615857565b906000526020600020906003020160010154905092915050565b6000818181121561154557611545615b48565b6001600160a01b0383166000908152600185016020526040812054908190036126465784546125cb90600161581f565b6001600160a01b03858116600081815260018981016020908152604080842087905580516060810182529485528482018a81529085018981528c548085018e558d8652929094209451600390920290940180546001600160a01b03191691909516178455915191830191909155516002909101559050611fd0565b6000856126538187613e10565b8154811061266357612663615857565b90600052602060002090600302019050838160010160008282546126879190615886565b92505081905550828160020160008282546123739190615886565b6126d381600180546126b4919061581f565b6001600160a01b0385166000908152600e602052604090209190613e46565b816001600160a01b03167f655c1cd0236fb6dc4916f34c8ff10e3b18fcaea5b344dfc16c36fbb1bdfc5df282604051610b2491815260200190565b6001805461271c9190615a67565b8135146127615760405162461bcd60e51b8152602060048201526013602482015272115413d0d217d393d517d0d3d3535255151151606a1b6044820152606401610a7b565b60006127706020830183615c90565b9150506064811180159061278657506008548111155b6127a25760405162461bcd60e51b8152600401610a7b90615b8f565b60006127ac610c3b565b90506000805460646127be9190615cd9565b6127cd86356020880135615a67565b6003546127da9190615cd9565b6127e5906064615cd9565b6127ef9190615cf0565b905060005b838110156119c857600061280b6020870187615c90565b8381811061281b5761281b615857565b9050604002018036038101906128319190615d3a565b805190915060009061284590600790611ee6565b90506000612857604089013587615cd9565b6020840151845161286a90600790611f04565b54600485015461287a919061581f565b6128849088615cd9565b61288e9190615cd9565b6128989190615cf0565b90506000806128ab856000015184613f64565b915091506128bd85600001518361409d565b84516128c99082614108565b5050505050806128d89061586d565b90506127f4565b600c60005b8154811015612aae57600082828154811061290157612901615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b03168084526001820154948401949094526002015490820152915061294d600782613a1c565b15612a9b57600061295f600783611ee6565b905061297d836020015182600401546129789190615886565b614152565b600482015560208301516009546129979161297891615886565b60095560006129a7600784613881565b905060006129b6600785614168565b90506001600160a01b038116158015906129d95750816129d7600783613881565b105b806129f85750816129f66129ee60078761242a565b600790613881565b115b15612a7e57612a08600785614263565b604080516101208101909152612a7e908590858160a081018260048282826020028201915b815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0384166000908152600d60205260408120555050505b505080612aa79061586d565b90506128e4565b5060005b8154811015612c09576000828281548110612acf57612acf615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b031680845260018201549484019490945260020154908201529150612b1b600782613a1c565b158015612b3f57506001600160a01b0381166000908152600d602052604090205415155b15612bf6576000612b51600783611ee6565b9050612b608360200151614152565b60048281019190915560408051610120810191829052835460a08201908152612bdb9386938692849290918491906001830160c08601808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0382166000908152600d6020526040812055505b505080612c029061586d565b9050612ab2565b506000600c5550565b81546000908103612c2557506000611545565b82546000905b80821015612c72576000612c3f8383614644565b60008781526020902090915085908201541115612c5e57809150612c6c565b612c6981600161581f565b92505b50612c2b565b600082118015612c9e575083612c9b86612c8d600186615a67565b600091825260209091200190565b54145b15612cb757612cae600183615a67565b92505050611545565b509050611545565b505092915050565b60008260010154600003612cdd57506000611545565b600183015483546001600160a01b0384166000908152600686016020526040902054612d099190615cd9565b6115429190615cf0565b8254600184015460009190612d289084615cd9565b612d329190615cf0565b6001600160a01b0384166000908152600686016020526040812080549293508392909190612d61908490615a67565b9250508190555080846001016000828254612d7c9190615a67565b90915550506002840154612d9a90612d95908390615cd9565b612588565b6001600160a01b038416600090815260048601602052604081208054909190612dc4908490615886565b9091555050835482908590600090612ddd908490615a67565b909155505050505050565b600260da5403612e3a5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c006044820152606401610a7b565b600260da55565b81546000905b8360010154811015612eac57600081815260028501602090815260409182902082518084019093528054835260010154908201819052841015612e8a5750612eac565b8051612e96908461581f565b9250508080612ea49061586d565b915050612e47565b9250929050565b600160da55565b6076546001600160a01b031633146115745760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610a7b565b6001600160a01b0381166000908152600583016020526040812054612f39848461465f565b6115429190615a67565b60a880546001600160a01b03191690556114cf816146d9565b6001600160a01b038116600081815260106020526040808220805460ff19166001179055517fa850ae9193f515cbae8d35e8925bd2be26627fc91bce650b8652ed254e9cab039190a250565b612fb3600783611ee6565b6007015460ff16612ffb5760405163973d02cb60e01b815260206004820152601160248201527024a72b20a624a22fab20a624a220aa27a960791b6044820152606401610a7b565b61300a82600061134684612588565b613021838261301a600786611f04565b919061472b565b816001600160a01b0316836001600160a01b03167fe5541a6b6103d4fa7e021ed54fad39c66f27a76bd13d374cf6240ae6bd0bb72b8360405161306691815260200190565b60405180910390a3505050565b604354610100900460ff1661309a5760405162461bcd60e51b8152600401610a7b90615d74565b61157461480b565b600081815b8181101561314d5760006130bc82600161581f565b90505b8281101561313a578585828181106130d9576130d9615857565b90506020028101906130eb9190615bb7565b6080013586868481811061310157613101615857565b90506020028101906131139190615bb7565b60800135036131285760009350505050611545565b806131328161586d565b9150506130bf565b50806131458161586d565b9150506130a7565b506001949350505050565b6000806120306001600160a01b0316620249f08888888888604051602001613184959493929190615de8565b60408051601f198184030181529082905261319e91615e45565b6000604051808303818686fa925050503d80600081146131da576040519150601f19603f3d011682016040523d82523d6000602084013e6131df565b606091505b50915091506000818060200190518101906131fa9190615e57565b90508280156132065750805b6132525760405162461bcd60e51b815260206004820152601d60248201527f5349474e41545552455f564552494649434154494f4e5f4641494c45440000006044820152606401610a7b565b5050505050505050565b60008061326a600884615cf0565b90506000613279600885615a90565b905084821061328d576000925050506132bf565b6000600160ff83161b8787858181106132a8576132a8615857565b9050013560f81c60f81b60f81c60ff161611925050505b9392505050565b600082815261013e6020908152604080832084845282528083206001600160a01b038716845290915290205460ff16156132ff57505050565b600082815261013e6020908152604080832084845282528083206001600160a01b03871684529091528120805460ff19166001179055613340600785611ee6565b6001600160a01b0385166000908152600b602052604090205490915060649061336b90600a90615cd9565b6133759190615cf0565b6001600160a01b0385166000908152600b60205260408120805490919061339d908490615a67565b9250508190555060006064600a83600401546133b99190615cd9565b6133c39190615cf0565b90506133d0600786614263565b808260040160008282546133e49190615a67565b909155505060408051610120810190915261345e908690848160a08101826004828282602002820191815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b8284866001600160a01b03167f2d7432ca38933aadba9547f33f0568008e240e52e66f1a16c6182e742bce3b1360405160405180910390a45050505050565b60018054600091826134ae8361586d565b9190505590508085146134d35760405162461bcd60e51b8152600401610a7b90615a0d565b83356020850135116134f75760405162461bcd60e51b8152600401610a7b90615a3a565b8335600f6000613508600185615a67565b8152602001908152602001600020600101546001613526919061581f565b146135435760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f6020908152604080832087830135600180830182905589358355928901356002808401919091558054938401815585527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace9092019190915591906135b090860186615c90565b915050606481118015906135c657506008548111155b6135e25760405162461bcd60e51b8152600401610a7b90615b8f565b60006135ec610c3b565b90506000805460646135fe9190615cd9565b61360d893560208b0135615a67565b60035461361a9190615cd9565b613625906064615cd9565b61362f9190615cf0565b905060005b838110156137de5786818151811061364e5761364e615857565b60200260200101516137ce57600061366960208a018a615c90565b8381811061367957613679615857565b90506040020180360381019061368f9190615d3a565b80519091506000906136a390600790611ee6565b905060006136b560408c013587615cd9565b602084015184516136c890600790611f04565b5460048501546136d8919061581f565b6136e29088615cd9565b6136ec9190615cd9565b6136f69190615cf0565b9050600080613709856000015184613f64565b9150915081846006016000828254613721919061581f565b909155505084516040518481526001600160a01b03909116907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2845161378190829061377b90600790611f04565b90614832565b84600001516001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac826040516137c091815260200190565b60405180910390a250505050505b6137d78161586d565b9050613634565b506137e76128df565b876020013588600001358a7f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a8b6040013560405161382791815260200190565b60405180910390a4505050505050505050565b60006138468383612f14565b6001600160a01b038316600090815260058501602052604081208054929350839290919061387590849061581f565b90915550909392505050565b6001600160a01b038116600090815260048301602090815260408083205460038601909252822060070154611542919061581f565b60018201546000908082036138cf576000915050611545565b60006138dc600183615a67565b90505b84548110612cbf57600081815260028601602090815260409182902082518084019093528054835260010154908201819052851061391d5750612cbf565b8051613929908561581f565b9350816000036139395750612cbf565b508061394481615e74565b9150506138df565b613954615381565b6006546040516bffffffffffffffffffffffff19606085901b1660208201524660348201526001600160a01b039091169063a850a909907ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee906054016040516020818303038152906040526040518363ffffffff1660e01b81526004016139dc929190615e8b565b6040805180830381865afa1580156139f8573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115459190615ec5565b60006001600160a01b03821615801590611542575082546001600160a01b03838116911614806115425750506001600160a01b03908116600090815260039290920160205260409091205416151590565b60005b82546001600160a01b03838116911614801590613aba57506001600160a01b0382811660009081526003850160205260408082205490921681522060020154600160a01b900460ff165b15613d8c576001600160a01b03808316600090815260038501602052604080822054831680835281832054841683529120600101549091168103613c42576001600160a01b0381811660009081526003860160205260408082205483168252808220600290810154909316808352912090910154909250600160a01b900460ff1615613ba3576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060020154811690841603613bd957809250613bd984846148af565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613c3d91869116614a62565b613d86565b6001600160a01b03818116600090815260038601602052604080822054831682528082206001015490921680825291902060020154909250600160a01b900460ff1615613cec576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060010154811690841603613d2257809250613d228484614a62565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613d86918691166148af565b50613a70565b505080546001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60005b6001600160a01b0382811660009081526003850160205260409020600201541615613e0a576001600160a01b03918216600090815260038401602052604090206002015490911690613dbd565b50919050565b6001600160a01b038116600090815260018301602052604081205490819003613e3b57613e3b615b48565b611542600182615a67565b81600003613e5657613e56615b48565b82546001840154818103613ead576040805180820182528581526020808201868152600085815260028a019092529281209151825591516001918201558601805491613ea18361586d565b91905055505050505050565b60006002860181613ebf600185615a67565b815260200190815260200160002060010154905080841015613ee357613ee3615b48565b83811015613f32576040805180820182528681526020808201878152600086815260028b019092529281209151825591516001918201558701805491613f288361586d565b91905055506119c8565b84600287016000613f44600186615a67565b81526020019081526020016000206000016000828254612373919061581f565b60008080613f73600786611ee6565b604080516101208101909152908160a081018260048282826020028201915b815481526020019060010190808311613f925750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff161515608090920191909152820151919250600090613ff69088611f04565b54905060008290036140115760008094509450505050612eac565b806000036140285785600094509450505050612eac565b6000614034828461581f565b61403e8489615cd9565b6140489190615cf0565b905060006140568289615a67565b90506000606482876040015161406c9190615cd9565b6140769190615cf0565b9050614082818461581f565b61408c8284615a67565b975097505050505050509250929050565b60006140aa600784611ee6565b9050818160060160008282546140c0919061581f565b90915550506040518281526001600160a01b038416907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2505050565b6141178161377b600785611f04565b816001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac82604051610b2491815260200190565b60008082121561416457614164615b48565b5090565b60006001600160a01b038216614191576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206002015416156141e1576001600160a01b0380831660009081526003850160205260409020600201546124a391859116614c15565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061423957506001600160a01b0380821660009081526003850160205260409020600201548382169116145b15611545576001600160a01b03808216600090815260038501602052604090205491925016614200565b6001600160a01b03811661427957614279615b48565b6142838282613a1c565b6142a25780604051634b2990ed60e11b8152600401610a7b91906154f0565b6001600160a01b038181166000908152600384016020526040812060010154909182911615806142ef57506001600160a01b03838116600090815260038601602052604090206002015416155b156142fb575081614367565b506001600160a01b038083166000908152600385016020526040902060020154165b6001600160a01b0381811660009081526003860160205260409020600101541615614367576001600160a01b0390811660009081526003850160205260409020600101541661431d565b6001600160a01b03818116600090815260038601602052604090206001015416156143b3576001600160a01b0380821660009081526003860160205260409020600101541691506143d6565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b038082166000908152600386016020526040808220548584168352912080546001600160a01b0319169190921690811790915580156144af576001600160a01b038082166000908152600387016020526040902060010154811690831603614477576001600160a01b038181166000908152600387016020526040902060010180546001600160a01b0319169185169190911790556144c9565b6001600160a01b038181166000908152600387016020526040902060020180546001600160a01b0319169185169190911790556144c9565b84546001600160a01b0319166001600160a01b0384161785555b6001600160a01b038281166000818152600388016020526040902060020154600160a01b900460ff1615918616146145a457614506868487614c65565b6001600160a01b03858116600090815260038801602052604080822060018082015488861680865284862092830180549288166001600160a01b03199384168117909155865284862080548316821790556002938401805494909301805494909716938216841787559285529284208054909316821790925590549152815460ff60a01b1916600160a01b9182900460ff1615159091021790559193915b80156145b4576145b48685614d5d565b6001600160a01b0383166000908152600387016020526040812080546001600160a01b0319908116825560018083018054909216909155600290910180546001600160a81b0319169055870180549161460c83615e74565b90915550506001600160a01b038316600090815260038701602052604081206007015460028801805491929091612373908490615a67565b60006146536002848418615cf0565b6115429084841661581f565b6001600160a01b03811660009081526006830160205260408120546002840154829161468f91612d959190615cd9565b6001600160a01b0384166000908152600486016020526040812054919250906146bc906129789084615886565b90506146d0670de0b6b3a764000082615cf0565b95945050505050565b607680546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600083600101546000148061473f57508354155b61476357835460018501546147549084615cd9565b61475e9190615cf0565b614765565b815b6001600160a01b038416600090815260068601602052604081208054929350839290919061479490849061581f565b92505081905550808460010160008282546147af919061581f565b90915550508354829085906000906147c890849061581f565b909155505060028401546147e190612d95908390615cd9565b6001600160a01b038416600090815260048601602052604081208054909190612ddd908490615905565b604354610100900460ff16612eb35760405162461bcd60e51b8152600401610a7b90615d74565b8060000361483e575050565b8160010154600003614872576003820154604051636130835f60e01b8152610a7b916001600160a01b0316906004016154f0565b6001820154614889670de0b6b3a764000083615cd9565b6148939190615cf0565b8260020160008282546148a6919061581f565b90915550505050565b6001600160a01b038082166000818152600385016020526040808220600281018054915482871680865293852060010154959094526001600160a01b031990911693851693841790559216908015614932576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b03191691841691821790556149835784546001600160a01b0319166001600160a01b038416178555614a18565b6001600160a01b0380831660009081526003870160205260409020600101548116908516036149e4576001600160a01b038281166000908152600387016020526040902060010180546001600160a01b031916918516919091179055614a18565b6001600160a01b038281166000908152600387016020526040902060020180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206001018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b6001600160a01b038082166000818152600385016020526040808220600181018054915482871680865293852060020154959094526001600160a01b031990911693851693841790559216908015614ae5576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b0319169184169182179055614b365784546001600160a01b0319166001600160a01b038416178555614bcb565b6001600160a01b038083166000908152600387016020526040902060020154811690851603614b97576001600160a01b038281166000908152600387016020526040902060020180546001600160a01b031916918516919091179055614bcb565b6001600160a01b038281166000908152600387016020526040902060010180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206002018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b60005b6001600160a01b0382811660009081526003850160205260409020600101541615613e0a576001600160a01b03918216600090815260038401602052604090206001015490911690614c18565b6001600160a01b038082166000908152600385016020526040808220548584168352912080546001600160a01b0319169190921690811790915580614cc25783546001600160a01b0319166001600160a01b038416178455614d57565b6001600160a01b038082166000908152600386016020526040902060010154811690831603614d23576001600160a01b038181166000908152600386016020526040902060010180546001600160a01b031916918516919091179055614d57565b6001600160a01b038181166000908152600386016020526040902060020180546001600160a01b0319169185169190911790555b50505050565b60005b82546001600160a01b03838116911614801590614da257506001600160a01b0382166000908152600384016020526040902060020154600160a01b900460ff16155b156152fe576001600160a01b038083166000818152600386016020526040808220548416808352912060010154909216900361506c576001600160a01b038181166000908152600386016020526040808220600290810154909316808352912090910154909250600160a01b900460ff1615614e89576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b179055614e6684826148af565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16158015614efc57506001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16155b15614f37576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16614fec576001600160a01b0380831660008181526003870160205260408082206001810154909416825281206002908101805460ff60a01b19908116909155929091529091018054909116600160a01b179055614fc98483614a62565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0380821660009081526003860160205260408082206002908101805487861685528385208301805460ff600160a01b9384900416151590920260ff60a01b1992831617815582548216909255905490941683529120018054909116905561505a84826148af565b83546001600160a01b031692506152f8565b6001600160a01b0381811660009081526003860160205260408082206001015490921680825291902060020154909250600160a01b900460ff161561511c576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b1790556150f98482614a62565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff1615801561518f57506001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16155b156151ca576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16615277576001600160a01b03808316600081815260038701602052604080822060029081018054909516835290822001805460ff60a01b19908116909155919052815416600160a01b17905561525484836148af565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038082166000908152600386016020526040808220600290810180548786168552838520808401805460ff600160a01b9485900416151590930260ff60a01b19938416179055825482169092556001909101549094168352912001805490911690556152ea8482614a62565b83546001600160a01b031692505b50614d60565b506001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60405180608001604052806004906020820280368337509192915050565b8260048101928215615375579160200282015b8281111561537557825182559160200191906001019061535a565b5061416492915061539f565b60405180604001604052806002906020820280368337509192915050565b5b8082111561416457600081556001016153a0565b6020808252825182820181905260009190848201906040850190845b818110156153f55783516001600160a01b0316835292840192918401916001016153d0565b50909695505050505050565b6001600160a01b03811681146114cf57600080fd5b803561242581615401565b60006020828403121561543357600080fd5b81356132bf81615401565b6101208101818860005b6004811015615467578151835260209283019290910190600101615448565b505050608082019690965260a081019490945260c084019290925260e0830152151561010090910152919050565b60008060c083850312156154a857600080fd5b60408301848111156154b957600080fd5b8392508460c0850111156154cc57600080fd5b809150509250929050565b6000602082840312156154e957600080fd5b5035919050565b6001600160a01b0391909116815260200190565b600060608284031215613e0a57600080fd5b600080600060a0848603121561552b57600080fd5b8335925061553c8560208601615504565b915060808401356001600160401b0381111561555757600080fd5b61556386828701615504565b9150509250925092565b6000806040838503121561558057600080fd5b823561558b81615401565b946020939093013593505050565b60008083601f8401126155ab57600080fd5b5081356001600160401b038111156155c257600080fd5b6020830191508360208260051b8501011115612eac57600080fd5b600080602083850312156155f057600080fd5b82356001600160401b0381111561560657600080fd5b61561285828601615599565b90969095509350505050565b6000806040838503121561563157600080fd5b823561563c81615401565b915060208301356154cc81615401565b60008060006060848603121561566157600080fd5b8335925060208401359150604084013561567a81615401565b809150509250925092565b80151581146114cf57600080fd5b600080604083850312156156a657600080fd5b82356156b181615401565b915060208301356154cc81615685565b600080600080600085870360e08112156156da57600080fd5b60808112156156e857600080fd5b5085945060808601356001600160401b038082111561570657600080fd5b818801915088601f83011261571a57600080fd5b81358181111561572957600080fd5b8960208260081b850101111561573e57600080fd5b60208301965080955050505061575660a08701615416565b915061576460c08701615416565b90509295509295909350565b6000806000806000806000610100888a03121561578c57600080fd5b8735965060208801359550604088013594506157ab8960608a01615504565b935060c08801356001600160401b03808211156157c757600080fd5b6157d38b838c01615504565b945060e08a01359150808211156157e957600080fd5b506157f68a828b01615599565b989b979a50959850939692959293505050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561154557611545615809565b60808181019083833792915050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161587f5761587f615809565b5060010190565b8082018281126000831280158216821582161715612cbf57612cbf615809565b6007815266756e7374616b6560c81b602082015260400190565b6040815260006158d2604083016158a6565b82810360208401526132bf816014815273494e53554646494349454e545f42414c414e434560601b602082015260400190565b8181036000831280158383131683831282161715611e3a57611e3a615809565b600d81526c5354414b455f544f4f5f4c4f5760981b602082015260400190565b604081526000615957604083016158a6565b82810360208401526132bf81615925565b80820260008212600160ff1b8414161561598457615984615809565b818105831482151761154557611545615809565b6020808252600990820152682b20a624a220aa27a960b91b604082015260600190565b6040815260056040820152647374616b6560d81b606082015260806020820152600061154560808301615925565b6020808252600a908201526914d654d5115350d0531360b21b604082015260600190565b60208082526013908201527215539156141150d5115117d15413d0d217d251606a1b604082015260600190565b6020808252601390820152721393d7d09313d0d2d4d7d0d3d3535255151151606a1b604082015260600190565b8181038181111561154557611545615809565b634e487b7160e01b600052601260045260246000fd5b600082615a9f57615a9f615a7a565b500690565b602080825260139082015272494e56414c49445f53544152545f424c4f434b60681b604082015260600190565b600a815269756e64656c656761746560b01b602082015260400190565b6040815260006158d260408301615ad1565b601281527144454c45474154494f4e5f544f4f5f4c4f5760701b602082015260400190565b604081526000615b3760408301615ad1565b82810360208401526132bf81615b00565b634e487b7160e01b600052600160045260246000fd5b60408152600860408201526764656c656761746560c01b606082015260806020820152600061154560808301615b00565b6020808252600e908201526d0929cac82989288be988a9c8ea8960931b604082015260600190565b6000823560de19833603018112615bcd57600080fd5b9190910192915050565b6000808335601e19843603018112615bee57600080fd5b8301803591506001600160401b03821115615c0857600080fd5b602001915036819003821315612eac57600080fd5b61010081016040858337608084604084013760c082018360005b6002811015615c56578151835260209283019290910190600101615c37565b505050949350505050565b60008060408385031215615c7457600080fd5b8251615c7f81615685565b60208401519092506154cc81615685565b6000808335601e19843603018112615ca757600080fd5b8301803591506001600160401b03821115615cc157600080fd5b6020019150600681901b3603821315612eac57600080fd5b808202811582820484141761154557611545615809565b600082615cff57615cff615a7a565b500490565b604080519081016001600160401b0381118282101715615d3457634e487b7160e01b600052604160045260246000fd5b60405290565b600060408284031215615d4c57600080fd5b615d54615d04565b8235615d5f81615401565b81526020928301359281019290925250919050565b6020808252602b908201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960408201526a6e697469616c697a696e6760a81b606082015260800190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b858152606060208201526000615e02606083018688615dbf565b8281036040840152615e15818587615dbf565b98975050505050505050565b60005b83811015615e3c578181015183820152602001615e24565b50506000910152565b60008251615bcd818460208701615e21565b600060208284031215615e6957600080fd5b81516132bf81615685565b600081615e8357615e83615809565b506000190190565b8281526040602082015260008251806040840152615eb0816060850160208701615e21565b601f01601f1916919091016060019392505050565b600060408284031215615ed757600080fd5b82601f830112615ee657600080fd5b615eee615d04565b806040840185811115615f0057600080fd5b845b81811015615f1a578051845260209384019301615f02565b50909594505050505056fea2646970667358221220a626fbc30de48fae03e0787e09215ca109af7c4393b840809136a8d3e07d3add64736f6c63430008110033\",\n  \"deployedBytecode\": \"0x6080604052600436106102ca5760003560e01c8063715018a61161017b578063a2562ddd116100d7578063e0563ab111610085578063e0563ab114610890578063e30c3978146108a6578063e3f56eaa146108c4578063ea0fee4f146108e4578063eacdc5ff146108f9578063f2fde38b1461090f578063f3f437031461092f57600080fd5b8063a2562ddd146107a4578063a2b915e2146107c4578063a77af229146107e4578063a90049d0146107f9578063ae97dde814610366578063c6b61e4c14610819578063ce513b6f1461087057600080fd5b8063947287cf11610134578063947287cf146106df57806395b0b027146106f557806397e5230d14610715578063982ef0a71461072c5780639a91e6d71461073f5780639b19251a14610754578063a15808791461078457600080fd5b8063715018a61461061057806378f619321461062557806379ba5097146106775780637f6497831461068c5780638b0e9f3f146106ac5780638da5cb5b146106c157600080fd5b80633a4b66f11161022a57806351351d53116101e357806351351d531461052b57806351cff8d91461054657806352a9674b14610566578063548db1741461059a5780635689762f146105ba578063572d356e146105da578063628da527146105f057600080fd5b80633a4b66f11461046b5780633b878c22146104735780633fd5000114610489578063410899c9146104a957806346df33d2146104c95780634d99dd161461050b57600080fd5b80632153f7fa116102875780632153f7fa1461039d578063284017f5146103bd57806328f73148146103e05780632e17de78146103f557806333378ada14610415578063355e6b4314610435578063375b3c0a1461045557600080fd5b80630209fdd0146102cf57806302985992146102fa5780631604e4161461031e5780631904bb2e146103345780631bce714d146103665780631f6590b81461037b575b600080fd5b3480156102db57600080fd5b506102e461094f565b6040516102f191906153b4565b60405180910390f35b34801561030657600080fd5b5061031060055481565b6040519081526020016102f1565b34801561032a57600080fd5b5061031060035481565b34801561034057600080fd5b5061035461034f366004615421565b610960565b6040516102f19695949392919061543e565b34801561037257600080fd5b50610310606481565b34801561038757600080fd5b5061039b610396366004615495565b610a33565b005b3480156103a957600080fd5b506102e46103b83660046154d7565b610b30565b3480156103c957600080fd5b506103d361202081565b6040516102f191906154f0565b3480156103ec57600080fd5b50610310610c3b565b34801561040157600080fd5b5061039b6104103660046154d7565b610d09565b34801561042157600080fd5b50610310610430366004615421565b610e2f565b34801561044157600080fd5b5061039b6104503660046154d7565b610e46565b34801561046157600080fd5b5061031060045481565b61039b610f11565b34801561047f57600080fd5b506103d361101081565b34801561049557600080fd5b506103106104a43660046154d7565b610ff0565b3480156104b557600080fd5b5061039b6104c4366004615516565b611011565b3480156104d557600080fd5b506104e96104e43660046154d7565b61121f565b60408051825181526020808401519082015291810151908201526060016102f1565b34801561051757600080fd5b5061039b61052636600461556d565b611297565b34801561053757600080fd5b506103d36002600160a01b0381565b34801561055257600080fd5b5061039b610561366004615421565b6113a2565b34801561057257600080fd5b506103107ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee81565b3480156105a657600080fd5b5061039b6105b53660046155dd565b6114d2565b3480156105c657600080fd5b506103106105d536600461561e565b61152b565b3480156105e657600080fd5b5061031060005481565b3480156105fc57600080fd5b5061031061060b36600461561e565b61154b565b34801561061c57600080fd5b5061039b611562565b34801561063157600080fd5b5061066761064036600461564c565b61013e60209081526000938452604080852082529284528284209052825290205460ff1681565b60405190151581526020016102f1565b34801561068357600080fd5b5061039b611576565b34801561069857600080fd5b5061039b6106a73660046155dd565b6115ed565b3480156106b857600080fd5b50600954610310565b3480156106cd57600080fd5b506076546001600160a01b03166103d3565b3480156106eb57600080fd5b5061031061520881565b34801561070157600080fd5b506006546103d3906001600160a01b031681565b34801561072157600080fd5b50610310620249f081565b61039b61073a366004615693565b611641565b34801561074b57600080fd5b5061039b61169d565b34801561076057600080fd5b5061066761076f366004615421565b60106020526000908152604090205460ff1681565b34801561079057600080fd5b5061039b61079f3660046156c1565b611702565b3480156107b057600080fd5b5061039b6107bf366004615770565b6119d0565b3480156107d057600080fd5b506103106107df366004615421565b611d5e565b3480156107f057600080fd5b50610310600a81565b34801561080557600080fd5b5061039b610814366004615693565b611d72565b34801561082557600080fd5b506108556108343660046154d7565b600f6020526000908152604090208054600182015460029092015490919083565b604080519384526020840192909252908201526060016102f1565b34801561087c57600080fd5b5061031061088b366004615421565b611e13565b34801561089c57600080fd5b506103d361203081565b3480156108b257600080fd5b5060a8546001600160a01b03166103d3565b3480156108d057600080fd5b506103106108df366004615421565b611e41565b3480156108f057600080fd5b50610310600181565b34801561090557600080fd5b5061031060015481565b34801561091b57600080fd5b5061039b61092a366004615421565b611e4e565b34801561093b57600080fd5b5061031061094a366004615421565b611ebf565b606061095b6064610b30565b905090565b610968615329565b6000808080808061097a600789611ee6565b604080516101208101909152908160a081018260048282826020028201915b8154815260200190600101908083116109995750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff1615156080909201919091528251908301519099509750909150610a029089611f04565b546020820151610a12919061581f565b94508060400151935080606001519250806080015191505091939550919395565b3360009081526010602052604090205460ff16610a845760405163973d02cb60e01b815260206004820152600960248201526815d2125511531254d560ba1b60448201526064015b60405180910390fd5b610a8f338383611f22565b604080516101208101909152610ae29033908060a0810185600482826080808284376000920182905250928452505060208201819052604082018190526060820152600160809091015260079190611fd7565b610aeb33612380565b336001600160a01b03167fcab5c47e498c5b85fd42d656842253cfe070f7648c3a198275347b47dec5d27b82604051610b249190615832565b60405180910390a25050565b60606000600760010154831115610b4957600854610b4b565b825b90506000816001600160401b03811115610b6757610b67615841565b604051908082528060200260200182016040528015610b90578160200160208202803683370190505b50905081600003610ba2579392505050565b6000610bae60076123c9565b90508082600081518110610bc457610bc4615857565b6001600160a01b039092166020928302919091019091015260015b83811015610c3157610bf260078361242a565b915081838281518110610c0757610c07615857565b6001600160a01b039092166020928302919091019091015280610c298161586d565b915050610bdf565b5090949350505050565b60008060076001015460641115610c5457600854610c57565b60645b905080600003610c6957600091505090565b6000610c7560076123c9565b9050610c82600782611f04565b54610c8e600783611ee6565b60040154610c9c919061581f565b610ca6908461581f565b925060015b82811015610d0357610cbe60078361242a565b9150610ccb600783611f04565b54610cd7600784611ee6565b60040154610ce5919061581f565b610cef908561581f565b935080610cfb8161586d565b915050610cab565b50505090565b6000610d16600c3361252c565b336000908152600a6020526040902060070154610d339190615886565b90506000610d4083612588565b905081811315610d63576040516396906e6360e01b8152600401610a7b906158c0565b6000610d6f8284615905565b905060045481128015610d8157508015155b15610d9f576040516396906e6360e01b8152600401610a7b90615945565b610da761169d565b610dc233610db784600019615968565b600c9190600061259b565b80600003610dea576000610dd7600733611ee6565b600701805460ff19169115159190911790555b610df433856126a2565b60405184815233907f0f5bb82176feb1b5e747e28471aa92156a04d9f3ab9f45f28e2d704232b93f759060200160405180910390a250505050565b6000610e3c600783611ee6565b6006015492915050565b610e51600733611ee6565b6007015460ff16610e755760405163973d02cb60e01b8152600401610a7b90615998565b6064811115610ebb5760405162461bcd60e51b815260206004820152601260248201527124a72b20a624a22fa1a7a6a6a4a9a9a4a7a760711b6044820152606401610a7b565b6000610ec8600733611ee6565b6005810154604080519182526020820185905291925033917f6e500db30ce535d38852e318f333e9be41a3fec6c65d234ebb06203c896db9a5910160405180910390a260050155565b610f1c600733611ee6565b6007015460ff16610f405760405163973d02cb60e01b8152600401610a7b90615998565b6000610f4d600c3361252c565b336000908152600a6020526040902060070154610f6a9190615886565b905060045481610f7934612588565b610f839190615886565b1215610fa2576040516396906e6360e01b8152600401610a7b906159bb565b610faa61169d565b610fb8600c3334600061259b565b60405134815233907f9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d9060200160405180910390a250565b6002818154811061100057600080fd5b600091825260209091200154905081565b336002600160a01b03146110385760405163973d02cb60e01b8152600401610a7b906159e9565b60018054600091826110498361586d565b91905055905080841461106e5760405162461bcd60e51b8152600401610a7b90615a0d565b82356020840135116110925760405162461bcd60e51b8152600401610a7b90615a3a565b6000546110a484356020860135615a67565b6110af90600161581f565b6110b99190615a90565b156111145760405162461bcd60e51b815260206004820152602560248201527f45504f43485f4d5553545f42455f444956495349424c455f42595f45504f43486044820152645f53495a4560d81b6064820152608401610a7b565b8235600f6000611125600185615a67565b8152602001908152602001600020600101546001611143919061581f565b146111605760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f602090815260408083209186013560018084018290558735845591870135600280850191909155805492830181559093527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01919091556111c8848461270e565b6111d06128df565b83602001358460000135867f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a876040013560405161121091815260200190565b60405180910390a45050505050565b6040805160608101825260008082526020820181905291810182905290611247600284612c12565b9050600f600061125883600161581f565b81526020019081526020016000206040518060600160405290816000820154815260200160018201548152602001600282015481525050915050919050565b60006112a4600784611f04565b905060006112b28233612cc7565b9050808311156112d5576040516396906e6360e01b8152600401610a7b90615aee565b6112e0823385612d13565b60006112ec8483615a67565b9050600554811080156112fe57508015155b1561131c576040516396906e6360e01b8152600401610a7b90615b25565b611327856000611d72565b600061133285612588565b905061135086600061134684600019615968565b600c92919061259b565b61135a33866126a2565b6040518581526001600160a01b0387169033907f4d10bd049775c77bd7f255195afba5088028ecb3c7c277d393ccff7934f2f92c9060200160405180910390a3505050505050565b6113aa612de8565b6001600160a01b0381166113c0576113c0615b48565b336000908152600e6020526040812060015490919081906113e2908490612e41565b80855560405182815291935091506001600160a01b0385169033907f2717ead6b9200dd235aad468c9809ea400fe33ac69b5bfaa6d3e90fc922b63989060200160405180910390a36000846001600160a01b03168360405160006040518083038185875af1925050503d8060008114611477576040519150601f19603f3d011682016040523d82523d6000602084013e61147c565b606091505b50509050806114c15760405162461bcd60e51b815260206004820152601160248201527015d2551211149055d05317d19052531151607a1b6044820152606401610a7b565b505050506114cf600160da55565b50565b6114da612eba565b60005b81811015611526576115148383838181106114fa576114fa615857565b905060200201602081019061150f9190615421565b612380565b8061151e8161586d565b9150506114dd565b505050565b60006115428261153c600786611f04565b90612f14565b90505b92915050565b60006115428261155c600786611f04565b90612cc7565b61156a612eba565b6115746000612f43565b565b60a85433906001600160a01b031681146115e45760405162461bcd60e51b815260206004820152602960248201527f4f776e61626c6532537465703a2063616c6c6572206973206e6f7420746865206044820152683732bb9037bbb732b960b91b6064820152608401610a7b565b6114cf81612f43565b6115f5612eba565b60005b818110156115265761162f83838381811061161557611615615857565b905060200201602081019061162a9190615421565b612f5c565b806116398161586d565b9150506115f8565b600061164e600784611f04565b6005549091503461165f8333612cc7565b611669919061581f565b1015611688576040516396906e6360e01b8152600401610a7b90615b5e565b6116928383611d72565b611526338434612fa8565b60006116aa600733611ee6565b600681015490915060008190036116bf575050565b600060068301556116d033826126a2565b60405181815233907f378c825c0ac073cbae19612eb73cde31c0972f0c8ba2dedb659840f32550df2b90602001610b24565b604354610100900460ff16158080156117225750604354600160ff909116105b8061173c5750303b15801561173c575060435460ff166001145b61179f5760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b6064820152608401610a7b565b6043805460ff1916600117905580156117c2576043805461ff0019166101001790555b336002600160a01b03146117e95760405163973d02cb60e01b8152600401610a7b906159e9565b6001805560608601356000556117fe82612f43565b611806613073565b853560035560208601356004556040860135600555600680546001600160a01b0319166001600160a01b03851617905560005b848110156119815760006040518060a0016040528088888581811061186057611860615857565b905061010002016020016004806020026040519081016040528092919082600460200280828437600092019190915250505081526020018888858181106118a9576118a9615857565b9050610100020160e00135815260200160008152602001600081526020016001151581525090506119058787848181106118e5576118e5615857565b6118fc926020610100909202019081019150615421565b60079083611fd7565b61196e87878481811061191a5761191a615857565b611931926020610100909202019081019150615421565b88888581811061194357611943615857565b9050610100020160a00189898681811061195f5761195f615857565b90506101000201602001611f22565b50806119798161586d565b915050611839565b5080156119c8576043805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b505050505050565b8060028110156119f25760405162461bcd60e51b8152600401610a7b90615b8f565b6119fc83836130a2565b611a3f5760405162461bcd60e51b8152602060048201526014602482015273424c4f434b484153485f4e4f545f554e4951554560601b6044820152606401610a7b565b60005b81811015611bd957611bd14689868685818110611a6157611a61615857565b9050602002810190611a739190615bb7565b608001358a888887818110611a8a57611a8a615857565b9050602002810190611a9c9190615bb7565b35898988818110611aaf57611aaf615857565b9050602002810190611ac19190615bb7565b602001358a8a89818110611ad757611ad7615857565b9050602002810190611ae99190615bb7565b604001358b8b8a818110611aff57611aff615857565b9050602002810190611b119190615bb7565b604080516020810199909952880196909652606087810195909552608087019390935260a086019190915260c085015260e084015201356101008201526101200160405160208183030381529060405280519060200120858584818110611b7a57611b7a615857565b9050602002810190611b8c9190615bb7565b611b9a9060c0810190615bd7565b878786818110611bac57611bac615857565b9050602002810190611bbe9190615bb7565b611bcc9060a0810190615bd7565b613158565b600101611a42565b506000606460076001015410611bf0576064611bf4565b6008545b90506000611c0182610b30565b90506000826001600160401b03811115611c1d57611c1d615841565b604051908082528060200260200182016040528015611c46578160200160208202803683370190505b50905060005b83811015611d44576000805b86811015611d3a57611c9b898983818110611c7557611c75615857565b9050602002810190611c879190615bb7565b611c959060a0810190615bd7565b8561325c565b15611cae5781611caa8161586d565b9250505b6001821115611d2857611cff858481518110611ccc57611ccc615857565b60200260200101518a8a84818110611ce657611ce6615857565b9050602002810190611cf89190615bb7565b358e6132c6565b6001848481518110611d1357611d13615857565b91151560209283029190910190910152611d3a565b80611d328161586d565b915050611c58565b5050600101611c4c565b50611d518b89898461349d565b5050505050505050505050565b6000611d6b600783611f04565b5492915050565b6000611d7f600784611f04565b90506000611d8d823361383a565b905080600003611d9d5750505050565b8215611db357611dae338583612fa8565b611dbd565b611dbd33826126a2565b821515846001600160a01b0316336001600160a01b03167f6b2cd13395c5d2d1f95934a6a8f884d2cb71e846eb99d6759f7e6a39c060b2d084604051611e0591815260200190565b60405180910390a450505050565b6001546001600160a01b0382166000908152600e602052604081209091611e3a9190612e41565b5092915050565b6000611545600783613881565b611e56612eba565b60a880546001600160a01b0383166001600160a01b03199091168117909155611e876076546001600160a01b031690565b6001600160a01b03167f38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e2270060405160405180910390a350565b6001546001600160a01b0382166000908152600e60205260408120909161154591906138b6565b6001600160a01b031660009081526003918201602052604090200190565b6001600160a01b031660009081526004919091016020526040902090565b60065460009081906001600160a01b031663ebbdac918585611f438961394c565b6040518463ffffffff1660e01b8152600401611f6193929190615c1d565b6040805180830381865afa158015611f7d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611fa19190615c61565b91509150801580611fb0575081155b15611fd05784604051633615713d60e21b8152600401610a7b91906154f0565b5050505050565b6001600160a01b038216611fed57611fed615b48565b611ff78383613a1c565b15612017578160405163cda981d560e01b8152600401610a7b91906154f0565b6001600160a01b0382166000908152600380850160205260409091208251839291909101906120499082906004615347565b50602082810151600483015560408301516005830155606083015160068301556080909201516007909101805460ff191691151591909117905581015160000361209257505050565b82546000906001600160a01b0316816120ab8686613881565b90505b6001600160a01b03821615612121578192506120ca8683613881565b8110156120f9576001600160a01b039182166000908152600387016020526040902060010154909116906120ae565b6001600160a01b039182166000908152600387016020526040902060020154909116906120ae565b6040518060a00160405280846001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160011515815260200185815250866003016000876001600160a01b03166001600160a01b0316815260200190815260200160002060008201518160000160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060208201518160010160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060408201518160020160006101000a8154816001600160a01b0302191690836001600160a01b0316021790555060608201518160020160146101000a81548160ff021916908315150217905550608082015181600301600082015181600001906004612256929190615347565b506020820151600482015560408201516005820155606082015160068201556080909101516007909101805460ff191691151591909117905550506001600160a01b0383166122bd5785546001600160a01b0319166001600160a01b03861617865561233a565b6122c78684613881565b811015612306576001600160a01b038381166000908152600388016020526040902060010180546001600160a01b03191691871691909117905561233a565b6001600160a01b038381166000908152600388016020526040902060020180546001600160a01b0319169187169190911790555b6123448686613a6d565b6001860180549060006123568361586d565b91905055508360200151866002016000828254612373919061581f565b9091555050505050505050565b6001600160a01b038116600081815260106020526040808220805460ff19169055517fcdd2e9b91a56913d370075169cefa1602ba36be5301664f752192bb1709df7579190a250565b80546001600160a01b03168015612425575b6001600160a01b0381811660009081526003840160205260409020600201541615612425576001600160a01b039081166000908152600383016020526040902060020154166123db565b919050565b60006001600160a01b038216612453576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206001015416156124aa576001600160a01b0380831660009081526003850160205260409020600101546124a391859116613dba565b9050611545565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061250257506001600160a01b0380821660009081526003850160205260409020600101548382169116145b15611545576001600160a01b038082166000908152600385016020526040902054919250166124c9565b6001600160a01b038116600090815260018301602052604081205461255357506000611545565b8261255e8184613e10565b8154811061256e5761256e615857565b906000526020600020906003020160010154905092915050565b6000818181121561154557611545615b48565b6001600160a01b0383166000908152600185016020526040812054908190036126465784546125cb90600161581f565b6001600160a01b03858116600081815260018981016020908152604080842087905580516060810182529485528482018a81529085018981528c548085018e558d8652929094209451600390920290940180546001600160a01b03191691909516178455915191830191909155516002909101559050611fd0565b6000856126538187613e10565b8154811061266357612663615857565b90600052602060002090600302019050838160010160008282546126879190615886565b92505081905550828160020160008282546123739190615886565b6126d381600180546126b4919061581f565b6001600160a01b0385166000908152600e602052604090209190613e46565b816001600160a01b03167f655c1cd0236fb6dc4916f34c8ff10e3b18fcaea5b344dfc16c36fbb1bdfc5df282604051610b2491815260200190565b6001805461271c9190615a67565b8135146127615760405162461bcd60e51b8152602060048201526013602482015272115413d0d217d393d517d0d3d3535255151151606a1b6044820152606401610a7b565b60006127706020830183615c90565b9150506064811180159061278657506008548111155b6127a25760405162461bcd60e51b8152600401610a7b90615b8f565b60006127ac610c3b565b90506000805460646127be9190615cd9565b6127cd86356020880135615a67565b6003546127da9190615cd9565b6127e5906064615cd9565b6127ef9190615cf0565b905060005b838110156119c857600061280b6020870187615c90565b8381811061281b5761281b615857565b9050604002018036038101906128319190615d3a565b805190915060009061284590600790611ee6565b90506000612857604089013587615cd9565b6020840151845161286a90600790611f04565b54600485015461287a919061581f565b6128849088615cd9565b61288e9190615cd9565b6128989190615cf0565b90506000806128ab856000015184613f64565b915091506128bd85600001518361409d565b84516128c99082614108565b5050505050806128d89061586d565b90506127f4565b600c60005b8154811015612aae57600082828154811061290157612901615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b03168084526001820154948401949094526002015490820152915061294d600782613a1c565b15612a9b57600061295f600783611ee6565b905061297d836020015182600401546129789190615886565b614152565b600482015560208301516009546129979161297891615886565b60095560006129a7600784613881565b905060006129b6600785614168565b90506001600160a01b038116158015906129d95750816129d7600783613881565b105b806129f85750816129f66129ee60078761242a565b600790613881565b115b15612a7e57612a08600785614263565b604080516101208101909152612a7e908590858160a081018260048282826020028201915b815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0384166000908152600d60205260408120555050505b505080612aa79061586d565b90506128e4565b5060005b8154811015612c09576000828281548110612acf57612acf615857565b600091825260209182902060408051606081018252600390930290910180546001600160a01b031680845260018201549484019490945260020154908201529150612b1b600782613a1c565b158015612b3f57506001600160a01b0381166000908152600d602052604090205415155b15612bf6576000612b51600783611ee6565b9050612b608360200151614152565b60048281019190915560408051610120810191829052835460a08201908152612bdb9386938692849290918491906001830160c08601808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b6001600160a01b0382166000908152600d6020526040812055505b505080612c029061586d565b9050612ab2565b506000600c5550565b81546000908103612c2557506000611545565b82546000905b80821015612c72576000612c3f8383614644565b60008781526020902090915085908201541115612c5e57809150612c6c565b612c6981600161581f565b92505b50612c2b565b600082118015612c9e575083612c9b86612c8d600186615a67565b600091825260209091200190565b54145b15612cb757612cae600183615a67565b92505050611545565b509050611545565b505092915050565b60008260010154600003612cdd57506000611545565b600183015483546001600160a01b0384166000908152600686016020526040902054612d099190615cd9565b6115429190615cf0565b8254600184015460009190612d289084615cd9565b612d329190615cf0565b6001600160a01b0384166000908152600686016020526040812080549293508392909190612d61908490615a67565b9250508190555080846001016000828254612d7c9190615a67565b90915550506002840154612d9a90612d95908390615cd9565b612588565b6001600160a01b038416600090815260048601602052604081208054909190612dc4908490615886565b9091555050835482908590600090612ddd908490615a67565b909155505050505050565b600260da5403612e3a5760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c006044820152606401610a7b565b600260da55565b81546000905b8360010154811015612eac57600081815260028501602090815260409182902082518084019093528054835260010154908201819052841015612e8a5750612eac565b8051612e96908461581f565b9250508080612ea49061586d565b915050612e47565b9250929050565b600160da55565b6076546001600160a01b031633146115745760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610a7b565b6001600160a01b0381166000908152600583016020526040812054612f39848461465f565b6115429190615a67565b60a880546001600160a01b03191690556114cf816146d9565b6001600160a01b038116600081815260106020526040808220805460ff19166001179055517fa850ae9193f515cbae8d35e8925bd2be26627fc91bce650b8652ed254e9cab039190a250565b612fb3600783611ee6565b6007015460ff16612ffb5760405163973d02cb60e01b815260206004820152601160248201527024a72b20a624a22fab20a624a220aa27a960791b6044820152606401610a7b565b61300a82600061134684612588565b613021838261301a600786611f04565b919061472b565b816001600160a01b0316836001600160a01b03167fe5541a6b6103d4fa7e021ed54fad39c66f27a76bd13d374cf6240ae6bd0bb72b8360405161306691815260200190565b60405180910390a3505050565b604354610100900460ff1661309a5760405162461bcd60e51b8152600401610a7b90615d74565b61157461480b565b600081815b8181101561314d5760006130bc82600161581f565b90505b8281101561313a578585828181106130d9576130d9615857565b90506020028101906130eb9190615bb7565b6080013586868481811061310157613101615857565b90506020028101906131139190615bb7565b60800135036131285760009350505050611545565b806131328161586d565b9150506130bf565b50806131458161586d565b9150506130a7565b506001949350505050565b6000806120306001600160a01b0316620249f08888888888604051602001613184959493929190615de8565b60408051601f198184030181529082905261319e91615e45565b6000604051808303818686fa925050503d80600081146131da576040519150601f19603f3d011682016040523d82523d6000602084013e6131df565b606091505b50915091506000818060200190518101906131fa9190615e57565b90508280156132065750805b6132525760405162461bcd60e51b815260206004820152601d60248201527f5349474e41545552455f564552494649434154494f4e5f4641494c45440000006044820152606401610a7b565b5050505050505050565b60008061326a600884615cf0565b90506000613279600885615a90565b905084821061328d576000925050506132bf565b6000600160ff83161b8787858181106132a8576132a8615857565b9050013560f81c60f81b60f81c60ff161611925050505b9392505050565b600082815261013e6020908152604080832084845282528083206001600160a01b038716845290915290205460ff16156132ff57505050565b600082815261013e6020908152604080832084845282528083206001600160a01b03871684529091528120805460ff19166001179055613340600785611ee6565b6001600160a01b0385166000908152600b602052604090205490915060649061336b90600a90615cd9565b6133759190615cf0565b6001600160a01b0385166000908152600b60205260408120805490919061339d908490615a67565b9250508190555060006064600a83600401546133b99190615cd9565b6133c39190615cf0565b90506133d0600786614263565b808260040160008282546133e49190615a67565b909155505060408051610120810190915261345e908690848160a08101826004828282602002820191815481526020019060010190808311612a2d57505050918352505060048201546020820152600582015460408201526006820154606082015260079182015460ff1615156080909101529190611fd7565b8284866001600160a01b03167f2d7432ca38933aadba9547f33f0568008e240e52e66f1a16c6182e742bce3b1360405160405180910390a45050505050565b60018054600091826134ae8361586d565b9190505590508085146134d35760405162461bcd60e51b8152600401610a7b90615a0d565b83356020850135116134f75760405162461bcd60e51b8152600401610a7b90615a3a565b8335600f6000613508600185615a67565b8152602001908152602001600020600101546001613526919061581f565b146135435760405162461bcd60e51b8152600401610a7b90615aa4565b6000818152600f6020908152604080832087830135600180830182905589358355928901356002808401919091558054938401815585527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace9092019190915591906135b090860186615c90565b915050606481118015906135c657506008548111155b6135e25760405162461bcd60e51b8152600401610a7b90615b8f565b60006135ec610c3b565b90506000805460646135fe9190615cd9565b61360d893560208b0135615a67565b60035461361a9190615cd9565b613625906064615cd9565b61362f9190615cf0565b905060005b838110156137de5786818151811061364e5761364e615857565b60200260200101516137ce57600061366960208a018a615c90565b8381811061367957613679615857565b90506040020180360381019061368f9190615d3a565b80519091506000906136a390600790611ee6565b905060006136b560408c013587615cd9565b602084015184516136c890600790611f04565b5460048501546136d8919061581f565b6136e29088615cd9565b6136ec9190615cd9565b6136f69190615cf0565b9050600080613709856000015184613f64565b9150915081846006016000828254613721919061581f565b909155505084516040518481526001600160a01b03909116907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2845161378190829061377b90600790611f04565b90614832565b84600001516001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac826040516137c091815260200190565b60405180910390a250505050505b6137d78161586d565b9050613634565b506137e76128df565b876020013588600001358a7f0ce8712c4dee4bd5a691f0bc1c39594671591e77395f8ebf6a3fb5f63fbea66a8b6040013560405161382791815260200190565b60405180910390a4505050505050505050565b60006138468383612f14565b6001600160a01b038316600090815260058501602052604081208054929350839290919061387590849061581f565b90915550909392505050565b6001600160a01b038116600090815260048301602090815260408083205460038601909252822060070154611542919061581f565b60018201546000908082036138cf576000915050611545565b60006138dc600183615a67565b90505b84548110612cbf57600081815260028601602090815260409182902082518084019093528054835260010154908201819052851061391d5750612cbf565b8051613929908561581f565b9350816000036139395750612cbf565b508061394481615e74565b9150506138df565b613954615381565b6006546040516bffffffffffffffffffffffff19606085901b1660208201524660348201526001600160a01b039091169063a850a909907ffd10bf199d0185af9cce2005e6acc8d19924428058ac3374e16f18c97569b4ee906054016040516020818303038152906040526040518363ffffffff1660e01b81526004016139dc929190615e8b565b6040805180830381865afa1580156139f8573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115459190615ec5565b60006001600160a01b03821615801590611542575082546001600160a01b03838116911614806115425750506001600160a01b03908116600090815260039290920160205260409091205416151590565b60005b82546001600160a01b03838116911614801590613aba57506001600160a01b0382811660009081526003850160205260408082205490921681522060020154600160a01b900460ff165b15613d8c576001600160a01b03808316600090815260038501602052604080822054831680835281832054841683529120600101549091168103613c42576001600160a01b0381811660009081526003860160205260408082205483168252808220600290810154909316808352912090910154909250600160a01b900460ff1615613ba3576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060020154811690841603613bd957809250613bd984846148af565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613c3d91869116614a62565b613d86565b6001600160a01b03818116600090815260038601602052604080822054831682528082206001015490921680825291902060020154909250600160a01b900460ff1615613cec576001600160a01b0380821660008181526003870160205260408082206002808201805460ff60a01b1990811690915588871685528385208201805482169055825487168552928420018054909216600160a01b1790915591905254169250613d86565b6001600160a01b038082166000908152600386016020526040902060010154811690841603613d2257809250613d228484614a62565b506001600160a01b0380831660009081526003850160205260408082205483168083528183206002808201805460ff60a01b19908116909155825487168652938520018054909316600160a01b1790925591829052549091613d86918691166148af565b50613a70565b505080546001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60005b6001600160a01b0382811660009081526003850160205260409020600201541615613e0a576001600160a01b03918216600090815260038401602052604090206002015490911690613dbd565b50919050565b6001600160a01b038116600090815260018301602052604081205490819003613e3b57613e3b615b48565b611542600182615a67565b81600003613e5657613e56615b48565b82546001840154818103613ead576040805180820182528581526020808201868152600085815260028a019092529281209151825591516001918201558601805491613ea18361586d565b91905055505050505050565b60006002860181613ebf600185615a67565b815260200190815260200160002060010154905080841015613ee357613ee3615b48565b83811015613f32576040805180820182528681526020808201878152600086815260028b019092529281209151825591516001918201558701805491613f288361586d565b91905055506119c8565b84600287016000613f44600186615a67565b81526020019081526020016000206000016000828254612373919061581f565b60008080613f73600786611ee6565b604080516101208101909152908160a081018260048282826020028201915b815481526020019060010190808311613f925750505091835250506004820154602080830191909152600583015460408301526006830154606083015260079283015460ff161515608090920191909152820151919250600090613ff69088611f04565b54905060008290036140115760008094509450505050612eac565b806000036140285785600094509450505050612eac565b6000614034828461581f565b61403e8489615cd9565b6140489190615cf0565b905060006140568289615a67565b90506000606482876040015161406c9190615cd9565b6140769190615cf0565b9050614082818461581f565b61408c8284615a67565b975097505050505050509250929050565b60006140aa600784611ee6565b9050818160060160008282546140c0919061581f565b90915550506040518281526001600160a01b038416907f65da9d8bbc1fc112cfb499f15f17b3bfe4c5070dfca6a3e30f7f5f7f17bd86849060200160405180910390a2505050565b6141178161377b600785611f04565b816001600160a01b03167f520e62b73478b262ede881cd483440f8e7b8a4651b0ff9154aeb3510089ddbac82604051610b2491815260200190565b60008082121561416457614164615b48565b5090565b60006001600160a01b038216614191576040516365e52d5160e11b815260040160405180910390fd5b6001600160a01b03828116600090815260038501602052604090206002015416156141e1576001600160a01b0380831660009081526003850160205260409020600201546124a391859116614c15565b506001600160a01b038082166000908152600384016020526040902054165b6001600160a01b0381161580159061423957506001600160a01b0380821660009081526003850160205260409020600201548382169116145b15611545576001600160a01b03808216600090815260038501602052604090205491925016614200565b6001600160a01b03811661427957614279615b48565b6142838282613a1c565b6142a25780604051634b2990ed60e11b8152600401610a7b91906154f0565b6001600160a01b038181166000908152600384016020526040812060010154909182911615806142ef57506001600160a01b03838116600090815260038601602052604090206002015416155b156142fb575081614367565b506001600160a01b038083166000908152600385016020526040902060020154165b6001600160a01b0381811660009081526003860160205260409020600101541615614367576001600160a01b0390811660009081526003850160205260409020600101541661431d565b6001600160a01b03818116600090815260038601602052604090206001015416156143b3576001600160a01b0380821660009081526003860160205260409020600101541691506143d6565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b038082166000908152600386016020526040808220548584168352912080546001600160a01b0319169190921690811790915580156144af576001600160a01b038082166000908152600387016020526040902060010154811690831603614477576001600160a01b038181166000908152600387016020526040902060010180546001600160a01b0319169185169190911790556144c9565b6001600160a01b038181166000908152600387016020526040902060020180546001600160a01b0319169185169190911790556144c9565b84546001600160a01b0319166001600160a01b0384161785555b6001600160a01b038281166000818152600388016020526040902060020154600160a01b900460ff1615918616146145a457614506868487614c65565b6001600160a01b03858116600090815260038801602052604080822060018082015488861680865284862092830180549288166001600160a01b03199384168117909155865284862080548316821790556002938401805494909301805494909716938216841787559285529284208054909316821790925590549152815460ff60a01b1916600160a01b9182900460ff1615159091021790559193915b80156145b4576145b48685614d5d565b6001600160a01b0383166000908152600387016020526040812080546001600160a01b0319908116825560018083018054909216909155600290910180546001600160a81b0319169055870180549161460c83615e74565b90915550506001600160a01b038316600090815260038701602052604081206007015460028801805491929091612373908490615a67565b60006146536002848418615cf0565b6115429084841661581f565b6001600160a01b03811660009081526006830160205260408120546002840154829161468f91612d959190615cd9565b6001600160a01b0384166000908152600486016020526040812054919250906146bc906129789084615886565b90506146d0670de0b6b3a764000082615cf0565b95945050505050565b607680546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600083600101546000148061473f57508354155b61476357835460018501546147549084615cd9565b61475e9190615cf0565b614765565b815b6001600160a01b038416600090815260068601602052604081208054929350839290919061479490849061581f565b92505081905550808460010160008282546147af919061581f565b90915550508354829085906000906147c890849061581f565b909155505060028401546147e190612d95908390615cd9565b6001600160a01b038416600090815260048601602052604081208054909190612ddd908490615905565b604354610100900460ff16612eb35760405162461bcd60e51b8152600401610a7b90615d74565b8060000361483e575050565b8160010154600003614872576003820154604051636130835f60e01b8152610a7b916001600160a01b0316906004016154f0565b6001820154614889670de0b6b3a764000083615cd9565b6148939190615cf0565b8260020160008282546148a6919061581f565b90915550505050565b6001600160a01b038082166000818152600385016020526040808220600281018054915482871680865293852060010154959094526001600160a01b031990911693851693841790559216908015614932576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b03191691841691821790556149835784546001600160a01b0319166001600160a01b038416178555614a18565b6001600160a01b0380831660009081526003870160205260409020600101548116908516036149e4576001600160a01b038281166000908152600387016020526040902060010180546001600160a01b031916918516919091179055614a18565b6001600160a01b038281166000908152600387016020526040902060020180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206001018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b6001600160a01b038082166000818152600385016020526040808220600181018054915482871680865293852060020154959094526001600160a01b031990911693851693841790559216908015614ae5576001600160a01b038181166000908152600387016020526040902080546001600160a01b0319169186169190911790555b6001600160a01b038381166000908152600387016020526040902080546001600160a01b0319169184169182179055614b365784546001600160a01b0319166001600160a01b038416178555614bcb565b6001600160a01b038083166000908152600387016020526040902060020154811690851603614b97576001600160a01b038281166000908152600387016020526040902060020180546001600160a01b031916918516919091179055614bcb565b6001600160a01b038281166000908152600387016020526040902060010180546001600160a01b0319169185169190911790555b50506001600160a01b039081166000818152600390940160205260408085206002018054939094166001600160a01b03199384168117909455928452919092208054909216179055565b60005b6001600160a01b0382811660009081526003850160205260409020600101541615613e0a576001600160a01b03918216600090815260038401602052604090206001015490911690614c18565b6001600160a01b038082166000908152600385016020526040808220548584168352912080546001600160a01b0319169190921690811790915580614cc25783546001600160a01b0319166001600160a01b038416178455614d57565b6001600160a01b038082166000908152600386016020526040902060010154811690831603614d23576001600160a01b038181166000908152600386016020526040902060010180546001600160a01b031916918516919091179055614d57565b6001600160a01b038181166000908152600386016020526040902060020180546001600160a01b0319169185169190911790555b50505050565b60005b82546001600160a01b03838116911614801590614da257506001600160a01b0382166000908152600384016020526040902060020154600160a01b900460ff16155b156152fe576001600160a01b038083166000818152600386016020526040808220548416808352912060010154909216900361506c576001600160a01b038181166000908152600386016020526040808220600290810154909316808352912090910154909250600160a01b900460ff1615614e89576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b179055614e6684826148af565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16158015614efc57506001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16155b15614f37576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff16614fec576001600160a01b0380831660008181526003870160205260408082206001810154909416825281206002908101805460ff60a01b19908116909155929091529091018054909116600160a01b179055614fc98483614a62565b6001600160a01b0380821660009081526003860160205260409020600201541691505b6001600160a01b0380821660009081526003860160205260408082206002908101805487861685528385208301805460ff600160a01b9384900416151590920260ff60a01b1992831617815582548216909255905490941683529120018054909116905561505a84826148af565b83546001600160a01b031692506152f8565b6001600160a01b0381811660009081526003860160205260408082206001015490921680825291902060020154909250600160a01b900460ff161561511c576001600160a01b0380831660009081526003860160205260408082206002908101805460ff60a01b1990811690915593851683529120018054909116600160a01b1790556150f98482614a62565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038281166000908152600386016020526040808220600290810154909316825290200154600160a01b900460ff1615801561518f57506001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16155b156151ca576001600160a01b03821660009081526003850160205260409020600201805460ff60a01b1916600160a01b1790559150816152f8565b6001600160a01b0382811660009081526003860160205260408082206001015490921681522060020154600160a01b900460ff16615277576001600160a01b03808316600081815260038701602052604080822060029081018054909516835290822001805460ff60a01b19908116909155919052815416600160a01b17905561525484836148af565b6001600160a01b0380821660009081526003860160205260409020600101541691505b6001600160a01b038082166000908152600386016020526040808220600290810180548786168552838520808401805460ff600160a01b9485900416151590930260ff60a01b19938416179055825482169092556001909101549094168352912001805490911690556152ea8482614a62565b83546001600160a01b031692505b50614d60565b506001600160a01b03166000908152600390910160205260409020600201805460ff60a01b19169055565b60405180608001604052806004906020820280368337509192915050565b8260048101928215615375579160200282015b8281111561537557825182559160200191906001019061535a565b5061416492915061539f565b60405180604001604052806002906020820280368337509192915050565b5b8082111561416457600081556001016153a0565b6020808252825182820181905260009190848201906040850190845b818110156153f55783516001600160a01b0316835292840192918401916001016153d0565b50909695505050505050565b6001600160a01b03811681146114cf57600080fd5b803561242581615401565b60006020828403121561543357600080fd5b81356132bf81615401565b6101208101818860005b6004811015615467578151835260209283019290910190600101615448565b505050608082019690965260a081019490945260c084019290925260e0830152151561010090910152919050565b60008060c083850312156154a857600080fd5b60408301848111156154b957600080fd5b8392508460c0850111156154cc57600080fd5b809150509250929050565b6000602082840312156154e957600080fd5b5035919050565b6001600160a01b0391909116815260200190565b600060608284031215613e0a57600080fd5b600080600060a0848603121561552b57600080fd5b8335925061553c8560208601615504565b915060808401356001600160401b0381111561555757600080fd5b61556386828701615504565b9150509250925092565b6000806040838503121561558057600080fd5b823561558b81615401565b946020939093013593505050565b60008083601f8401126155ab57600080fd5b5081356001600160401b038111156155c257600080fd5b6020830191508360208260051b8501011115612eac57600080fd5b600080602083850312156155f057600080fd5b82356001600160401b0381111561560657600080fd5b61561285828601615599565b90969095509350505050565b6000806040838503121561563157600080fd5b823561563c81615401565b915060208301356154cc81615401565b60008060006060848603121561566157600080fd5b8335925060208401359150604084013561567a81615401565b809150509250925092565b80151581146114cf57600080fd5b600080604083850312156156a657600080fd5b82356156b181615401565b915060208301356154cc81615685565b600080600080600085870360e08112156156da57600080fd5b60808112156156e857600080fd5b5085945060808601356001600160401b038082111561570657600080fd5b818801915088601f83011261571a57600080fd5b81358181111561572957600080fd5b8960208260081b850101111561573e57600080fd5b60208301965080955050505061575660a08701615416565b915061576460c08701615416565b90509295509295909350565b6000806000806000806000610100888a03121561578c57600080fd5b8735965060208801359550604088013594506157ab8960608a01615504565b935060c08801356001600160401b03808211156157c757600080fd5b6157d38b838c01615504565b945060e08a01359150808211156157e957600080fd5b506157f68a828b01615599565b989b979a50959850939692959293505050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561154557611545615809565b60808181019083833792915050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161587f5761587f615809565b5060010190565b8082018281126000831280158216821582161715612cbf57612cbf615809565b6007815266756e7374616b6560c81b602082015260400190565b6040815260006158d2604083016158a6565b82810360208401526132bf816014815273494e53554646494349454e545f42414c414e434560601b602082015260400190565b8181036000831280158383131683831282161715611e3a57611e3a615809565b600d81526c5354414b455f544f4f5f4c4f5760981b602082015260400190565b604081526000615957604083016158a6565b82810360208401526132bf81615925565b80820260008212600160ff1b8414161561598457615984615809565b818105831482151761154557611545615809565b6020808252600990820152682b20a624a220aa27a960b91b604082015260600190565b6040815260056040820152647374616b6560d81b606082015260806020820152600061154560808301615925565b6020808252600a908201526914d654d5115350d0531360b21b604082015260600190565b60208082526013908201527215539156141150d5115117d15413d0d217d251606a1b604082015260600190565b6020808252601390820152721393d7d09313d0d2d4d7d0d3d3535255151151606a1b604082015260600190565b8181038181111561154557611545615809565b634e487b7160e01b600052601260045260246000fd5b600082615a9f57615a9f615a7a565b500690565b602080825260139082015272494e56414c49445f53544152545f424c4f434b60681b604082015260600190565b600a815269756e64656c656761746560b01b602082015260400190565b6040815260006158d260408301615ad1565b601281527144454c45474154494f4e5f544f4f5f4c4f5760701b602082015260400190565b604081526000615b3760408301615ad1565b82810360208401526132bf81615b00565b634e487b7160e01b600052600160045260246000fd5b60408152600860408201526764656c656761746560c01b606082015260806020820152600061154560808301615b00565b6020808252600e908201526d0929cac82989288be988a9c8ea8960931b604082015260600190565b6000823560de19833603018112615bcd57600080fd5b9190910192915050565b6000808335601e19843603018112615bee57600080fd5b8301803591506001600160401b03821115615c0857600080fd5b602001915036819003821315612eac57600080fd5b61010081016040858337608084604084013760c082018360005b6002811015615c56578151835260209283019290910190600101615c37565b505050949350505050565b60008060408385031215615c7457600080fd5b8251615c7f81615685565b60208401519092506154cc81615685565b6000808335601e19843603018112615ca757600080fd5b8301803591506001600160401b03821115615cc157600080fd5b6020019150600681901b3603821315612eac57600080fd5b808202811582820484141761154557611545615809565b600082615cff57615cff615a7a565b500490565b604080519081016001600160401b0381118282101715615d3457634e487b7160e01b600052604160045260246000fd5b60405290565b600060408284031215615d4c57600080fd5b615d54615d04565b8235615d5f81615401565b81526020928301359281019290925250919050565b6020808252602b908201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960408201526a6e697469616c697a696e6760a81b606082015260800190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b858152606060208201526000615e02606083018688615dbf565b8281036040840152615e15818587615dbf565b98975050505050505050565b60005b83811015615e3c578181015183820152602001615e24565b50506000910152565b60008251615bcd818460208701615e21565b600060208284031215615e6957600080fd5b81516132bf81615685565b600081615e8357615e83615809565b506000190190565b8281526040602082015260008251806040840152615eb0816060850160208701615e21565b601f01601f1916919091016060019392505050565b600060408284031215615ed757600080fd5b82601f830112615ee657600080fd5b615eee615d04565b806040840185811115615f0057600080fd5b845b81811015615f1a578051845260209384019301615f02565b50909594505050505056fea2646970667358221220a626fbc30de48fae03e0787e09215ca109af7c4393b840809136a8d3e07d3add64736f6c63430008110033\",\n  \"linkReferences\": {},\n  \"deployedLinkReferences\": {}\n}"
 var L2StateSenderArtifact string = "{\n  \"_format\": \"hh-sol-artifact-1\",\n  \"contractName\": \"L2StateSender\",\n  \"sourceName\": \"contracts/child/L2StateSender.sol\",\n  \"abi\": [\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"sender\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"receiver\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"bytes\",\n          \"name\": \"data\",\n          \"type\": \"bytes\"\n        }\n      ],\n      \"name\": \"L2StateSynced\",\n      \"type\": \"event\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"MAX_LENGTH\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"counter\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"receiver\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"bytes\",\n          \"name\": \"data\",\n          \"type\": \"bytes\"\n        }\n      ],\n      \"name\": \"syncState\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    }\n  ],\n  \"bytecode\": \"0x608060405234801561001057600080fd5b50610297806100206000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c806316f198311461004657806361bc221a1461005b578063a6f9885c14610076575b600080fd5b61005961005436600461017a565b61007f565b005b61006460005481565b60405190815260200160405180910390f35b61006461080081565b6001600160a01b0383166100cd5760405162461bcd60e51b815260206004820152601060248201526f24a72b20a624a22fa922a1a2a4ab22a960811b60448201526064015b60405180910390fd5b6108008111156101145760405162461bcd60e51b815260206004820152601260248201527108ab0868a8a88a6be9a82b0be988a9c8ea8960731b60448201526064016100c4565b826001600160a01b0316336001600160a01b031660008081546101369061020b565b9190508190557fedaf3c471ebd67d60c29efe34b639ede7d6a1d92eaeb3f503e784971e67118a5858560405161016d929190610232565b60405180910390a4505050565b60008060006040848603121561018f57600080fd5b83356001600160a01b03811681146101a657600080fd5b9250602084013567ffffffffffffffff808211156101c357600080fd5b818601915086601f8301126101d757600080fd5b8135818111156101e657600080fd5b8760208285010111156101f857600080fd5b6020830194508093505050509250925092565b60006001820161022b57634e487b7160e01b600052601160045260246000fd5b5060010190565b60208152816020820152818360408301376000818301604090810191909152601f909201601f1916010191905056fea2646970667358221220d2d546f135265c71683c4923890de2d8ae66f3be17185690036424019a5309ae64736f6c63430008110033\",\n  \"deployedBytecode\": \"0x608060405234801561001057600080fd5b50600436106100415760003560e01c806316f198311461004657806361bc221a1461005b578063a6f9885c14610076575b600080fd5b61005961005436600461017a565b61007f565b005b61006460005481565b60405190815260200160405180910390f35b61006461080081565b6001600160a01b0383166100cd5760405162461bcd60e51b815260206004820152601060248201526f24a72b20a624a22fa922a1a2a4ab22a960811b60448201526064015b60405180910390fd5b6108008111156101145760405162461bcd60e51b815260206004820152601260248201527108ab0868a8a88a6be9a82b0be988a9c8ea8960731b60448201526064016100c4565b826001600160a01b0316336001600160a01b031660008081546101369061020b565b9190508190557fedaf3c471ebd67d60c29efe34b639ede7d6a1d92eaeb3f503e784971e67118a5858560405161016d929190610232565b60405180910390a4505050565b60008060006040848603121561018f57600080fd5b83356001600160a01b03811681146101a657600080fd5b9250602084013567ffffffffffffffff808211156101c357600080fd5b818601915086601f8301126101d757600080fd5b8135818111156101e657600080fd5b8760208285010111156101f857600080fd5b6020830194508093505050509250925092565b60006001820161022b57634e487b7160e01b600052601160045260246000fd5b5060010190565b60208152816020820152818360408301376000818301604090810191909152601f909201601f1916010191905056fea2646970667358221220d2d546f135265c71683c4923890de2d8ae66f3be17185690036424019a5309ae64736f6c63430008110033\",\n  \"linkReferences\": {},\n  \"deployedLinkReferences\": {}\n}\n"
 var StateReceiverArtifact string = "{\n  \"_format\": \"hh-sol-artifact-1\",\n  \"contractName\": \"StateReceiver\",\n  \"sourceName\": \"contracts/child/StateReceiver.sol\",\n  \"abi\": [\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"only\",\n          \"type\": \"string\"\n        }\n      ],\n      \"name\": \"Unauthorized\",\n      \"type\": \"error\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"startId\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"endId\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"bytes32\",\n          \"name\": \"root\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"name\": \"NewCommitment\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"uint256\",\n          \"name\": \"counter\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"bool\",\n          \"name\": \"status\",\n          \"type\": \"bool\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"bytes\",\n          \"name\": \"message\",\n          \"type\": \"bytes\"\n        }\n      ],\n      \"name\": \"StateSyncResult\",\n      \"type\": \"event\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TOKEN_CONTRACT\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"SYSTEM\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"bytes32[][]\",\n          \"name\": \"proofs\",\n          \"type\": \"bytes32[][]\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"id\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"address\",\n              \"name\": \"sender\",\n              \"type\": \"address\"\n            },\n            {\n              \"internalType\": \"address\",\n              \"name\": \"receiver\",\n              \"type\": \"address\"\n            },\n            {\n              \"internalType\": \"bytes\",\n              \"name\": \"data\",\n              \"type\": \"bytes\"\n            }\n          ],\n          \"internalType\": \"struct StateReceiver.StateSync[]\",\n          \"name\": \"objs\",\n          \"type\": \"tuple[]\"\n        }\n      ],\n      \"name\": \"batchExecute\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"root\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct StateReceiver.StateSyncCommitment\",\n          \"name\": \"commitment\",\n          \"type\": \"tuple\"\n        },\n        {\n          \"internalType\": \"bytes\",\n          \"name\": \"signature\",\n          \"type\": \"bytes\"\n        },\n        {\n          \"internalType\": \"bytes\",\n          \"name\": \"bitmap\",\n          \"type\": \"bytes\"\n        }\n      ],\n      \"name\": \"commit\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"commitmentCounter\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"commitmentIds\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"commitments\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"startId\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"endId\",\n          \"type\": \"uint256\"\n        },\n        {\n          \"internalType\": \"bytes32\",\n          \"name\": \"root\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"bytes32[]\",\n          \"name\": \"proof\",\n          \"type\": \"bytes32[]\"\n        },\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"id\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"address\",\n              \"name\": \"sender\",\n              \"type\": \"address\"\n            },\n            {\n              \"internalType\": \"address\",\n              \"name\": \"receiver\",\n              \"type\": \"address\"\n            },\n            {\n              \"internalType\": \"bytes\",\n              \"name\": \"data\",\n              \"type\": \"bytes\"\n            }\n          ],\n          \"internalType\": \"struct StateReceiver.StateSync\",\n          \"name\": \"obj\",\n          \"type\": \"tuple\"\n        }\n      ],\n      \"name\": \"execute\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"getCommitmentByStateSyncId\",\n      \"outputs\": [\n        {\n          \"components\": [\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"startId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"uint256\",\n              \"name\": \"endId\",\n              \"type\": \"uint256\"\n            },\n            {\n              \"internalType\": \"bytes32\",\n              \"name\": \"root\",\n              \"type\": \"bytes32\"\n            }\n          ],\n          \"internalType\": \"struct StateReceiver.StateSyncCommitment\",\n          \"name\": \"\",\n          \"type\": \"tuple\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"id\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"getRootByStateSyncId\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bytes32\",\n          \"name\": \"\",\n          \"type\": \"bytes32\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"lastCommittedId\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"processedStateSyncs\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    }\n  ],\n  \"bytecode\": \"0x608060405234801561001057600080fd5b50611428806100206000396000f3fe608060405234801561001057600080fd5b50600436106100e05760003560e01c80639017c127116100875780639017c127146101de578063947287cf146101f157806397e5230d146101fa578063ad240c2a14610204578063c59a18f71461020d578063c6df461714610220578063e0563ab114610233578063eb70ef441461023c57600080fd5b8063196f1b2d146100e557806323e281cf1461010b578063284017f5146101145780633b878c221461013557806349ce89971461013e57806350d5b95b1461018857806351351d531461019d578063544c5e0f146101ab575b600080fd5b6100f86100f3366004610e88565b610271565b6040519081526020015b60405180910390f35b6100f860325481565b61011d61202081565b6040516001600160a01b039091168152602001610102565b61011d61101081565b61016d61014c366004610e88565b60356020526000908152604090208054600182015460029092015490919083565b60408051938452602084019290925290820152606001610102565b61019b610196366004610eec565b6102f0565b005b61011d6002600160a01b0381565b6101ce6101b9366004610e88565b60346020526000908152604090205460ff1681565b6040519015158152602001610102565b61019b6101ec366004610f5b565b6103b7565b6100f861520881565b6100f8620249f081565b6100f860335481565b6100f861021b366004610e88565b610557565b61019b61022e366004611007565b610578565b61011d61203081565b61024f61024a366004610e88565b61075c565b6040805182518152602080840151908201529181015190820152606001610102565b60008060358161028260368661081f565b81526020810191909152604001600020600201549050806102ea5760405162461bcd60e51b815260206004820152601d60248201527f537461746552656365697665723a204e4f5f524f4f545f464f525f494400000060448201526064015b60405180910390fd5b92915050565b60006102fc823561075c565b805190915061036c906103109084356110a5565b8251602084015161032191906110a5565b61032c9060016110b8565b83604001518787876040516020016103449190611110565b604051602081830303815290604052805190602001206108cc9095949392919063ffffffff16565b6103a85760405162461bcd60e51b815260206004820152600d60248201526c24a72b20a624a22fa82927a7a360991b60448201526064016102e1565b6103b182610a68565b50505050565b8281811461041a5760405162461bcd60e51b815260206004820152602a60248201527f537461746552656365697665723a20554e4d4154434845445f4c454e4754485f604482015269504152414d455445525360b01b60648201526084016102e1565b60005b8181101561054f57600061045485858481811061043c5761043c6111ac565b905060200281019061044e91906111c2565b3561075c565b905060006105088260000151878786818110610472576104726111ac565b905060200281019061048491906111c2565b61048f9190356110a5565b835160208501516104a091906110a5565b6104ab9060016110b8565b84604001518b8b888181106104c2576104c26111ac565b90506020028101906104d491906111e2565b8b8b8a8181106104e6576104e66111ac565b90506020028101906104f891906111c2565b6040516020016103449190611110565b90508061051957505060010161041d565b61054586868581811061052e5761052e6111ac565b905060200281019061054091906111c2565b610a68565b505060010161041d565b505050505050565b6036818154811061056757600080fd5b600091825260209091200154905081565b336002600160a01b03146105bc5760405163973d02cb60e01b815260206004820152600a60248201526914d654d5115350d0531360b21b60448201526064016102e1565b6033546105ca9060016110b8565b85351461060c5760405162461bcd60e51b815260206004820152601060248201526f1253959053125117d4d510549517d25160821b60448201526064016102e1565b8435602086013510156106525760405162461bcd60e51b815260206004820152600e60248201526d1253959053125117d1539117d25160921b60448201526064016102e1565b60408051863560208083019190915287013581830152908601356060820152610697906080016040516020818303038152906040528051906020012085858585610c83565b603280548691603591600091826106ad8361122b565b90915550815260208082019290925260409081016000208335815591830135600183015582013560028201555050603680546001810182556000919091526020868101357f4a11f94e20a93c79f6ec743a1954ec4fc2c08429ae2122118bf234b2185c81b89092018290556033829055604080519088013581528735917f11efd893530b26afc66d488ff54cb15df117cb6e0e4a08c6dcb166d766c3bf3b910160405180910390a35050505050565b604080516060810182526000808252602082018190529181018290529061078460368461081f565b60365490915081036107e45760405162461bcd60e51b815260206004820152602360248201527f537461746552656365697665723a204e4f5f434f4d4d49544d454e545f464f5260448201526217d25160ea1b60648201526084016102e1565b600090815260356020908152604091829020825160608101845281548152600182015492810192909252600201549181019190915292915050565b81546000908103610832575060006102ea565b82546000905b8082101561087f57600061084c8383610d87565b6000878152602090209091508590820154111561086b57809150610879565b6108768160016110b8565b92505b50610838565b6000821180156108ab5750836108a88661089a6001866110a5565b600091825260209091200190565b54145b156108c4576108bb6001836110a5565b925050506102ea565b509392505050565b6000816108da866001610da9565b811461091f5760405162461bcd60e51b81526020600482015260146024820152730929cac82989288bea0a49e9e8cbe988a9c8ea8960631b60448201526064016102e1565b8587106109635760405162461bcd60e51b81526020600482015260126024820152710929cac82989288be988a828cbe929c888ab60731b60448201526064016102e1565b8761099f5760405162461bcd60e51b815260206004820152600c60248201526b24a72b20a624a22fa622a0a360a11b60448201526064016102e1565b8760005b82811015610a595760008686838181106109bf576109bf6111ac565b90506020020135905060028a6109d5919061125a565b600003610a0d576040805160208101859052908101829052606001604051602081830303815290604052805190602001209250610a3a565b60408051602081018390529081018490526060016040516020818303038152906040528051906020012092505b610a4560028b61126e565b99505080610a529061122b565b90506109a3565b50909414979650505050505050565b803560009081526034602052604090205460ff1615610ad85760405162461bcd60e51b815260206004820152602660248201527f537461746552656365697665723a2053544154455f53594e435f49535f50524f60448201526510d154d4d15160d21b60648201526084016102e1565b610ae86060820160408301611282565b6001600160a01b03163b600003610b3c576040805160208082526000908201819052918335917f31c652130602f3ce96ceaf8a4c2b8b49f049166c6fcf2eb31943a75ec7c936ae910160405180910390a350565b8035600090815260346020526040808220805460ff191660011790558190610b6a9060608501908501611282565b6001600160a01b03168335610b856040860160208701611282565b610b92606087018761129d565b604051602401610ba594939291906112e3565b60408051601f198184030181529181526020820180516001600160e01b031663eeb4994560e01b17905251610bda919061133c565b6000604051808303816000865af19150503d8060008114610c17576040519150601f19603f3d011682016040523d82523d6000602084013e610c1c565b606091505b509150915081610c3f5782356000908152603460205260409020805460ff191690555b81151583600001357f31c652130602f3ce96ceaf8a4c2b8b49f049166c6fcf2eb31943a75ec7c936ae83604051610c76919061134e565b60405180910390a3505050565b6000806120306001600160a01b0316620249f08888888888604051602001610caf959493929190611381565b60408051601f1981840301815290829052610cc99161133c565b6000604051808303818686fa925050503d8060008114610d05576040519150601f19603f3d011682016040523d82523d6000602084013e610d0a565b606091505b5091509150600081806020019051810190610d2591906113ba565b9050828015610d315750805b610d7d5760405162461bcd60e51b815260206004820152601d60248201527f5349474e41545552455f564552494649434154494f4e5f4641494c454400000060448201526064016102e1565b5050505050505050565b6000610d96600284841861126e565b610da2908484166110b8565b9392505050565b600080610db584610df4565b90506001836002811115610dcb57610dcb6113dc565b148015610ddb575083816001901b105b610de6576000610de9565b60015b60ff16019392505050565b600080608083901c15610e0957608092831c92015b604083901c15610e1b57604092831c92015b602083901c15610e2d57602092831c92015b601083901c15610e3f57601092831c92015b600883901c15610e5157600892831c92015b600483901c15610e6357600492831c92015b600283901c15610e7557600292831c92015b600183901c156102ea5760010192915050565b600060208284031215610e9a57600080fd5b5035919050565b60008083601f840112610eb357600080fd5b5081356001600160401b03811115610eca57600080fd5b6020830191508360208260051b8501011115610ee557600080fd5b9250929050565b600080600060408486031215610f0157600080fd5b83356001600160401b0380821115610f1857600080fd5b610f2487838801610ea1565b90955093506020860135915080821115610f3d57600080fd5b50840160808187031215610f5057600080fd5b809150509250925092565b60008060008060408587031215610f7157600080fd5b84356001600160401b0380821115610f8857600080fd5b610f9488838901610ea1565b90965094506020870135915080821115610fad57600080fd5b50610fba87828801610ea1565b95989497509550505050565b60008083601f840112610fd857600080fd5b5081356001600160401b03811115610fef57600080fd5b602083019150836020828501011115610ee557600080fd5b600080600080600085870360a081121561102057600080fd5b606081121561102e57600080fd5b5085945060608601356001600160401b038082111561104c57600080fd5b61105889838a01610fc6565b9096509450608088013591508082111561107157600080fd5b5061107e88828901610fc6565b969995985093965092949392505050565b634e487b7160e01b600052601160045260246000fd5b818103818111156102ea576102ea61108f565b808201808211156102ea576102ea61108f565b80356001600160a01b03811681146110e257600080fd5b919050565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b60208152813560208201526000611129602084016110cb565b60018060a01b03808216604085015280611145604087016110cb565b16606085015250506060830135601e1984360301811261116457600080fd5b83016020810190356001600160401b0381111561118057600080fd5b80360382131561118f57600080fd5b6080808501526111a360a0850182846110e7565b95945050505050565b634e487b7160e01b600052603260045260246000fd5b60008235607e198336030181126111d857600080fd5b9190910192915050565b6000808335601e198436030181126111f957600080fd5b8301803591506001600160401b0382111561121357600080fd5b6020019150600581901b3603821315610ee557600080fd5b60006001820161123d5761123d61108f565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008261126957611269611244565b500690565b60008261127d5761127d611244565b500490565b60006020828403121561129457600080fd5b610da2826110cb565b6000808335601e198436030181126112b457600080fd5b8301803591506001600160401b038211156112ce57600080fd5b602001915036819003821315610ee557600080fd5b8481526001600160a01b038416602082015260606040820181905260009061130e90830184866110e7565b9695505050505050565b60005b8381101561133357818101518382015260200161131b565b50506000910152565b600082516111d8818460208701611318565b602081526000825180602084015261136d816040850160208701611318565b601f01601f19169190910160400192915050565b85815260606020820152600061139b6060830186886110e7565b82810360408401526113ae8185876110e7565b98975050505050505050565b6000602082840312156113cc57600080fd5b81518015158114610da257600080fd5b634e487b7160e01b600052602160045260246000fdfea2646970667358221220f12de4c572934572c3dae6184bcfe87730e8a0938ba1504547710fd27554218264736f6c63430008110033\",\n  \"deployedBytecode\": \"0x608060405234801561001057600080fd5b50600436106100e05760003560e01c80639017c127116100875780639017c127146101de578063947287cf146101f157806397e5230d146101fa578063ad240c2a14610204578063c59a18f71461020d578063c6df461714610220578063e0563ab114610233578063eb70ef441461023c57600080fd5b8063196f1b2d146100e557806323e281cf1461010b578063284017f5146101145780633b878c221461013557806349ce89971461013e57806350d5b95b1461018857806351351d531461019d578063544c5e0f146101ab575b600080fd5b6100f86100f3366004610e88565b610271565b6040519081526020015b60405180910390f35b6100f860325481565b61011d61202081565b6040516001600160a01b039091168152602001610102565b61011d61101081565b61016d61014c366004610e88565b60356020526000908152604090208054600182015460029092015490919083565b60408051938452602084019290925290820152606001610102565b61019b610196366004610eec565b6102f0565b005b61011d6002600160a01b0381565b6101ce6101b9366004610e88565b60346020526000908152604090205460ff1681565b6040519015158152602001610102565b61019b6101ec366004610f5b565b6103b7565b6100f861520881565b6100f8620249f081565b6100f860335481565b6100f861021b366004610e88565b610557565b61019b61022e366004611007565b610578565b61011d61203081565b61024f61024a366004610e88565b61075c565b6040805182518152602080840151908201529181015190820152606001610102565b60008060358161028260368661081f565b81526020810191909152604001600020600201549050806102ea5760405162461bcd60e51b815260206004820152601d60248201527f537461746552656365697665723a204e4f5f524f4f545f464f525f494400000060448201526064015b60405180910390fd5b92915050565b60006102fc823561075c565b805190915061036c906103109084356110a5565b8251602084015161032191906110a5565b61032c9060016110b8565b83604001518787876040516020016103449190611110565b604051602081830303815290604052805190602001206108cc9095949392919063ffffffff16565b6103a85760405162461bcd60e51b815260206004820152600d60248201526c24a72b20a624a22fa82927a7a360991b60448201526064016102e1565b6103b182610a68565b50505050565b8281811461041a5760405162461bcd60e51b815260206004820152602a60248201527f537461746552656365697665723a20554e4d4154434845445f4c454e4754485f604482015269504152414d455445525360b01b60648201526084016102e1565b60005b8181101561054f57600061045485858481811061043c5761043c6111ac565b905060200281019061044e91906111c2565b3561075c565b905060006105088260000151878786818110610472576104726111ac565b905060200281019061048491906111c2565b61048f9190356110a5565b835160208501516104a091906110a5565b6104ab9060016110b8565b84604001518b8b888181106104c2576104c26111ac565b90506020028101906104d491906111e2565b8b8b8a8181106104e6576104e66111ac565b90506020028101906104f891906111c2565b6040516020016103449190611110565b90508061051957505060010161041d565b61054586868581811061052e5761052e6111ac565b905060200281019061054091906111c2565b610a68565b505060010161041d565b505050505050565b6036818154811061056757600080fd5b600091825260209091200154905081565b336002600160a01b03146105bc5760405163973d02cb60e01b815260206004820152600a60248201526914d654d5115350d0531360b21b60448201526064016102e1565b6033546105ca9060016110b8565b85351461060c5760405162461bcd60e51b815260206004820152601060248201526f1253959053125117d4d510549517d25160821b60448201526064016102e1565b8435602086013510156106525760405162461bcd60e51b815260206004820152600e60248201526d1253959053125117d1539117d25160921b60448201526064016102e1565b60408051863560208083019190915287013581830152908601356060820152610697906080016040516020818303038152906040528051906020012085858585610c83565b603280548691603591600091826106ad8361122b565b90915550815260208082019290925260409081016000208335815591830135600183015582013560028201555050603680546001810182556000919091526020868101357f4a11f94e20a93c79f6ec743a1954ec4fc2c08429ae2122118bf234b2185c81b89092018290556033829055604080519088013581528735917f11efd893530b26afc66d488ff54cb15df117cb6e0e4a08c6dcb166d766c3bf3b910160405180910390a35050505050565b604080516060810182526000808252602082018190529181018290529061078460368461081f565b60365490915081036107e45760405162461bcd60e51b815260206004820152602360248201527f537461746552656365697665723a204e4f5f434f4d4d49544d454e545f464f5260448201526217d25160ea1b60648201526084016102e1565b600090815260356020908152604091829020825160608101845281548152600182015492810192909252600201549181019190915292915050565b81546000908103610832575060006102ea565b82546000905b8082101561087f57600061084c8383610d87565b6000878152602090209091508590820154111561086b57809150610879565b6108768160016110b8565b92505b50610838565b6000821180156108ab5750836108a88661089a6001866110a5565b600091825260209091200190565b54145b156108c4576108bb6001836110a5565b925050506102ea565b509392505050565b6000816108da866001610da9565b811461091f5760405162461bcd60e51b81526020600482015260146024820152730929cac82989288bea0a49e9e8cbe988a9c8ea8960631b60448201526064016102e1565b8587106109635760405162461bcd60e51b81526020600482015260126024820152710929cac82989288be988a828cbe929c888ab60731b60448201526064016102e1565b8761099f5760405162461bcd60e51b815260206004820152600c60248201526b24a72b20a624a22fa622a0a360a11b60448201526064016102e1565b8760005b82811015610a595760008686838181106109bf576109bf6111ac565b90506020020135905060028a6109d5919061125a565b600003610a0d576040805160208101859052908101829052606001604051602081830303815290604052805190602001209250610a3a565b60408051602081018390529081018490526060016040516020818303038152906040528051906020012092505b610a4560028b61126e565b99505080610a529061122b565b90506109a3565b50909414979650505050505050565b803560009081526034602052604090205460ff1615610ad85760405162461bcd60e51b815260206004820152602660248201527f537461746552656365697665723a2053544154455f53594e435f49535f50524f60448201526510d154d4d15160d21b60648201526084016102e1565b610ae86060820160408301611282565b6001600160a01b03163b600003610b3c576040805160208082526000908201819052918335917f31c652130602f3ce96ceaf8a4c2b8b49f049166c6fcf2eb31943a75ec7c936ae910160405180910390a350565b8035600090815260346020526040808220805460ff191660011790558190610b6a9060608501908501611282565b6001600160a01b03168335610b856040860160208701611282565b610b92606087018761129d565b604051602401610ba594939291906112e3565b60408051601f198184030181529181526020820180516001600160e01b031663eeb4994560e01b17905251610bda919061133c565b6000604051808303816000865af19150503d8060008114610c17576040519150601f19603f3d011682016040523d82523d6000602084013e610c1c565b606091505b509150915081610c3f5782356000908152603460205260409020805460ff191690555b81151583600001357f31c652130602f3ce96ceaf8a4c2b8b49f049166c6fcf2eb31943a75ec7c936ae83604051610c76919061134e565b60405180910390a3505050565b6000806120306001600160a01b0316620249f08888888888604051602001610caf959493929190611381565b60408051601f1981840301815290829052610cc99161133c565b6000604051808303818686fa925050503d8060008114610d05576040519150601f19603f3d011682016040523d82523d6000602084013e610d0a565b606091505b5091509150600081806020019051810190610d2591906113ba565b9050828015610d315750805b610d7d5760405162461bcd60e51b815260206004820152601d60248201527f5349474e41545552455f564552494649434154494f4e5f4641494c454400000060448201526064016102e1565b5050505050505050565b6000610d96600284841861126e565b610da2908484166110b8565b9392505050565b600080610db584610df4565b90506001836002811115610dcb57610dcb6113dc565b148015610ddb575083816001901b105b610de6576000610de9565b60015b60ff16019392505050565b600080608083901c15610e0957608092831c92015b604083901c15610e1b57604092831c92015b602083901c15610e2d57602092831c92015b601083901c15610e3f57601092831c92015b600883901c15610e5157600892831c92015b600483901c15610e6357600492831c92015b600283901c15610e7557600292831c92015b600183901c156102ea5760010192915050565b600060208284031215610e9a57600080fd5b5035919050565b60008083601f840112610eb357600080fd5b5081356001600160401b03811115610eca57600080fd5b6020830191508360208260051b8501011115610ee557600080fd5b9250929050565b600080600060408486031215610f0157600080fd5b83356001600160401b0380821115610f1857600080fd5b610f2487838801610ea1565b90955093506020860135915080821115610f3d57600080fd5b50840160808187031215610f5057600080fd5b809150509250925092565b60008060008060408587031215610f7157600080fd5b84356001600160401b0380821115610f8857600080fd5b610f9488838901610ea1565b90965094506020870135915080821115610fad57600080fd5b50610fba87828801610ea1565b95989497509550505050565b60008083601f840112610fd857600080fd5b5081356001600160401b03811115610fef57600080fd5b602083019150836020828501011115610ee557600080fd5b600080600080600085870360a081121561102057600080fd5b606081121561102e57600080fd5b5085945060608601356001600160401b038082111561104c57600080fd5b61105889838a01610fc6565b9096509450608088013591508082111561107157600080fd5b5061107e88828901610fc6565b969995985093965092949392505050565b634e487b7160e01b600052601160045260246000fd5b818103818111156102ea576102ea61108f565b808201808211156102ea576102ea61108f565b80356001600160a01b03811681146110e257600080fd5b919050565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b60208152813560208201526000611129602084016110cb565b60018060a01b03808216604085015280611145604087016110cb565b16606085015250506060830135601e1984360301811261116457600080fd5b83016020810190356001600160401b0381111561118057600080fd5b80360382131561118f57600080fd5b6080808501526111a360a0850182846110e7565b95945050505050565b634e487b7160e01b600052603260045260246000fd5b60008235607e198336030181126111d857600080fd5b9190910192915050565b6000808335601e198436030181126111f957600080fd5b8301803591506001600160401b0382111561121357600080fd5b6020019150600581901b3603821315610ee557600080fd5b60006001820161123d5761123d61108f565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008261126957611269611244565b500690565b60008261127d5761127d611244565b500490565b60006020828403121561129457600080fd5b610da2826110cb565b6000808335601e198436030181126112b457600080fd5b8301803591506001600160401b038211156112ce57600080fd5b602001915036819003821315610ee557600080fd5b8481526001600160a01b038416602082015260606040820181905260009061130e90830184866110e7565b9695505050505050565b60005b8381101561133357818101518382015260200161131b565b50506000910152565b600082516111d8818460208701611318565b602081526000825180602084015261136d816040850160208701611318565b601f01601f19169190910160400192915050565b85815260606020820152600061139b6060830186886110e7565b82810360408401526113ae8185876110e7565b98975050505050505050565b6000602082840312156113cc57600080fd5b81518015158114610da257600080fd5b634e487b7160e01b600052602160045260246000fdfea2646970667358221220f12de4c572934572c3dae6184bcfe87730e8a0938ba1504547710fd27554218264736f6c63430008110033\",\n  \"linkReferences\": {},\n  \"deployedLinkReferences\": {}\n}\n"
 var NativeERC20Artifact string = "{\n  \"_format\": \"hh-sol-artifact-1\",\n  \"contractName\": \"NativeERC20\",\n  \"sourceName\": \"contracts/child/NativeERC20.sol\",\n  \"abi\": [\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"only\",\n          \"type\": \"string\"\n        }\n      ],\n      \"name\": \"Unauthorized\",\n      \"type\": \"error\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"owner\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"spender\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"value\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Approval\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint8\",\n          \"name\": \"version\",\n          \"type\": \"uint8\"\n        }\n      ],\n      \"name\": \"Initialized\",\n      \"type\": \"event\"\n    },\n    {\n      \"anonymous\": false,\n      \"inputs\": [\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"from\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": true,\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        },\n        {\n          \"indexed\": false,\n          \"internalType\": \"uint256\",\n          \"name\": \"value\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"Transfer\",\n      \"type\": \"event\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TOKEN_CONTRACT\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"NATIVE_TRANSFER_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"SYSTEM\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"VALIDATOR_PKCHECK_PRECOMPILE_GAS\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"owner\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"spender\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"allowance\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"spender\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"approve\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        }\n      ],\n      \"name\": \"balanceOf\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"burn\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"decimals\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint8\",\n          \"name\": \"\",\n          \"type\": \"uint8\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"spender\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"subtractedValue\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"decreaseAllowance\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"spender\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"addedValue\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"increaseAllowance\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"predicate_\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"rootToken_\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"string\",\n          \"name\": \"name_\",\n          \"type\": \"string\"\n        },\n        {\n          \"internalType\": \"string\",\n          \"name\": \"symbol_\",\n          \"type\": \"string\"\n        },\n        {\n          \"internalType\": \"uint8\",\n          \"name\": \"decimals_\",\n          \"type\": \"uint8\"\n        }\n      ],\n      \"name\": \"initialize\",\n      \"outputs\": [],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"account\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"mint\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"name\",\n      \"outputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"\",\n          \"type\": \"string\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"predicate\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"rootToken\",\n      \"outputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"\",\n          \"type\": \"address\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"symbol\",\n      \"outputs\": [\n        {\n          \"internalType\": \"string\",\n          \"name\": \"\",\n          \"type\": \"string\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [],\n      \"name\": \"totalSupply\",\n      \"outputs\": [\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"stateMutability\": \"view\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"transfer\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    },\n    {\n      \"inputs\": [\n        {\n          \"internalType\": \"address\",\n          \"name\": \"from\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"address\",\n          \"name\": \"to\",\n          \"type\": \"address\"\n        },\n        {\n          \"internalType\": \"uint256\",\n          \"name\": \"amount\",\n          \"type\": \"uint256\"\n        }\n      ],\n      \"name\": \"transferFrom\",\n      \"outputs\": [\n        {\n          \"internalType\": \"bool\",\n          \"name\": \"\",\n          \"type\": \"bool\"\n        }\n      ],\n      \"stateMutability\": \"nonpayable\",\n      \"type\": \"function\"\n    }\n  ],\n  \"bytecode\": \"0x608060405234801561001057600080fd5b506111c3806100206000396000f3fe608060405234801561001057600080fd5b50600436106101425760003560e01c806370a08231116100b85780639dc29fac1161007c5780639dc29fac14610278578063a457c2d71461028b578063a9059cbb1461029e578063dd62ed3e146102b1578063e0563ab1146102c4578063e6198705146102cd57600080fd5b806370a082311461022d5780638420ce9914610248578063947287cf1461025d57806395d89b411461026657806397e5230d1461026e57600080fd5b8063284017f51161010a578063284017f5146101d2578063313ce567146101db57806339509351146101f05780633b878c221461020357806340c10f191461020c57806351351d531461021f57600080fd5b806306fdde0314610147578063095ea7b31461016557806318160ddd146101885780631f2d00651461019a57806323b872dd146101bf575b600080fd5b61014f6102de565b60405161015c9190610cfd565b60405180910390f35b610178610173366004610d4c565b610370565b604051901515815260200161015c565b6034545b60405190815260200161015c565b6036546001600160a01b03165b6040516001600160a01b03909116815260200161015c565b6101786101cd366004610d76565b61038a565b6101a761202081565b60395460405160ff909116815260200161015c565b6101786101fe366004610d4c565b6103ae565b6101a761101081565b61017861021a366004610d4c565b6103d0565b6101a76002600160a01b0381565b61018c61023b366004610db2565b6001600160a01b03163190565b61025b610256366004610e1d565b610419565b005b61018c61520881565b61014f6105c8565b61018c620249f081565b610178610286366004610d4c565b6105d7565b610178610299366004610d4c565b61060e565b6101786102ac366004610d4c565b610689565b61018c6102bf366004610ec8565b610697565b6101a761203081565b6035546001600160a01b03166101a7565b6060603780546102ed90610efb565b80601f016020809104026020016040519081016040528092919081815260200182805461031990610efb565b80156103665780601f1061033b57610100808354040283529160200191610366565b820191906000526020600020905b81548152906001019060200180831161034957829003601f168201915b5050505050905090565b60003361037e8185856106c2565b60019150505b92915050565b6000336103988582856107e6565b6103a3858585610860565b506001949350505050565b60003361037e8185856103c18383610697565b6103cb9190610f4b565b6106c2565b6035546000906001600160a01b031633146104065760405162461bcd60e51b81526004016103fd90610f5e565b60405180910390fd5b6104108383610a25565b50600192915050565b600054610100900460ff16158080156104395750600054600160ff909116105b806104535750303b158015610453575060005460ff166001145b6104b65760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b60648201526084016103fd565b6000805460ff1916600117905580156104d9576000805461ff0019166101001790555b336002600160a01b031461051d5760405163973d02cb60e01b815260206004820152600a60248201526914d654d5115350d0531360b21b60448201526064016103fd565b603580546001600160a01b03808b166001600160a01b03199283161790925560368054928a1692909116919091179055603761055a868883611007565b506038610568848683611007565b506039805460ff191660ff841617905580156105be576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b5050505050505050565b6060603880546102ed90610efb565b6035546000906001600160a01b031633146106045760405162461bcd60e51b81526004016103fd90610f5e565b6104108383610b7f565b6000338161061c8286610697565b90508381101561067c5760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084016103fd565b6103a382868684036106c2565b60003361037e818585610860565b6001600160a01b03918216600090815260336020908152604080832093909416825291909152205490565b6001600160a01b0383166107245760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016103fd565b6001600160a01b0382166107855760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016103fd565b6001600160a01b0383811660008181526033602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b60006107f28484610697565b9050600019811461085a578181101561084d5760405162461bcd60e51b815260206004820152601d60248201527f45524332303a20696e73756666696369656e7420616c6c6f77616e636500000060448201526064016103fd565b61085a84848484036106c2565b50505050565b6001600160a01b0383166108c45760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b60648201526084016103fd565b6001600160a01b0382166109265760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b60648201526084016103fd565b6000806120206001600160a01b031685858560405160200161094a939291906110c8565b60408051601f1981840301815290829052610964916110ec565b6000604051808303816000865af19150503d80600081146109a1576040519150601f19603f3d011682016040523d82523d6000602084013e6109a6565b606091505b50915091508180156109c75750808060200190518101906109c79190611108565b6109e35760405162461bcd60e51b81526004016103fd9061112a565b836001600160a01b0316856001600160a01b031660008051602061116e83398151915285604051610a1691815260200190565b60405180910390a35050505050565b6001600160a01b038216610a7b5760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f20616464726573730060448201526064016103fd565b8060346000828254610a8d9190610f4b565b9091555050604051600090819061202090610ab0908390879087906020016110c8565b60408051601f1981840301815290829052610aca916110ec565b6000604051808303816000865af19150503d8060008114610b07576040519150601f19603f3d011682016040523d82523d6000602084013e610b0c565b606091505b5091509150818015610b2d575080806020019051810190610b2d9190611108565b610b495760405162461bcd60e51b81526004016103fd9061112a565b6040518381526001600160a01b0385169060009060008051602061116e833981519152906020015b60405180910390a350505050565b6001600160a01b038216610bdf5760405162461bcd60e51b815260206004820152602160248201527f45524332303a206275726e2066726f6d20746865207a65726f206164647265736044820152607360f81b60648201526084016103fd565b8060346000828254610bf1919061115a565b9091555050604051600090819061202090610c14908690849087906020016110c8565b60408051601f1981840301815290829052610c2e916110ec565b6000604051808303816000865af19150503d8060008114610c6b576040519150601f19603f3d011682016040523d82523d6000602084013e610c70565b606091505b5091509150818015610c91575080806020019051810190610c919190611108565b610cad5760405162461bcd60e51b81526004016103fd9061112a565b6040518381526000906001600160a01b0386169060008051602061116e83398151915290602001610b71565b60005b83811015610cf4578181015183820152602001610cdc565b50506000910152565b6020815260008251806020840152610d1c816040850160208701610cd9565b601f01601f19169190910160400192915050565b80356001600160a01b0381168114610d4757600080fd5b919050565b60008060408385031215610d5f57600080fd5b610d6883610d30565b946020939093013593505050565b600080600060608486031215610d8b57600080fd5b610d9484610d30565b9250610da260208501610d30565b9150604084013590509250925092565b600060208284031215610dc457600080fd5b610dcd82610d30565b9392505050565b60008083601f840112610de657600080fd5b50813567ffffffffffffffff811115610dfe57600080fd5b602083019150836020828501011115610e1657600080fd5b9250929050565b600080600080600080600060a0888a031215610e3857600080fd5b610e4188610d30565b9650610e4f60208901610d30565b9550604088013567ffffffffffffffff80821115610e6c57600080fd5b610e788b838c01610dd4565b909750955060608a0135915080821115610e9157600080fd5b50610e9e8a828b01610dd4565b909450925050608088013560ff81168114610eb857600080fd5b8091505092959891949750929550565b60008060408385031215610edb57600080fd5b610ee483610d30565b9150610ef260208401610d30565b90509250929050565b600181811c90821680610f0f57607f821691505b602082108103610f2f57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561038457610384610f35565b60208082526024908201527f4e617469766545524332303a204f6e6c79207072656469636174652063616e2060408201526318d85b1b60e21b606082015260800190565b634e487b7160e01b600052604160045260246000fd5b601f82111561100257600081815260208120601f850160051c81016020861015610fdf5750805b601f850160051c820191505b81811015610ffe57828155600101610feb565b5050505b505050565b67ffffffffffffffff83111561101f5761101f610fa2565b6110338361102d8354610efb565b83610fb8565b6000601f841160018114611067576000851561104f5750838201355b600019600387901b1c1916600186901b1783556110c1565b600083815260209020601f19861690835b828110156110985786850135825560209485019460019092019101611078565b50868210156110b55760001960f88860031b161c19848701351681555b505060018560011b0183555b5050505050565b6001600160a01b039384168152919092166020820152604081019190915260600190565b600082516110fe818460208701610cd9565b9190910192915050565b60006020828403121561111a57600080fd5b81518015158114610dcd57600080fd5b60208082526016908201527514149150d3d35412531157d0d0531317d1905253115160521b604082015260600190565b8181038181111561038457610384610f3556feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201cc2b3ea246b46198f0ff59b53d69a9ac96c47a287d6ed78414118383506ad4264736f6c63430008110033\",\n  \"deployedBytecode\": \"0x608060405234801561001057600080fd5b50600436106101425760003560e01c806370a08231116100b85780639dc29fac1161007c5780639dc29fac14610278578063a457c2d71461028b578063a9059cbb1461029e578063dd62ed3e146102b1578063e0563ab1146102c4578063e6198705146102cd57600080fd5b806370a082311461022d5780638420ce9914610248578063947287cf1461025d57806395d89b411461026657806397e5230d1461026e57600080fd5b8063284017f51161010a578063284017f5146101d2578063313ce567146101db57806339509351146101f05780633b878c221461020357806340c10f191461020c57806351351d531461021f57600080fd5b806306fdde0314610147578063095ea7b31461016557806318160ddd146101885780631f2d00651461019a57806323b872dd146101bf575b600080fd5b61014f6102de565b60405161015c9190610cfd565b60405180910390f35b610178610173366004610d4c565b610370565b604051901515815260200161015c565b6034545b60405190815260200161015c565b6036546001600160a01b03165b6040516001600160a01b03909116815260200161015c565b6101786101cd366004610d76565b61038a565b6101a761202081565b60395460405160ff909116815260200161015c565b6101786101fe366004610d4c565b6103ae565b6101a761101081565b61017861021a366004610d4c565b6103d0565b6101a76002600160a01b0381565b61018c61023b366004610db2565b6001600160a01b03163190565b61025b610256366004610e1d565b610419565b005b61018c61520881565b61014f6105c8565b61018c620249f081565b610178610286366004610d4c565b6105d7565b610178610299366004610d4c565b61060e565b6101786102ac366004610d4c565b610689565b61018c6102bf366004610ec8565b610697565b6101a761203081565b6035546001600160a01b03166101a7565b6060603780546102ed90610efb565b80601f016020809104026020016040519081016040528092919081815260200182805461031990610efb565b80156103665780601f1061033b57610100808354040283529160200191610366565b820191906000526020600020905b81548152906001019060200180831161034957829003601f168201915b5050505050905090565b60003361037e8185856106c2565b60019150505b92915050565b6000336103988582856107e6565b6103a3858585610860565b506001949350505050565b60003361037e8185856103c18383610697565b6103cb9190610f4b565b6106c2565b6035546000906001600160a01b031633146104065760405162461bcd60e51b81526004016103fd90610f5e565b60405180910390fd5b6104108383610a25565b50600192915050565b600054610100900460ff16158080156104395750600054600160ff909116105b806104535750303b158015610453575060005460ff166001145b6104b65760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b60648201526084016103fd565b6000805460ff1916600117905580156104d9576000805461ff0019166101001790555b336002600160a01b031461051d5760405163973d02cb60e01b815260206004820152600a60248201526914d654d5115350d0531360b21b60448201526064016103fd565b603580546001600160a01b03808b166001600160a01b03199283161790925560368054928a1692909116919091179055603761055a868883611007565b506038610568848683611007565b506039805460ff191660ff841617905580156105be576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b5050505050505050565b6060603880546102ed90610efb565b6035546000906001600160a01b031633146106045760405162461bcd60e51b81526004016103fd90610f5e565b6104108383610b7f565b6000338161061c8286610697565b90508381101561067c5760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084016103fd565b6103a382868684036106c2565b60003361037e818585610860565b6001600160a01b03918216600090815260336020908152604080832093909416825291909152205490565b6001600160a01b0383166107245760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016103fd565b6001600160a01b0382166107855760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016103fd565b6001600160a01b0383811660008181526033602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b60006107f28484610697565b9050600019811461085a578181101561084d5760405162461bcd60e51b815260206004820152601d60248201527f45524332303a20696e73756666696369656e7420616c6c6f77616e636500000060448201526064016103fd565b61085a84848484036106c2565b50505050565b6001600160a01b0383166108c45760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b60648201526084016103fd565b6001600160a01b0382166109265760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b60648201526084016103fd565b6000806120206001600160a01b031685858560405160200161094a939291906110c8565b60408051601f1981840301815290829052610964916110ec565b6000604051808303816000865af19150503d80600081146109a1576040519150601f19603f3d011682016040523d82523d6000602084013e6109a6565b606091505b50915091508180156109c75750808060200190518101906109c79190611108565b6109e35760405162461bcd60e51b81526004016103fd9061112a565b836001600160a01b0316856001600160a01b031660008051602061116e83398151915285604051610a1691815260200190565b60405180910390a35050505050565b6001600160a01b038216610a7b5760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f20616464726573730060448201526064016103fd565b8060346000828254610a8d9190610f4b565b9091555050604051600090819061202090610ab0908390879087906020016110c8565b60408051601f1981840301815290829052610aca916110ec565b6000604051808303816000865af19150503d8060008114610b07576040519150601f19603f3d011682016040523d82523d6000602084013e610b0c565b606091505b5091509150818015610b2d575080806020019051810190610b2d9190611108565b610b495760405162461bcd60e51b81526004016103fd9061112a565b6040518381526001600160a01b0385169060009060008051602061116e833981519152906020015b60405180910390a350505050565b6001600160a01b038216610bdf5760405162461bcd60e51b815260206004820152602160248201527f45524332303a206275726e2066726f6d20746865207a65726f206164647265736044820152607360f81b60648201526084016103fd565b8060346000828254610bf1919061115a565b9091555050604051600090819061202090610c14908690849087906020016110c8565b60408051601f1981840301815290829052610c2e916110ec565b6000604051808303816000865af19150503d8060008114610c6b576040519150601f19603f3d011682016040523d82523d6000602084013e610c70565b606091505b5091509150818015610c91575080806020019051810190610c919190611108565b610cad5760405162461bcd60e51b81526004016103fd9061112a565b6040518381526000906001600160a01b0386169060008051602061116e83398151915290602001610b71565b60005b83811015610cf4578181015183820152602001610cdc565b50506000910152565b6020815260008251806020840152610d1c816040850160208701610cd9565b601f01601f19169190910160400192915050565b80356001600160a01b0381168114610d4757600080fd5b919050565b60008060408385031215610d5f57600080fd5b610d6883610d30565b946020939093013593505050565b600080600060608486031215610d8b57600080fd5b610d9484610d30565b9250610da260208501610d30565b9150604084013590509250925092565b600060208284031215610dc457600080fd5b610dcd82610d30565b9392505050565b60008083601f840112610de657600080fd5b50813567ffffffffffffffff811115610dfe57600080fd5b602083019150836020828501011115610e1657600080fd5b9250929050565b600080600080600080600060a0888a031215610e3857600080fd5b610e4188610d30565b9650610e4f60208901610d30565b9550604088013567ffffffffffffffff80821115610e6c57600080fd5b610e788b838c01610dd4565b909750955060608a0135915080821115610e9157600080fd5b50610e9e8a828b01610dd4565b909450925050608088013560ff81168114610eb857600080fd5b8091505092959891949750929550565b60008060408385031215610edb57600080fd5b610ee483610d30565b9150610ef260208401610d30565b90509250929050565b600181811c90821680610f0f57607f821691505b602082108103610f2f57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561038457610384610f35565b60208082526024908201527f4e617469766545524332303a204f6e6c79207072656469636174652063616e2060408201526318d85b1b60e21b606082015260800190565b634e487b7160e01b600052604160045260246000fd5b601f82111561100257600081815260208120601f850160051c81016020861015610fdf5750805b601f850160051c820191505b81811015610ffe57828155600101610feb565b5050505b505050565b67ffffffffffffffff83111561101f5761101f610fa2565b6110338361102d8354610efb565b83610fb8565b6000601f841160018114611067576000851561104f5750838201355b600019600387901b1c1916600186901b1783556110c1565b600083815260209020601f19861690835b828110156110985786850135825560209485019460019092019101611078565b50868210156110b55760001960f88860031b161c19848701351681555b505060018560011b0183555b5050505050565b6001600160a01b039384168152919092166020820152604081019190915260600190565b600082516110fe818460208701610cd9565b9190910192915050565b60006020828403121561111a57600080fd5b81518015158114610dcd57600080fd5b60208082526016908201527514149150d3d35412531157d0d0531317d1905253115160521b604082015260600190565b8181038181111561038457610384610f3556feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201cc2b3ea246b46198f0ff59b53d69a9ac96c47a287d6ed78414118383506ad4264736f6c63430008110033\",\n  \"linkReferences\": {},\n  \"deployedLinkReferences\": {}\n}\n"
@@ -39,3 +39,4 @@ func (u *Uptime) AddValidatorUptime(address types.Address, count int64) {
 }
 
 var _ StateTransactionInput = &CommitEpochChildValidatorSetFn{}
+var _ StateTransactionInput = &CommitEpochWithDoubleSignerSlashingChildValidatorSetFn{}
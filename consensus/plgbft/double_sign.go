@@ -0,0 +1,418 @@
+package plgbft
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/bitmap"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/helper/common"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/plg-ibft/messages/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// doubleSignLookback is the number of the latest heights whose signed messages are kept for double sign detection
+const doubleSignLookback = 3
+
+// DoubleSignEvidence is a proof that a validator signed two conflicting consensus messages
+// of the same type at the same height and round
+type DoubleSignEvidence struct {
+	Signer types.Address     `json:"signer"`
+	Epoch  uint64            `json:"epoch"`
+	Height uint64            `json:"height"`
+	Round  uint64            `json:"round"`
+	Type   proto.MessageType `json:"type"`
+	// Messages are the conflicting messages signed by the signer (protobuf marshalled)
+	Messages [][]byte `json:"messages"`
+	// SlashingInputs are the conflicting checkpoints sealed by the signer, in the form accepted by
+	// the validator set contract. They are available only for the conflicting COMMIT messages
+	// whose proposals were received
+	SlashingInputs []*contractsapi.DoubleSignerSlashingInput `json:"slashingInputs,omitempty"`
+}
+
+// key returns the key which identifies the misbehavior of the evidence within its epoch
+func (e *DoubleSignEvidence) key() []byte {
+	key := make([]byte, 0, 2*8+1+types.AddressLength)
+	key = append(key, common.EncodeUint64ToBytes(e.Height)...)
+	key = append(key, common.EncodeUint64ToBytes(e.Round)...)
+	key = append(key, byte(e.Type))
+
+	return append(key, e.Signer.Bytes()...)
+}
+
+// signedMessageKey identifies the consensus messages which a validator is allowed to sign only once
+type signedMessageKey struct {
+	height  uint64
+	round   uint64
+	msgType proto.MessageType
+	sender  types.Address
+}
+
+// proposalCheckpoint is the checkpoint of a received proposal, which is sealed by the COMMIT messages
+type proposalCheckpoint struct {
+	height     uint64
+	blockHash  types.Hash
+	checkpoint *CheckpointData
+}
+
+// doubleSignDetector keeps the first consensus message signed by each validator per height, round and type,
+// and detects the messages which conflict with them
+type doubleSignDetector struct {
+	lock sync.Mutex
+
+	// messages are the first signed messages of the validators
+	messages map[signedMessageKey]*proto.Message
+
+	// checkpoints are the checkpoints of the received proposals by their proposal hashes
+	checkpoints map[types.Hash]*proposalCheckpoint
+}
+
+// newDoubleSignDetector creates a new double sign detector
+func newDoubleSignDetector() *doubleSignDetector {
+	return &doubleSignDetector{
+		messages:    make(map[signedMessageKey]*proto.Message),
+		checkpoints: make(map[types.Hash]*proposalCheckpoint),
+	}
+}
+
+// addProposal records the checkpoint of the proposal with the given hash
+func (d *doubleSignDetector) addProposal(proposalHash types.Hash, checkpoint *proposalCheckpoint) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.checkpoints[proposalHash] = checkpoint
+}
+
+// getProposal returns the recorded checkpoint of the proposal with the given hash
+func (d *doubleSignDetector) getProposal(proposalHash types.Hash) (*proposalCheckpoint, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	checkpoint, ok := d.checkpoints[proposalHash]
+
+	return checkpoint, ok
+}
+
+// addMessage records the message signed by the given sender and returns the previously
+// recorded message of the sender which conflicts with it (if any)
+func (d *doubleSignDetector) addMessage(sender types.Address, msg *proto.Message) *proto.Message {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	key := signedMessageKey{
+		height:  msg.View.Height,
+		round:   msg.View.Round,
+		msgType: msg.Type,
+		sender:  sender,
+	}
+
+	recorded, ok := d.messages[key]
+	if !ok {
+		d.messages[key] = msg
+
+		return nil
+	}
+
+	if bytes.Equal(getProposalHash(recorded), getProposalHash(msg)) {
+		return nil
+	}
+
+	return recorded
+}
+
+// prune removes the messages and the proposals below the given height
+func (d *doubleSignDetector) prune(height uint64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for key := range d.messages {
+		if key.height < height {
+			delete(d.messages, key)
+		}
+	}
+
+	for hash, checkpoint := range d.checkpoints {
+		if checkpoint.height < height {
+			delete(d.checkpoints, hash)
+		}
+	}
+}
+
+// getProposalHash returns the proposal hash signed by the given message,
+// or nil if the message doesn't sign any proposal
+func getProposalHash(msg *proto.Message) []byte {
+	switch msg.Type {
+	case proto.MessageType_PREPREPARE:
+		return msg.GetPreprepareData().GetProposalHash()
+	case proto.MessageType_PREPARE:
+		return msg.GetPrepareData().GetProposalHash()
+	case proto.MessageType_COMMIT:
+		return msg.GetCommitData().GetProposalHash()
+	default:
+		return nil
+	}
+}
+
+// checkDoubleSign records the given consensus message and persists the double sign evidence
+// if its sender has already signed a conflicting message at the same height and round
+func (c *consensusRuntime) checkDoubleSign(msg *proto.Message) error {
+	if msg.View == nil || len(getProposalHash(msg)) == 0 {
+		return nil
+	}
+
+	c.lock.RLock()
+	epoch, lastBuiltBlock := c.epoch, c.lastBuiltBlock
+	c.lock.RUnlock()
+
+	// only the messages of the current epoch, close to the head, are checked
+	height := msg.View.Height
+	if height < epoch.FirstBlockInEpoch || height > lastBuiltBlock.Number+1 ||
+		height+doubleSignLookback <= lastBuiltBlock.Number {
+		return nil
+	}
+
+	msgNoSig, err := msg.PayloadNoSig()
+	if err != nil {
+		return err
+	}
+
+	sender, err := wallet.RecoverAddressFromSignature(msg.Signature, msgNoSig)
+	if err != nil {
+		return fmt.Errorf("failed to recover address from signature: %w", err)
+	}
+
+	if !bytes.Equal(msg.From, sender.Bytes()) || !epoch.Validators.ContainsAddress(sender) {
+		return nil
+	}
+
+	if msg.Type == proto.MessageType_PREPREPARE {
+		c.addProposalCheckpoint(msg)
+	}
+
+	conflicting := c.doubleSignDetector.addMessage(sender, msg)
+	if conflicting == nil {
+		return nil
+	}
+
+	evidence, err := c.newDoubleSignEvidence(sender, epoch, conflicting, msg)
+	if err != nil {
+		return err
+	}
+
+	inserted, err := c.state.EvidenceStore.insertEvidence(evidence)
+	if err != nil {
+		return fmt.Errorf("failed to persist double sign evidence: %w", err)
+	}
+
+	if inserted {
+		metrics.IncrCounter([]string{consensusMetricsPrefix, "double_signs"}, 1)
+
+		c.logger.Warn("double sign detected",
+			"signer", sender,
+			"type", msg.Type.String(),
+			"height", height,
+			"round", msg.View.Round,
+			"slashable", len(evidence.SlashingInputs) > 0,
+		)
+	}
+
+	return nil
+}
+
+// addProposalCheckpoint records the checkpoint of the proposal from the given PREPREPARE message,
+// so that the conflicting commits of it can be slashed
+func (c *consensusRuntime) addProposalCheckpoint(msg *proto.Message) {
+	data := msg.GetPreprepareData()
+	if len(data.GetProposal().GetRawProposal()) == 0 {
+		return
+	}
+
+	var block types.Block
+	if err := block.UnmarshalRLP(data.Proposal.RawProposal); err != nil || block.Number() != msg.View.Height {
+		return
+	}
+
+	extra, err := GetIbftExtra(block.Header.ExtraData)
+	if err != nil || extra.Checkpoint == nil {
+		return
+	}
+
+	proposalHash, err := extra.Checkpoint.Hash(c.config.blockchain.GetChainID(), block.Number(), block.Hash())
+	if err != nil || !bytes.Equal(proposalHash.Bytes(), data.ProposalHash) {
+		return
+	}
+
+	c.doubleSignDetector.addProposal(proposalHash, &proposalCheckpoint{
+		height:     block.Number(),
+		blockHash:  block.Hash(),
+		checkpoint: extra.Checkpoint,
+	})
+}
+
+// newDoubleSignEvidence creates the evidence of the conflicting messages signed by the given sender.
+// Conflicting COMMIT messages whose proposals are known are converted to the slashing inputs
+func (c *consensusRuntime) newDoubleSignEvidence(
+	sender types.Address,
+	epoch *epochMetadata,
+	messages ...*proto.Message,
+) (*DoubleSignEvidence, error) {
+	msg := messages[len(messages)-1]
+	evidence := &DoubleSignEvidence{
+		Signer: sender,
+		Epoch:  epoch.Number,
+		Height: msg.View.Height,
+		Round:  msg.View.Round,
+		Type:   msg.Type,
+	}
+
+	for _, m := range messages {
+		raw, err := protobuf.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+
+		evidence.Messages = append(evidence.Messages, raw)
+	}
+
+	if msg.Type != proto.MessageType_COMMIT {
+		return evidence, nil
+	}
+
+	chainID := c.config.blockchain.GetChainID()
+	inputs := make([]*contractsapi.DoubleSignerSlashingInput, 0, len(messages))
+
+	for _, m := range messages {
+		commit := m.GetCommitData()
+
+		proposal, ok := c.doubleSignDetector.getProposal(types.BytesToHash(commit.ProposalHash))
+		if !ok || proposal.checkpoint.BlockRound != evidence.Round ||
+			proposal.checkpoint.EpochNumber != epoch.Number {
+			return evidence, nil
+		}
+
+		input := newDoubleSignerSlashingInput(epoch.Validators, sender, proposal, commit.CommittedSeal)
+
+		// committed seal is not covered by the consensus message validation, so it is verified
+		// before it gets submitted, since an invalid input would invalidate the whole epoch ending block
+		if _, _, err := verifyDoubleSignerSlashingInput(
+			chainID, evidence.Height, evidence.Round, epoch.Validators, input); err != nil {
+			c.logger.Debug("double sign evidence is not slashable", "signer", sender, "error", err)
+
+			return evidence, nil
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	evidence.SlashingInputs = inputs
+
+	return evidence, nil
+}
+
+// newDoubleSignerSlashingInput creates the slashing input of the checkpoint sealed by the given validator
+func newDoubleSignerSlashingInput(
+	validators AccountSet,
+	signer types.Address,
+	proposal *proposalCheckpoint,
+	seal []byte,
+) *contractsapi.DoubleSignerSlashingInput {
+	signers := bitmap.Bitmap{}
+	signers.Set(uint64(validators.Index(signer)))
+
+	return &contractsapi.DoubleSignerSlashingInput{
+		EpochID:                 new(big.Int).SetUint64(proposal.checkpoint.EpochNumber),
+		EventRoot:               proposal.checkpoint.EventRoot,
+		CurrentValidatorSetHash: proposal.checkpoint.CurrentValidatorsHash,
+		NextValidatorSetHash:    proposal.checkpoint.NextValidatorsHash,
+		BlockHash:               proposal.blockHash,
+		Bitmap:                  signers,
+		Signature:               seal,
+	}
+}
+
+// verifyDoubleSignerSlashingInput verifies that the checkpoint of the given slashing input is sealed
+// by a single validator. It returns the address of the validator and the checkpoint hash
+func verifyDoubleSignerSlashingInput(
+	chainID, blockNumber, round uint64,
+	validators AccountSet,
+	input *contractsapi.DoubleSignerSlashingInput,
+) (types.Address, types.Hash, error) {
+	signers, err := validators.GetFilteredValidators(input.Bitmap)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroHash, err
+	}
+
+	if len(signers) != 1 {
+		return types.ZeroAddress, types.ZeroHash,
+			fmt.Errorf("slashing input must be sealed by a single validator, but got %d", len(signers))
+	}
+
+	checkpoint := &CheckpointData{
+		BlockRound:            round,
+		EpochNumber:           input.EpochID.Uint64(),
+		CurrentValidatorsHash: input.CurrentValidatorSetHash,
+		NextValidatorsHash:    input.NextValidatorSetHash,
+		EventRoot:             input.EventRoot,
+	}
+
+	checkpointHash, err := checkpoint.Hash(chainID, blockNumber, input.BlockHash)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroHash, err
+	}
+
+	signature, err := bls.UnmarshalSignature(input.Signature)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroHash, fmt.Errorf("failed to unmarshall signature: %w", err)
+	}
+
+	if !signature.Verify(signers[0].BlsKey, checkpointHash.Bytes(), bls.DomainCheckpointManager) {
+		return types.ZeroAddress, types.ZeroHash, fmt.Errorf("incorrect seal from %s", signers[0].Address)
+	}
+
+	return signers[0].Address, checkpointHash, nil
+}
+
+// calculateDoubleSignerSlashingInput creates the commit epoch input which slashes the validators that double
+// signed at the earliest height and round of the epoch with slashable evidence. The validator set contract
+// accepts a single height and round per commit epoch, so the rest of the evidence is only kept in the state.
+// It returns nil if there is no slashable evidence in the epoch
+func (c *consensusRuntime) calculateDoubleSignerSlashingInput(
+	commitEpoch *contractsapi.CommitEpochChildValidatorSetFn,
+	epoch *epochMetadata,
+	pendingBlockNumber uint64,
+) (*contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn, error) {
+	evidence, err := c.state.EvidenceStore.getEvidence(epoch.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	var input *contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn
+
+	for _, e := range evidence {
+		if len(e.SlashingInputs) == 0 || e.Height >= pendingBlockNumber {
+			continue
+		}
+
+		if input == nil {
+			input = &contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn{
+				CurEpochID:  commitEpoch.ID,
+				BlockNumber: new(big.Int).SetUint64(e.Height),
+				PbftRound:   new(big.Int).SetUint64(e.Round),
+				Epoch:       commitEpoch.Epoch,
+				Uptime:      commitEpoch.Uptime,
+			}
+		} else if input.BlockNumber.Uint64() != e.Height || input.PbftRound.Uint64() != e.Round {
+			break
+		}
+
+		input.Inputs = append(input.Inputs, e.SlashingInputs...)
+	}
+
+	return input, nil
+}
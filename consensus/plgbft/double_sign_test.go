@@ -0,0 +1,206 @@
+package plgbft
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusRuntime_CheckDoubleSign(t *testing.T) {
+	t.Parallel()
+
+	const height = uint64(5)
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B", "C", "D"})
+	accounts := validators.getPublicIdentities()
+	epoch := &epochMetadata{Number: 1, FirstBlockInEpoch: 1, Validators: accounts}
+
+	runtime := &consensusRuntime{
+		state:              newTestState(t),
+		config:             &runtimeConfig{blockchain: new(blockchainMock)},
+		epoch:              epoch,
+		lastBuiltBlock:     &types.Header{Number: height - 1},
+		doubleSignDetector: newDoubleSignDetector(),
+		logger:             hclog.NewNullLogger(),
+	}
+
+	currentValidatorsHash, err := accounts.Hash()
+	require.NoError(t, err)
+
+	// proposer A proposes two different blocks in the same round
+	proposals := make([]*proto.Proposal, 2)
+	proposalHashes := make([][]byte, 2)
+
+	for i := range proposals {
+		extra := &Extra{
+			Checkpoint: &CheckpointData{
+				EpochNumber:           epoch.Number,
+				CurrentValidatorsHash: currentValidatorsHash,
+				NextValidatorsHash:    currentValidatorsHash,
+			},
+		}
+		header := &types.Header{
+			Number:    height,
+			Timestamp: uint64(i),
+			ExtraData: append(make([]byte, ExtraVanity), extra.MarshalRLPTo(nil)...),
+		}
+		header.ComputeHash()
+
+		block := &types.Block{Header: header}
+
+		proposalHash, err := extra.Checkpoint.Hash(0, height, block.Hash())
+		require.NoError(t, err)
+
+		proposals[i] = &proto.Proposal{RawProposal: block.MarshalRLP()}
+		proposalHashes[i] = proposalHash.Bytes()
+	}
+
+	signMessage := func(alias string, msg *proto.Message) *proto.Message {
+		t.Helper()
+
+		key := validators.getValidator(alias).Key()
+		msg.From = key.Address().Bytes()
+
+		signed, err := key.SignIBFTMessage(msg)
+		require.NoError(t, err)
+
+		return signed
+	}
+
+	view := &proto.View{Height: height}
+
+	for i := range proposals {
+		msg := signMessage("A", &proto.Message{
+			View: view,
+			Type: proto.MessageType_PREPREPARE,
+			Payload: &proto.Message_PreprepareData{PreprepareData: &proto.PrePrepareMessage{
+				Proposal:     proposals[i],
+				ProposalHash: proposalHashes[i],
+			}},
+		})
+		require.NoError(t, runtime.checkDoubleSign(msg))
+	}
+
+	// validator B commits both of the proposals
+	for i := range proposals {
+		seal, err := validators.getValidator("B").mustSign(proposalHashes[i], bls.DomainCheckpointManager).Marshal()
+		require.NoError(t, err)
+
+		msg := signMessage("B", &proto.Message{
+			View: view,
+			Type: proto.MessageType_COMMIT,
+			Payload: &proto.Message_CommitData{CommitData: &proto.CommitMessage{
+				ProposalHash:  proposalHashes[i],
+				CommittedSeal: seal,
+			}},
+		})
+		require.NoError(t, runtime.checkDoubleSign(msg))
+
+		// repeated messages are not a double sign
+		require.NoError(t, runtime.checkDoubleSign(msg))
+	}
+
+	// validator C prepares a single proposal
+	require.NoError(t, runtime.checkDoubleSign(signMessage("C", &proto.Message{
+		View:    view,
+		Type:    proto.MessageType_PREPARE,
+		Payload: &proto.Message_PrepareData{PrepareData: &proto.PrepareMessage{ProposalHash: proposalHashes[0]}},
+	})))
+
+	evidence, err := runtime.state.EvidenceStore.getEvidence(epoch.Number)
+	require.NoError(t, err)
+	require.Len(t, evidence, 2)
+
+	require.Equal(t, proto.MessageType_PREPREPARE, evidence[0].Type)
+	require.Equal(t, validators.getValidator("A").Address(), evidence[0].Signer)
+	require.Len(t, evidence[0].Messages, 2)
+	require.Empty(t, evidence[0].SlashingInputs)
+
+	require.Equal(t, proto.MessageType_COMMIT, evidence[1].Type)
+	require.Equal(t, validators.getValidator("B").Address(), evidence[1].Signer)
+	require.Equal(t, height, evidence[1].Height)
+	require.Len(t, evidence[1].SlashingInputs, 2)
+
+	// the evidence of the committed proposals is slashed in the epoch ending block
+	commitEpochInput := createTestCommitEpochInput(t, epoch.Number, accounts, 10)
+
+	slashingInput, err := runtime.calculateDoubleSignerSlashingInput(commitEpochInput, epoch, height)
+	require.NoError(t, err)
+	require.Nil(t, slashingInput)
+
+	slashingInput, err = runtime.calculateDoubleSignerSlashingInput(commitEpochInput, epoch, height+1)
+	require.NoError(t, err)
+	require.NotNil(t, slashingInput)
+	require.Equal(t, height, slashingInput.BlockNumber.Uint64())
+	require.Equal(t, evidence[1].SlashingInputs, slashingInput.Inputs)
+
+	slashingEnabled := true
+
+	fsm := &fsm{
+		config: &PlgBFTConfig{
+			Forks: []*ParamsFork{{From: height + 1, DoubleSignerSlashing: &slashingEnabled}},
+		},
+		parent:           &types.Header{Number: height},
+		backend:          new(blockchainMock),
+		validators:       validators.toValidatorSet(),
+		epochNumber:      epoch.Number,
+		isEndOfEpoch:     true,
+		commitEpochInput: commitEpochInput,
+	}
+
+	// proposer may slash double signers unknown to the validator
+	fsm.doubleSignerSlashingInput = slashingInput
+
+	commitEpochTx, err := fsm.createCommitEpochTx()
+	require.NoError(t, err)
+
+	fsm.doubleSignerSlashingInput = nil
+	require.NoError(t, fsm.verifyCommitEpochTx(commitEpochTx))
+
+	// slashing is rejected before the fork enabling it
+	fsm.config.Forks[0].From = height + 2
+	require.ErrorIs(t, fsm.verifyCommitEpochTx(commitEpochTx), errDoubleSignerSlashingInactive)
+
+	// the same checkpoint sealed twice is not a double sign
+	slashingInput.Inputs = []*contractsapi.DoubleSignerSlashingInput{slashingInput.Inputs[0], slashingInput.Inputs[0]}
+	require.ErrorContains(t, fsm.verifyDoubleSignerSlashing(slashingInput), "no conflicting checkpoints")
+}
+
+func TestDoubleSignDetector_Prune(t *testing.T) {
+	t.Parallel()
+
+	detector := newDoubleSignDetector()
+	sender := types.StringToAddress("1")
+
+	newPrepare := func(height uint64, proposalHash []byte) *proto.Message {
+		return &proto.Message{
+			View:    &proto.View{Height: height},
+			Type:    proto.MessageType_PREPARE,
+			Payload: &proto.Message_PrepareData{PrepareData: &proto.PrepareMessage{ProposalHash: proposalHash}},
+		}
+	}
+
+	for height := uint64(1); height <= 3; height++ {
+		require.Nil(t, detector.addMessage(sender, newPrepare(height, []byte{1})))
+		detector.addProposal(types.BytesToHash([]byte{byte(height)}), &proposalCheckpoint{height: height})
+	}
+
+	require.NotNil(t, detector.addMessage(sender, newPrepare(1, []byte{2})))
+
+	detector.prune(3)
+
+	// pruned messages are forgotten
+	require.Nil(t, detector.addMessage(sender, newPrepare(1, []byte{2})))
+	require.NotNil(t, detector.addMessage(sender, newPrepare(3, []byte{2})))
+
+	_, ok := detector.getProposal(types.BytesToHash([]byte{2}))
+	require.False(t, ok)
+
+	_, ok = detector.getProposal(types.BytesToHash([]byte{3}))
+	require.True(t, ok)
+}
@@ -254,14 +254,10 @@ func createValidatorSetDelta(oldValidatorSet, newValidatorSet AccountSet) (*Vali
 		// Check if the validator is among both old and new validator set
 		oldValidator, validatorExists := oldValidatorSetMap[newValidator.Address]
 		if validatorExists {
-			if !oldValidator.EqualAddressAndBlsKey(newValidator) {
-				return nil, fmt.Errorf("validator '%s' found in both old and new validator set, but its BLS keys differ",
-					newValidator.Address.String())
-			}
-
 			// If it is, then discard it from removed validators...
 			delete(removedValidators, newValidator.Address)
 
+			// validator whose BLS key has been rotated is updated as well
 			if !oldValidator.Equals(newValidator) {
 				updatedValidators = append(updatedValidators, newValidator)
 			}
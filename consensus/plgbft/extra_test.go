@@ -562,8 +562,16 @@ func TestExtra_CreateValidatorSetDelta_BlsDiffer(t *testing.T) {
 
 	newValidatorSet[0].BlsKey = privateKey.PublicKey()
 
-	_, err = createValidatorSetDelta(oldValidatorSet, newValidatorSet)
-	require.Error(t, err)
+	delta, err := createValidatorSetDelta(oldValidatorSet, newValidatorSet)
+	require.NoError(t, err)
+
+	// validator with the rotated BLS key is updated
+	require.Len(t, delta.Updated, 1)
+	require.Equal(t, newValidatorSet[0], delta.Updated[0])
+
+	validators, err := oldValidatorSet.ApplyDelta(delta)
+	require.NoError(t, err)
+	require.Equal(t, privateKey.PublicKey(), validators.GetValidatorMetadata(newValidatorSet[0].Address).BlsKey)
 }
 
 func TestExtra_ValidateDelta(t *testing.T) {
@@ -33,6 +33,7 @@ var (
 	errCommitEpochTxSingleExpected = errors.New("only one commit epoch transaction is allowed in an epoch ending block")
 	errProposalDontMatch           = errors.New("failed to insert proposal, because the validated proposal " +
 		"is either nil or it does not match the received one")
+	errDoubleSignerSlashingInactive = errors.New("double signer slashing is not active at the block")
 )
 
 type fsm struct {
@@ -65,6 +66,10 @@ type fsm struct {
 	// It is populated only for epoch-ending blocks.
 	commitEpochInput *contractsapi.CommitEpochChildValidatorSetFn
 
+	// doubleSignerSlashingInput extends the commit epoch input with the double sign evidence
+	// gathered during the epoch. It is populated only for epoch-ending blocks if there is any evidence.
+	doubleSignerSlashingInput *contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn
+
 	// isEndOfEpoch indicates if epoch reached its end
 	isEndOfEpoch bool
 
@@ -242,12 +247,11 @@ func (f *fsm) getValidatorsTransition(delta *ValidatorSetDelta) (AccountSet, err
 // createCommitEpochTx create a StateTransaction, which invokes ValidatorSet smart contract
 // and sends all the necessary metadata to it.
 func (f *fsm) createCommitEpochTx() (*types.Transaction, error) {
-	input, err := f.commitEpochInput.EncodeAbi()
-	if err != nil {
-		return nil, err
+	if f.doubleSignerSlashingInput != nil {
+		return createCommitEpochTxFromInput(f.doubleSignerSlashingInput)
 	}
 
-	return createStateTransactionWithData(contracts.ValidatorSetContract, input), nil
+	return createCommitEpochTxFromInput(f.commitEpochInput)
 }
 
 // ValidateCommit is used to validate that a given commit is valid
@@ -441,7 +445,8 @@ func (f *fsm) VerifyStateTransactions(transactions []*types.Transaction) error {
 			if !verified {
 				return fmt.Errorf("invalid signature for tx = %v", tx.Hash)
 			}
-		case *contractsapi.CommitEpochChildValidatorSetFn:
+		case *contractsapi.CommitEpochChildValidatorSetFn,
+			*contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn:
 			if commitEpochTxExists {
 				// if we already validated commit epoch tx,
 				// that means someone added more than one commit epoch tx to block,
@@ -567,7 +572,26 @@ func (f *fsm) getCurrentValidators(pendingBlockState *state.Transition) (Account
 // verifyCommitEpochTx creates commit epoch transaction and compares its hash with the one extracted from the block.
 func (f *fsm) verifyCommitEpochTx(commitEpochTx *types.Transaction) error {
 	if f.isEndOfEpoch {
-		localCommitEpochTx, err := f.createCommitEpochTx()
+		// double sign evidence is gathered by each validator on its own, so the proposer may slash
+		// regardless of the local evidence, as long as its evidence is valid
+		if input, err := decodeStateTransaction(commitEpochTx.Input); err == nil {
+			if slashingInput, ok := input.(*contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn); ok {
+				if !f.config.ParamsAt(f.Height()).DoubleSignerSlashing {
+					return errDoubleSignerSlashingInactive
+				}
+
+				if err := f.verifyDoubleSignerSlashing(slashingInput); err != nil {
+					return err
+				}
+
+				// the rest of the commit epoch input must match the local one
+				if commitEpochTx, err = createCommitEpochTxFromSlashingInput(slashingInput); err != nil {
+					return err
+				}
+			}
+		}
+
+		localCommitEpochTx, err := createCommitEpochTxFromInput(f.commitEpochInput)
 		if err != nil {
 			return err
 		}
@@ -586,6 +610,69 @@ func (f *fsm) verifyCommitEpochTx(commitEpochTx *types.Transaction) error {
 	return errCommitEpochTxNotExpected
 }
 
+// verifyDoubleSignerSlashing verifies that each validator slashed by the given input
+// sealed at least two different checkpoints at the same height and round of the current epoch
+func (f *fsm) verifyDoubleSignerSlashing(
+	input *contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn) error {
+	if input.BlockNumber.Uint64() >= f.Height() {
+		return fmt.Errorf("invalid double signer slashing block number %d", input.BlockNumber)
+	}
+
+	if len(input.Inputs) == 0 {
+		return fmt.Errorf("double signer slashing without inputs")
+	}
+
+	validators := f.validators.Accounts()
+	checkpoints := make(map[types.Address]map[types.Hash]struct{})
+
+	for _, slashingInput := range input.Inputs {
+		if slashingInput.EpochID.Uint64() != f.epochNumber {
+			return fmt.Errorf("invalid double signer slashing epoch %d", slashingInput.EpochID)
+		}
+
+		signer, checkpointHash, err := verifyDoubleSignerSlashingInput(f.backend.GetChainID(),
+			input.BlockNumber.Uint64(), input.PbftRound.Uint64(), validators, slashingInput)
+		if err != nil {
+			return fmt.Errorf("invalid double signer slashing input: %w", err)
+		}
+
+		if _, ok := checkpoints[signer]; !ok {
+			checkpoints[signer] = make(map[types.Hash]struct{})
+		}
+
+		checkpoints[signer][checkpointHash] = struct{}{}
+	}
+
+	for signer, hashes := range checkpoints {
+		if len(hashes) < 2 {
+			return fmt.Errorf("no conflicting checkpoints sealed by %s", signer)
+		}
+	}
+
+	return nil
+}
+
+// createCommitEpochTxFromInput creates a commit epoch state transaction from the given input
+func createCommitEpochTxFromInput(input contractsapi.StateTransactionInput) (*types.Transaction, error) {
+	data, err := input.EncodeAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return createStateTransactionWithData(contracts.ValidatorSetContract, data), nil
+}
+
+// createCommitEpochTxFromSlashingInput creates a commit epoch state transaction
+// from the given input, without the double signer slashing part
+func createCommitEpochTxFromSlashingInput(
+	input *contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn) (*types.Transaction, error) {
+	return createCommitEpochTxFromInput(&contractsapi.CommitEpochChildValidatorSetFn{
+		ID:     input.CurEpochID,
+		Epoch:  input.Epoch,
+		Uptime: input.Uptime,
+	})
+}
+
 func validateHeaderFields(parent *types.Header, header *types.Header) error {
 	// verify parent hash
 	if parent.Hash != header.ParentHash {
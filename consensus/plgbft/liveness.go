@@ -0,0 +1,84 @@
+package plgbft
+
+import (
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/bitmap"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// trackLiveness records which validators signed and proposed the given block.
+// The liveness snapshot is persisted only at the end of the epoch, and on close.
+// It must be called before the proposer calculator is updated with the block
+func (c *consensusRuntime) trackLiveness(header *types.Header, epoch *epochMetadata, isEndOfEpoch bool) error {
+	extra, err := GetIbftExtra(header.ExtraData)
+	if err != nil {
+		return err
+	}
+
+	proposerSnapshot, ok := c.proposerCalculator.GetSnapshot()
+	if !ok {
+		return fmt.Errorf("proposer snapshot is empty")
+	}
+
+	block, err := newLivenessBlock(header, extra, epoch, proposerSnapshot)
+	if err != nil {
+		return err
+	}
+
+	c.livenessTracker.Track(block)
+
+	if !isEndOfEpoch {
+		return nil
+	}
+
+	return c.persistLiveness()
+}
+
+// persistLiveness writes the liveness snapshot to the state.
+// The blocks tracked since the last write are lost if the node crashes
+func (c *consensusRuntime) persistLiveness() error {
+	return c.state.LivenessStore.writeLivenessSnapshot(c.livenessTracker.Snapshot())
+}
+
+// newLivenessBlock creates the liveness record of the given block
+// from its committed seals bitmap and the proposers of the rounds needed to seal it
+func newLivenessBlock(
+	header *types.Header,
+	extra *Extra,
+	epoch *epochMetadata,
+	proposerSnapshot *ProposerSnapshot,
+) (*liveness.Block, error) {
+	block := &liveness.Block{
+		Number:   header.Number,
+		Epoch:    epoch.Number,
+		Proposer: types.BytesToAddress(header.Miner),
+	}
+
+	if extra.Committed != nil {
+		signers := bitmap.Bitmap(extra.Committed.Bitmap)
+
+		for i, validator := range epoch.Validators {
+			if signers.IsSet(uint64(i)) {
+				block.Signers = append(block.Signers, validator.Address)
+			} else {
+				block.Absentees = append(block.Absentees, validator.Address)
+			}
+		}
+	}
+
+	// proposers of the previous rounds failed to get their proposals committed
+	if extra.Checkpoint != nil && proposerSnapshot.Height == header.Number {
+		for round := uint64(0); round < extra.Checkpoint.BlockRound; round++ {
+			proposer, err := proposerSnapshot.CalcProposer(round, header.Number)
+			if err != nil {
+				return nil, err
+			}
+
+			block.MissedProposers = append(block.MissedProposers, proposer)
+		}
+	}
+
+	return block, nil
+}
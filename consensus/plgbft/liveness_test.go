@@ -0,0 +1,116 @@
+package plgbft
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/bitmap"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusRuntime_NewLivenessBlock(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blockNumber = uint64(7)
+		blockRound  = uint64(2)
+	)
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B", "C", "D"}).getPublicIdentities()
+	epoch := &epochMetadata{Number: 1, Validators: validators}
+
+	// validators A, B and D signed the block
+	signers := bitmap.Bitmap{}
+	signers.Set(0)
+	signers.Set(1)
+	signers.Set(3)
+
+	extra := &Extra{
+		Committed:  &Signature{Bitmap: signers},
+		Checkpoint: &CheckpointData{BlockRound: blockRound},
+	}
+
+	proposerSnapshot := NewProposerSnapshot(blockNumber, validators)
+
+	expectedMissedProposers := make([]types.Address, blockRound)
+
+	for round := uint64(0); round < blockRound; round++ {
+		proposer, err := proposerSnapshot.Copy().CalcProposer(round, blockNumber)
+		require.NoError(t, err)
+
+		expectedMissedProposers[round] = proposer
+	}
+
+	proposer, err := proposerSnapshot.Copy().CalcProposer(blockRound, blockNumber)
+	require.NoError(t, err)
+
+	header := &types.Header{Number: blockNumber, Miner: proposer.Bytes()}
+
+	block, err := newLivenessBlock(header, extra, epoch, proposerSnapshot)
+	require.NoError(t, err)
+
+	require.Equal(t, blockNumber, block.Number)
+	require.Equal(t, epoch.Number, block.Epoch)
+	require.Equal(t, proposer, block.Proposer)
+	require.Equal(t, []types.Address{validators[0].Address, validators[1].Address, validators[3].Address}, block.Signers)
+	require.Equal(t, []types.Address{validators[2].Address}, block.Absentees)
+	require.Equal(t, expectedMissedProposers, block.MissedProposers)
+
+	// proposals are not tracked if proposer snapshot is not at the block height
+	block, err = newLivenessBlock(header, extra, epoch, NewProposerSnapshot(blockNumber+1, validators))
+	require.NoError(t, err)
+	require.Empty(t, block.MissedProposers)
+}
+
+func TestConsensusRuntime_TrackLiveness_Persist(t *testing.T) {
+	t.Parallel()
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B"}).getPublicIdentities()
+	epoch := &epochMetadata{Number: 1, Validators: validators}
+	config := &runtimeConfig{State: newTestState(t)}
+
+	runtime := &consensusRuntime{
+		proposerCalculator: NewProposerCalculatorFromSnapshot(
+			NewProposerSnapshot(1, validators), config, hclog.NewNullLogger()),
+		logger:           hclog.NewNullLogger(),
+		state:            config.State,
+		config:           config,
+		stateSyncManager: &dummyStateSyncManager{},
+		livenessTracker:  liveness.NewTracker(liveness.DefaultWindowSize, nil),
+	}
+
+	extra := &Extra{Committed: &Signature{Bitmap: bitmap.Bitmap{}}}
+	newHeader := func(number uint64) *types.Header {
+		return &types.Header{
+			Number:    number,
+			ExtraData: append(make([]byte, ExtraVanity), extra.MarshalRLPTo(nil)...),
+		}
+	}
+
+	persistedBlocks := func() int {
+		snapshot, err := runtime.state.LivenessStore.getLivenessSnapshot()
+		require.NoError(t, err)
+
+		if snapshot == nil {
+			return 0
+		}
+
+		return len(snapshot.Blocks)
+	}
+
+	// the snapshot isn't written in the middle of the epoch
+	require.NoError(t, runtime.trackLiveness(newHeader(1), epoch, false))
+	require.Equal(t, 0, persistedBlocks())
+
+	require.NoError(t, runtime.trackLiveness(newHeader(2), epoch, true))
+	require.Equal(t, 2, persistedBlocks())
+
+	require.NoError(t, runtime.trackLiveness(newHeader(3), epoch, false))
+	require.Equal(t, 2, persistedBlocks())
+
+	// closing the runtime writes the blocks tracked since the end of the epoch
+	runtime.close()
+	require.Equal(t, 3, persistedBlocks())
+}
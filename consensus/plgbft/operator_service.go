@@ -0,0 +1,214 @@
+package plgbft
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	plgbftProto "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// defaultStatusProposers is the number of the next proposers returned if not specified
+const defaultStatusProposers = 5
+
+type operator struct {
+	plgbftProto.UnimplementedPlgbftOperatorServer
+
+	plgbft *Plgbft
+}
+
+// Status returns the status of the PlgBFT consensus
+func (o *operator) Status(
+	ctx context.Context,
+	req *plgbftProto.PlgbftStatusReq,
+) (*plgbftProto.PlgbftStatusResp, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	runtime := o.plgbft.runtime
+	if runtime == nil {
+		return nil, fmt.Errorf("consensus runtime is not initialized")
+	}
+
+	data, err := runtime.getGuardedData()
+	if err != nil {
+		return nil, err
+	}
+
+	proposersNum := req.Proposers
+	if proposersNum == 0 {
+		proposersNum = defaultStatusProposers
+	}
+
+	nextProposers, err := calcNextProposers(data.proposerSnapshot, proposersNum)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rootchain may be unreachable, which should not prevent reporting the rest of the status
+	lastCheckpointBlock, err := runtime.checkpointManager.LastCheckpointBlock()
+	if err != nil {
+		o.plgbft.logger.Warn("failed to query the last checkpoint block", "error", err)
+	}
+
+	buckets, err := o.plgbft.state.bucketsStats()
+	if err != nil {
+		return nil, err
+	}
+
+	params := o.plgbft.consensusConfig.ParamsAt(data.lastBuiltBlock.Number + 1)
+
+	return &plgbftProto.PlgbftStatusResp{
+		Key:                 o.plgbft.key.String(),
+		BlockNumber:         data.lastBuiltBlock.Number,
+		Epoch:               data.epoch.Number,
+		FirstBlockInEpoch:   data.epoch.FirstBlockInEpoch,
+		EpochSize:           params.EpochSize,
+		SprintSize:          params.SprintSize,
+		Validators:          validatorsToProtoValidators(data.epoch.Validators),
+		NextProposers:       nextProposers,
+		LastCheckpointBlock: lastCheckpointBlock,
+		PendingCommitments:  commitmentsToProtoCommitments(runtime.stateSyncManager.PendingCommitments()),
+		Buckets:             bucketsStatsToProtoBuckets(buckets),
+	}, nil
+}
+
+// Liveness returns the liveness of the validators over the sliding window of the latest blocks and in the given epoch
+func (o *operator) Liveness(
+	ctx context.Context,
+	req *plgbftProto.PlgbftLivenessReq,
+) (*plgbftProto.PlgbftLivenessResp, error) {
+	runtime := o.plgbft.runtime
+	if runtime == nil {
+		return nil, fmt.Errorf("consensus runtime is not initialized")
+	}
+
+	epoch := req.Epoch
+	if epoch == 0 {
+		data, err := runtime.getGuardedData()
+		if err != nil {
+			return nil, err
+		}
+
+		epoch = data.epoch.Number
+	}
+
+	// no block of the current epoch may be inserted yet
+	epochStats, ok := runtime.livenessTracker.Epoch(epoch)
+	if !ok && req.Epoch != 0 {
+		return nil, fmt.Errorf("liveness of epoch %d is not tracked", epoch)
+	}
+
+	windowFrom, windowTo, windowStats := runtime.livenessTracker.Window()
+
+	return &plgbftProto.PlgbftLivenessResp{
+		WindowFrom:    windowFrom,
+		WindowTo:      windowTo,
+		Window:        livenessToProtoLiveness(windowStats),
+		Epoch:         epoch,
+		EpochLiveness: livenessToProtoLiveness(epochStats),
+	}, nil
+}
+
+// calcNextProposers calculates the proposers of the next rounds of the snapshot height.
+// The priorities of a snapshot copy are incremented once per round,
+// instead of recalculating each round from the start.
+func calcNextProposers(snapshot *ProposerSnapshot, num uint64) ([]string, error) {
+	proposers := make([]string, 0, num)
+	if num == 0 {
+		return proposers, nil
+	}
+
+	snapshot = snapshot.Copy()
+	if len(snapshot.Validators) == 0 {
+		return nil, fmt.Errorf("validator set cannot be nul or empty")
+	}
+
+	totalVotingPower := snapshot.GetTotalVotingPower()
+
+	if err := updateWithChangeSet(snapshot, totalVotingPower); err != nil {
+		return nil, err
+	}
+
+	for round := uint64(0); round < num; round++ {
+		proposer, err := incrementProposerPriority(snapshot, totalVotingPower)
+		if err != nil {
+			return nil, fmt.Errorf("cannot increment proposer priority: %w", err)
+		}
+
+		proposers = append(proposers, proposer.Metadata.Address.String())
+	}
+
+	return proposers, nil
+}
+
+// validatorsToProtoValidators converts validators to response of validators
+func validatorsToProtoValidators(validators AccountSet) []*plgbftProto.PlgbftStatusResp_Validator {
+	protoValidators := make([]*plgbftProto.PlgbftStatusResp_Validator, len(validators))
+
+	for idx, validator := range validators {
+		protoValidators[idx] = &plgbftProto.PlgbftStatusResp_Validator{
+			Address:     validator.Address.String(),
+			VotingPower: validator.VotingPower.String(),
+			Active:      validator.IsActive,
+		}
+	}
+
+	return protoValidators
+}
+
+// commitmentsToProtoCommitments converts pending commitments to response of commitments
+func commitmentsToProtoCommitments(commitments []*PendingCommitment) []*plgbftProto.PlgbftStatusResp_Commitment {
+	protoCommitments := make([]*plgbftProto.PlgbftStatusResp_Commitment, len(commitments))
+
+	for idx, commitment := range commitments {
+		protoCommitments[idx] = &plgbftProto.PlgbftStatusResp_Commitment{
+			Epoch:   commitment.Epoch,
+			StartId: commitment.StartID.Uint64(),
+			EndId:   commitment.EndID.Uint64(),
+			Root:    commitment.Root.String(),
+		}
+	}
+
+	return protoCommitments
+}
+
+// bucketsStatsToProtoBuckets converts buckets stats to response of buckets sorted by name
+func bucketsStatsToProtoBuckets(buckets map[string]*bolt.BucketStats) []*plgbftProto.PlgbftStatusResp_BucketStats {
+	protoBuckets := make([]*plgbftProto.PlgbftStatusResp_BucketStats, 0, len(buckets))
+
+	for name, stats := range buckets {
+		protoBuckets = append(protoBuckets, &plgbftProto.PlgbftStatusResp_BucketStats{
+			Name:       name,
+			Keys:       uint64(stats.KeyN),
+			Depth:      uint64(stats.Depth),
+			InUseBytes: uint64(stats.BranchInuse + stats.LeafInuse),
+		})
+	}
+
+	sort.Slice(protoBuckets, func(i, j int) bool {
+		return protoBuckets[i].Name < protoBuckets[j].Name
+	})
+
+	return protoBuckets
+}
+
+// livenessToProtoLiveness converts validators liveness to response of validators liveness
+func livenessToProtoLiveness(stats []*liveness.ValidatorStats) []*plgbftProto.PlgbftLivenessResp_ValidatorLiveness {
+	protoLiveness := make([]*plgbftProto.PlgbftLivenessResp_ValidatorLiveness, len(stats))
+
+	for idx, s := range stats {
+		protoLiveness[idx] = &plgbftProto.PlgbftLivenessResp_ValidatorLiveness{
+			Address:         s.Address.String(),
+			BlocksSigned:    s.BlocksSigned,
+			BlocksMissed:    s.BlocksMissed,
+			ProposalsMade:   s.ProposalsMade,
+			ProposalsMissed: s.ProposalsMissed,
+		}
+	}
+
+	return protoLiveness
+}
@@ -0,0 +1,137 @@
+package plgbft
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	plgbftProto "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_CalcNextProposers(t *testing.T) {
+	t.Parallel()
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B", "C"}, []uint64{1, 2, 3}).getPublicIdentities()
+	snapshot := NewProposerSnapshot(10, validators)
+
+	proposers, err := calcNextProposers(snapshot, 10)
+	require.NoError(t, err)
+	require.Len(t, proposers, 10)
+
+	for round, proposer := range proposers {
+		expected, err := snapshot.Copy().CalcProposer(uint64(round), 10)
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), proposer)
+	}
+}
+
+func TestOperator_StatusProposersLimit(t *testing.T) {
+	t.Parallel()
+
+	operator := &operator{plgbft: &Plgbft{}}
+
+	_, err := operator.Status(context.Background(), &plgbftProto.PlgbftStatusReq{Proposers: 101})
+	assert.ErrorContains(t, err, "less than or equal to 100")
+
+	assert.NoError(t, (&plgbftProto.PlgbftStatusReq{Proposers: 100}).ValidateAll())
+}
+
+func TestOperator_ValidatorsAndCommitmentsToProto(t *testing.T) {
+	t.Parallel()
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B"}, []uint64{10, 20}).getPublicIdentities()
+
+	protoValidators := validatorsToProtoValidators(validators)
+	require.Len(t, protoValidators, 2)
+
+	for i, v := range validators {
+		assert.Equal(t, v.Address.String(), protoValidators[i].Address)
+		assert.Equal(t, v.VotingPower.String(), protoValidators[i].VotingPower)
+		assert.Equal(t, v.IsActive, protoValidators[i].Active)
+	}
+
+	commitments := []*PendingCommitment{
+		{
+			StateSyncCommitment: &contractsapi.StateSyncCommitment{
+				StartID: big.NewInt(1),
+				EndID:   big.NewInt(5),
+				Root:    types.StringToHash("0x1"),
+			},
+			Epoch: 3,
+		},
+	}
+
+	protoCommitments := commitmentsToProtoCommitments(commitments)
+	require.Len(t, protoCommitments, 1)
+	assert.Equal(t, uint64(3), protoCommitments[0].Epoch)
+	assert.Equal(t, uint64(1), protoCommitments[0].StartId)
+	assert.Equal(t, uint64(5), protoCommitments[0].EndId)
+	assert.Equal(t, types.StringToHash("0x1").String(), protoCommitments[0].Root)
+}
+
+func TestOperator_BucketsStats(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	require.NoError(t, state.EpochStore.insertEpoch(1))
+
+	stats, err := state.bucketsStats()
+	require.NoError(t, err)
+
+	buckets := bucketsStatsToProtoBuckets(stats)
+	require.Len(t, buckets, len(stats))
+
+	for i := 1; i < len(buckets); i++ {
+		assert.Less(t, buckets[i-1].Name, buckets[i].Name)
+	}
+
+	for _, bucket := range buckets {
+		if bucket.Name == string(epochsBucket) {
+			assert.NotZero(t, bucket.Keys)
+		}
+	}
+}
+
+func TestOperator_Liveness(t *testing.T) {
+	t.Parallel()
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B"}).getPublicIdentities()
+
+	tracker := liveness.NewTracker(liveness.DefaultWindowSize, nil)
+	tracker.Track(&liveness.Block{
+		Number:    1,
+		Epoch:     1,
+		Signers:   []types.Address{validators[0].Address},
+		Absentees: []types.Address{validators[1].Address},
+		Proposer:  validators[0].Address,
+	})
+
+	op := &operator{plgbft: &Plgbft{runtime: &consensusRuntime{livenessTracker: tracker}}}
+
+	resp, err := op.Liveness(context.Background(), &plgbftProto.PlgbftLivenessReq{Epoch: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1), resp.WindowFrom)
+	assert.Equal(t, uint64(1), resp.WindowTo)
+	assert.Equal(t, uint64(1), resp.Epoch)
+	assert.Equal(t, resp.Window, resp.EpochLiveness)
+
+	expected := map[string]*plgbftProto.PlgbftLivenessResp_ValidatorLiveness{
+		validators[0].Address.String(): {Address: validators[0].Address.String(), BlocksSigned: 1, ProposalsMade: 1},
+		validators[1].Address.String(): {Address: validators[1].Address.String(), BlocksMissed: 1},
+	}
+
+	require.Len(t, resp.Window, 2)
+
+	for _, v := range resp.Window {
+		assert.Equal(t, expected[v.Address], v)
+	}
+
+	_, err = op.Liveness(context.Background(), &plgbftProto.PlgbftLivenessReq{Epoch: 2})
+	require.ErrorContains(t, err, "liveness of epoch 2 is not tracked")
+}
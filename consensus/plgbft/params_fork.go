@@ -0,0 +1,217 @@
+package plgbft
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/plingatech/go-plgchain/consensus/emptyblock"
+	"github.com/plingatech/go-plgchain/contracts"
+	"github.com/plingatech/go-plgchain/helper/common"
+	"github.com/plingatech/go-plgchain/state"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+var (
+	errForksNotSorted    = errors.New("forks must be sorted by strictly increasing activation heights")
+	errInvalidEpochSize  = errors.New("epoch size must be greater than zero")
+	errInvalidSprintSize = errors.New("sprint size must be greater than zero")
+	errInvalidBlockTime  = errors.New("block time must be greater than zero")
+	errInvalidCheckpoint = errors.New("checkpoint interval must be greater than zero")
+
+	// storage slots of the ChildValidatorSet contract parameters which are overridden by the forks
+	epochSizeStorageSlot   = types.BytesToHash(big.NewInt(0).Bytes())
+	epochRewardStorageSlot = types.BytesToHash(big.NewInt(3).Bytes())
+)
+
+// ParamsFork overrides the consensus parameters starting from the block with the given number.
+// Parameters which are not set keep the values of the previous forks
+type ParamsFork struct {
+	// From is the number of the first block using the overridden parameters,
+	// it has to be the first block of an epoch
+	From uint64 `json:"from"`
+
+	// EpochSize is size of epoch
+	EpochSize *uint64 `json:"epochSize,omitempty"`
+
+	// EpochReward is assigned to validators for blocks sealing
+	EpochReward *uint64 `json:"epochReward,omitempty"`
+
+	// SprintSize is size of sprint
+	SprintSize *uint64 `json:"sprintSize,omitempty"`
+
+	// BlockTime is target frequency of blocks production
+	BlockTime *common.Duration `json:"blockTime,omitempty"`
+
+	// MaxBlockInterval is the maximum period between blocks if there are no pending transactions
+	MaxBlockInterval *common.Duration `json:"maxBlockInterval,omitempty"`
+
+	// CheckpointInterval is the number of blocks between checkpoints in the middle of the epoch
+	CheckpointInterval *uint64 `json:"checkpointInterval,omitempty"`
+
+	// DoubleSignerSlashing enables the slashing of the double signers in the epoch ending blocks
+	DoubleSignerSlashing *bool `json:"doubleSignerSlashing,omitempty"`
+}
+
+// validate checks that the overridden parameters are not zero
+func (f *ParamsFork) validate() error {
+	if f.EpochSize != nil && *f.EpochSize == 0 {
+		return errInvalidEpochSize
+	}
+
+	if f.SprintSize != nil && *f.SprintSize == 0 {
+		return errInvalidSprintSize
+	}
+
+	if f.BlockTime != nil && f.BlockTime.Duration <= 0 {
+		return errInvalidBlockTime
+	}
+
+	if f.CheckpointInterval != nil && *f.CheckpointInterval == 0 {
+		return errInvalidCheckpoint
+	}
+
+	return nil
+}
+
+// apply overrides the given parameters with the ones set by the fork
+func (f *ParamsFork) apply(params *ConsensusParams) {
+	if f.EpochSize != nil {
+		params.EpochSize = *f.EpochSize
+	}
+
+	if f.EpochReward != nil {
+		params.EpochReward = *f.EpochReward
+	}
+
+	if f.SprintSize != nil {
+		params.SprintSize = *f.SprintSize
+	}
+
+	if f.BlockTime != nil {
+		params.BlockTime = f.BlockTime.Duration
+	}
+
+	if f.MaxBlockInterval != nil {
+		params.MaxBlockInterval = f.MaxBlockInterval.Duration
+	}
+
+	if f.CheckpointInterval != nil {
+		params.CheckpointInterval = *f.CheckpointInterval
+	}
+
+	if f.DoubleSignerSlashing != nil {
+		params.DoubleSignerSlashing = *f.DoubleSignerSlashing
+	}
+}
+
+// ConsensusParams are the consensus parameters in effect at a block
+type ConsensusParams struct {
+	EpochSize          uint64
+	EpochReward        uint64
+	SprintSize         uint64
+	BlockTime          time.Duration
+	MaxBlockInterval   time.Duration
+	CheckpointInterval uint64
+
+	// DoubleSignerSlashing is disabled until a fork enables it
+	DoubleSignerSlashing bool
+}
+
+// ParamsAt returns the consensus parameters in effect at the block with the given number
+func (p *PlgBFTConfig) ParamsAt(blockNumber uint64) *ConsensusParams {
+	params := &ConsensusParams{
+		EpochSize:          p.EpochSize,
+		EpochReward:        p.EpochReward,
+		SprintSize:         p.SprintSize,
+		BlockTime:          p.BlockTime.Duration,
+		MaxBlockInterval:   p.MaxBlockInterval.Duration,
+		CheckpointInterval: defaultCheckpointsOffset,
+	}
+
+	for _, fork := range p.Forks {
+		if fork.From > blockNumber {
+			break
+		}
+
+		fork.apply(params)
+	}
+
+	return params
+}
+
+// ValidateForks checks that the forks are sorted by their activation heights,
+// don't set zero parameters and each of them activates at the first block of an epoch
+func (p *PlgBFTConfig) ValidateForks() error {
+	var (
+		params     = p.ParamsAt(0)
+		epochStart = uint64(1)
+		lastFrom   = uint64(0)
+	)
+
+	if err := params.skipperParams().Validate(); err != nil {
+		return err
+	}
+
+	for i, fork := range p.Forks {
+		if fork.From <= lastFrom {
+			return errForksNotSorted
+		}
+
+		if err := fork.validate(); err != nil {
+			return fmt.Errorf("invalid fork at block %d: %w", fork.From, err)
+		}
+
+		if params.EpochSize == 0 {
+			return errInvalidEpochSize
+		}
+
+		// epochs preceding the fork have the size which was in effect at their first block
+		for epochStart < fork.From {
+			epochStart += params.EpochSize
+		}
+
+		if epochStart != fork.From {
+			return fmt.Errorf("fork %d activates at block %d which is not the first block of an epoch (next epoch starts at block %d)",
+				i, fork.From, epochStart)
+		}
+
+		fork.apply(params)
+		lastFrom = fork.From
+
+		if err := params.skipperParams().Validate(); err != nil {
+			return fmt.Errorf("invalid fork at block %d: %w", fork.From, err)
+		}
+	}
+
+	return nil
+}
+
+// skipperParams returns the parameters of the empty block skipper
+func (p *ConsensusParams) skipperParams() emptyblock.Params {
+	return emptyblock.Params{
+		MaxBlockInterval: p.MaxBlockInterval,
+		BlockTime:        p.BlockTime,
+	}
+}
+
+// applyForkState updates the parameters stored in the ChildValidatorSet contract once a fork activates,
+// so the epoch commitments and the rewards distribution use the overridden epoch size and epoch reward
+func (p *PlgBFTConfig) applyForkState(blockNumber uint64, transition *state.Transition) {
+	for _, fork := range p.Forks {
+		if fork.From != blockNumber {
+			continue
+		}
+
+		if fork.EpochSize != nil {
+			transition.SetState(contracts.ValidatorSetContract, epochSizeStorageSlot,
+				types.BytesToHash(new(big.Int).SetUint64(*fork.EpochSize).Bytes()))
+		}
+
+		if fork.EpochReward != nil {
+			transition.SetState(contracts.ValidatorSetContract, epochRewardStorageSlot,
+				types.BytesToHash(new(big.Int).SetUint64(*fork.EpochReward).Bytes()))
+		}
+	}
+}
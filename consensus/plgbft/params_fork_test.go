@@ -0,0 +1,152 @@
+package plgbft
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/consensus/emptyblock"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	"github.com/plingatech/go-plgchain/contracts"
+	"github.com/plingatech/go-plgchain/helper/common"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
+
+func TestPlgBFTConfig_ParamsAt(t *testing.T) {
+	t.Parallel()
+
+	config := &PlgBFTConfig{
+		EpochSize:   10,
+		EpochReward: 1,
+		SprintSize:  5,
+		BlockTime:   common.Duration{Duration: 2 * time.Second},
+		Forks: []*ParamsFork{
+			{From: 21, EpochSize: uint64Ptr(20), CheckpointInterval: uint64Ptr(10)},
+			{
+				From:             61,
+				BlockTime:        &common.Duration{Duration: time.Second},
+				MaxBlockInterval: &common.Duration{Duration: time.Minute},
+				EpochReward:      uint64Ptr(5),
+			},
+		},
+	}
+
+	require.NoError(t, config.ValidateForks())
+
+	require.Equal(t, &ConsensusParams{
+		EpochSize:          10,
+		EpochReward:        1,
+		SprintSize:         5,
+		BlockTime:          2 * time.Second,
+		CheckpointInterval: defaultCheckpointsOffset,
+	}, config.ParamsAt(20))
+
+	require.Equal(t, &ConsensusParams{
+		EpochSize:          20,
+		EpochReward:        1,
+		SprintSize:         5,
+		BlockTime:          2 * time.Second,
+		CheckpointInterval: 10,
+	}, config.ParamsAt(21))
+
+	// parameters which are not overridden keep the values of the previous forks
+	require.Equal(t, &ConsensusParams{
+		EpochSize:          20,
+		EpochReward:        5,
+		SprintSize:         5,
+		BlockTime:          time.Second,
+		MaxBlockInterval:   time.Minute,
+		CheckpointInterval: 10,
+	}, config.ParamsAt(100))
+
+	runtime := &consensusRuntime{config: &runtimeConfig{PlgBFTConfig: config}}
+
+	require.True(t, runtime.isFixedSizeOfEpochMet(20, &epochMetadata{FirstBlockInEpoch: 11}))
+	require.False(t, runtime.isFixedSizeOfEpochMet(30, &epochMetadata{FirstBlockInEpoch: 21}))
+	require.True(t, runtime.isFixedSizeOfEpochMet(40, &epochMetadata{FirstBlockInEpoch: 21}))
+}
+
+func TestPlgBFTConfig_ValidateForks(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(forks ...*ParamsFork) *PlgBFTConfig {
+		return &PlgBFTConfig{EpochSize: 10, SprintSize: 5, Forks: forks}
+	}
+
+	require.NoError(t, newConfig().ValidateForks())
+	require.NoError(t, newConfig(&ParamsFork{From: 11, EpochSize: uint64Ptr(7)}, &ParamsFork{From: 25}).ValidateForks())
+
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 21}, &ParamsFork{From: 11}).ValidateForks(), errForksNotSorted)
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11}, &ParamsFork{From: 11}).ValidateForks(), errForksNotSorted)
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11, EpochSize: uint64Ptr(0)}).ValidateForks(), errInvalidEpochSize)
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11, SprintSize: uint64Ptr(0)}).ValidateForks(), errInvalidSprintSize)
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11, CheckpointInterval: uint64Ptr(0)}).ValidateForks(),
+		errInvalidCheckpoint)
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11, BlockTime: &common.Duration{}}).ValidateForks(),
+		errInvalidBlockTime)
+
+	// max block interval must not be shorter than the block time in effect
+	blockTime := &common.Duration{Duration: 2 * time.Second}
+	require.NoError(t, newConfig(&ParamsFork{From: 11, MaxBlockInterval: &common.Duration{Duration: time.Minute}}).ValidateForks())
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11, MaxBlockInterval: &common.Duration{Duration: time.Second},
+		BlockTime: blockTime}).ValidateForks(), emptyblock.ErrInvalidMaxBlockInterval)
+	require.ErrorIs(t, newConfig(&ParamsFork{From: 11, MaxBlockInterval: &common.Duration{Duration: 3 * time.Second}},
+		&ParamsFork{From: 21, BlockTime: &common.Duration{Duration: 5 * time.Second}}).ValidateForks(),
+		emptyblock.ErrInvalidMaxBlockInterval)
+
+	// epoch which started before the fork keeps its size
+	require.ErrorContains(t, newConfig(&ParamsFork{From: 11, EpochSize: uint64Ptr(7)}, &ParamsFork{From: 21}).ValidateForks(),
+		"not the first block of an epoch")
+	require.ErrorContains(t, newConfig(&ParamsFork{From: 15}).ValidateForks(), "not the first block of an epoch")
+}
+
+func TestPlgBFTConfig_applyForkState(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t, map[types.Address]*chain.GenesisAccount{
+		contracts.ValidatorSetContract: {Code: contractsapi.ChildValidatorSet.DeployedBytecode},
+		contracts.BLSContract:          {Code: contractsapi.BLS.DeployedBytecode},
+	})
+
+	config := &PlgBFTConfig{
+		EpochSize:   10,
+		EpochReward: 1,
+		Governance:  types.StringToAddress("1"),
+		Forks: []*ParamsFork{
+			{From: 11, SprintSize: uint64Ptr(2)},
+			{From: 21, EpochSize: uint64Ptr(20), EpochReward: uint64Ptr(3)},
+		},
+	}
+
+	input, err := getInitChildValidatorSetInput(*config)
+	require.NoError(t, err)
+	require.NoError(t, initContract(contracts.ValidatorSetContract, input, "ChildValidatorSet", transition))
+
+	getParam := func(method string) uint64 {
+		t.Helper()
+
+		result := transition.Call2(contracts.SystemCaller, contracts.ValidatorSetContract,
+			contractsapi.ChildValidatorSet.Abi.Methods[method].ID(), big.NewInt(0), 1000000)
+		require.NoError(t, result.Err)
+
+		return new(big.Int).SetBytes(result.ReturnValue).Uint64()
+	}
+
+	for _, blockNumber := range []uint64{10, 11, 20} {
+		config.applyForkState(blockNumber, transition)
+
+		require.Equal(t, uint64(10), getParam("epochSize"))
+		require.Equal(t, uint64(1), getParam("epochReward"))
+	}
+
+	config.applyForkState(21, transition)
+
+	require.Equal(t, uint64(20), getParam("epochSize"))
+	require.Equal(t, uint64(3), getParam("epochReward"))
+}
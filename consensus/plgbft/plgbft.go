@@ -10,9 +10,12 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/chain"
 	"github.com/plingatech/go-plgchain/consensus"
+	"github.com/plingatech/go-plgchain/consensus/emptyblock"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/contractsapi"
+	plgbftProto "github.com/plingatech/go-plgchain/consensus/plgbft/proto"
 	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	"github.com/plingatech/go-plgchain/contracts"
 	"github.com/plingatech/go-plgchain/helper/common"
 	"github.com/plingatech/go-plgchain/helper/progress"
@@ -58,6 +61,10 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		return nil, err
 	}
 
+	if err := plgbft.consensusConfig.ValidateForks(); err != nil {
+		return nil, fmt.Errorf("invalid plgbft forks: %w", err)
+	}
+
 	return plgbft, nil
 }
 
@@ -109,6 +116,15 @@ type Plgbft struct {
 
 	// tx pool as interface
 	txPool txPoolInterface
+
+	// operator is the grpc operator service
+	operator *operator
+
+	// emptyBlockSkipper delays the empty blocks up to the max block interval
+	emptyBlockSkipper *emptyblock.Skipper
+
+	// roundTracker applies the round timeouts and tracks the rounds of the sequence
+	roundTracker *roundchange.Tracker
 }
 
 func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *state.Transition) error {
@@ -190,15 +206,15 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 func (p *Plgbft) Initialize() error {
 	p.logger.Info("initializing plgbft...")
 
-	// read account
-	account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
-	if err != nil {
+	var err error
+
+	// set key, the validator keys are either held by the remote signer or read from the secrets manager
+	if p.config.RemoteSigner != nil {
+		p.key = wallet.NewRemoteKey(p.config.RemoteSigner)
+	} else if p.key, err = wallet.NewKeyFromSecrets(p.config.SecretsManager); err != nil {
 		return fmt.Errorf("failed to read account data. Error: %w", err)
 	}
 
-	// set key
-	p.key = wallet.NewKey(account)
-
 	// create and set syncer
 	p.syncer = syncer.NewSyncer(
 		p.config.Logger.Named("syncer"),
@@ -209,8 +225,9 @@ func (p *Plgbft) Initialize() error {
 
 	// set blockchain backend
 	p.blockchain = &blockchainWrapper{
-		blockchain: p.config.Blockchain,
-		executor:   p.config.Executor,
+		blockchain:     p.config.Blockchain,
+		executor:       p.config.Executor,
+		preCommitState: p.PreCommitState,
 	}
 
 	// create bridge and consensus topics
@@ -221,6 +238,21 @@ func (p *Plgbft) Initialize() error {
 	// set block time
 	p.blockTime = time.Duration(p.config.BlockTime)
 
+	// the parameters are validated with the forks and resolved per block, since the forks override them
+	p.emptyBlockSkipper = emptyblock.NewForkSkipper(
+		func(number uint64) emptyblock.Params {
+			return p.consensusConfig.ParamsAt(number).skipperParams()
+		},
+		func() bool { return p.txPool.Length() > 0 },
+	)
+
+	p.roundTracker, err = roundchange.NewTracker(p.config.RoundTimeout, func(extension time.Duration) {
+		p.ibft.ExtendRoundTimeout(extension)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create round tracker. Error: %w", err)
+	}
+
 	// initialize plgbft consensus data directory
 	p.dataDir = filepath.Join(p.config.Config.Path, "plgbft")
 	// create the data dir if not exists
@@ -243,6 +275,12 @@ func (p *Plgbft) Initialize() error {
 
 	p.ibft = newIBFTConsensusWrapper(p.logger, p.runtime, p)
 
+	// register the grpc operator
+	if p.config.Grpc != nil {
+		p.operator = &operator{plgbft: p}
+		plgbftProto.RegisterPlgbftOperatorServer(p.config.Grpc, p.operator)
+	}
+
 	if err = p.subscribeToIbftTopic(); err != nil {
 		return fmt.Errorf("IBFT topic subscription failed: %w", err)
 	}
@@ -295,6 +333,7 @@ func (p *Plgbft) initRuntime() error {
 		txPool:                p.txPool,
 		bridgeTopic:           p.bridgeTopic,
 		numBlockConfirmations: p.config.NumBlockConfirmations,
+		roundTracker:          p.roundTracker,
 	}
 
 	runtime, err := newConsensusRuntime(p.logger, runtimeConfig)
@@ -363,6 +402,24 @@ func (p *Plgbft) startConsensusProtocol() {
 
 		p.txPool.SetSealing(isValidator) // update tx pool
 
+		// empty blocks are skipped until there are transactions to include,
+		// except for the epoch and sprint ending blocks which are produced on schedule
+		if isValidator && p.emptyBlockSkipper.Enabled(latestHeader.Number+1) && !p.runtime.isEndOfSprintOrEpoch(latestHeader.Number+1) {
+			produceCh, stopWaiting := p.emptyBlockSkipper.Wait(latestHeader)
+
+			select {
+			case <-produceCh:
+			case <-syncerBlockCh:
+				stopWaiting()
+
+				continue
+			case <-p.closeCh:
+				stopWaiting()
+
+				return
+			}
+		}
+
 		if isValidator {
 			// initialze FSM as a stateless ibft backend via runtime as an adapter
 			err = p.runtime.FSM()
@@ -372,6 +429,7 @@ func (p *Plgbft) startConsensusProtocol() {
 				continue
 			}
 
+			p.roundTracker.StartSequence(latestHeader.Number + 1)
 			sequenceCh, stopSequence = p.ibft.runSequence(latestHeader.Number + 1)
 		}
 
@@ -482,8 +540,9 @@ func (p *Plgbft) GetBlockCreator(h *types.Header) (types.Address, error) {
 }
 
 // PreCommitState a hook to be called before finalizing state transition on inserting block
-func (p *Plgbft) PreCommitState(_ *types.Header, _ *state.Transition) error {
-	// Not required
+func (p *Plgbft) PreCommitState(header *types.Header, transition *state.Transition) error {
+	p.consensusConfig.applyForkState(header.Number, transition)
+
 	return nil
 }
 
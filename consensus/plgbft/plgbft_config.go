@@ -35,6 +35,10 @@ type PlgBFTConfig struct {
 	// BlockTime is target frequency of blocks production
 	BlockTime common.Duration `json:"blockTime"`
 
+	// MaxBlockInterval is the maximum period between blocks if there are no pending transactions.
+	// Empty blocks are not skipped if it is zero
+	MaxBlockInterval common.Duration `json:"maxBlockInterval"`
+
 	// Governance is the initial governance address
 	Governance types.Address `json:"governance"`
 
@@ -45,6 +49,9 @@ type PlgBFTConfig struct {
 	NativeTokenConfig *TokenConfig `json:"nativeTokenConfig"`
 
 	InitialTrieRoot types.Hash `json:"initialTrieRoot"`
+
+	// Forks override the consensus parameters starting from the given heights
+	Forks []*ParamsFork `json:"forks,omitempty"`
 }
 
 // GetPlgBFTConfig deserializes provided chain config and returns PlgBFTConfig
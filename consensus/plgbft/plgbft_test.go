@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/consensus"
 	"github.com/plingatech/go-plgchain/consensus/ibft/signer"
+	"github.com/plingatech/go-plgchain/consensus/liveness"
 	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
 	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
 	"github.com/plingatech/go-plgchain/helper/progress"
@@ -202,7 +203,12 @@ func TestPlgbft_Close(t *testing.T) {
 	plgbft := Plgbft{
 		closeCh: make(chan struct{}),
 		syncer:  syncer,
-		runtime: &consensusRuntime{stateSyncManager: &dummyStateSyncManager{}},
+		runtime: &consensusRuntime{
+			stateSyncManager: &dummyStateSyncManager{},
+			state:            newTestState(t),
+			livenessTracker:  liveness.NewTracker(liveness.DefaultWindowSize, nil),
+			logger:           hclog.NewNullLogger(),
+		},
 	}
 
 	assert.NoError(t, plgbft.Close())
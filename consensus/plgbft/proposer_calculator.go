@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/plingatech/go-plgchain/helper/common"
@@ -29,6 +30,19 @@ type ProposerSnapshot struct {
 	Round      uint64
 	Proposer   *PrioritizedValidator
 	Validators []*PrioritizedValidator
+
+	// proposers are the proposers calculated for the rounds, so each of them is calculated once
+	proposers map[proposerRound]types.Address
+
+	// lock guards the calculated proposer, since the sequence routine of the consensus calculates it
+	// while the messages of other rounds are checked for the quorum
+	lock sync.RWMutex
+}
+
+// proposerRound is the height and the round of the calculated proposer
+type proposerRound struct {
+	height uint64
+	round  uint64
 }
 
 // NewProposerSnapshotFromState create ProposerSnapshot from state if possible or from genesis block
@@ -73,6 +87,9 @@ func (pcs *ProposerSnapshot) CalcProposer(round, height uint64) (types.Address,
 		return types.ZeroAddress, fmt.Errorf("invalid height - expected %d, got %d", pcs.Height, height)
 	}
 
+	pcs.lock.Lock()
+	defer pcs.lock.Unlock()
+
 	// optimization -> return current proposer if already calculated for this round
 	if pcs.Round == round && pcs.Proposer != nil {
 		return pcs.Proposer.Metadata.Address, nil
@@ -80,21 +97,62 @@ func (pcs *ProposerSnapshot) CalcProposer(round, height uint64) (types.Address,
 
 	// do not change priorities on original snapshot while executing CalcProposer
 	// if round = 0 then we need one iteration
-	proposer, err := incrementProposerPriorityNTimes(pcs.Copy(), round+1)
+	proposer, err := incrementProposerPriorityNTimes(pcs.copy(), round+1)
 	if err != nil {
 		return types.ZeroAddress, err
 	}
 
 	pcs.Proposer = proposer
 	pcs.Round = round
+	pcs.cacheProposer(round, proposer.Metadata.Address)
 
 	return proposer.Metadata.Address, nil
 }
 
+// GetProposer returns the proposer of the given round, calculating it only if it is not calculated yet.
+// Unlike CalcProposer, the latest calculated proposer stays the same
+func (pcs *ProposerSnapshot) GetProposer(round, height uint64) (types.Address, error) {
+	if height != pcs.Height {
+		return types.ZeroAddress, fmt.Errorf("invalid height - expected %d, got %d", pcs.Height, height)
+	}
+
+	pcs.lock.Lock()
+	defer pcs.lock.Unlock()
+
+	if proposer, ok := pcs.proposers[proposerRound{height: height, round: round}]; ok {
+		return proposer, nil
+	}
+
+	proposer, err := incrementProposerPriorityNTimes(pcs.copy(), round+1)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	pcs.cacheProposer(round, proposer.Metadata.Address)
+
+	return proposer.Metadata.Address, nil
+}
+
+// cacheProposer saves the calculated proposer of the given round, the caller must hold the lock
+func (pcs *ProposerSnapshot) cacheProposer(round uint64, proposer types.Address) {
+	if pcs.proposers == nil {
+		pcs.proposers = make(map[proposerRound]types.Address)
+	}
+
+	pcs.proposers[proposerRound{height: pcs.Height, round: round}] = proposer
+}
+
 // GetLatestProposer returns latest calculated proposer if any
 func (pcs *ProposerSnapshot) GetLatestProposer(round, height uint64) (types.Address, error) {
+	if pcs == nil {
+		return types.ZeroAddress, fmt.Errorf("get latest proposer not found - height: %d, round: %d", height, round)
+	}
+
+	pcs.lock.RLock()
+	defer pcs.lock.RUnlock()
+
 	// round must be same as saved one and proposer must exist
-	if pcs == nil || pcs.Proposer == nil || pcs.Round != round || pcs.Height != height {
+	if pcs.Proposer == nil || pcs.Round != round || pcs.Height != height {
 		return types.ZeroAddress,
 			fmt.Errorf("get latest proposer not found - height: %d, round: %d, pc height: %d, pc round: %d",
 				height, round, pcs.Height, pcs.Round)
@@ -104,7 +162,7 @@ func (pcs *ProposerSnapshot) GetLatestProposer(round, height uint64) (types.Addr
 }
 
 // GetTotalVotingPower returns total voting power from all the validators
-func (pcs ProposerSnapshot) GetTotalVotingPower() *big.Int {
+func (pcs *ProposerSnapshot) GetTotalVotingPower() *big.Int {
 	totalVotingPower := new(big.Int)
 	for _, v := range pcs.Validators {
 		totalVotingPower.Add(totalVotingPower, v.Metadata.VotingPower)
@@ -115,12 +173,23 @@ func (pcs ProposerSnapshot) GetTotalVotingPower() *big.Int {
 
 // Copy Returns copy of current ProposerSnapshot object
 func (pcs *ProposerSnapshot) Copy() *ProposerSnapshot {
+	pcs.lock.RLock()
+	defer pcs.lock.RUnlock()
+
+	return pcs.copy()
+}
+
+// copy returns copy of current ProposerSnapshot object, the caller must hold the lock
+func (pcs *ProposerSnapshot) copy() *ProposerSnapshot {
 	var proposer *PrioritizedValidator
 
 	valCopy := make([]*PrioritizedValidator, len(pcs.Validators))
 
 	for i, val := range pcs.Validators {
-		valCopy[i] = &PrioritizedValidator{Metadata: val.Metadata.Copy(), ProposerPriority: val.ProposerPriority}
+		valCopy[i] = &PrioritizedValidator{
+			Metadata:         val.Metadata.Copy(),
+			ProposerPriority: new(big.Int).Set(val.ProposerPriority),
+		}
 
 		if pcs.Proposer != nil && pcs.Proposer.Metadata.Address == val.Metadata.Address {
 			proposer = valCopy[i]
@@ -258,6 +327,7 @@ func (pc *ProposerCalculator) updatePerBlock(blockNumber uint64) error {
 	pc.snapshot.Height = blockNumber + 1 // snapshot (validator priorities) is prepared for the next block
 	pc.snapshot.Round = 0
 	pc.snapshot.Proposer = nil
+	pc.snapshot.proposers = nil
 
 	return nil
 }
@@ -3,6 +3,7 @@ package plgbft
 import (
 	"bytes"
 	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
@@ -441,6 +442,92 @@ func TestProposerCalculator_GetLatestProposer(t *testing.T) {
 	assert.Equal(t, proposerAddress, address)
 }
 
+func TestProposerCalculator_GetProposer(t *testing.T) {
+	t.Parallel()
+
+	validatorSet := newTestValidators(t, 5).getPublicIdentities()
+	snapshot := NewProposerSnapshot(1, validatorSet)
+
+	latest, err := snapshot.CalcProposer(0, 1)
+	require.NoError(t, err)
+
+	expected, err := NewProposerSnapshot(1, validatorSet).CalcProposer(2, 1)
+	require.NoError(t, err)
+
+	proposer, err := snapshot.GetProposer(2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, expected, proposer)
+
+	// the latest calculated proposer stays the same
+	address, err := snapshot.GetLatestProposer(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, latest, address)
+
+	// the proposer of the round is calculated only once, so changing the priorities doesn't affect it
+	for _, v := range snapshot.Validators {
+		if v.Metadata.Address != expected {
+			v.ProposerPriority = big.NewInt(1000000)
+
+			break
+		}
+	}
+
+	proposer, err = snapshot.GetProposer(2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, expected, proposer)
+
+	proposer, err = snapshot.GetProposer(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, latest, proposer)
+
+	// wrong height
+	_, err = snapshot.GetProposer(2, 2)
+	assert.Error(t, err)
+}
+
+func TestProposerCalculator_ConcurrentCalcProposer(t *testing.T) {
+	t.Parallel()
+
+	const rounds = 20
+
+	validatorSet := newTestValidators(t, 5).getPublicIdentities()
+	snapshot := NewProposerSnapshot(1, validatorSet)
+
+	// expected proposers are calculated on the separate snapshot
+	expected := make([]types.Address, rounds)
+
+	for round := uint64(0); round < rounds; round++ {
+		proposer, err := NewProposerSnapshot(1, validatorSet).CalcProposer(round, 1)
+		require.NoError(t, err)
+
+		expected[round] = proposer
+	}
+
+	var wg sync.WaitGroup
+
+	for round := uint64(0); round < rounds; round++ {
+		wg.Add(2)
+
+		go func(round uint64) {
+			defer wg.Done()
+
+			proposer, err := snapshot.CalcProposer(round, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, expected[round], proposer)
+		}(round)
+
+		go func(round uint64) {
+			defer wg.Done()
+
+			proposer, err := snapshot.GetProposer(round, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, expected[round], proposer)
+		}(round)
+	}
+
+	wg.Wait()
+}
+
 func TestProposerCalculator_UpdateValidatorsSameVpUpdatedAndNewAdded(t *testing.T) {
 	t.Parallel()
 
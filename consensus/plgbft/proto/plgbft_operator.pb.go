@@ -0,0 +1,878 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: consensus/plgbft/proto/plgbft_operator.proto
+
+package proto
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlgbftStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of the next proposers to calculate
+	Proposers uint64 `protobuf:"varint,1,opt,name=proposers,proto3" json:"proposers,omitempty"`
+}
+
+func (x *PlgbftStatusReq) Reset() {
+	*x = PlgbftStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftStatusReq) ProtoMessage() {}
+
+func (x *PlgbftStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftStatusReq.ProtoReflect.Descriptor instead.
+func (*PlgbftStatusReq) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlgbftStatusReq) GetProposers() uint64 {
+	if x != nil {
+		return x.Proposers
+	}
+	return 0
+}
+
+type PlgbftStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key                 string                          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	BlockNumber         uint64                          `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Epoch               uint64                          `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	FirstBlockInEpoch   uint64                          `protobuf:"varint,4,opt,name=first_block_in_epoch,json=firstBlockInEpoch,proto3" json:"first_block_in_epoch,omitempty"`
+	EpochSize           uint64                          `protobuf:"varint,5,opt,name=epoch_size,json=epochSize,proto3" json:"epoch_size,omitempty"`
+	SprintSize          uint64                          `protobuf:"varint,6,opt,name=sprint_size,json=sprintSize,proto3" json:"sprint_size,omitempty"`
+	Validators          []*PlgbftStatusResp_Validator   `protobuf:"bytes,7,rep,name=validators,proto3" json:"validators,omitempty"`
+	NextProposers       []string                        `protobuf:"bytes,8,rep,name=next_proposers,json=nextProposers,proto3" json:"next_proposers,omitempty"`
+	LastCheckpointBlock uint64                          `protobuf:"varint,9,opt,name=last_checkpoint_block,json=lastCheckpointBlock,proto3" json:"last_checkpoint_block,omitempty"`
+	PendingCommitments  []*PlgbftStatusResp_Commitment  `protobuf:"bytes,10,rep,name=pending_commitments,json=pendingCommitments,proto3" json:"pending_commitments,omitempty"`
+	Buckets             []*PlgbftStatusResp_BucketStats `protobuf:"bytes,11,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *PlgbftStatusResp) Reset() {
+	*x = PlgbftStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftStatusResp) ProtoMessage() {}
+
+func (x *PlgbftStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftStatusResp.ProtoReflect.Descriptor instead.
+func (*PlgbftStatusResp) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlgbftStatusResp) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PlgbftStatusResp) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp) GetFirstBlockInEpoch() uint64 {
+	if x != nil {
+		return x.FirstBlockInEpoch
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp) GetEpochSize() uint64 {
+	if x != nil {
+		return x.EpochSize
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp) GetSprintSize() uint64 {
+	if x != nil {
+		return x.SprintSize
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp) GetValidators() []*PlgbftStatusResp_Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *PlgbftStatusResp) GetNextProposers() []string {
+	if x != nil {
+		return x.NextProposers
+	}
+	return nil
+}
+
+func (x *PlgbftStatusResp) GetLastCheckpointBlock() uint64 {
+	if x != nil {
+		return x.LastCheckpointBlock
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp) GetPendingCommitments() []*PlgbftStatusResp_Commitment {
+	if x != nil {
+		return x.PendingCommitments
+	}
+	return nil
+}
+
+func (x *PlgbftStatusResp) GetBuckets() []*PlgbftStatusResp_BucketStats {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type PlgbftLivenessReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of the epoch to query, the current epoch if not specified
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *PlgbftLivenessReq) Reset() {
+	*x = PlgbftLivenessReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftLivenessReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftLivenessReq) ProtoMessage() {}
+
+func (x *PlgbftLivenessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftLivenessReq.ProtoReflect.Descriptor instead.
+func (*PlgbftLivenessReq) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlgbftLivenessReq) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type PlgbftLivenessResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WindowFrom    uint64                                  `protobuf:"varint,1,opt,name=window_from,json=windowFrom,proto3" json:"window_from,omitempty"`
+	WindowTo      uint64                                  `protobuf:"varint,2,opt,name=window_to,json=windowTo,proto3" json:"window_to,omitempty"`
+	Window        []*PlgbftLivenessResp_ValidatorLiveness `protobuf:"bytes,3,rep,name=window,proto3" json:"window,omitempty"`
+	Epoch         uint64                                  `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EpochLiveness []*PlgbftLivenessResp_ValidatorLiveness `protobuf:"bytes,5,rep,name=epoch_liveness,json=epochLiveness,proto3" json:"epoch_liveness,omitempty"`
+}
+
+func (x *PlgbftLivenessResp) Reset() {
+	*x = PlgbftLivenessResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftLivenessResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftLivenessResp) ProtoMessage() {}
+
+func (x *PlgbftLivenessResp) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftLivenessResp.ProtoReflect.Descriptor instead.
+func (*PlgbftLivenessResp) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlgbftLivenessResp) GetWindowFrom() uint64 {
+	if x != nil {
+		return x.WindowFrom
+	}
+	return 0
+}
+
+func (x *PlgbftLivenessResp) GetWindowTo() uint64 {
+	if x != nil {
+		return x.WindowTo
+	}
+	return 0
+}
+
+func (x *PlgbftLivenessResp) GetWindow() []*PlgbftLivenessResp_ValidatorLiveness {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *PlgbftLivenessResp) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *PlgbftLivenessResp) GetEpochLiveness() []*PlgbftLivenessResp_ValidatorLiveness {
+	if x != nil {
+		return x.EpochLiveness
+	}
+	return nil
+}
+
+type PlgbftStatusResp_Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	VotingPower string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	Active      bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *PlgbftStatusResp_Validator) Reset() {
+	*x = PlgbftStatusResp_Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftStatusResp_Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftStatusResp_Validator) ProtoMessage() {}
+
+func (x *PlgbftStatusResp_Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftStatusResp_Validator.ProtoReflect.Descriptor instead.
+func (*PlgbftStatusResp_Validator) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *PlgbftStatusResp_Validator) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PlgbftStatusResp_Validator) GetVotingPower() string {
+	if x != nil {
+		return x.VotingPower
+	}
+	return ""
+}
+
+func (x *PlgbftStatusResp_Validator) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PlgbftStatusResp_Commitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch   uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StartId uint64 `protobuf:"varint,2,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	EndId   uint64 `protobuf:"varint,3,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
+	Root    string `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *PlgbftStatusResp_Commitment) Reset() {
+	*x = PlgbftStatusResp_Commitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftStatusResp_Commitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftStatusResp_Commitment) ProtoMessage() {}
+
+func (x *PlgbftStatusResp_Commitment) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftStatusResp_Commitment.ProtoReflect.Descriptor instead.
+func (*PlgbftStatusResp_Commitment) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *PlgbftStatusResp_Commitment) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp_Commitment) GetStartId() uint64 {
+	if x != nil {
+		return x.StartId
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp_Commitment) GetEndId() uint64 {
+	if x != nil {
+		return x.EndId
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp_Commitment) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+type PlgbftStatusResp_BucketStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keys       uint64 `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Depth      uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	InUseBytes uint64 `protobuf:"varint,4,opt,name=in_use_bytes,json=inUseBytes,proto3" json:"in_use_bytes,omitempty"`
+}
+
+func (x *PlgbftStatusResp_BucketStats) Reset() {
+	*x = PlgbftStatusResp_BucketStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftStatusResp_BucketStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftStatusResp_BucketStats) ProtoMessage() {}
+
+func (x *PlgbftStatusResp_BucketStats) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftStatusResp_BucketStats.ProtoReflect.Descriptor instead.
+func (*PlgbftStatusResp_BucketStats) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *PlgbftStatusResp_BucketStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlgbftStatusResp_BucketStats) GetKeys() uint64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp_BucketStats) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *PlgbftStatusResp_BucketStats) GetInUseBytes() uint64 {
+	if x != nil {
+		return x.InUseBytes
+	}
+	return 0
+}
+
+type PlgbftLivenessResp_ValidatorLiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BlocksSigned    uint64 `protobuf:"varint,2,opt,name=blocks_signed,json=blocksSigned,proto3" json:"blocks_signed,omitempty"`
+	BlocksMissed    uint64 `protobuf:"varint,3,opt,name=blocks_missed,json=blocksMissed,proto3" json:"blocks_missed,omitempty"`
+	ProposalsMade   uint64 `protobuf:"varint,4,opt,name=proposals_made,json=proposalsMade,proto3" json:"proposals_made,omitempty"`
+	ProposalsMissed uint64 `protobuf:"varint,5,opt,name=proposals_missed,json=proposalsMissed,proto3" json:"proposals_missed,omitempty"`
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) Reset() {
+	*x = PlgbftLivenessResp_ValidatorLiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlgbftLivenessResp_ValidatorLiveness) ProtoMessage() {}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlgbftLivenessResp_ValidatorLiveness.ProtoReflect.Descriptor instead.
+func (*PlgbftLivenessResp_ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) GetBlocksSigned() uint64 {
+	if x != nil {
+		return x.BlocksSigned
+	}
+	return 0
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) GetBlocksMissed() uint64 {
+	if x != nil {
+		return x.BlocksMissed
+	}
+	return 0
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) GetProposalsMade() uint64 {
+	if x != nil {
+		return x.ProposalsMade
+	}
+	return 0
+}
+
+func (x *PlgbftLivenessResp_ValidatorLiveness) GetProposalsMissed() uint64 {
+	if x != nil {
+		return x.ProposalsMissed
+	}
+	return 0
+}
+
+var File_consensus_plgbft_proto_plgbft_operator_proto protoreflect.FileDescriptor
+
+var file_consensus_plgbft_proto_plgbft_operator_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x70, 0x6c, 0x67, 0x62,
+	0x66, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a, 0x0f, 0x50,
+	0x6c, 0x67, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x64, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x73, 0x22, 0xb2, 0x06, 0x0a, 0x10, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x14, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
+	0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a,
+	0x60, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x1a, 0x68, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x1a, 0x6d, 0x0a, 0x0b, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x6e, 0x5f, 0x75,
+	0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x50, 0x6c,
+	0x67, 0x62, 0x66, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xc7, 0x03, 0x0a, 0x12, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74,
+	0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a,
+	0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x6f, 0x12, 0x40, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x69, 0x76, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x1a, 0xc9, 0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x4d, 0x61, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x32,
+	0x80, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x76, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x4c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x42, 0x19, 0x5a, 0x17, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2f, 0x70, 0x6c, 0x67, 0x62, 0x66, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_consensus_plgbft_proto_plgbft_operator_proto_rawDescOnce sync.Once
+	file_consensus_plgbft_proto_plgbft_operator_proto_rawDescData = file_consensus_plgbft_proto_plgbft_operator_proto_rawDesc
+)
+
+func file_consensus_plgbft_proto_plgbft_operator_proto_rawDescGZIP() []byte {
+	file_consensus_plgbft_proto_plgbft_operator_proto_rawDescOnce.Do(func() {
+		file_consensus_plgbft_proto_plgbft_operator_proto_rawDescData = protoimpl.X.CompressGZIP(file_consensus_plgbft_proto_plgbft_operator_proto_rawDescData)
+	})
+	return file_consensus_plgbft_proto_plgbft_operator_proto_rawDescData
+}
+
+var file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_consensus_plgbft_proto_plgbft_operator_proto_goTypes = []interface{}{
+	(*PlgbftStatusReq)(nil),                      // 0: v1.PlgbftStatusReq
+	(*PlgbftStatusResp)(nil),                     // 1: v1.PlgbftStatusResp
+	(*PlgbftLivenessReq)(nil),                    // 2: v1.PlgbftLivenessReq
+	(*PlgbftLivenessResp)(nil),                   // 3: v1.PlgbftLivenessResp
+	(*PlgbftStatusResp_Validator)(nil),           // 4: v1.PlgbftStatusResp.Validator
+	(*PlgbftStatusResp_Commitment)(nil),          // 5: v1.PlgbftStatusResp.Commitment
+	(*PlgbftStatusResp_BucketStats)(nil),         // 6: v1.PlgbftStatusResp.BucketStats
+	(*PlgbftLivenessResp_ValidatorLiveness)(nil), // 7: v1.PlgbftLivenessResp.ValidatorLiveness
+}
+var file_consensus_plgbft_proto_plgbft_operator_proto_depIdxs = []int32{
+	4, // 0: v1.PlgbftStatusResp.validators:type_name -> v1.PlgbftStatusResp.Validator
+	5, // 1: v1.PlgbftStatusResp.pending_commitments:type_name -> v1.PlgbftStatusResp.Commitment
+	6, // 2: v1.PlgbftStatusResp.buckets:type_name -> v1.PlgbftStatusResp.BucketStats
+	7, // 3: v1.PlgbftLivenessResp.window:type_name -> v1.PlgbftLivenessResp.ValidatorLiveness
+	7, // 4: v1.PlgbftLivenessResp.epoch_liveness:type_name -> v1.PlgbftLivenessResp.ValidatorLiveness
+	0, // 5: v1.PlgbftOperator.Status:input_type -> v1.PlgbftStatusReq
+	2, // 6: v1.PlgbftOperator.Liveness:input_type -> v1.PlgbftLivenessReq
+	1, // 7: v1.PlgbftOperator.Status:output_type -> v1.PlgbftStatusResp
+	3, // 8: v1.PlgbftOperator.Liveness:output_type -> v1.PlgbftLivenessResp
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_consensus_plgbft_proto_plgbft_operator_proto_init() }
+func file_consensus_plgbft_proto_plgbft_operator_proto_init() {
+	if File_consensus_plgbft_proto_plgbft_operator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftLivenessReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftLivenessResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftStatusResp_Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftStatusResp_Commitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftStatusResp_BucketStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlgbftLivenessResp_ValidatorLiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_consensus_plgbft_proto_plgbft_operator_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_consensus_plgbft_proto_plgbft_operator_proto_goTypes,
+		DependencyIndexes: file_consensus_plgbft_proto_plgbft_operator_proto_depIdxs,
+		MessageInfos:      file_consensus_plgbft_proto_plgbft_operator_proto_msgTypes,
+	}.Build()
+	File_consensus_plgbft_proto_plgbft_operator_proto = out.File
+	file_consensus_plgbft_proto_plgbft_operator_proto_rawDesc = nil
+	file_consensus_plgbft_proto_plgbft_operator_proto_goTypes = nil
+	file_consensus_plgbft_proto_plgbft_operator_proto_depIdxs = nil
+}
@@ -0,0 +1,1094 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: consensus/plgbft/proto/plgbft_operator.proto
+
+package proto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PlgbftStatusReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlgbftStatusReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftStatusReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlgbftStatusReqMultiError, or
+// nil if none found.
+func (m *PlgbftStatusReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftStatusReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProposers() > 100 {
+		err := PlgbftStatusReqValidationError{
+			field:  "Proposers",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlgbftStatusReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftStatusReqMultiError is an error wrapping multiple validation errors
+// returned by PlgbftStatusReq.ValidateAll() if the designated constraints
+// aren't met.
+type PlgbftStatusReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftStatusReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftStatusReqMultiError) AllErrors() []error { return m }
+
+// PlgbftStatusReqValidationError is the validation error returned by
+// PlgbftStatusReq.Validate if the designated constraints aren't met.
+type PlgbftStatusReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftStatusReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftStatusReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftStatusReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftStatusReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftStatusReqValidationError) ErrorName() string {
+	return "PlgbftStatusReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftStatusReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftStatusReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftStatusReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftStatusReqValidationError{}
+
+// Validate checks the field values on PlgbftStatusResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlgbftStatusResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftStatusResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PlgbftStatusRespMultiError, or nil if none found.
+func (m *PlgbftStatusResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftStatusResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Epoch
+
+	// no validation rules for FirstBlockInEpoch
+
+	// no validation rules for EpochSize
+
+	// no validation rules for SprintSize
+
+	for idx, item := range m.GetValidators() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlgbftStatusRespValidationError{
+						field:  fmt.Sprintf("Validators[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlgbftStatusRespValidationError{
+						field:  fmt.Sprintf("Validators[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlgbftStatusRespValidationError{
+					field:  fmt.Sprintf("Validators[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LastCheckpointBlock
+
+	for idx, item := range m.GetPendingCommitments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlgbftStatusRespValidationError{
+						field:  fmt.Sprintf("PendingCommitments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlgbftStatusRespValidationError{
+						field:  fmt.Sprintf("PendingCommitments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlgbftStatusRespValidationError{
+					field:  fmt.Sprintf("PendingCommitments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlgbftStatusRespValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlgbftStatusRespValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlgbftStatusRespValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlgbftStatusRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftStatusRespMultiError is an error wrapping multiple validation errors
+// returned by PlgbftStatusResp.ValidateAll() if the designated constraints
+// aren't met.
+type PlgbftStatusRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftStatusRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftStatusRespMultiError) AllErrors() []error { return m }
+
+// PlgbftStatusRespValidationError is the validation error returned by
+// PlgbftStatusResp.Validate if the designated constraints aren't met.
+type PlgbftStatusRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftStatusRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftStatusRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftStatusRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftStatusRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftStatusRespValidationError) ErrorName() string {
+	return "PlgbftStatusRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftStatusRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftStatusResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftStatusRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftStatusRespValidationError{}
+
+// Validate checks the field values on PlgbftStatusResp_Validator with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *PlgbftStatusResp_Validator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftStatusResp_Validator with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlgbftStatusResp_ValidatorMultiError, or nil if none found.
+func (m *PlgbftStatusResp_Validator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftStatusResp_Validator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for VotingPower
+
+	// no validation rules for Active
+
+	if len(errors) > 0 {
+		return PlgbftStatusResp_ValidatorMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftStatusResp_ValidatorMultiError is an error wrapping multiple validation
+// errors returned by PlgbftStatusResp_Validator.ValidateAll() if the designated
+// constraints aren't met.
+type PlgbftStatusResp_ValidatorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftStatusResp_ValidatorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftStatusResp_ValidatorMultiError) AllErrors() []error { return m }
+
+// PlgbftStatusResp_ValidatorValidationError is the validation error returned by
+// PlgbftStatusResp_Validator.Validate if the designated constraints aren't met.
+type PlgbftStatusResp_ValidatorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftStatusResp_ValidatorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftStatusResp_ValidatorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftStatusResp_ValidatorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftStatusResp_ValidatorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftStatusResp_ValidatorValidationError) ErrorName() string {
+	return "PlgbftStatusResp_ValidatorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftStatusResp_ValidatorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftStatusResp_Validator.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftStatusResp_ValidatorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftStatusResp_ValidatorValidationError{}
+
+// Validate checks the field values on PlgbftStatusResp_Commitment with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PlgbftStatusResp_Commitment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftStatusResp_Commitment with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlgbftStatusResp_CommitmentMultiError, or nil if none found.
+func (m *PlgbftStatusResp_Commitment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftStatusResp_Commitment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Epoch
+
+	// no validation rules for StartId
+
+	// no validation rules for EndId
+
+	// no validation rules for Root
+
+	if len(errors) > 0 {
+		return PlgbftStatusResp_CommitmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftStatusResp_CommitmentMultiError is an error wrapping multiple
+// validation errors returned by PlgbftStatusResp_Commitment.ValidateAll() if
+// the designated constraints aren't met.
+type PlgbftStatusResp_CommitmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftStatusResp_CommitmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftStatusResp_CommitmentMultiError) AllErrors() []error { return m }
+
+// PlgbftStatusResp_CommitmentValidationError is the validation error returned
+// by PlgbftStatusResp_Commitment.Validate if the designated constraints aren't
+// met.
+type PlgbftStatusResp_CommitmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftStatusResp_CommitmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftStatusResp_CommitmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftStatusResp_CommitmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftStatusResp_CommitmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftStatusResp_CommitmentValidationError) ErrorName() string {
+	return "PlgbftStatusResp_CommitmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftStatusResp_CommitmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftStatusResp_Commitment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftStatusResp_CommitmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftStatusResp_CommitmentValidationError{}
+
+// Validate checks the field values on PlgbftStatusResp_BucketStats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PlgbftStatusResp_BucketStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftStatusResp_BucketStats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlgbftStatusResp_BucketStatsMultiError, or nil if none found.
+func (m *PlgbftStatusResp_BucketStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftStatusResp_BucketStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Keys
+
+	// no validation rules for Depth
+
+	// no validation rules for InUseBytes
+
+	if len(errors) > 0 {
+		return PlgbftStatusResp_BucketStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftStatusResp_BucketStatsMultiError is an error wrapping multiple
+// validation errors returned by PlgbftStatusResp_BucketStats.ValidateAll() if
+// the designated constraints aren't met.
+type PlgbftStatusResp_BucketStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftStatusResp_BucketStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftStatusResp_BucketStatsMultiError) AllErrors() []error { return m }
+
+// PlgbftStatusResp_BucketStatsValidationError is the validation error returned
+// by PlgbftStatusResp_BucketStats.Validate if the designated constraints aren't
+// met.
+type PlgbftStatusResp_BucketStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftStatusResp_BucketStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftStatusResp_BucketStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftStatusResp_BucketStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftStatusResp_BucketStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftStatusResp_BucketStatsValidationError) ErrorName() string {
+	return "PlgbftStatusResp_BucketStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftStatusResp_BucketStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftStatusResp_BucketStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftStatusResp_BucketStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftStatusResp_BucketStatsValidationError{}
+
+// Validate checks the field values on PlgbftLivenessReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlgbftLivenessReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftLivenessReq with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PlgbftLivenessReqMultiError, or nil if none found.
+func (m *PlgbftLivenessReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftLivenessReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Epoch
+
+	if len(errors) > 0 {
+		return PlgbftLivenessReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftLivenessReqMultiError is an error wrapping multiple validation errors
+// returned by PlgbftLivenessReq.ValidateAll() if the designated constraints
+// aren't met.
+type PlgbftLivenessReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftLivenessReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftLivenessReqMultiError) AllErrors() []error { return m }
+
+// PlgbftLivenessReqValidationError is the validation error returned by
+// PlgbftLivenessReq.Validate if the designated constraints aren't met.
+type PlgbftLivenessReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftLivenessReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftLivenessReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftLivenessReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftLivenessReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftLivenessReqValidationError) ErrorName() string {
+	return "PlgbftLivenessReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftLivenessReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftLivenessReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftLivenessReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftLivenessReqValidationError{}
+
+// Validate checks the field values on PlgbftLivenessResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlgbftLivenessResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftLivenessResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PlgbftLivenessRespMultiError, or nil if none found.
+func (m *PlgbftLivenessResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftLivenessResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WindowFrom
+
+	// no validation rules for WindowTo
+
+	for idx, item := range m.GetWindow() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlgbftLivenessRespValidationError{
+						field:  fmt.Sprintf("Window[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlgbftLivenessRespValidationError{
+						field:  fmt.Sprintf("Window[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlgbftLivenessRespValidationError{
+					field:  fmt.Sprintf("Window[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Epoch
+
+	for idx, item := range m.GetEpochLiveness() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlgbftLivenessRespValidationError{
+						field:  fmt.Sprintf("EpochLiveness[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlgbftLivenessRespValidationError{
+						field:  fmt.Sprintf("EpochLiveness[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlgbftLivenessRespValidationError{
+					field:  fmt.Sprintf("EpochLiveness[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlgbftLivenessRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftLivenessRespMultiError is an error wrapping multiple validation errors
+// returned by PlgbftLivenessResp.ValidateAll() if the designated constraints
+// aren't met.
+type PlgbftLivenessRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftLivenessRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftLivenessRespMultiError) AllErrors() []error { return m }
+
+// PlgbftLivenessRespValidationError is the validation error returned by
+// PlgbftLivenessResp.Validate if the designated constraints aren't met.
+type PlgbftLivenessRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftLivenessRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftLivenessRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftLivenessRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftLivenessRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftLivenessRespValidationError) ErrorName() string {
+	return "PlgbftLivenessRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftLivenessRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftLivenessResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftLivenessRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftLivenessRespValidationError{}
+
+// Validate checks the field values on PlgbftLivenessResp_ValidatorLiveness with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PlgbftLivenessResp_ValidatorLiveness) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlgbftLivenessResp_ValidatorLiveness
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PlgbftLivenessResp_ValidatorLivenessMultiError, or nil if none found.
+func (m *PlgbftLivenessResp_ValidatorLiveness) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlgbftLivenessResp_ValidatorLiveness) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for BlocksSigned
+
+	// no validation rules for BlocksMissed
+
+	// no validation rules for ProposalsMade
+
+	// no validation rules for ProposalsMissed
+
+	if len(errors) > 0 {
+		return PlgbftLivenessResp_ValidatorLivenessMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlgbftLivenessResp_ValidatorLivenessMultiError is an error wrapping multiple
+// validation errors returned by
+// PlgbftLivenessResp_ValidatorLiveness.ValidateAll() if the designated
+// constraints aren't met.
+type PlgbftLivenessResp_ValidatorLivenessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlgbftLivenessResp_ValidatorLivenessMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlgbftLivenessResp_ValidatorLivenessMultiError) AllErrors() []error { return m }
+
+// PlgbftLivenessResp_ValidatorLivenessValidationError is the validation error
+// returned by PlgbftLivenessResp_ValidatorLiveness.Validate if the designated
+// constraints aren't met.
+type PlgbftLivenessResp_ValidatorLivenessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlgbftLivenessResp_ValidatorLivenessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlgbftLivenessResp_ValidatorLivenessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlgbftLivenessResp_ValidatorLivenessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlgbftLivenessResp_ValidatorLivenessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlgbftLivenessResp_ValidatorLivenessValidationError) ErrorName() string {
+	return "PlgbftLivenessResp_ValidatorLivenessValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlgbftLivenessResp_ValidatorLivenessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlgbftLivenessResp_ValidatorLiveness.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlgbftLivenessResp_ValidatorLivenessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlgbftLivenessResp_ValidatorLivenessValidationError{}
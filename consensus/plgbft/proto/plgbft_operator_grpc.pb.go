@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: consensus/plgbft/proto/plgbft_operator.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PlgbftOperatorClient is the client API for PlgbftOperator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlgbftOperatorClient interface {
+	Status(ctx context.Context, in *PlgbftStatusReq, opts ...grpc.CallOption) (*PlgbftStatusResp, error)
+	Liveness(ctx context.Context, in *PlgbftLivenessReq, opts ...grpc.CallOption) (*PlgbftLivenessResp, error)
+}
+
+type plgbftOperatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlgbftOperatorClient(cc grpc.ClientConnInterface) PlgbftOperatorClient {
+	return &plgbftOperatorClient{cc}
+}
+
+func (c *plgbftOperatorClient) Status(ctx context.Context, in *PlgbftStatusReq, opts ...grpc.CallOption) (*PlgbftStatusResp, error) {
+	out := new(PlgbftStatusResp)
+	err := c.cc.Invoke(ctx, "/v1.PlgbftOperator/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plgbftOperatorClient) Liveness(ctx context.Context, in *PlgbftLivenessReq, opts ...grpc.CallOption) (*PlgbftLivenessResp, error) {
+	out := new(PlgbftLivenessResp)
+	err := c.cc.Invoke(ctx, "/v1.PlgbftOperator/Liveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlgbftOperatorServer is the server API for PlgbftOperator service.
+// All implementations must embed UnimplementedPlgbftOperatorServer
+// for forward compatibility
+type PlgbftOperatorServer interface {
+	Status(context.Context, *PlgbftStatusReq) (*PlgbftStatusResp, error)
+	Liveness(context.Context, *PlgbftLivenessReq) (*PlgbftLivenessResp, error)
+	mustEmbedUnimplementedPlgbftOperatorServer()
+}
+
+// UnimplementedPlgbftOperatorServer must be embedded to have forward compatible implementations.
+type UnimplementedPlgbftOperatorServer struct {
+}
+
+func (UnimplementedPlgbftOperatorServer) Status(context.Context, *PlgbftStatusReq) (*PlgbftStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedPlgbftOperatorServer) Liveness(context.Context, *PlgbftLivenessReq) (*PlgbftLivenessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liveness not implemented")
+}
+func (UnimplementedPlgbftOperatorServer) mustEmbedUnimplementedPlgbftOperatorServer() {}
+
+// UnsafePlgbftOperatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlgbftOperatorServer will
+// result in compilation errors.
+type UnsafePlgbftOperatorServer interface {
+	mustEmbedUnimplementedPlgbftOperatorServer()
+}
+
+func RegisterPlgbftOperatorServer(s grpc.ServiceRegistrar, srv PlgbftOperatorServer) {
+	s.RegisterService(&PlgbftOperator_ServiceDesc, srv)
+}
+
+func _PlgbftOperator_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlgbftStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlgbftOperatorServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PlgbftOperator/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlgbftOperatorServer).Status(ctx, req.(*PlgbftStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlgbftOperator_Liveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlgbftLivenessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlgbftOperatorServer).Liveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PlgbftOperator/Liveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlgbftOperatorServer).Liveness(ctx, req.(*PlgbftLivenessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlgbftOperator_ServiceDesc is the grpc.ServiceDesc for PlgbftOperator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlgbftOperator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.PlgbftOperator",
+	HandlerType: (*PlgbftOperatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _PlgbftOperator_Status_Handler,
+		},
+		{
+			MethodName: "Liveness",
+			Handler:    _PlgbftOperator_Liveness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "consensus/plgbft/proto/plgbft_operator.proto",
+}
@@ -1,6 +1,7 @@
 package plgbft
 
 import (
+	"bytes"
 	"encoding/hex"
 	"math"
 	"math/big"
@@ -325,6 +326,111 @@ func TestIntegration_CommitEpoch(t *testing.T) {
 	}
 }
 
+func TestIntegration_UpdateBlsKey(t *testing.T) {
+	t.Parallel()
+
+	// the ChildValidatorSet artifact has to be rebuilt from core-contracts (make compile-core-contracts)
+	// before the key update can be submitted to it
+	if !bytes.Contains(contractsapi.ChildValidatorSet.DeployedBytecode,
+		contractsapi.ChildValidatorSet.Abi.GetMethod("updateBlsKey").ID()) {
+		t.Skip("ChildValidatorSet artifact does not implement updateBlsKey")
+	}
+
+	validators := newTestValidatorsWithAliases(t, []string{"A", "B", "C"})
+	accSet := validators.getPublicIdentities()
+	rotated := validators.getValidator("A")
+
+	alloc := map[types.Address]*chain.GenesisAccount{
+		contracts.ValidatorSetContract: {
+			Code: contractsapi.ChildValidatorSet.DeployedBytecode,
+		},
+		contracts.BLSContract: {
+			Code: contractsapi.BLS.DeployedBytecode,
+		},
+	}
+
+	initValidators := make([]*Validator, accSet.Len())
+
+	for i, validator := range validators.getValidators() {
+		signature, err := secretsHelper.MakeKOSKSignature(
+			validator.account.Bls, validator.Address(), 0, bls.DomainValidatorSet)
+		require.NoError(t, err)
+
+		signatureBytes, err := signature.Marshal()
+		require.NoError(t, err)
+
+		initValidators[i] = validator.paramsValidator()
+		initValidators[i].BlsSignature = hex.EncodeToString(signatureBytes)
+
+		alloc[validator.Address()] = &chain.GenesisAccount{Balance: initValidators[i].Balance}
+	}
+
+	transition := newTestTransition(t, alloc)
+
+	plgBFTConfig := PlgBFTConfig{
+		InitialValidatorSet: initValidators,
+		EpochSize:           10,
+		SprintSize:          5,
+		EpochReward:         1,
+		Governance:          accSet.GetAddresses()[0],
+	}
+
+	initInput, err := getInitChildValidatorSetInput(plgBFTConfig)
+	require.NoError(t, err)
+	require.NoError(t, initContract(contracts.ValidatorSetContract, initInput, "ChildValidatorSet", transition))
+
+	getBlsKey := func() *bls.PublicKey {
+		t.Helper()
+
+		getValidatorFn := contractsapi.ChildValidatorSet.Abi.GetMethod("getValidator")
+
+		input, err := getValidatorFn.Encode([]interface{}{rotated.Address()})
+		require.NoError(t, err)
+
+		result := transition.Call2(contracts.SystemCaller, contracts.ValidatorSetContract, input, big.NewInt(0), 10000000)
+		require.NoError(t, result.Err)
+
+		output, err := getValidatorFn.Outputs.Decode(result.ReturnValue)
+		require.NoError(t, err)
+
+		blsKey, err := bls.UnmarshalPublicKeyFromBigInt(output.(map[string]interface{})["blsKey"].([4]*big.Int)) //nolint:forcetypeassert
+		require.NoError(t, err)
+
+		return blsKey
+	}
+
+	newKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	signature, err := secretsHelper.MakeKOSKSignature(newKey, rotated.Address(), 0, bls.DomainValidatorSet)
+	require.NoError(t, err)
+
+	sigMarshal, err := signature.ToBigInt()
+	require.NoError(t, err)
+
+	updateBlsKey := &contractsapi.UpdateBlsKeyChildValidatorSetFn{
+		Signature: sigMarshal,
+		Pubkey:    newKey.PublicKey().ToBigInt(),
+	}
+	input, err := updateBlsKey.EncodeAbi()
+	require.NoError(t, err)
+
+	result := transition.Call2(rotated.Address(), contracts.ValidatorSetContract, input, big.NewInt(0), 10000000)
+	require.NoError(t, result.Err)
+
+	// the key keeps signing until the epoch is committed
+	require.Equal(t, rotated.account.Bls.PublicKey().Marshal(), getBlsKey().Marshal())
+
+	commitEpoch := createTestCommitEpochInput(t, 1, accSet, plgBFTConfig.EpochSize)
+	input, err = commitEpoch.EncodeAbi()
+	require.NoError(t, err)
+
+	result = transition.Call2(contracts.SystemCaller, contracts.ValidatorSetContract, input, big.NewInt(0), 10000000000)
+	require.NoError(t, result.Err)
+
+	require.Equal(t, newKey.PublicKey().Marshal(), getBlsKey().Marshal())
+}
+
 func deployAndInitContract(t *testing.T, transition *state.Transition, scArtifact *artifact.Artifact, sender types.Address,
 	initCallback func() ([]byte, error)) types.Address {
 	t.Helper()
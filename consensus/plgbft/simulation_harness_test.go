@@ -0,0 +1,1356 @@
+package plgbft
+
+import (
+	"container/heap"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/blockchain"
+	"github.com/plingatech/go-plgchain/consensus"
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/consensus/plgbft/wallet"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
+	"github.com/plingatech/go-plgchain/helper/common"
+	"github.com/plingatech/go-plgchain/state"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/plg-ibft/messages"
+	"github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/contract"
+	ethgow "github.com/umbracle/ethgo/wallet"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// The simulation harness runs a network of PlgBFT validators in a single process. Each node runs
+// the real consensus runtime, fsm and plg-ibft sequence on top of an in-memory chain, while the
+// messages between the nodes go through a simulated network which applies latency, drops and partitions.
+//
+// The nodes are driven by a single scheduler of virtual time: an event (a message delivery, a block
+// sync or a timer) is executed once all the nodes have settled, and the virtual clock jumps
+// to the time of the next event. The seed determines the keys of the validators and the latency
+// and the drops of each message, but not the interleaving of the nodes: the settle detection
+// and the plg-ibft round timers run on the wall clock, which plg-ibft doesn't allow to replace.
+// The scenarios therefore assert the safety and the liveness of the network rather than the exact blocks.
+//
+// TODO: run the plg-ibft round timers on the virtual clock once plg-ibft accepts an injected clock,
+// so that a seed reproduces the same run
+
+const (
+	// simGenesisTime is the virtual time of the genesis block
+	simGenesisTime = 1672531200
+
+	// simChainID is the chain id of the simulated chain
+	simChainID = 100
+
+	// simTraceTail is the number of the latest trace entries printed once the scenario fails
+	simTraceTail = 200
+)
+
+// simConfig is the configuration of the simulated network.
+// The wall clock durations are scaled by simWallClockFactor once the simulation is created
+type simConfig struct {
+	// validatorsCount is the number of the validators (nodes) in the network
+	validatorsCount int
+	// seed determines the keys of the validators, message latencies and drops
+	seed int64
+	// blockTime is the (virtual) block time
+	blockTime time.Duration
+	// epochSize and sprintSize are the consensus parameters of the chain
+	epochSize  uint64
+	sprintSize uint64
+	// minLatency and maxLatency bound the (virtual) latency of the messages between the nodes
+	minLatency time.Duration
+	maxLatency time.Duration
+	// dropRate is the probability of a message between two different nodes being dropped
+	dropRate float64
+	// roundTimeout is the (wall clock) timeout of the first round, doubled in each next round up to four times
+	roundTimeout time.Duration
+	// settleTime is the (wall clock) time without any activity after which the nodes are considered idle
+	settleTime time.Duration
+	// settleTimeout is the (wall clock) time after which the next event is executed even if the nodes
+	// haven't settled, since plg-ibft keeps restarting the round while it is waiting for more messages
+	settleTimeout time.Duration
+	// logger is the logger of the nodes
+	logger hclog.Logger
+}
+
+// defaultSimConfig returns the configuration of a healthy network of the given size
+func defaultSimConfig(validatorsCount int) *simConfig {
+	return &simConfig{
+		validatorsCount: validatorsCount,
+		seed:            1,
+		blockTime:       2 * time.Second,
+		epochSize:       10,
+		sprintSize:      5,
+		minLatency:      10 * time.Millisecond,
+		maxLatency:      200 * time.Millisecond,
+		roundTimeout:    2 * time.Second,
+		settleTime:      3 * time.Millisecond,
+		settleTimeout:   100 * time.Millisecond,
+		logger:          hclog.NewNullLogger(),
+	}
+}
+
+// simInterceptor replaces the message sent by a (byzantine) node to the given node
+// by the returned messages. Returning no messages withholds the message from the node
+type simInterceptor func(to int, msg *proto.Message) []*proto.Message
+
+// simClock is the virtual clock of the simulation
+type simClock struct {
+	lock sync.RWMutex
+	now  time.Time
+}
+
+func (c *simClock) Now() time.Time {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.now
+}
+
+// advance moves the clock forward to the given time
+func (c *simClock) advance(to time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if to.After(c.now) {
+		c.now = to
+	}
+}
+
+// simEvent is an action scheduled at the given virtual time. The events of the same time
+// are ordered by their keys, which don't depend on the goroutine scheduling
+type simEvent struct {
+	at  time.Time
+	key string
+	seq uint64
+	fn  func()
+}
+
+type simEventQueue []*simEvent
+
+func (q simEventQueue) Len() int { return len(q) }
+
+func (q simEventQueue) Less(i, j int) bool {
+	if !q[i].at.Equal(q[j].at) {
+		return q[i].at.Before(q[j].at)
+	}
+
+	if q[i].key != q[j].key {
+		return q[i].key < q[j].key
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q simEventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *simEventQueue) Push(x interface{}) { *q = append(*q, x.(*simEvent)) } //nolint:forcetypeassert
+
+func (q *simEventQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	ev := old[n-1]
+	*q = old[:n-1]
+
+	return ev
+}
+
+// simulation is a network of the simulated PlgBFT nodes
+type simulation struct {
+	t      *testing.T
+	config *simConfig
+
+	clock      *simClock
+	validators AccountSet
+	genesis    *types.Header
+	nodes      []*simNode
+
+	// busy is the number of the nodes (and their handlers) currently doing some work
+	busy int64
+	// lastActivity is the wall clock time (in nanoseconds) of the last activity of the nodes
+	lastActivity int64
+
+	lock        sync.Mutex
+	queue       simEventQueue
+	seq         uint64
+	sent        map[string]int
+	groups      []int
+	offline     []bool
+	finalized   map[uint64]types.Hash
+	roundChange []*proto.View
+	violations  []string
+	trace       []string
+	activityCh  chan struct{}
+	closeCh     chan struct{}
+	schedulerCh chan struct{}
+	started     bool
+	stopped     bool
+}
+
+// newSimulation creates the network of the nodes. The network is started by start
+func newSimulation(t *testing.T, config *simConfig) *simulation {
+	t.Helper()
+
+	setupHeaderHashFunc()
+
+	if config.logger == nil {
+		config.logger = hclog.NewNullLogger()
+	}
+
+	config.roundTimeout *= simWallClockFactor
+	config.settleTime *= simWallClockFactor
+	config.settleTimeout *= simWallClockFactor
+
+	s := &simulation{
+		t:           t,
+		config:      config,
+		clock:       &simClock{now: time.Unix(simGenesisTime, 0).UTC()},
+		sent:        make(map[string]int),
+		groups:      make([]int, config.validatorsCount),
+		offline:     make([]bool, config.validatorsCount),
+		finalized:   make(map[uint64]types.Hash),
+		roundChange: make([]*proto.View, config.validatorsCount),
+		activityCh:  make(chan struct{}, 1),
+		closeCh:     make(chan struct{}),
+		schedulerCh: make(chan struct{}),
+	}
+
+	accounts := make([]*wallet.Account, config.validatorsCount)
+	s.validators = make(AccountSet, config.validatorsCount)
+
+	for i := range accounts {
+		accounts[i] = s.newAccount(t, i)
+		s.validators[i] = &ValidatorMetadata{
+			Address:     types.Address(accounts[i].Ecdsa.Address()),
+			BlsKey:      accounts[i].Bls.PublicKey(),
+			VotingPower: big.NewInt(1),
+			IsActive:    true,
+		}
+	}
+
+	s.genesis = s.newGenesis(t)
+
+	for i, account := range accounts {
+		s.nodes = append(s.nodes, newSimNode(t, s, i, account))
+	}
+
+	t.Cleanup(func() {
+		s.stop()
+
+		if t.Failed() {
+			s.dumpTrace()
+		}
+	})
+
+	return s
+}
+
+// newAccount derives the keys of the validator from the seed and its index
+func (s *simulation) newAccount(t *testing.T, index int) *wallet.Account {
+	t.Helper()
+
+	ecdsaRaw := sha256.Sum256([]byte(fmt.Sprintf("ecdsa/%d/%d", s.config.seed, index)))
+
+	ecdsaKey, err := ethgow.NewWalletFromPrivKey(ecdsaRaw[:])
+	require.NoError(t, err)
+
+	// BLS private key is an integer lower than the curve order
+	blsRaw := sha256.Sum256([]byte(fmt.Sprintf("bls/%d/%d", s.config.seed, index)))
+	blsScalar := new(big.Int).SetBytes(blsRaw[:31])
+
+	blsKey, err := bls.UnmarshalPrivateKey([]byte(blsScalar.String()))
+	require.NoError(t, err)
+
+	return &wallet.Account{Ecdsa: ecdsaKey, Bls: blsKey}
+}
+
+// newGenesis creates the genesis header which registers the validators
+func (s *simulation) newGenesis(t *testing.T) *types.Header {
+	t.Helper()
+
+	extra := &Extra{
+		Validators: &ValidatorSetDelta{Added: s.validators},
+		Checkpoint: &CheckpointData{},
+	}
+
+	genesis := &types.Header{
+		Number:       0,
+		Difficulty:   1,
+		Timestamp:    simGenesisTime,
+		MixHash:      PlgBFTMixDigest,
+		ExtraData:    append(make([]byte, ExtraVanity), extra.MarshalRLPTo(nil)...),
+		StateRoot:    types.EmptyRootHash,
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+		Sha3Uncles:   types.EmptyUncleHash,
+	}
+
+	genesis.ComputeHash()
+
+	return genesis
+}
+
+// start runs the scheduler and the consensus of all the nodes
+func (s *simulation) start() {
+	s.lock.Lock()
+	s.started = true
+	s.lock.Unlock()
+
+	s.touch()
+
+	go s.runScheduler()
+
+	for _, n := range s.nodes {
+		go n.run()
+	}
+}
+
+// stop stops the nodes and the scheduler
+func (s *simulation) stop() {
+	s.lock.Lock()
+	started, stopped := s.started, s.stopped
+	s.stopped = true
+	s.lock.Unlock()
+
+	if stopped {
+		return
+	}
+
+	for _, n := range s.nodes {
+		close(n.closeCh)
+	}
+
+	if started {
+		for _, n := range s.nodes {
+			<-n.doneCh
+		}
+	}
+
+	close(s.closeCh)
+
+	if started {
+		<-s.schedulerCh
+	}
+
+	for _, n := range s.nodes {
+		n.runtime.close()
+		require.NoError(s.t, n.state.db.Close())
+	}
+}
+
+// active marks the start of the work of a node. The scheduler doesn't execute the next event until it is done
+func (s *simulation) active() {
+	atomic.AddInt64(&s.busy, 1)
+	s.touch()
+}
+
+// idle marks the end of the work of a node
+func (s *simulation) idle() {
+	s.touch()
+	atomic.AddInt64(&s.busy, -1)
+}
+
+// touch records the activity of the nodes
+func (s *simulation) touch() {
+	atomic.StoreInt64(&s.lastActivity, time.Now().UnixNano())
+
+	select {
+	case s.activityCh <- struct{}{}:
+	default:
+	}
+}
+
+// waitSettled waits until none of the nodes is doing any work, at most for the settle timeout.
+// It returns false if the simulation is stopped
+func (s *simulation) waitSettled() bool {
+	deadline := time.Now().Add(s.config.settleTimeout)
+
+	for {
+		if atomic.LoadInt64(&s.busy) == 0 &&
+			time.Since(time.Unix(0, atomic.LoadInt64(&s.lastActivity))) >= s.config.settleTime {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			return true
+		}
+
+		select {
+		case <-s.closeCh:
+			return false
+		case <-time.After(s.config.settleTime / 3):
+		}
+	}
+}
+
+// runScheduler executes the scheduled events one by one, in the order of their virtual time
+func (s *simulation) runScheduler() {
+	defer close(s.schedulerCh)
+
+	for s.waitSettled() {
+		s.lock.Lock()
+
+		if s.queue.Len() == 0 {
+			s.lock.Unlock()
+
+			select {
+			case <-s.closeCh:
+				return
+			case <-s.activityCh:
+			}
+
+			continue
+		}
+
+		ev := heap.Pop(&s.queue).(*simEvent) //nolint:forcetypeassert
+		s.lock.Unlock()
+
+		s.clock.advance(ev.at)
+		ev.fn()
+	}
+}
+
+// schedule schedules the given function to be executed at the given virtual time
+func (s *simulation) schedule(at time.Time, key string, fn func()) {
+	s.lock.Lock()
+	s.seq++
+	heap.Push(&s.queue, &simEvent{at: at, key: key, seq: s.seq, fn: fn})
+	s.lock.Unlock()
+
+	s.touch()
+}
+
+// random returns a deterministic pseudo random number from [0, 1) for the given key
+func (s *simulation) random(key string) float64 {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s", s.config.seed, key)))
+
+	return float64(binary.BigEndian.Uint64(hash[:8])>>11) / float64(1<<53)
+}
+
+// latency returns the latency of the given message between two different nodes
+func (s *simulation) latency(key string) time.Duration {
+	spread := s.config.maxLatency - s.config.minLatency
+
+	return s.config.minLatency + time.Duration(s.random("latency/"+key)*float64(spread))
+}
+
+// tracef records the entry of the simulation trace
+func (s *simulation) tracef(format string, args ...interface{}) {
+	elapsed := s.clock.Now().Sub(time.Unix(simGenesisTime, 0))
+	entry := fmt.Sprintf("[%10s] ", elapsed) + fmt.Sprintf(format, args...)
+
+	s.lock.Lock()
+	s.trace = append(s.trace, entry)
+	s.lock.Unlock()
+}
+
+// dumpTrace logs the latest trace entries, to investigate the failed scenario
+func (s *simulation) dumpTrace() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	from := 0
+	if len(s.trace) > simTraceTail {
+		from = len(s.trace) - simTraceTail
+	}
+
+	s.t.Logf("simulation trace (seed %d, last %d of %d entries):\n%s",
+		s.config.seed, len(s.trace)-from, len(s.trace), strings.Join(s.trace[from:], "\n"))
+}
+
+// connected returns true if the messages can be exchanged between the given nodes
+func (s *simulation) connected(a, b int) bool {
+	if a == b {
+		return true
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return !s.offline[a] && !s.offline[b] && s.groups[a] == s.groups[b]
+}
+
+// partition splits the network into the given groups of nodes.
+// The nodes which are not listed form one more group
+func (s *simulation) partition(groups ...[]int) {
+	s.lock.Lock()
+
+	for i := range s.groups {
+		s.groups[i] = 0
+	}
+
+	for i, group := range groups {
+		for _, node := range group {
+			s.groups[node] = i + 1
+		}
+	}
+
+	s.lock.Unlock()
+
+	s.tracef("partition %v", groups)
+}
+
+// heal removes the partitions and lets the nodes catch up with each other
+func (s *simulation) heal() {
+	s.partition()
+	s.announceHeads()
+}
+
+// disconnect cuts the node off the network
+func (s *simulation) disconnect(node int) {
+	s.lock.Lock()
+	s.offline[node] = true
+	s.lock.Unlock()
+
+	s.tracef("node %d disconnected", node)
+}
+
+// reconnect reconnects the node to the network and lets it catch up with the others
+func (s *simulation) reconnect(node int) {
+	s.lock.Lock()
+	s.offline[node] = false
+	s.lock.Unlock()
+
+	s.tracef("node %d reconnected", node)
+	s.announceHeads()
+}
+
+// multicast sends the message of the given node to all the nodes, including itself
+func (s *simulation) multicast(from int, msg *proto.Message) {
+	interceptor := s.nodes[from].interceptor
+
+	if msg.Type == proto.MessageType_ROUND_CHANGE {
+		s.lock.Lock()
+		s.roundChange[from] = msg.View
+		s.lock.Unlock()
+	}
+
+	for to := range s.nodes {
+		msgs := []*proto.Message{msg}
+		if to != from && interceptor != nil {
+			msgs = interceptor(to, msg)
+		}
+
+		for _, m := range msgs {
+			s.send(from, to, m)
+		}
+	}
+}
+
+// send schedules the delivery of the message from one node to another
+func (s *simulation) send(from, to int, msg *proto.Message) {
+	msg = protobuf.Clone(msg).(*proto.Message) //nolint:forcetypeassert
+
+	id := fmt.Sprintf("msg/%03d/%03d/%s/%d/%d", to, from, msg.Type, msg.View.GetHeight(), msg.View.GetRound())
+
+	s.lock.Lock()
+	key := fmt.Sprintf("%s/%d", id, s.sent[id])
+	s.sent[id]++
+	s.lock.Unlock()
+
+	latency := time.Duration(0)
+
+	if from != to {
+		if s.random("drop/"+key) < s.config.dropRate {
+			s.tracef("%d -> %d %s h=%d r=%d dropped", from, to, msg.Type, msg.View.GetHeight(), msg.View.GetRound())
+
+			return
+		}
+
+		latency = s.latency(key)
+	}
+
+	s.schedule(s.clock.Now().Add(latency), key, func() {
+		s.deliver(from, to, msg)
+	})
+}
+
+// deliver hands the message over to the consensus of the node, the same way as the consensus topic handler
+func (s *simulation) deliver(from, to int, msg *proto.Message) {
+	if !s.connected(from, to) {
+		s.tracef("%d -> %d %s h=%d r=%d lost", from, to, msg.Type, msg.View.GetHeight(), msg.View.GetRound())
+
+		return
+	}
+
+	s.tracef("%d -> %d %s h=%d r=%d", from, to, msg.Type, msg.View.GetHeight(), msg.View.GetRound())
+
+	n := s.nodes[to]
+	n.ibft.AddMessage(msg)
+
+	if err := n.runtime.checkDoubleSign(msg); err != nil {
+		s.tracef("node %d failed to check double sign: %v", to, err)
+	}
+}
+
+// onBlockCommitted checks the block committed by the node against the blocks finalized by the other nodes
+// and announces it to the rest of the network
+func (s *simulation) onBlockCommitted(node int, block *types.FullBlock) {
+	number, hash := block.Block.Number(), block.Block.Hash()
+
+	s.lock.Lock()
+	if finalized, ok := s.finalized[number]; !ok {
+		s.finalized[number] = hash
+	} else if finalized != hash {
+		s.violations = append(s.violations,
+			fmt.Sprintf("node %d finalized block %d %s, conflicting with %s", node, number, hash, finalized))
+	}
+	s.lock.Unlock()
+
+	s.tracef("node %d committed block %d %s (round %d)", node, number, hash, blockRound(block.Block.Header))
+	s.announce(node)
+}
+
+// announceHeads announces the heads of all the nodes
+func (s *simulation) announceHeads() {
+	for i := range s.nodes {
+		s.announce(i)
+	}
+}
+
+// announce lets the other nodes sync the chain of the given node, as the syncer does
+func (s *simulation) announce(from int) {
+	number := s.nodes[from].chain.CurrentHeader().Number
+
+	for to := range s.nodes {
+		if to == from {
+			continue
+		}
+
+		to := to
+		key := fmt.Sprintf("sync/%03d/%03d/%d", to, from, number)
+
+		s.schedule(s.clock.Now().Add(s.latency(key)), key, func() {
+			s.sync(to, from)
+		})
+	}
+}
+
+// sync inserts the blocks of the given peer which the node is missing
+func (s *simulation) sync(to, from int) {
+	if !s.connected(to, from) {
+		return
+	}
+
+	node, peer := s.nodes[to], s.nodes[from]
+	synced := false
+
+	for number := node.chain.CurrentHeader().Number + 1; number <= peer.chain.CurrentHeader().Number; number++ {
+		block, ok := peer.chain.getBlock(number)
+		if !ok {
+			break
+		}
+
+		if err := node.chain.CommitBlock(block); err != nil {
+			s.tracef("node %d failed to sync block %d from node %d: %v", to, number, from, err)
+
+			break
+		}
+
+		node.runtime.OnBlockInserted(block)
+
+		synced = true
+	}
+
+	if synced {
+		s.tracef("node %d synced to block %d from node %d", to, node.chain.CurrentHeader().Number, from)
+
+		select {
+		case node.syncCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// height returns the head of the given node
+func (s *simulation) height(node int) uint64 {
+	return s.nodes[node].chain.CurrentHeader().Number
+}
+
+// heights returns the heads of all the nodes
+func (s *simulation) heights() []uint64 {
+	heights := make([]uint64, len(s.nodes))
+	for i := range s.nodes {
+		heights[i] = s.height(i)
+	}
+
+	return heights
+}
+
+// waitForHeight waits until the given nodes (all of them, if none is given) reach the height
+func (s *simulation) waitForHeight(height uint64, timeout time.Duration, nodes ...int) error {
+	if len(nodes) == 0 {
+		for i := range s.nodes {
+			nodes = append(nodes, i)
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		reached := true
+
+		for _, node := range nodes {
+			if s.height(node) < height {
+				reached = false
+
+				break
+			}
+		}
+
+		if reached {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("nodes %v didn't reach height %d in %s, heights: %v", nodes, height, timeout, s.heights())
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitForRoundChange waits until the given nodes (all of them, if none is given) change the round
+// to at least the given one at the height following their head, which means they are stuck at the height
+func (s *simulation) waitForRoundChange(round uint64, timeout time.Duration, nodes ...int) error {
+	if len(nodes) == 0 {
+		for i := range s.nodes {
+			nodes = append(nodes, i)
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		reached := true
+
+		for _, node := range nodes {
+			s.lock.Lock()
+			view := s.roundChange[node]
+			s.lock.Unlock()
+
+			if view == nil || view.Height != s.height(node)+1 || view.Round < round {
+				reached = false
+
+				break
+			}
+		}
+
+		if reached {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("nodes %v didn't change to round %d in %s, heights: %v", nodes, round, timeout, s.heights())
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// assertSafety asserts that no two nodes finalized conflicting blocks
+func (s *simulation) assertSafety(t *testing.T) {
+	t.Helper()
+
+	s.lock.Lock()
+	violations := append([]string(nil), s.violations...)
+	s.lock.Unlock()
+
+	require.Empty(t, violations, "safety violated")
+
+	for _, n := range s.nodes {
+		for number := uint64(1); number <= n.chain.CurrentHeader().Number; number++ {
+			block, ok := n.chain.getBlock(number)
+			require.True(t, ok)
+
+			s.lock.Lock()
+			finalized := s.finalized[number]
+			s.lock.Unlock()
+
+			require.Equal(t, finalized, block.Block.Hash(), "node %d has conflicting block %d", n.id, number)
+		}
+	}
+}
+
+// blockHashes returns the hashes of the blocks finalized up to the given height
+func (s *simulation) blockHashes(height uint64) []types.Hash {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hashes := make([]types.Hash, 0, height)
+	for number := uint64(1); number <= height; number++ {
+		hashes = append(hashes, s.finalized[number])
+	}
+
+	return hashes
+}
+
+// evidence returns the double sign evidence gathered by the given node in all the epochs so far
+func (s *simulation) evidence(t *testing.T, node int) []*DoubleSignEvidence {
+	t.Helper()
+
+	n := s.nodes[node]
+	lastEpoch := n.chain.CurrentHeader().Number/s.config.epochSize + 1
+
+	var evidence []*DoubleSignEvidence
+
+	for epoch := uint64(1); epoch <= lastEpoch; epoch++ {
+		epochEvidence, err := n.state.EvidenceStore.getEvidence(epoch)
+		require.NoError(t, err)
+
+		evidence = append(evidence, epochEvidence...)
+	}
+
+	return evidence
+}
+
+// blockRound returns the round in which the block was finalized
+func blockRound(header *types.Header) uint64 {
+	extra, err := GetIbftExtra(header.ExtraData)
+	if err != nil || extra.Checkpoint == nil {
+		return 0
+	}
+
+	return extra.Checkpoint.BlockRound
+}
+
+// simNode is a validator of the simulated network
+type simNode struct {
+	id      int
+	sim     *simulation
+	key     *wallet.Key
+	state   *State
+	chain   *simChain
+	runtime *consensusRuntime
+	ibft    *IBFTConsensusWrapper
+	tracker *roundchange.Tracker
+
+	// interceptor makes the node byzantine, by altering its messages to the other nodes
+	interceptor simInterceptor
+
+	syncCh  chan struct{}
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+func newSimNode(t *testing.T, s *simulation, id int, account *wallet.Account) *simNode {
+	t.Helper()
+
+	n := &simNode{
+		id:      id,
+		sim:     s,
+		key:     wallet.NewKey(account),
+		syncCh:  make(chan struct{}, 1),
+		closeCh: make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+
+	n.chain = newSimChain(n, s.genesis)
+
+	dataDir := t.TempDir()
+
+	var err error
+
+	n.state, err = newState(filepath.Join(dataDir, stateFileName), hclog.NewNullLogger(), n.closeCh)
+	require.NoError(t, err)
+
+	n.tracker, err = roundchange.NewTracker(
+		&roundchange.Timeout{Base: s.config.roundTimeout, Multiplier: 2, Max: 4 * s.config.roundTimeout},
+		func(extension time.Duration) { n.ibft.ExtendRoundTimeout(extension) },
+	)
+	require.NoError(t, err)
+
+	logger := s.config.logger.Named(fmt.Sprintf("node-%d", id))
+
+	config := &runtimeConfig{
+		PlgBFTConfig: &PlgBFTConfig{
+			EpochSize:  s.config.epochSize,
+			SprintSize: s.config.sprintSize,
+			BlockTime:  common.Duration{Duration: s.config.blockTime},
+		},
+		DataDir:       dataDir,
+		Key:           n.key,
+		State:         n.state,
+		blockchain:    n.chain,
+		plgbftBackend: s,
+		txPool:        &simTxPool{},
+		roundTracker:  n.tracker,
+	}
+
+	n.runtime, err = newConsensusRuntime(logger, config)
+	require.NoError(t, err)
+
+	n.runtime.setIsActiveValidator(true)
+	n.ibft = newIBFTConsensusWrapper(logger, &simBackend{consensusRuntime: n.runtime, sim: s}, n)
+
+	return n
+}
+
+// run is the consensus loop of the node, the same as the one of the PlgBFT consensus
+func (n *simNode) run() {
+	defer close(n.doneCh)
+
+	for {
+		n.sim.active()
+
+		height := n.chain.CurrentHeader().Number + 1
+
+		if err := n.runtime.FSM(); err != nil {
+			n.sim.tracef("node %d failed to create fsm for block %d: %v", n.id, height, err)
+			n.sim.idle()
+
+			select {
+			case <-n.syncCh:
+				continue
+			case <-n.closeCh:
+				return
+			}
+		}
+
+		n.tracker.StartSequence(height)
+		sequenceCh, stopSequence := n.ibft.runSequence(height)
+
+		n.sim.idle()
+
+		select {
+		case <-n.syncCh:
+			stopSequence()
+		case <-sequenceCh:
+		case <-n.closeCh:
+			stopSequence()
+
+			return
+		}
+	}
+}
+
+// Multicast is implementation of core.Transport interface
+func (n *simNode) Multicast(msg *proto.Message) {
+	if msg != nil {
+		n.sim.multicast(n.id, msg)
+	}
+}
+
+// sleepUntil blocks the node until the given virtual time. The node is idle meanwhile
+func (n *simNode) sleepUntil(at time.Time) {
+	if !at.After(n.sim.clock.Now()) {
+		return
+	}
+
+	doneCh := make(chan struct{})
+
+	n.sim.schedule(at, fmt.Sprintf("timer/%03d", n.id), func() { close(doneCh) })
+	n.sim.idle()
+
+	select {
+	case <-doneCh:
+	case <-n.closeCh:
+	}
+
+	n.sim.active()
+}
+
+// forgeProposal signs a PREPREPARE message proposing a different block than the given one,
+// at the same height and round
+func (n *simNode) forgeProposal(msg *proto.Message) (*proto.Message, error) {
+	data := msg.GetPreprepareData()
+
+	var block types.Block
+	if err := block.UnmarshalRLP(data.GetProposal().GetRawProposal()); err != nil {
+		return nil, err
+	}
+
+	extra, err := GetIbftExtra(block.Header.ExtraData)
+	if err != nil {
+		return nil, err
+	}
+
+	block.Header.Timestamp++
+	block.Header.ComputeHash()
+
+	proposalHash, err := extra.Checkpoint.Hash(simChainID, block.Number(), block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	forged := protobuf.Clone(msg).(*proto.Message) //nolint:forcetypeassert
+	forged.Signature = nil
+
+	forgedData := forged.GetPreprepareData()
+	forgedData.Proposal.RawProposal = block.MarshalRLP()
+	forgedData.ProposalHash = proposalHash.Bytes()
+
+	return n.key.SignIBFTMessage(forged)
+}
+
+// GetValidators is implementation of the plgbftBackend interface. The validator set of the simulation is fixed
+func (s *simulation) GetValidators(uint64, []*types.Header) (AccountSet, error) {
+	return s.validators.Copy(), nil
+}
+
+var _ blockchainBackend = (*simChain)(nil)
+
+// simChain is the in-memory chain of a node
+type simChain struct {
+	node *simNode
+
+	lock     sync.RWMutex
+	head     *types.Header
+	byNumber map[uint64]*types.FullBlock
+	byHash   map[types.Hash]*types.FullBlock
+}
+
+func newSimChain(node *simNode, genesis *types.Header) *simChain {
+	genesisBlock := &types.FullBlock{Block: &types.Block{Header: genesis}}
+
+	return &simChain{
+		node:     node,
+		head:     genesis,
+		byNumber: map[uint64]*types.FullBlock{0: genesisBlock},
+		byHash:   map[types.Hash]*types.FullBlock{genesis.Hash: genesisBlock},
+	}
+}
+
+func (c *simChain) getBlock(number uint64) (*types.FullBlock, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	block, ok := c.byNumber[number]
+
+	return block, ok
+}
+
+func (c *simChain) CurrentHeader() *types.Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.head
+}
+
+// CommitBlock appends the block to the chain. The block which is already in the chain is ignored,
+// since both the consensus and the sync may insert the same block
+func (c *simChain) CommitBlock(block *types.FullBlock) error {
+	number, hash := block.Block.Number(), block.Block.Hash()
+
+	c.lock.Lock()
+
+	if existing, ok := c.byNumber[number]; ok {
+		c.lock.Unlock()
+
+		if existing.Block.Hash() != hash {
+			return fmt.Errorf("block %d %s conflicts with the committed block %s", number, hash, existing.Block.Hash())
+		}
+
+		return nil
+	}
+
+	if number != c.head.Number+1 || block.Block.ParentHash() != c.head.Hash {
+		c.lock.Unlock()
+
+		return fmt.Errorf("block %d doesn't extend the head %d", number, c.head.Number)
+	}
+
+	c.byNumber[number] = block
+	c.byHash[hash] = block
+	c.head = block.Block.Header
+	c.lock.Unlock()
+
+	c.node.sim.onBlockCommitted(c.node.id, block)
+
+	return nil
+}
+
+func (c *simChain) NewBlockBuilder(parent *types.Header, coinbase types.Address,
+	_ txPoolInterface, blockTime time.Duration, _ hclog.Logger) (blockBuilder, error) {
+	return &simBlockBuilder{
+		node:      c.node,
+		parent:    parent,
+		coinbase:  coinbase,
+		blockTime: blockTime,
+	}, nil
+}
+
+func (c *simChain) ProcessBlock(parent *types.Header, block *types.Block,
+	callback func(*state.Transition) error) (*types.FullBlock, error) {
+	if err := callback(nil); err != nil {
+		return nil, err
+	}
+
+	header := block.Header.Copy()
+
+	built := consensus.BuildBlock(consensus.BuildBlockParams{
+		Header: header,
+		Txns:   block.Transactions,
+	})
+
+	built.Header.ComputeHash()
+
+	if built.Hash() != block.Hash() {
+		return nil, fmt.Errorf("invalid block hash %s, expected %s", block.Hash(), built.Hash())
+	}
+
+	return &types.FullBlock{Block: built}, nil
+}
+
+func (c *simChain) GetStateProviderForBlock(header *types.Header) (contract.Provider, error) {
+	return &simStateProvider{number: header.Number}, nil
+}
+
+func (c *simChain) GetStateProvider(*state.Transition) contract.Provider {
+	return &simStateProvider{number: c.CurrentHeader().Number + 1}
+}
+
+func (c *simChain) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	block, ok := c.getBlock(number)
+	if !ok {
+		return nil, false
+	}
+
+	return block.Block.Header, true
+}
+
+func (c *simChain) GetHeaderByHash(hash types.Hash) (*types.Header, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	block, ok := c.byHash[hash]
+	if !ok {
+		return nil, false
+	}
+
+	return block.Block.Header, true
+}
+
+func (c *simChain) GetSystemState(provider contract.Provider) SystemState {
+	return &simSystemState{
+		sim:    c.node.sim,
+		number: provider.(*simStateProvider).number, //nolint:forcetypeassert
+	}
+}
+
+func (c *simChain) SubscribeEvents() blockchain.Subscription {
+	return nil
+}
+
+func (c *simChain) GetChainID() uint64 {
+	return simChainID
+}
+
+// simStateProvider refers to the state at the given block. The simulated chain doesn't execute the transactions
+type simStateProvider struct {
+	number uint64
+}
+
+func (p *simStateProvider) Call(ethgo.Address, []byte, *contract.CallOpts) ([]byte, error) {
+	return nil, fmt.Errorf("state of the simulated chain can't be called")
+}
+
+func (p *simStateProvider) Txn(ethgo.Address, ethgo.Key, []byte) (contract.Txn, error) {
+	return nil, fmt.Errorf("state of the simulated chain can't be modified")
+}
+
+// simSystemState mimics the system contracts: the validator set is fixed
+// and the epoch is advanced by the last block of each epoch
+type simSystemState struct {
+	sim    *simulation
+	number uint64
+}
+
+func (s *simSystemState) GetValidatorSet() (AccountSet, error) {
+	return s.sim.validators.Copy(), nil
+}
+
+func (s *simSystemState) GetEpoch() (uint64, error) {
+	return s.number/s.sim.config.epochSize + 1, nil
+}
+
+func (s *simSystemState) GetNextCommittedIndex() (uint64, error) {
+	return 0, nil
+}
+
+var _ blockBuilder = (*simBlockBuilder)(nil)
+
+// simBlockBuilder builds the blocks of the simulated chain on the virtual clock
+type simBlockBuilder struct {
+	node      *simNode
+	parent    *types.Header
+	coinbase  types.Address
+	blockTime time.Duration
+
+	header *types.Header
+	txns   []*types.Transaction
+}
+
+func (b *simBlockBuilder) Reset() error {
+	headerTime := time.Unix(int64(b.parent.Timestamp), 0).Add(b.blockTime)
+	if now := b.node.sim.clock.Now(); headerTime.Before(now) {
+		headerTime = now
+	}
+
+	b.header = &types.Header{
+		ParentHash:   b.parent.Hash,
+		Number:       b.parent.Number + 1,
+		Miner:        b.coinbase[:],
+		Difficulty:   1,
+		StateRoot:    types.EmptyRootHash,
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+		Sha3Uncles:   types.EmptyUncleHash,
+		Timestamp:    uint64(headerTime.Unix()),
+	}
+	b.txns = nil
+
+	return nil
+}
+
+func (b *simBlockBuilder) WriteTx(tx *types.Transaction) error {
+	b.txns = append(b.txns, tx)
+
+	return nil
+}
+
+// Fill waits for the block time, as the block builder waits for the transactions
+func (b *simBlockBuilder) Fill() {
+	b.node.sleepUntil(time.Unix(int64(b.header.Timestamp), 0))
+}
+
+func (b *simBlockBuilder) Build(handler func(h *types.Header)) (*types.FullBlock, error) {
+	if handler != nil {
+		handler(b.header)
+	}
+
+	block := consensus.BuildBlock(consensus.BuildBlockParams{
+		Header: b.header,
+		Txns:   b.txns,
+	})
+
+	block.Header.ComputeHash()
+
+	return &types.FullBlock{Block: block}, nil
+}
+
+func (b *simBlockBuilder) GetState() *state.Transition {
+	return nil
+}
+
+func (b *simBlockBuilder) Receipts() []*types.Receipt {
+	return nil
+}
+
+var _ txPoolInterface = (*simTxPool)(nil)
+
+// simTxPool is an empty transaction pool, the simulated nodes produce empty blocks
+type simTxPool struct{}
+
+func (p *simTxPool) Prepare()                          {}
+func (p *simTxPool) Length() uint64                    { return 0 }
+func (p *simTxPool) Peek() *types.Transaction          { return nil }
+func (p *simTxPool) Pop(*types.Transaction)            {}
+func (p *simTxPool) Drop(*types.Transaction)           {}
+func (p *simTxPool) Demote(*types.Transaction)         {}
+func (p *simTxPool) SetSealing(bool)                   {}
+func (p *simTxPool) ResetWithHeaders(...*types.Header) {}
+
+// simBackend is the consensus runtime as the backend of plg-ibft,
+// which reports the work done by the sequence routine to the scheduler
+type simBackend struct {
+	*consensusRuntime
+	sim *simulation
+}
+
+func (b *simBackend) BuildPrePrepareMessage(rawProposal []byte, certificate *proto.RoundChangeCertificate,
+	view *proto.View) *proto.Message {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.BuildPrePrepareMessage(rawProposal, certificate, view)
+}
+
+func (b *simBackend) BuildPrepareMessage(proposalHash []byte, view *proto.View) *proto.Message {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.BuildPrepareMessage(proposalHash, view)
+}
+
+func (b *simBackend) BuildCommitMessage(proposalHash []byte, view *proto.View) *proto.Message {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.BuildCommitMessage(proposalHash, view)
+}
+
+func (b *simBackend) BuildRoundChangeMessage(proposal *proto.Proposal, certificate *proto.PreparedCertificate,
+	view *proto.View) *proto.Message {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.BuildRoundChangeMessage(proposal, certificate, view)
+}
+
+func (b *simBackend) IsValidProposal(rawProposal []byte) bool {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.IsValidProposal(rawProposal)
+}
+
+func (b *simBackend) IsValidValidator(msg *proto.Message) bool {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.IsValidValidator(msg)
+}
+
+func (b *simBackend) IsProposer(id []byte, height, round uint64) bool {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.IsProposer(id, height, round)
+}
+
+func (b *simBackend) IsValidProposalHash(proposal *proto.Proposal, hash []byte) bool {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.IsValidProposalHash(proposal, hash)
+}
+
+func (b *simBackend) IsValidCommittedSeal(proposalHash []byte, committedSeal *messages.CommittedSeal) bool {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.IsValidCommittedSeal(proposalHash, committedSeal)
+}
+
+func (b *simBackend) BuildProposal(view *proto.View) []byte {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.BuildProposal(view)
+}
+
+func (b *simBackend) InsertProposal(proposal *proto.Proposal, committedSeals []*messages.CommittedSeal) {
+	b.sim.active()
+	defer b.sim.idle()
+
+	b.consensusRuntime.InsertProposal(proposal, committedSeals)
+}
+
+func (b *simBackend) HasQuorum(height uint64, msgs []*proto.Message, msgType proto.MessageType) bool {
+	b.sim.active()
+	defer b.sim.idle()
+
+	return b.consensusRuntime.HasQuorum(height, msgs, msgType)
+}
@@ -0,0 +1,7 @@
+//go:build !race
+// +build !race
+
+package plgbft
+
+// simWallClockFactor scales the wall clock durations of the simulation
+const simWallClockFactor = 1
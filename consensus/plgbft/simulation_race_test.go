@@ -0,0 +1,8 @@
+//go:build race
+// +build race
+
+package plgbft
+
+// simWallClockFactor scales the wall clock durations of the simulation, since the nodes run
+// several times slower under the race detector while the plg-ibft round timers don't
+const simWallClockFactor = 4
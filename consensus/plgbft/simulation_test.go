@@ -0,0 +1,168 @@
+package plgbft
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulation_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	s := newSimulation(t, defaultSimConfig(4))
+	s.start()
+
+	// the chain goes over the end of the first epoch
+	require.NoError(t, s.waitForHeight(12, time.Minute))
+	s.assertSafety(t)
+}
+
+func TestSimulation_Seed(t *testing.T) {
+	t.Parallel()
+
+	first := newSimulation(t, defaultSimConfig(4))
+	second := newSimulation(t, defaultSimConfig(4))
+
+	config := defaultSimConfig(4)
+	config.seed = 2
+	other := newSimulation(t, config)
+
+	// the seed determines the validators and the latencies and the drops of the messages
+	require.Equal(t, first.validators.GetAddresses(), second.validators.GetAddresses())
+	require.NotEqual(t, first.validators.GetAddresses(), other.validators.GetAddresses())
+
+	key := "msg/001/000/PREPREPARE/1/0/0"
+
+	require.Equal(t, first.latency(key), second.latency(key))
+	require.Equal(t, first.random("drop/"+key), second.random("drop/"+key))
+	require.NotEqual(t, first.random("drop/"+key), other.random("drop/"+key))
+
+	for i := 0; i < 100; i++ {
+		latency := first.latency(fmt.Sprintf("%s/%d", key, i))
+		require.GreaterOrEqual(t, latency, config.minLatency)
+		require.LessOrEqual(t, latency, config.maxLatency)
+	}
+}
+
+func TestSimulation_Partition(t *testing.T) {
+	t.Parallel()
+
+	config := defaultSimConfig(4)
+	config.roundTimeout = 500 * time.Millisecond
+
+	s := newSimulation(t, config)
+	s.start()
+
+	require.NoError(t, s.waitForHeight(3, time.Minute))
+
+	// neither half of the network has the quorum
+	s.partition([]int{0, 1}, []int{2, 3})
+
+	// the blocks finalized before the partition took effect are still synced,
+	// then the nodes keep changing the rounds without finalizing any block
+	require.NoError(t, s.waitForRoundChange(1, time.Minute))
+
+	stalled := s.heights()
+
+	require.NoError(t, s.waitForRoundChange(2, time.Minute))
+	require.Equal(t, stalled, s.heights())
+
+	s.heal()
+
+	require.NoError(t, s.waitForHeight(stalled[0]+3, time.Minute))
+	s.assertSafety(t)
+}
+
+func TestSimulation_MinorityPartition(t *testing.T) {
+	t.Parallel()
+
+	config := defaultSimConfig(4)
+	config.roundTimeout = 500 * time.Millisecond
+
+	s := newSimulation(t, config)
+	s.start()
+
+	require.NoError(t, s.waitForHeight(2, time.Minute))
+
+	s.disconnect(3)
+
+	isolated := s.height(3)
+
+	// the rest of the network has the quorum
+	require.NoError(t, s.waitForHeight(isolated+4, time.Minute, 0, 1, 2))
+	require.Equal(t, isolated, s.height(3))
+
+	s.reconnect(3)
+
+	require.NoError(t, s.waitForHeight(isolated+6, time.Minute))
+	s.assertSafety(t)
+}
+
+func TestSimulation_MessageDrops(t *testing.T) {
+	t.Parallel()
+
+	config := defaultSimConfig(4)
+	config.dropRate = 0.2
+	config.roundTimeout = 500 * time.Millisecond
+
+	s := newSimulation(t, config)
+	s.start()
+
+	require.NoError(t, s.waitForHeight(8, 2*time.Minute))
+	s.assertSafety(t)
+}
+
+func TestSimulation_EquivocatingProposer(t *testing.T) {
+	t.Parallel()
+
+	const byzantine = 0
+
+	config := defaultSimConfig(4)
+	config.roundTimeout = 500 * time.Millisecond
+
+	s := newSimulation(t, config)
+
+	// the byzantine proposer sends a different block to the odd nodes,
+	// and both of the blocks to the node 2, which detects the double sign
+	node := s.nodes[byzantine]
+	node.interceptor = func(to int, msg *proto.Message) []*proto.Message {
+		if msg.Type != proto.MessageType_PREPREPARE {
+			return []*proto.Message{msg}
+		}
+
+		forged, err := node.forgeProposal(msg)
+		if err != nil {
+			s.tracef("node %d failed to forge proposal: %v", byzantine, err)
+
+			return []*proto.Message{msg}
+		}
+
+		switch {
+		case to%2 == 1:
+			return []*proto.Message{forged}
+		case to == 2:
+			return []*proto.Message{msg, forged}
+		default:
+			return []*proto.Message{msg}
+		}
+	}
+
+	s.start()
+
+	require.NoError(t, s.waitForHeight(10, 2*time.Minute))
+	s.assertSafety(t)
+
+	signer := s.validators[byzantine].Address
+	detected := false
+
+	for _, evidence := range s.evidence(t, 2) {
+		if evidence.Type == proto.MessageType_PREPREPARE && evidence.Signer == signer {
+			detected = true
+		}
+	}
+
+	require.True(t, detected, "double sign of the byzantine proposer is not detected")
+}
@@ -54,6 +54,8 @@ type State struct {
 	CheckpointStore       *CheckpointStore
 	EpochStore            *EpochStore
 	ProposerSnapshotStore *ProposerSnapshotStore
+	LivenessStore         *LivenessStore
+	EvidenceStore         *EvidenceStore
 }
 
 // newState creates new instance of State
@@ -70,6 +72,8 @@ func newState(path string, logger hclog.Logger, closeCh chan struct{}) (*State,
 		CheckpointStore:       &CheckpointStore{db: db},
 		EpochStore:            &EpochStore{db: db},
 		ProposerSnapshotStore: &ProposerSnapshotStore{db: db},
+		LivenessStore:         &LivenessStore{db: db},
+		EvidenceStore:         &EvidenceStore{db: db},
 	}
 
 	if err = s.initStorages(); err != nil {
@@ -95,6 +99,12 @@ func (s *State) initStorages() error {
 		if err := s.ProposerSnapshotStore.initialize(tx); err != nil {
 			return err
 		}
+		if err := s.LivenessStore.initialize(tx); err != nil {
+			return err
+		}
+		if err := s.EvidenceStore.initialize(tx); err != nil {
+			return err
+		}
 
 		return nil
 	})
@@ -102,6 +112,26 @@ func (s *State) initStorages() error {
 	return err
 }
 
+// bucketsStats returns stats for each of the top level buckets in db
+func (s *State) bucketsStats() (map[string]*bolt.BucketStats, error) {
+	stats := make(map[string]*bolt.BucketStats)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			bs := b.Stats()
+			stats[string(name)] = &bs
+
+			return nil
+		})
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot check buckets stats: %w", err)
+	}
+
+	return stats, nil
+}
+
 // bucketStats returns stats for the given bucket in db
 func bucketStats(bucketName []byte, db *bolt.DB) (*bolt.BucketStats, error) {
 	var stats *bolt.BucketStats
@@ -0,0 +1,90 @@
+package plgbft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/helper/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// bucket to store double sign evidence, grouped by epochs
+	evidenceBucket = []byte("evidence")
+)
+
+/*
+Bolt DB schema:
+
+evidence/
+|--> epochNumber
+	|--> height + round + message type + signer -> *DoubleSignEvidence (json marshalled)
+*/
+
+type EvidenceStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *EvidenceStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(evidenceBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(evidenceBucket), err)
+	}
+
+	return nil
+}
+
+// insertEvidence inserts the double sign evidence to its epoch bucket in db.
+// It returns false if the evidence of the same misbehavior is already stored
+func (s *EvidenceStore) insertEvidence(evidence *DoubleSignEvidence) (bool, error) {
+	raw, err := json.Marshal(evidence)
+	if err != nil {
+		return false, err
+	}
+
+	inserted := false
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(evidenceBucket).CreateBucketIfNotExists(common.EncodeUint64ToBytes(evidence.Epoch))
+		if err != nil {
+			return err
+		}
+
+		key := evidence.key()
+		if bucket.Get(key) != nil {
+			return nil
+		}
+
+		inserted = true
+
+		return bucket.Put(key, raw)
+	})
+
+	return inserted && err == nil, err
+}
+
+// getEvidence returns the double sign evidence of the given epoch,
+// sorted by the height and the round of the misbehavior
+func (s *EvidenceStore) getEvidence(epoch uint64) ([]*DoubleSignEvidence, error) {
+	var evidence []*DoubleSignEvidence
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(evidenceBucket).Bucket(common.EncodeUint64ToBytes(epoch))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(_, v []byte) error {
+			var e *DoubleSignEvidence
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			evidence = append(evidence, e)
+
+			return nil
+		})
+	})
+
+	return evidence, err
+}
@@ -0,0 +1,50 @@
+package plgbft
+
+import (
+	"testing"
+
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_insertEvidence_getEvidence(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	evidence, err := state.EvidenceStore.getEvidence(1)
+	require.NoError(t, err)
+	require.Empty(t, evidence)
+
+	newEvidence := func(height, round uint64) *DoubleSignEvidence {
+		return &DoubleSignEvidence{
+			Signer:   types.StringToAddress("1"),
+			Epoch:    1,
+			Height:   height,
+			Round:    round,
+			Type:     proto.MessageType_COMMIT,
+			Messages: [][]byte{{1}, {2}},
+		}
+	}
+
+	// evidence is sorted by height and round regardless of insertion order
+	for _, e := range []*DoubleSignEvidence{newEvidence(3, 0), newEvidence(2, 1), newEvidence(2, 0)} {
+		inserted, err := state.EvidenceStore.insertEvidence(e)
+		require.NoError(t, err)
+		require.True(t, inserted)
+	}
+
+	// the same misbehavior is stored only once
+	inserted, err := state.EvidenceStore.insertEvidence(newEvidence(3, 0))
+	require.NoError(t, err)
+	require.False(t, inserted)
+
+	evidence, err = state.EvidenceStore.getEvidence(1)
+	require.NoError(t, err)
+	require.Equal(t, []*DoubleSignEvidence{newEvidence(2, 0), newEvidence(2, 1), newEvidence(3, 0)}, evidence)
+
+	evidence, err = state.EvidenceStore.getEvidence(2)
+	require.NoError(t, err)
+	require.Empty(t, evidence)
+}
@@ -0,0 +1,64 @@
+package plgbft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	bolt "go.etcd.io/bbolt"
+)
+
+/*
+Bolt DB schema:
+
+liveness/
+|--> livenessSnapshotKey - only current one snapshot is preserved -> *liveness.Snapshot (json marshalled)
+*/
+var (
+	// bucket to store validators liveness snapshot
+	livenessBucket = []byte("liveness")
+	// livenessSnapshotKey is a static key which is used to save latest liveness snapshot.
+	// (there will always be one object in bucket)
+	livenessSnapshotKey = []byte("livenessSnapshotKey")
+)
+
+type LivenessStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *LivenessStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(livenessBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(livenessBucket), err)
+	}
+
+	return nil
+}
+
+// getLivenessSnapshot gets latest liveness snapshot
+func (s *LivenessStore) getLivenessSnapshot() (*liveness.Snapshot, error) {
+	var snapshot *liveness.Snapshot
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(livenessBucket).Get(livenessSnapshotKey)
+		if value == nil {
+			return nil
+		}
+
+		return json.Unmarshal(value, &snapshot)
+	})
+
+	return snapshot, err
+}
+
+// writeLivenessSnapshot writes liveness snapshot
+func (s *LivenessStore) writeLivenessSnapshot(snapshot *liveness.Snapshot) error {
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(livenessBucket).Put(livenessSnapshotKey, raw)
+	})
+}
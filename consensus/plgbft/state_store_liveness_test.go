@@ -0,0 +1,34 @@
+package plgbft
+
+import (
+	"testing"
+
+	"github.com/plingatech/go-plgchain/consensus/liveness"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_getLivenessSnapshot_writeLivenessSnapshot(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	snap, err := state.LivenessStore.getLivenessSnapshot()
+	require.NoError(t, err)
+	require.Nil(t, snap)
+
+	validator := types.StringToAddress("1")
+	newSnapshot := &liveness.Snapshot{
+		Blocks: []*liveness.Block{
+			{Number: 1, Epoch: 1, Signers: []types.Address{validator}, Proposer: validator},
+		},
+		Epochs: map[uint64]map[types.Address]*liveness.Stats{
+			1: {validator: {BlocksSigned: 1, ProposalsMade: 1}},
+		},
+	}
+	require.NoError(t, state.LivenessStore.writeLivenessSnapshot(newSnapshot))
+
+	snap, err = state.LivenessStore.getLivenessSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, newSnapshot, snap)
+}
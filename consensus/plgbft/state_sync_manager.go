@@ -41,6 +41,7 @@ type StateSyncManager interface {
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
 	PostBlock(req *PostBlockRequest) error
 	PostEpoch(req *PostEpochRequest) error
+	PendingCommitments() []*PendingCommitment
 }
 
 var _ StateSyncManager = (*dummyStateSyncManager)(nil)
@@ -53,6 +54,7 @@ func (n *dummyStateSyncManager) Close()                                        {
 func (n *dummyStateSyncManager) Commitment() (*CommitmentMessageSigned, error) { return nil, nil }
 func (n *dummyStateSyncManager) PostBlock(req *PostBlockRequest) error         { return nil }
 func (n *dummyStateSyncManager) PostEpoch(req *PostEpochRequest) error         { return nil }
+func (n *dummyStateSyncManager) PendingCommitments() []*PendingCommitment      { return nil }
 func (n *dummyStateSyncManager) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	return types.Proof{}, nil
 }
@@ -257,6 +259,17 @@ func (s *stateSyncManager) AddLog(eventLog *ethgo.Log) {
 	}
 }
 
+// PendingCommitments returns the commitments of the current epoch which are not yet submitted
+func (s *stateSyncManager) PendingCommitments() []*PendingCommitment {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	commitments := make([]*PendingCommitment, len(s.pendingCommitments))
+	copy(commitments, s.pendingCommitments)
+
+	return commitments
+}
+
 // Commitment returns a commitment to be submitted if there is a pending commitment with quorum
 func (s *stateSyncManager) Commitment() (*CommitmentMessageSigned, error) {
 	s.lock.RLock()
@@ -534,7 +547,8 @@ func (s *stateSyncManager) buildCommitment() error {
 
 	hashBytes := hash.Bytes()
 
-	signature, err := s.config.key.SignWithDomain(hashBytes, bls.DomainStateReceiver)
+	signature, err := s.config.key.SignStateSyncVote(hashBytes,
+		commitment.StartID.Uint64(), commitment.EndID.Uint64())
 	if err != nil {
 		return fmt.Errorf("failed to sign commitment message. Error: %w", err)
 	}
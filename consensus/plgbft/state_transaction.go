@@ -171,6 +171,7 @@ func decodeStateTransaction(txData []byte) (contractsapi.StateTransactionInput,
 	var (
 		commitFn      contractsapi.CommitStateReceiverFn
 		commitEpochFn contractsapi.CommitEpochChildValidatorSetFn
+		slashingFn    contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn
 		obj           contractsapi.StateTransactionInput
 	)
 
@@ -180,6 +181,9 @@ func decodeStateTransaction(txData []byte) (contractsapi.StateTransactionInput,
 	} else if bytes.Equal(sig, commitEpochFn.Sig()) {
 		// commit epoch
 		obj = &contractsapi.CommitEpochChildValidatorSetFn{}
+	} else if bytes.Equal(sig, slashingFn.Sig()) {
+		// commit epoch with double signer slashing
+		obj = &contractsapi.CommitEpochWithDoubleSignerSlashingChildValidatorSetFn{}
 	} else {
 		return nil, fmt.Errorf("unknown state transaction")
 	}
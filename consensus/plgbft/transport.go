@@ -30,6 +30,13 @@ func (p *Plgbft) subscribeToIbftTopic() error {
 
 		p.ibft.AddMessage(msg)
 
+		// other validators started the sequence, so it must not be skipped
+		p.emptyBlockSkipper.OnMessage(msg.GetView().GetHeight())
+
+		if err := p.runtime.checkDoubleSign(msg); err != nil {
+			p.logger.Debug("failed to check validator message for double signing", "error", err)
+		}
+
 		p.logger.Debug(
 			"validator message received",
 			"type", msg.Type.String(),
@@ -14,6 +14,10 @@ import (
 type Account struct {
 	Ecdsa *wallet.Key
 	Bls   *bls.PrivateKey
+
+	// PreviousBls is the BLS key replaced by the key rotation,
+	// which remains in use until the validator set activates the new one
+	PreviousBls *bls.PrivateKey
 }
 
 // GenerateAccount generates a new random account
@@ -66,7 +70,20 @@ func NewAccountFromSecret(secretsManager secrets.SecretsManager) (*Account, erro
 		return nil, err
 	}
 
-	return &Account{Ecdsa: ecdsaKey, Bls: blsKey}, nil
+	account := &Account{Ecdsa: ecdsaKey, Bls: blsKey}
+
+	// BLS key replaced by the key rotation
+	if secretsManager.HasSecret(secrets.ValidatorBLSKeyPrevious) {
+		if encodedKey, err = secretsManager.GetSecret(secrets.ValidatorBLSKeyPrevious); err != nil {
+			return nil, fmt.Errorf("failed to read account data: %w", err)
+		}
+
+		if account.PreviousBls, err = bls.UnmarshalPrivateKey(encodedKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return account, nil
 }
 
 // Save persists ECDSA and BLS private keys to the SecretsManager
@@ -93,6 +110,38 @@ func (a *Account) Save(secretsManager secrets.SecretsManager) (err error) {
 	return secretsManager.SetSecret(secrets.ValidatorBLSKey, blsRaw)
 }
 
+// RotateBls replaces the BLS key of the account by the newly generated one and persists it to the SecretsManager.
+// The replaced key is persisted as the previous BLS key, so that it can be used until the new key gets activated.
+func (a *Account) RotateBls(secretsManager secrets.SecretsManager) error {
+	newBlsKey, err := bls.GenerateBlsKey()
+	if err != nil {
+		return fmt.Errorf("cannot generate bls key. error: %w", err)
+	}
+
+	blsRaw, err := a.Bls.Marshal()
+	if err != nil {
+		return err
+	}
+
+	newBlsRaw, err := newBlsKey.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// the key replaced by the previous rotation is not needed anymore
+	if err = replaceSecret(secretsManager, secrets.ValidatorBLSKeyPrevious, blsRaw); err != nil {
+		return err
+	}
+
+	if err = replaceSecret(secretsManager, secrets.ValidatorBLSKey, newBlsRaw); err != nil {
+		return err
+	}
+
+	a.PreviousBls, a.Bls = a.Bls, newBlsKey
+
+	return nil
+}
+
 func (a *Account) GetEcdsaPrivateKey() (*ecdsa.PrivateKey, error) {
 	ecdsaRaw, err := a.Ecdsa.MarshallPrivateKey()
 	if err != nil {
@@ -101,3 +150,14 @@ func (a *Account) GetEcdsaPrivateKey() (*ecdsa.PrivateKey, error) {
 
 	return wallet.ParsePrivateKey(ecdsaRaw)
 }
+
+// replaceSecret sets the secret, removing the existing one first since not every SecretsManager overwrites secrets
+func replaceSecret(secretsManager secrets.SecretsManager, name string, value []byte) error {
+	if secretsManager.HasSecret(name) {
+		if err := secretsManager.RemoveSecret(name); err != nil {
+			return err
+		}
+	}
+
+	return secretsManager.SetSecret(name, value)
+}
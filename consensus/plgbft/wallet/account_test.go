@@ -33,6 +33,36 @@ func TestAccount(t *testing.T) {
 	assert.Equal(t, privKeyMarshalled, privKeyMarshalled1)
 }
 
+func TestAccount_RotateBls(t *testing.T) {
+	t.Parallel()
+
+	secretsManager := newSecretsManagerMock()
+
+	account := generateTestAccount(t)
+	require.NoError(t, account.Save(secretsManager))
+
+	oldBlsKey := account.Bls
+
+	require.NoError(t, account.RotateBls(secretsManager))
+	require.Equal(t, oldBlsKey, account.PreviousBls)
+	require.NotEqual(t, oldBlsKey.PublicKey(), account.Bls.PublicKey())
+
+	// both keys are loaded from the secrets
+	loaded, err := NewAccountFromSecret(secretsManager)
+	require.NoError(t, err)
+	require.Equal(t, account.Bls.PublicKey(), loaded.Bls.PublicKey())
+	require.Equal(t, oldBlsKey.PublicKey(), loaded.PreviousBls.PublicKey())
+
+	// the next rotation replaces the previous key
+	newBlsKey := account.Bls
+
+	require.NoError(t, account.RotateBls(secretsManager))
+
+	loaded, err = NewAccountFromSecret(secretsManager)
+	require.NoError(t, err)
+	require.Equal(t, newBlsKey.PublicKey(), loaded.PreviousBls.PublicKey())
+}
+
 func newSecretsManagerMock() secrets.SecretsManager {
 	return &secretsManagerMock{cache: make(map[string][]byte)}
 }
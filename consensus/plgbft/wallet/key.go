@@ -1,34 +1,73 @@
 package wallet
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"sync"
 
 	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
 	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/remotesigner"
+	"github.com/plingatech/go-plgchain/secrets"
 	"github.com/plingatech/go-plgchain/types"
 	"github.com/plingatech/plg-ibft/messages/proto"
 	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
 	protobuf "google.golang.org/protobuf/proto"
 )
 
+var (
+	errUnknownBlsKey   = errors.New("account has no BLS key matching the public key")
+	errRemoteRawDigest = errors.New("remote signer doesn't sign raw ECDSA digests")
+)
+
 type Key struct {
-	raw *Account
+	// raw is the account holding the keys, it is nil if signing is delegated to the remote signer
+	raw    *Account
+	signer remotesigner.Signer
+
+	// blsPubKey is the marshaled public key of the BLS key used for signing, the current key is used if it is nil
+	blsPubKey []byte
+	lock      sync.RWMutex
 }
 
 func NewKey(raw *Account) *Key {
 	return &Key{
-		raw: raw,
+		raw:    raw,
+		signer: &accountSigner{account: raw},
+	}
+}
+
+// NewKeyFromSecrets creates a key from the account stored in the given SecretsManager.
+// The account is reloaded from it when the key is switched to the BLS key rotated after the key was created
+func NewKeyFromSecrets(secretsManager secrets.SecretsManager) (*Key, error) {
+	account, err := NewAccountFromSecret(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		raw:    account,
+		signer: &accountSigner{account: account, secretsManager: secretsManager},
+	}, nil
+}
+
+// NewRemoteKey creates a key which delegates signing to the given signer, e.g. the remote signer
+func NewRemoteKey(signer remotesigner.Signer) *Key {
+	return &Key{
+		signer: signer,
 	}
 }
 
 // String returns hex encoded ECDSA address
 func (k *Key) String() string {
-	return k.raw.Ecdsa.Address().String()
+	return k.Address().String()
 }
 
 // Address returns ECDSA address
 func (k *Key) Address() ethgo.Address {
-	return k.raw.Ecdsa.Address()
+	return ethgo.Address(k.signer.Address())
 }
 
 // Sign signs the provided digest with BLS key
@@ -37,14 +76,37 @@ func (k *Key) Sign(digest []byte) ([]byte, error) {
 	return k.SignWithDomain(digest, bls.DomainCommonSigning)
 }
 
-// SignWithDomain signs the provided digest with BLS key and provided domain
+// SignWithDomain signs the provided digest with BLS key and provided domain.
+// Digests of the consensus domains are refused, they are signed by SignCommittedSeal and SignStateSyncVote
 func (k *Key) SignWithDomain(digest, domain []byte) ([]byte, error) {
-	signature, err := k.raw.Bls.Sign(digest, domain)
-	if err != nil {
-		return nil, err
-	}
+	return k.signBls(&remotesigner.Request{
+		KeyType: remotesigner.KeyBLS,
+		Kind:    remotesigner.KindDigest,
+		Data:    digest,
+		Domain:  domain,
+	})
+}
 
-	return signature.Marshal()
+// SignCommittedSeal signs the hash of the proposal committed in the given height and round with BLS key
+func (k *Key) SignCommittedSeal(proposalHash []byte, height, round uint64) ([]byte, error) {
+	return k.signBls(&remotesigner.Request{
+		KeyType: remotesigner.KeyBLS,
+		Kind:    remotesigner.KindCommittedSeal,
+		Data:    proposalHash,
+		Height:  height,
+		Round:   round,
+	})
+}
+
+// SignStateSyncVote signs the hash of the state sync commitment of the given range of state sync events with BLS key
+func (k *Key) SignStateSyncVote(commitmentHash []byte, startID, endID uint64) ([]byte, error) {
+	return k.signBls(&remotesigner.Request{
+		KeyType: remotesigner.KeyBLS,
+		Kind:    remotesigner.KindStateSyncVote,
+		Data:    commitmentHash,
+		Height:  startID,
+		Round:   endID,
+	})
 }
 
 // SignIBFTMessage signs the IBFT consensus message with ECDSA key
@@ -54,13 +116,43 @@ func (k *Key) SignIBFTMessage(msg *proto.Message) (*proto.Message, error) {
 		return nil, fmt.Errorf("cannot marshal message: %w", err)
 	}
 
-	if msg.Signature, err = k.raw.Ecdsa.Sign(crypto.Keccak256(msgRaw)); err != nil {
+	if msg.Signature, err = k.signer.Sign(&remotesigner.Request{
+		KeyType: remotesigner.KeyECDSA,
+		Kind:    remotesigner.KindIBFTMessage,
+		Data:    msgRaw,
+	}); err != nil {
 		return nil, fmt.Errorf("cannot create message signature: %w", err)
 	}
 
 	return msg, nil
 }
 
+// UseBlsKey makes the key sign by the BLS key matching the given public key, i.e. the key registered
+// in the validator set. It enables switching to the rotated BLS key at the epoch boundary.
+// Keys delegating signing to the remote signer pass the public key to it, the signer selects the key by it.
+func (k *Key) UseBlsKey(pubKey *bls.PublicKey) error {
+	if signer, ok := k.signer.(*accountSigner); ok {
+		if _, err := signer.getBlsKey(pubKey.Marshal()); err != nil {
+			return err
+		}
+	}
+
+	k.lock.Lock()
+	k.blsPubKey = pubKey.Marshal()
+	k.lock.Unlock()
+
+	return nil
+}
+
+// signBls signs the given request by the BLS key in use
+func (k *Key) signBls(req *remotesigner.Request) ([]byte, error) {
+	k.lock.RLock()
+	req.BLSPublicKey = k.blsPubKey
+	k.lock.RUnlock()
+
+	return k.signer.Sign(req)
+}
+
 // RecoverAddressFromSignature calculates keccak256 hash of provided rawContent
 // and recovers signer address from given signature and hash
 func RecoverAddressFromSignature(sig, rawContent []byte) (types.Address, error) {
@@ -81,6 +173,130 @@ func NewEcdsaSigner(ecdsaKey *Key) *ECDSASigner {
 	return &ECDSASigner{Key: ecdsaKey}
 }
 
+// Sign signs the provided digest with ECDSA key. The remote signer doesn't sign raw digests,
+// so keys delegating signing to it sign transactions by SignTx only
 func (k *ECDSASigner) Sign(b []byte) ([]byte, error) {
+	if k.raw == nil {
+		return nil, errRemoteRawDigest
+	}
+
 	return k.raw.Ecdsa.Sign(b)
 }
+
+// SignTx signs the transaction with ECDSA key according to EIP-155 for the given chain ID
+func (k *ECDSASigner) SignTx(txn *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error) {
+	unsigned := txn.Copy()
+	unsigned.V, unsigned.R, unsigned.S = nil, nil, nil
+
+	raw, err := unsigned.MarshalRLPTo(nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal transaction: %w", err)
+	}
+
+	return wallet.NewEIP155Signer(chainID).SignTx(txn, &transactionKey{Key: k.Key, txn: raw, chainID: chainID})
+}
+
+// transactionKey implements ethgo.Key interface and it signs the given transaction instead of its digest
+type transactionKey struct {
+	*Key
+
+	txn     []byte
+	chainID uint64
+}
+
+func (k *transactionKey) Sign([]byte) ([]byte, error) {
+	return k.signer.Sign(&remotesigner.Request{
+		KeyType: remotesigner.KeyECDSA,
+		Kind:    remotesigner.KindTransaction,
+		Data:    k.txn,
+		ChainID: k.chainID,
+	})
+}
+
+// accountSigner implements remotesigner.Signer interface and it is used for signing by the keys of the account
+type accountSigner struct {
+	account *Account
+
+	// secretsManager is the source of the account, if any. The account is reloaded from it
+	// when a BLS key which it doesn't hold is requested, since the key may have been rotated
+	secretsManager secrets.SecretsManager
+	lock           sync.RWMutex
+}
+
+// getBlsKey returns the BLS key of the account matching the given public key,
+// or the current one if the public key is empty
+func (s *accountSigner) getBlsKey(pubKey []byte) (*bls.PrivateKey, error) {
+	if key := s.findBlsKey(pubKey); key != nil {
+		return key, nil
+	}
+
+	if s.secretsManager == nil {
+		return nil, errUnknownBlsKey
+	}
+
+	account, err := NewAccountFromSecret(s.secretsManager)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload account: %w", err)
+	}
+
+	s.lock.Lock()
+	s.account = account
+	s.lock.Unlock()
+
+	if key := s.findBlsKey(pubKey); key != nil {
+		return key, nil
+	}
+
+	return nil, errUnknownBlsKey
+}
+
+func (s *accountSigner) findBlsKey(pubKey []byte) *bls.PrivateKey {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(pubKey) == 0 {
+		return s.account.Bls
+	}
+
+	for _, key := range []*bls.PrivateKey{s.account.Bls, s.account.PreviousBls} {
+		if key != nil && bytes.Equal(key.PublicKey().Marshal(), pubKey) {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (s *accountSigner) Address() types.Address {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return types.Address(s.account.Ecdsa.Address())
+}
+
+func (s *accountSigner) Sign(req *remotesigner.Request) ([]byte, error) {
+	digest, domain, err := req.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.KeyType == remotesigner.KeyECDSA {
+		s.lock.RLock()
+		ecdsaKey := s.account.Ecdsa
+		s.lock.RUnlock()
+
+		return ecdsaKey.Sign(digest)
+	}
+
+	blsKey, err := s.getBlsKey(req.BLSPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := blsKey.Sign(digest, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Marshal()
+}
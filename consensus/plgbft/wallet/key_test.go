@@ -4,9 +4,13 @@ import (
 	"testing"
 
 	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/remotesigner"
 	"github.com/plingatech/plg-ibft/messages/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
 )
 
 func Test_RecoverAddressFromSignature(t *testing.T) {
@@ -39,7 +43,7 @@ func Test_Sign(t *testing.T) {
 
 	for _, account := range []*Account{generateTestAccount(t), generateTestAccount(t)} {
 		key := NewKey(account)
-		ser, err := key.SignWithDomain(msg, bls.DomainCheckpointManager)
+		ser, err := key.SignCommittedSeal(msg, 1, 0)
 
 		require.NoError(t, err)
 
@@ -47,6 +51,10 @@ func Test_Sign(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.True(t, sig.Verify(key.raw.Bls.PublicKey(), msg, bls.DomainCheckpointManager))
+
+		// digests of the consensus domains are signed by their kind only
+		_, err = key.SignWithDomain(msg, bls.DomainCheckpointManager)
+		require.ErrorIs(t, err, remotesigner.ErrRawDigest)
 	}
 }
 
@@ -58,3 +66,125 @@ func Test_String(t *testing.T) {
 		assert.Equal(t, key.Address().String(), key.String())
 	}
 }
+
+func Test_RemoteKey(t *testing.T) {
+	t.Parallel()
+
+	account := generateTestAccount(t)
+
+	ecdsaKey, err := account.GetEcdsaPrivateKey()
+	require.NoError(t, err)
+
+	key := NewKey(account)
+	remoteKey := NewRemoteKey(remotesigner.NewLocalSigner(ecdsaKey, account.Bls))
+
+	assert.Equal(t, key.Address(), remoteKey.Address())
+
+	// the remote key signs the same as the local one
+	msg := []byte("some message")
+
+	expected, err := key.SignStateSyncVote(msg, 1, 5)
+	require.NoError(t, err)
+
+	signature, err := remoteKey.SignStateSyncVote(msg, 1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	expected, err = key.SignCommittedSeal(msg, 1, 0)
+	require.NoError(t, err)
+
+	signature, err = remoteKey.SignCommittedSeal(msg, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	// the remote key signs whole transactions only
+	_, err = NewEcdsaSigner(key).Sign(crypto.Keccak256(msg))
+	require.NoError(t, err)
+
+	_, err = NewEcdsaSigner(remoteKey).Sign(crypto.Keccak256(msg))
+	require.ErrorIs(t, err, errRemoteRawDigest)
+
+	newTransaction := func() *ethgo.Transaction {
+		return &ethgo.Transaction{Nonce: 1, GasPrice: 2, Gas: 21000, To: &ethgo.ZeroAddress, Input: msg}
+	}
+
+	expectedTxn, err := wallet.NewEIP155Signer(100).SignTx(newTransaction(), NewEcdsaSigner(key))
+	require.NoError(t, err)
+
+	txn, err := NewEcdsaSigner(remoteKey).SignTx(newTransaction(), 100)
+	require.NoError(t, err)
+	assert.Equal(t, expectedTxn, txn)
+
+	newMessage := func() *proto.Message {
+		return &proto.Message{
+			View:    &proto.View{Height: 1},
+			From:    key.Address().Bytes(),
+			Type:    proto.MessageType_COMMIT,
+			Payload: &proto.Message_CommitData{},
+		}
+	}
+
+	expectedMsg, err := key.SignIBFTMessage(newMessage())
+	require.NoError(t, err)
+
+	remoteMsg, err := remoteKey.SignIBFTMessage(newMessage())
+	require.NoError(t, err)
+	assert.Equal(t, expectedMsg.Signature, remoteMsg.Signature)
+}
+
+func Test_Key_UseBlsKey(t *testing.T) {
+	t.Parallel()
+
+	secretsManager := newSecretsManagerMock()
+
+	account := generateTestAccount(t)
+	require.NoError(t, account.Save(secretsManager))
+
+	key, err := NewKeyFromSecrets(secretsManager)
+	require.NoError(t, err)
+
+	remoteSigner, err := remotesigner.NewLocalSignerFromSecrets(secretsManager)
+	require.NoError(t, err)
+
+	remoteKey := NewRemoteKey(remoteSigner)
+
+	// the key is rotated by the separate process while the validator is running
+	oldBlsKey := account.Bls
+	require.NoError(t, account.RotateBls(secretsManager))
+
+	msg := crypto.Keccak256([]byte("some message"))
+
+	for _, key := range []*Key{key, remoteKey} {
+		verify := func(pubKey *bls.PublicKey) bool {
+			t.Helper()
+
+			raw, err := key.SignCommittedSeal(msg, 1, 0)
+			require.NoError(t, err)
+
+			signature, err := bls.UnmarshalSignature(raw)
+			require.NoError(t, err)
+
+			return signature.Verify(pubKey, msg, bls.DomainCheckpointManager)
+		}
+
+		// the key signs by the replaced key until the new one is registered in the validator set
+		require.True(t, verify(oldBlsKey.PublicKey()))
+
+		require.NoError(t, key.UseBlsKey(account.Bls.PublicKey()))
+		require.True(t, verify(account.Bls.PublicKey()))
+
+		require.NoError(t, key.UseBlsKey(oldBlsKey.PublicKey()))
+		require.True(t, verify(oldBlsKey.PublicKey()))
+	}
+
+	// the key unknown to the account is refused when it is switched to,
+	// while the remote signer refuses to sign by it
+	unknownKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+	require.ErrorIs(t, key.UseBlsKey(unknownKey.PublicKey()), errUnknownBlsKey)
+
+	require.NoError(t, remoteKey.UseBlsKey(unknownKey.PublicKey()))
+
+	_, err = remoteKey.SignCommittedSeal(msg, 1, 0)
+	require.ErrorIs(t, err, remotesigner.ErrUnknownBLSKey)
+}
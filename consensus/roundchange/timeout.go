@@ -0,0 +1,79 @@
+package roundchange
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	// ibftRound0Timeout and ibftRoundFactorBase are the parameters of the round timer in plg-ibft,
+	// which times out the round after ibftRound0Timeout * ibftRoundFactorBase^round plus the extension.
+	// plg-ibft doesn't export them, so they are pinned by a test running its round timer
+	ibftRound0Timeout   = 10 * time.Second
+	ibftRoundFactorBase = float64(2)
+)
+
+var (
+	ErrInvalidMultiplier = errors.New("round timeout multiplier must not be lower than 1")
+	ErrInvalidMaxTimeout = errors.New("max round timeout must not be shorter than base round timeout")
+)
+
+// Timeout is the policy of the round timeouts. The timeout of the first round is the base timeout,
+// each next round multiplies it by the multiplier, up to the max timeout
+type Timeout struct {
+	// Base is the timeout of the first round, zero keeps the default timeouts of plg-ibft
+	Base time.Duration
+
+	// Multiplier is the exponential backoff factor of the subsequent rounds
+	Multiplier float64
+
+	// Max is the upper bound of the round timeout, zero means unbounded
+	Max time.Duration
+}
+
+// Enabled returns true if the policy overrides the default round timeouts
+func (t *Timeout) Enabled() bool {
+	return t != nil && t.Base > 0
+}
+
+// Validate checks the parameters of the enabled policy
+func (t *Timeout) Validate() error {
+	if !t.Enabled() {
+		return nil
+	}
+
+	if t.Multiplier < 1 {
+		return ErrInvalidMultiplier
+	}
+
+	if t.Max != 0 && t.Max < t.Base {
+		return ErrInvalidMaxTimeout
+	}
+
+	return nil
+}
+
+// Duration returns the timeout of the given round
+func (t *Timeout) Duration(round uint64) time.Duration {
+	timeout := float64(t.Base) * math.Pow(t.Multiplier, float64(round))
+
+	if t.Max != 0 && timeout > float64(t.Max) {
+		return t.Max
+	}
+
+	if timeout >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return time.Duration(timeout)
+}
+
+// extension returns the amount by which the plg-ibft round timer has to be extended
+// (or shortened if negative) to time out the given round after the policy duration
+func (t *Timeout) extension(round uint64) time.Duration {
+	// the same computation as in plg-ibft
+	ibftTimeout := time.Duration(int(ibftRound0Timeout) * int(math.Pow(ibftRoundFactorBase, float64(round))))
+
+	return t.Duration(round) - ibftTimeout
+}
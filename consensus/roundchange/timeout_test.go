@@ -0,0 +1,154 @@
+package roundchange
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/plg-ibft/core"
+	"github.com/plingatech/plg-ibft/messages"
+	"github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout_Validate(t *testing.T) {
+	t.Parallel()
+
+	var disabled *Timeout
+
+	assert.False(t, disabled.Enabled())
+	assert.NoError(t, disabled.Validate())
+	assert.NoError(t, (&Timeout{}).Validate())
+	assert.NoError(t, (&Timeout{Base: time.Second, Multiplier: 1}).Validate())
+	assert.NoError(t, (&Timeout{Base: time.Second, Multiplier: 2, Max: time.Second}).Validate())
+
+	assert.ErrorIs(t, (&Timeout{Base: time.Second, Multiplier: 0.5}).Validate(), ErrInvalidMultiplier)
+	assert.ErrorIs(t, (&Timeout{Base: 2 * time.Second, Multiplier: 2, Max: time.Second}).Validate(), ErrInvalidMaxTimeout)
+}
+
+func TestTimeout_Duration(t *testing.T) {
+	t.Parallel()
+
+	timeout := &Timeout{Base: 2 * time.Second, Multiplier: 1.5, Max: 5 * time.Second}
+
+	assert.Equal(t, 2*time.Second, timeout.Duration(0))
+	assert.Equal(t, 3*time.Second, timeout.Duration(1))
+	assert.Equal(t, 4500*time.Millisecond, timeout.Duration(2))
+	assert.Equal(t, 5*time.Second, timeout.Duration(3))
+	assert.Equal(t, 5*time.Second, timeout.Duration(1000))
+
+	unbounded := &Timeout{Base: time.Second, Multiplier: 2}
+
+	assert.Equal(t, 8*time.Second, unbounded.Duration(3))
+	assert.Equal(t, time.Duration(math.MaxInt64), unbounded.Duration(1000))
+}
+
+func TestTimeout_extension(t *testing.T) {
+	t.Parallel()
+
+	timeout := &Timeout{Base: 2 * time.Second, Multiplier: 2, Max: 30 * time.Second}
+
+	// plg-ibft times out the rounds after 10s, 20s, 40s...
+	assert.Equal(t, -8*time.Second, timeout.extension(0))
+	assert.Equal(t, -16*time.Second, timeout.extension(1))
+	assert.Equal(t, -32*time.Second, timeout.extension(2))
+	assert.Equal(t, -130*time.Second, timeout.extension(4))
+
+	// the default policy of plg-ibft is not extended
+	assert.Equal(t, time.Duration(0), (&Timeout{Base: 10 * time.Second, Multiplier: 2}).extension(5))
+}
+
+// timeoutBackend is a plg-ibft backend of a validator which is never the proposer,
+// so its rounds only time out
+type timeoutBackend struct {
+	tracker *Tracker
+
+	lock     sync.Mutex
+	timeouts []time.Time
+}
+
+func (b *timeoutBackend) BuildPrePrepareMessage([]byte, *proto.RoundChangeCertificate, *proto.View) *proto.Message {
+	return nil
+}
+
+func (b *timeoutBackend) BuildPrepareMessage([]byte, *proto.View) *proto.Message {
+	return nil
+}
+
+func (b *timeoutBackend) BuildCommitMessage([]byte, *proto.View) *proto.Message {
+	return nil
+}
+
+func (b *timeoutBackend) BuildRoundChangeMessage(
+	_ *proto.Proposal, _ *proto.PreparedCertificate, view *proto.View) *proto.Message {
+	b.tracker.OnRoundTimeout(view.Height, view.Round)
+
+	b.lock.Lock()
+	b.timeouts = append(b.timeouts, time.Now())
+	b.lock.Unlock()
+
+	return &proto.Message{View: view, Type: proto.MessageType_ROUND_CHANGE}
+}
+
+func (b *timeoutBackend) IsValidProposal([]byte) bool                                { return false }
+func (b *timeoutBackend) IsValidValidator(*proto.Message) bool                       { return false }
+func (b *timeoutBackend) IsProposer([]byte, uint64, uint64) bool                     { return false }
+func (b *timeoutBackend) IsValidProposalHash(*proto.Proposal, []byte) bool           { return false }
+func (b *timeoutBackend) IsValidCommittedSeal([]byte, *messages.CommittedSeal) bool  { return false }
+func (b *timeoutBackend) BuildProposal(*proto.View) []byte                           { return nil }
+func (b *timeoutBackend) InsertProposal(*proto.Proposal, []*messages.CommittedSeal)  {}
+func (b *timeoutBackend) ID() []byte                                                 { return []byte{1} }
+func (b *timeoutBackend) HasQuorum(uint64, []*proto.Message, proto.MessageType) bool { return false }
+func (b *timeoutBackend) Multicast(*proto.Message)                                   {}
+
+func (b *timeoutBackend) getTimeouts() []time.Time {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return append([]time.Time(nil), b.timeouts...)
+}
+
+// TestTimeout_IBFTRoundTimer pins the round timer parameters of plg-ibft the extension is computed from
+func TestTimeout_IBFTRoundTimer(t *testing.T) {
+	t.Parallel()
+
+	const base = 200 * time.Millisecond
+
+	backend := &timeoutBackend{}
+	ibft := core.NewIBFT(hclog.NewNullLogger(), backend, backend)
+
+	tracker, err := NewTracker(&Timeout{Base: base, Multiplier: 2}, ibft.ExtendRoundTimeout)
+	require.NoError(t, err)
+
+	backend.tracker = tracker
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	tracker.StartSequence(1)
+
+	go func() {
+		defer close(done)
+
+		ibft.RunSequence(ctx, 1)
+	}()
+
+	start := time.Now()
+
+	// with different parameters, the rounds time out either immediately or after seconds
+	require.Eventually(t, func() bool {
+		return len(backend.getTimeouts()) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancelFn()
+	<-done
+
+	timeouts := backend.getTimeouts()
+
+	assert.InDelta(t, base, timeouts[0].Sub(start), float64(base/2))
+	assert.InDelta(t, 2*base, timeouts[1].Sub(timeouts[0]), float64(base/2))
+}
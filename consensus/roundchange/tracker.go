@@ -0,0 +1,162 @@
+package roundchange
+
+import (
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// roundMetricsPrefix is a round-related metrics prefix
+	roundMetricsPrefix = "consensus"
+
+	// causeTimeout is the cause of the round change when the round timer of the node expires
+	causeTimeout = "timeout"
+
+	// causeCertificate is the cause of the round change when the node jumps to a higher round
+	// upon a round change certificate or a proposal of the higher round
+	causeCertificate = "certificate"
+)
+
+// Phase is a phase of the IBFT round
+type Phase uint8
+
+const (
+	PhaseNewRound Phase = iota
+	PhasePrepare
+	PhaseCommit
+	PhaseFin
+)
+
+// String returns the name of the phase
+func (p Phase) String() string {
+	switch p {
+	case PhaseNewRound:
+		return "new_round"
+	case PhasePrepare:
+		return "prepare"
+	case PhaseCommit:
+		return "commit"
+	case PhaseFin:
+		return "fin"
+	default:
+		return "unknown"
+	}
+}
+
+// Tracker follows the rounds of the running IBFT sequence. It applies the round timeout policy
+// to the plg-ibft round timer and reports the round number, the round change causes
+// and the time spent in each phase of the round
+type Tracker struct {
+	// timeout is the policy of the round timeouts
+	timeout *Timeout
+
+	// extendRoundTimeout sets the extension of the plg-ibft round timer
+	extendRoundTimeout func(time.Duration)
+
+	lock       sync.Mutex
+	height     uint64
+	round      uint64
+	phase      Phase
+	phaseStart time.Time
+}
+
+// NewTracker creates a new tracker of the rounds. The round timer is not modified if the timeout policy is not enabled
+func NewTracker(timeout *Timeout, extendRoundTimeout func(time.Duration)) (*Tracker, error) {
+	if err := timeout.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Tracker{
+		timeout:            timeout,
+		extendRoundTimeout: extendRoundTimeout,
+	}, nil
+}
+
+// StartSequence is called right before the sequence of the given height is run
+func (t *Tracker) StartSequence(height uint64) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.height = height
+	t.enterRound(0)
+}
+
+// OnRoundTimeout is called once the round timer expires and the node moves to the given round.
+// It has to be called by the sequence routine before the timer of the new round starts,
+// which holds for building of the ROUND-CHANGE message
+func (t *Tracker) OnRoundTimeout(height, round uint64) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if height != t.height || round <= t.round {
+		return
+	}
+
+	metrics.IncrCounterWithLabels([]string{roundMetricsPrefix, "round_changes"}, 1,
+		[]metrics.Label{{Name: "cause", Value: causeTimeout}})
+
+	t.enterRound(round)
+}
+
+// OnPhase is called once the node enters the given phase of the given round
+func (t *Tracker) OnPhase(phase Phase, height, round uint64) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if height != t.height || round < t.round {
+		return
+	}
+
+	if round > t.round {
+		// the node has jumped to the higher round. plg-ibft reports the jump only once the round timer
+		// of the new round is running (even the PREPARE of a future proposal is built with the previous view),
+		// and the timer reads the extension unsynchronized, so the round keeps the extension of the previous round
+		metrics.IncrCounterWithLabels([]string{roundMetricsPrefix, "round_changes"}, 1,
+			[]metrics.Label{{Name: "cause", Value: causeCertificate}})
+
+		t.setRound(round)
+	}
+
+	if phase <= t.phase {
+		return
+	}
+
+	metrics.MeasureSinceWithLabels([]string{roundMetricsPrefix, "phase_duration"}, t.phaseStart,
+		[]metrics.Label{{Name: "phase", Value: t.phase.String()}})
+
+	t.phase = phase
+	t.phaseStart = time.Now()
+}
+
+// enterRound moves to the given round and applies its timeout to the round timer.
+// It must be called while the round timer of plg-ibft is not running
+func (t *Tracker) enterRound(round uint64) {
+	t.setRound(round)
+
+	if t.timeout.Enabled() && t.extendRoundTimeout != nil {
+		t.extendRoundTimeout(t.timeout.extension(round))
+	}
+}
+
+// setRound moves to the new round phase of the given round
+func (t *Tracker) setRound(round uint64) {
+	t.round = round
+	t.phase = PhaseNewRound
+	t.phaseStart = time.Now()
+
+	metrics.SetGauge([]string{roundMetricsPrefix, "round"}, float32(round))
+}
@@ -0,0 +1,81 @@
+package roundchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTracker(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTracker(&Timeout{Base: time.Second}, nil)
+	assert.ErrorIs(t, err, ErrInvalidMultiplier)
+
+	tracker, err := NewTracker(nil, nil)
+	require.NoError(t, err)
+
+	// the round timer is not touched if the policy is disabled
+	tracker.StartSequence(1)
+	tracker.OnRoundTimeout(1, 1)
+	assert.Equal(t, uint64(1), tracker.round)
+
+	var nilTracker *Tracker
+
+	assert.NotPanics(t, func() {
+		nilTracker.StartSequence(1)
+		nilTracker.OnRoundTimeout(1, 1)
+		nilTracker.OnPhase(PhasePrepare, 1, 0)
+	})
+}
+
+func TestTracker_Rounds(t *testing.T) {
+	t.Parallel()
+
+	var extensions []time.Duration
+
+	tracker, err := NewTracker(&Timeout{Base: 2 * time.Second, Multiplier: 2, Max: 30 * time.Second},
+		func(extension time.Duration) {
+			extensions = append(extensions, extension)
+		})
+	require.NoError(t, err)
+
+	tracker.StartSequence(5)
+	assert.Equal(t, []time.Duration{-8 * time.Second}, extensions)
+
+	tracker.OnPhase(PhasePrepare, 5, 0)
+	assert.Equal(t, PhasePrepare, tracker.phase)
+
+	// phases of the other heights and the previous phases are ignored
+	tracker.OnPhase(PhaseCommit, 4, 0)
+	tracker.OnPhase(PhaseNewRound, 5, 0)
+	assert.Equal(t, PhasePrepare, tracker.phase)
+
+	tracker.OnRoundTimeout(5, 1)
+	assert.Equal(t, uint64(1), tracker.round)
+	assert.Equal(t, PhaseNewRound, tracker.phase)
+	assert.Equal(t, []time.Duration{-8 * time.Second, -16 * time.Second}, extensions)
+
+	// the jump to the higher round doesn't touch the running round timer
+	tracker.OnPhase(PhaseCommit, 5, 3)
+	assert.Equal(t, uint64(3), tracker.round)
+	assert.Equal(t, PhaseCommit, tracker.phase)
+	assert.Equal(t, []time.Duration{-8 * time.Second, -16 * time.Second}, extensions)
+
+	// the timeout beyond the max timeout is bounded by it
+	tracker.OnRoundTimeout(5, 5)
+	assert.Equal(t, uint64(5), tracker.round)
+	assert.Equal(t, 30*time.Second-320*time.Second, extensions[2])
+
+	// stale timeouts are ignored
+	tracker.OnRoundTimeout(5, 2)
+	assert.Equal(t, uint64(5), tracker.round)
+	assert.Len(t, extensions, 3)
+
+	tracker.StartSequence(6)
+	assert.Equal(t, uint64(0), tracker.round)
+	assert.Equal(t, PhaseNewRound, tracker.phase)
+	assert.Equal(t, -8*time.Second, extensions[3])
+}
@@ -42,4 +42,6 @@ var (
 	AllowListContractsAddr = types.StringToAddress("0x0200000000000000000000000000000000000000")
 	// AllowListTransactionsAddr is the address of the transactions allow list
 	AllowListTransactionsAddr = types.StringToAddress("0x0200000000000000000000000000000000000002")
+	// BlockListTransactionsAddr is the address of the transactions block list
+	BlockListTransactionsAddr = types.StringToAddress("0x0200000000000000000000000000000000000004")
 )
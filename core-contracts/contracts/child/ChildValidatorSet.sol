@@ -100,6 +100,7 @@ contract ChildValidatorSet is
 
         _distributeRewards(epoch, uptime);
         _processQueue();
+        _processBlsKeyUpdates();
 
         emit NewEpoch(id, epoch.startBlock, epoch.endBlock, epoch.epochRoot);
     }
@@ -331,6 +332,7 @@ contract ChildValidatorSet is
         }
 
         _processQueue();
+        _processBlsKeyUpdates();
 
         emit NewEpoch(id, epoch.startBlock, epoch.endBlock, epoch.epochRoot);
     }
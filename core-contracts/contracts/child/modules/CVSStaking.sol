@@ -38,6 +38,20 @@ abstract contract CVSStaking is ICVSStaking, CVSStorage, CVSAccessControl, CVSWi
         emit NewValidator(msg.sender, pubkey);
     }
 
+    /**
+     * @inheritdoc ICVSStaking
+     */
+    function updateBlsKey(uint256[2] calldata signature, uint256[4] calldata pubkey) external onlyValidator {
+        verifyValidatorRegistration(msg.sender, signature, pubkey);
+
+        if (pendingBlsKeys[msg.sender][0] == 0) {
+            _pendingBlsKeyValidators.push(msg.sender);
+        }
+        pendingBlsKeys[msg.sender] = pubkey;
+
+        emit BlsKeyUpdateRequested(msg.sender, pubkey);
+    }
+
     /**
      * @inheritdoc ICVSStaking
      */
@@ -134,6 +148,22 @@ abstract contract CVSStaking is ICVSStaking, CVSStorage, CVSAccessControl, CVSWi
         return _validators.totalStakeOf(validator);
     }
 
+    /**
+     * @notice Replaces BLS keys of the validators by the keys scheduled for the rotation.
+     * Called at the end of the epoch, so that the new keys are used starting from the next epoch.
+     */
+    function _processBlsKeyUpdates() internal {
+        for (uint256 i = 0; i < _pendingBlsKeyValidators.length; ++i) {
+            address validatorAddr = _pendingBlsKeyValidators[i];
+            uint256[4] memory pubkey = pendingBlsKeys[validatorAddr];
+            delete pendingBlsKeys[validatorAddr];
+            Validator storage validator = _validators.get(validatorAddr);
+            validator.blsKey = pubkey;
+            emit BlsKeyUpdated(validatorAddr, pubkey);
+        }
+        delete _pendingBlsKeyValidators;
+    }
+
     function _distributeValidatorReward(address validator, uint256 reward) internal {
         Validator storage _validator = _validators.get(validator);
         _validator.withdrawableRewards += reward;
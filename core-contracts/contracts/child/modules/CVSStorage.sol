@@ -35,9 +35,12 @@ abstract contract CVSStorage is ICVSStorage {
 
     mapping(uint256 => Epoch) public epochs;
     mapping(address => bool) public whitelist;
+    mapping(address => uint256[4]) public pendingBlsKeys;
+    // slither-disable-next-line naming-convention
+    address[] internal _pendingBlsKeyValidators;
 
     // slither-disable-next-line unused-state,naming-convention
-    uint256[50] private __gap;
+    uint256[48] private __gap;
 
     /**
      * @inheritdoc ICVSStorage
@@ -10,6 +10,8 @@ interface ICVSStaking {
     event Unstaked(address indexed validator, uint256 amount);
     event ValidatorRewardClaimed(address indexed validator, uint256 amount);
     event ValidatorRewardDistributed(address indexed validator, uint256 amount);
+    event BlsKeyUpdateRequested(address indexed validator, uint256[4] blsKey);
+    event BlsKeyUpdated(address indexed validator, uint256[4] blsKey);
 
     /**
      * @notice Validates BLS signature with the provided pubkey and registers validators into the set.
@@ -18,6 +20,14 @@ interface ICVSStaking {
      */
     function register(uint256[2] calldata signature, uint256[4] calldata pubkey) external;
 
+    /**
+     * @notice Validates BLS signature with the provided pubkey and schedules the rotation of validator's BLS key.
+     * The new key replaces the current one at the end of the epoch.
+     * @param signature Signature to validate message against
+     * @param pubkey New BLS public key of validator
+     */
+    function updateBlsKey(uint256[2] calldata signature, uint256[4] calldata pubkey) external;
+
     /**
      * @notice Stakes sent amount. Claims rewards beforehand.
      */
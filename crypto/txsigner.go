@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -23,10 +24,19 @@ type TxSigner interface {
 	// SignTx signs a transaction
 	SignTx(tx *types.Transaction, priv *ecdsa.PrivateKey) (*types.Transaction, error)
 
+	// FeePayer returns the fee payer of a fee delegated transaction
+	FeePayer(tx *types.Transaction) (types.Address, error)
+
+	// SignFeePayerTx signs a fee delegated transaction as its fee payer
+	SignFeePayerTx(tx *types.Transaction, priv *ecdsa.PrivateKey) (*types.Transaction, error)
+
 	// CalculateV calculates the V value based on the type of signer used
 	CalculateV(parity byte) []byte
 }
 
+// ErrNotFeeDelegated is returned when the fee payer of a non fee delegated transaction is requested
+var ErrNotFeeDelegated = errors.New("transaction is not fee delegated")
+
 // NewSigner creates a new signer object (EIP155 or FrontierSigner)
 func NewSigner(forks chain.ForksInTime, chainID uint64) TxSigner {
 	var signer TxSigner
@@ -48,6 +58,16 @@ var signerPool fastrlp.ArenaPool
 
 // calcTxHash calculates the transaction hash (keccak256 hash of the RLP value)
 func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
+	return calcHash(tx, chainID, false)
+}
+
+// calcFeePayerHash calculates the hash signed by the fee payer,
+// which also covers the signature of the sender
+func calcFeePayerHash(tx *types.Transaction, chainID uint64) types.Hash {
+	return calcHash(tx, chainID, true)
+}
+
+func calcHash(tx *types.Transaction, chainID uint64, withSenderSig bool) types.Hash {
 	a := signerPool.Get()
 
 	v := a.NewArray()
@@ -64,6 +84,18 @@ func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
 	v.Set(a.NewBigInt(tx.Value))
 	v.Set(a.NewCopyBytes(tx.Input))
 
+	// the sender commits to the fee payer of a fee delegated transaction
+	if tx.IsFeeDelegated() {
+		v.Set(a.NewUint(uint64(tx.Type)))
+		v.Set(a.NewCopyBytes(tx.FeePayer.Bytes()))
+	}
+
+	if withSenderSig {
+		v.Set(a.NewBigInt(tx.V))
+		v.Set(a.NewBigInt(tx.R))
+		v.Set(a.NewBigInt(tx.S))
+	}
+
 	// EIP155
 	if chainID != 0 {
 		v.Set(a.NewUint(chainID))
@@ -78,6 +110,23 @@ func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
 	return types.BytesToHash(hash)
 }
 
+// recoverAddress recovers the address which signed the hash
+func recoverAddress(hash types.Hash, r, s, v *big.Int, isHomestead bool) (types.Address, error) {
+	sig, err := encodeSignature(r, s, v, isHomestead)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	pub, err := Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	buf := Keccak256(pub[1:])[12:]
+
+	return types.BytesToAddress(buf), nil
+}
+
 // Hash is a wrapper function for the calcTxHash, with chainID 0
 func (f *FrontierSigner) Hash(tx *types.Transaction) types.Hash {
 	return calcTxHash(tx, 0)
@@ -134,6 +183,47 @@ func (f *FrontierSigner) SignTx(
 	return tx, nil
 }
 
+// FeePayer decodes the fee payer signature and returns the fee payer of the transaction
+func (f *FrontierSigner) FeePayer(tx *types.Transaction) (types.Address, error) {
+	if !tx.IsFeeDelegated() {
+		return types.Address{}, ErrNotFeeDelegated
+	}
+
+	refV := big.NewInt(0)
+	if tx.PayerV != nil {
+		refV.SetBytes(tx.PayerV.Bytes())
+	}
+
+	refV.Sub(refV, big27)
+
+	return recoverAddress(calcFeePayerHash(tx, 0), tx.PayerR, tx.PayerS, refV, f.isHomestead)
+}
+
+// SignFeePayerTx signs the fee delegated transaction as the fee payer
+func (f *FrontierSigner) SignFeePayerTx(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	if !tx.IsFeeDelegated() {
+		return nil, ErrNotFeeDelegated
+	}
+
+	tx = tx.Copy()
+
+	h := calcFeePayerHash(tx, 0)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.PayerR = new(big.Int).SetBytes(sig[:32])
+	tx.PayerS = new(big.Int).SetBytes(sig[32:64])
+	tx.PayerV = new(big.Int).SetBytes(f.CalculateV(sig[64]))
+
+	return tx, nil
+}
+
 // calculateV returns the V value for transactions pre EIP155
 func (f *FrontierSigner) CalculateV(parity byte) []byte {
 	reference := big.NewInt(int64(parity))
@@ -217,6 +307,50 @@ func (e *EIP155Signer) SignTx(
 	return tx, nil
 }
 
+// FeePayer returns the fee payer of the fee delegated transaction
+func (e *EIP155Signer) FeePayer(tx *types.Transaction) (types.Address, error) {
+	if !tx.IsFeeDelegated() {
+		return types.Address{}, ErrNotFeeDelegated
+	}
+
+	bigV := big.NewInt(0)
+	if tx.PayerV != nil {
+		bigV.SetBytes(tx.PayerV.Bytes())
+	}
+
+	// v = CHAIN_ID * 2 + 35 + {0, 1}
+	mulOperand := big.NewInt(0).Mul(big.NewInt(int64(e.chainID)), big.NewInt(2))
+	bigV.Sub(bigV, mulOperand)
+	bigV.Sub(bigV, big35)
+
+	return recoverAddress(calcFeePayerHash(tx, e.chainID), tx.PayerR, tx.PayerS, bigV, e.isHomestead)
+}
+
+// SignFeePayerTx signs the fee delegated transaction as the fee payer
+func (e *EIP155Signer) SignFeePayerTx(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	if !tx.IsFeeDelegated() {
+		return nil, ErrNotFeeDelegated
+	}
+
+	tx = tx.Copy()
+
+	h := calcFeePayerHash(tx, e.chainID)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.PayerR = new(big.Int).SetBytes(sig[:32])
+	tx.PayerS = new(big.Int).SetBytes(sig[32:64])
+	tx.PayerV = new(big.Int).SetBytes(e.CalculateV(sig[64]))
+
+	return tx, nil
+}
+
 // calculateV returns the V value for transaction signatures. Based on EIP155
 func (e *EIP155Signer) CalculateV(parity byte) []byte {
 	reference := big.NewInt(int64(parity))
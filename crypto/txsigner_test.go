@@ -144,3 +144,58 @@ func TestEIP155Signer_ChainIDMismatch(t *testing.T) {
 		}
 	}
 }
+
+func TestEIP155Signer_FeePayer(t *testing.T) {
+	t.Parallel()
+
+	toAddress := types.StringToAddress("1")
+	signer := NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
+
+	senderKey, err := GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	payerKey, err := GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	payer := PubKeyToAddress(&payerKey.PublicKey)
+
+	txn := &types.Transaction{
+		To:       &toAddress,
+		Value:    big.NewInt(10),
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		Type:     types.FeeDelegatedTx,
+		FeePayer: payer,
+	}
+
+	senderSignedTx, err := signer.SignTx(txn, senderKey)
+	assert.NoError(t, err)
+
+	signedTx, err := signer.SignFeePayerTx(senderSignedTx, payerKey)
+	assert.NoError(t, err)
+
+	from, err := signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&senderKey.PublicKey), from)
+
+	feePayer, err := signer.FeePayer(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, payer, feePayer)
+
+	// the sender signature commits to the fee payer
+	otherPayerTx := signedTx.Copy()
+	otherPayerTx.FeePayer = types.StringToAddress("2")
+
+	from, err = signer.Sender(otherPayerTx)
+	assert.NoError(t, err)
+	assert.NotEqual(t, PubKeyToAddress(&senderKey.PublicKey), from)
+
+	// legacy transactions have no fee payer
+	txn.Type = types.LegacyTx
+
+	_, err = signer.SignFeePayerTx(txn, payerKey)
+	assert.ErrorIs(t, err, ErrNotFeeDelegated)
+
+	_, err = signer.FeePayer(txn)
+	assert.ErrorIs(t, err, ErrNotFeeDelegated)
+}
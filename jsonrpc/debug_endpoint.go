@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -47,6 +48,9 @@ type debugBlockchainStore interface {
 
 	// TraceCall traces a single call at the point when the given header is mined
 	TraceCall(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
+
+	// SetHead rewinds the chain to the block with the given number
+	SetHead(number uint64) error
 }
 
 type debugTxPoolStore interface {
@@ -69,11 +73,13 @@ type Debug struct {
 }
 
 type TraceConfig struct {
-	EnableMemory     bool    `json:"enableMemory"`
-	DisableStack     bool    `json:"disableStack"`
-	DisableStorage   bool    `json:"disableStorage"`
-	EnableReturnData bool    `json:"enableReturnData"`
-	Timeout          *string `json:"timeout"`
+	EnableMemory     bool            `json:"enableMemory"`
+	DisableStack     bool            `json:"disableStack"`
+	DisableStorage   bool            `json:"disableStorage"`
+	EnableReturnData bool            `json:"enableReturnData"`
+	Timeout          *string         `json:"timeout"`
+	Tracer           *string         `json:"tracer"`
+	TracerConfig     json.RawMessage `json:"tracerConfig"`
 }
 
 func (d *Debug) TraceBlockByNumber(
@@ -166,15 +172,25 @@ func (d *Debug) TraceCall(
 	}
 
 	tracer, cancel, err := newTracer(config)
-	defer cancel()
-
 	if err != nil {
 		return nil, err
 	}
 
+	defer cancel()
+
 	return d.store.TraceCall(tx, header, tracer)
 }
 
+// SetHead rewinds the chain to the block with the given number, so the later blocks are synced again.
+// It is an admin method, available only if the json-rpc admin methods are enabled
+func (d *Debug) SetHead(number argUint64) (interface{}, error) {
+	if err := d.store.SetHead(uint64(number)); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 func (d *Debug) traceBlock(
 	block *types.Block,
 	config *TraceConfig,
@@ -184,12 +200,12 @@ func (d *Debug) traceBlock(
 	}
 
 	tracer, cancel, err := newTracer(config)
-	defer cancel()
-
 	if err != nil {
 		return nil, err
 	}
 
+	defer cancel()
+
 	return d.store.TraceBlock(block, tracer)
 }
 
@@ -214,12 +230,19 @@ func newTracer(config *TraceConfig) (
 		}
 	}
 
-	tracer := structtracer.NewStructTracer(structtracer.Config{
-		EnableMemory:     config.EnableMemory,
-		EnableStack:      !config.DisableStack,
-		EnableStorage:    !config.DisableStorage,
-		EnableReturnData: config.EnableReturnData,
-	})
+	var tracer tracer.Tracer
+
+	if config.Tracer == nil || *config.Tracer == "" {
+		// use struct tracer configured by the top-level flags by default
+		tracer = structtracer.NewStructTracer(structtracer.Config{
+			EnableMemory:     config.EnableMemory,
+			EnableStack:      !config.DisableStack,
+			EnableStorage:    !config.DisableStorage,
+			EnableReturnData: config.EnableReturnData,
+		})
+	} else if tracer, err = newRegisteredTracer(*config.Tracer, config.TracerConfig); err != nil {
+		return nil, nil, err
+	}
 
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), timeout)
 
@@ -234,3 +257,17 @@ func newTracer(config *TraceConfig) (
 	// cancellation of context is done by caller
 	return tracer, cancel, nil
 }
+
+// newRegisteredTracer creates the tracer registered under the given name
+func newRegisteredTracer(name string, config json.RawMessage) (tracer.Tracer, error) {
+	t, err := tracer.New(name, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == nil {
+		return nil, fmt.Errorf("tracer %s factory returned nil tracer", name)
+	}
+
+	return t, nil
+}
@@ -2,14 +2,17 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
 
 	"github.com/plingatech/go-plgchain/helper/hex"
 	"github.com/plingatech/go-plgchain/state/runtime/tracer"
+	"github.com/plingatech/go-plgchain/state/runtime/tracer/structtracer"
 	"github.com/plingatech/go-plgchain/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type debugEndpointMockStore struct {
@@ -23,6 +26,7 @@ type debugEndpointMockStore struct {
 	traceCallFn         func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
 	getNonceFn          func(types.Address) uint64
 	getAccountFn        func(types.Hash, types.Address) (*Account, error)
+	setHeadFn           func(uint64) error
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
@@ -65,8 +69,13 @@ func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address)
 	return s.getAccountFn(root, addr)
 }
 
+func (s *debugEndpointMockStore) SetHead(number uint64) error {
+	return s.setHeadFn(number)
+}
+
 func TestDebugTraceConfigDecode(t *testing.T) {
 	timeout15s := "15s"
+	structTracerName := "structTracer"
 
 	tests := []struct {
 		input    string
@@ -154,6 +163,16 @@ func TestDebugTraceConfigDecode(t *testing.T) {
 				Timeout:          &timeout15s,
 			},
 		},
+		{
+			input: `{
+				"tracer": "structTracer",
+				"tracerConfig": {"enableMemory": true}
+			}`,
+			expected: TraceConfig{
+				Tracer:       &structTracerName,
+				TracerConfig: json.RawMessage(`{"enableMemory": true}`),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -719,6 +738,41 @@ func Test_newTracer(t *testing.T) {
 		assert.ErrorIs(t, ErrNoConfig, err)
 	})
 
+	t.Run("should create registered tracer by name", func(t *testing.T) {
+		t.Parallel()
+
+		name := structtracer.Name
+		tracer, cancel, err := newTracer(&TraceConfig{
+			Tracer:       &name,
+			TracerConfig: json.RawMessage(`{"enableMemory": true}`),
+		})
+
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			cancel()
+		})
+
+		structTracer, ok := tracer.(*structtracer.StructTracer)
+
+		require.True(t, ok)
+		assert.True(t, structTracer.Config.EnableMemory)
+		assert.True(t, structTracer.Config.EnableStack)
+	})
+
+	t.Run("should return error for unknown tracer", func(t *testing.T) {
+		t.Parallel()
+
+		name := "unknownTracer"
+		result, cancel, err := newTracer(&TraceConfig{
+			Tracer: &name,
+		})
+
+		assert.Nil(t, result)
+		assert.Nil(t, cancel)
+		assert.ErrorIs(t, err, tracer.ErrTracerNotFound)
+	})
+
 	t.Run("GetResult should return errExecutionTimeout if timeout happens", func(t *testing.T) {
 		t.Parallel()
 
@@ -767,3 +821,28 @@ func Test_newTracer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestDebugSetHead(t *testing.T) {
+	t.Parallel()
+
+	errNoState := errors.New("state not found")
+
+	store := &debugEndpointMockStore{
+		setHeadFn: func(number uint64) error {
+			if number == 0 {
+				return errNoState
+			}
+
+			return nil
+		},
+	}
+
+	endpoint := &Debug{store}
+
+	res, err := endpoint.SetHead(argUint64(1))
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	_, err = endpoint.SetHead(argUint64(0))
+	assert.ErrorIs(t, err, errNoState)
+}
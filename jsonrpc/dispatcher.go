@@ -56,6 +56,13 @@ type dispatcherParams struct {
 	priceLimit              uint64
 	jsonRPCBatchLengthLimit uint64
 	blockRangeLimit         uint64
+	enableAdmin             bool
+}
+
+// adminMethods are the methods changing the node state,
+// they are available only if the admin methods are enabled
+var adminMethods = map[string]struct{}{
+	"debug_setHead": {},
 }
 
 func newDispatcher(
@@ -132,6 +139,10 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
+	if _, ok := adminMethods[req.Method]; ok && !d.params.enableAdmin {
+		return nil, nil, NewMethodNotFoundError(req.Method)
+	}
+
 	callName := strings.SplitN(req.Method, "_", 2)
 	if len(callName) != 2 {
 		return nil, nil, NewMethodNotFoundError(req.Method)
@@ -457,6 +457,43 @@ func TestDispatcherBatchRequest(t *testing.T) {
 	}
 }
 
+func TestDispatcherAdminMethods(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	for i := uint64(1); i <= 2; i++ {
+		store.addHeader(&types.Header{Number: i})
+	}
+
+	store.header = store.historicalHeaders[2]
+
+	req := []byte(`{"id":1,"jsonrpc":"2.0","method":"debug_setHead","params":["0x1"]}`)
+
+	// admin methods are disabled by default
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{})
+
+	res, err := dispatcher.Handle(req)
+	require.NoError(t, err)
+
+	var resp SuccessResponse
+
+	require.NoError(t, json.Unmarshal(res, &resp))
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, NewMethodNotFoundError("debug_setHead").ErrorCode(), resp.Error.Code)
+	assert.Equal(t, uint64(2), store.header.Number)
+
+	dispatcher = newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{enableAdmin: true})
+
+	res, err = dispatcher.Handle(req)
+	require.NoError(t, err)
+
+	resp = SuccessResponse{}
+
+	require.NoError(t, json.Unmarshal(res, &resp))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(1), store.header.Number)
+}
+
 func newTestDispatcher(t *testing.T, logger hclog.Logger, store JSONRPCStore, params *dispatcherParams) *Dispatcher {
 	t.Helper()
 
@@ -334,6 +334,10 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		Logs:              logs,
 	}
 
+	if txn.IsFeeDelegated() {
+		res.FeePayer = argAddrPtr(txn.FeePayer)
+	}
+
 	return res, nil
 }
 
@@ -68,6 +68,7 @@ type Config struct {
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	EnableAdmin              bool
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -81,6 +82,7 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 			priceLimit:              config.PriceLimit,
 			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
 			blockRangeLimit:         config.BlockRangeLimit,
+			enableAdmin:             config.EnableAdmin,
 		},
 	)
 
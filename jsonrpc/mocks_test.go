@@ -83,6 +83,18 @@ func (m *mockStore) headerLoop(cond func(h *types.Header) bool) *types.Header {
 	return nil
 }
 
+func (m *mockStore) SetHead(number uint64) error {
+	if number >= m.header.Number {
+		return blockchain.ErrRewindNotBelowHead
+	}
+
+	m.header = m.headerLoop(func(h *types.Header) bool {
+		return h.Number == number
+	})
+
+	return nil
+}
+
 func (m *mockStore) emitEvent(evnt *mockEvent) {
 	m.receiptsLock.Lock()
 	if m.receipts == nil {
@@ -172,6 +184,14 @@ func (m *mockStore) GetCapacity() (uint64, uint64) {
 	return 0, 0
 }
 
+func (m *mockStore) GetExpiredCount() uint64 {
+	return 0
+}
+
+func (m *mockStore) GetAccountTxs(types.Address) ([]*types.Transaction, []*types.Transaction) {
+	return nil, nil
+}
+
 func (m *mockStore) GenerateExitProof(exitID uint64) (types.Proof, error) {
 	hash := types.BytesToHash([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
 
@@ -14,6 +14,12 @@ type txPoolStore interface {
 
 	// GetCapacity returns the current and max capacity of the pool in slots
 	GetCapacity() (uint64, uint64)
+
+	// GetExpiredCount returns the number of transactions removed from the pool after their lifetime passed
+	GetExpiredCount() uint64
+
+	// GetAccountTxs returns the pending and queued transactions of the account, each sorted by nonce
+	GetAccountTxs(addr types.Address) ([]*types.Transaction, []*types.Transaction)
 }
 
 // TxPool is the txpool jsonrpc endpoint
@@ -26,6 +32,11 @@ type ContentResponse struct {
 	Queued  map[types.Address]map[uint64]*txpoolTransaction `json:"queued"`
 }
 
+type ContentFromResponse struct {
+	Pending map[uint64]*txpoolTransaction `json:"pending"`
+	Queued  map[uint64]*txpoolTransaction `json:"queued"`
+}
+
 type InspectResponse struct {
 	Pending         map[string]map[string]string `json:"pending"`
 	Queued          map[string]map[string]string `json:"queued"`
@@ -36,6 +47,7 @@ type InspectResponse struct {
 type StatusResponse struct {
 	Pending uint64 `json:"pending"`
 	Queued  uint64 `json:"queued"`
+	Expired uint64 `json:"expired"`
 }
 
 type txpoolTransaction struct {
@@ -107,6 +119,31 @@ func (t *TxPool) Content() (interface{}, error) {
 	return resp, nil
 }
 
+// Create response for txpool_contentFrom request.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-txpool#txpool-contentfrom.
+func (t *TxPool) ContentFrom(addr types.Address) (interface{}, error) {
+	pendingTxs, queuedTxs := t.store.GetAccountTxs(addr)
+
+	// collect pending
+	pendingRPCTxs := make(map[uint64]*txpoolTransaction, len(pendingTxs))
+	for _, tx := range pendingTxs {
+		pendingRPCTxs[tx.Nonce] = toTxPoolTransaction(tx)
+	}
+
+	// collect enqueued
+	queuedRPCTxs := make(map[uint64]*txpoolTransaction, len(queuedTxs))
+	for _, tx := range queuedTxs {
+		queuedRPCTxs[tx.Nonce] = toTxPoolTransaction(tx)
+	}
+
+	resp := ContentFromResponse{
+		Pending: pendingRPCTxs,
+		Queued:  queuedRPCTxs,
+	}
+
+	return resp, nil
+}
+
 // Create response for txpool_inspect request.
 // See https://geth.ethereum.org/docs/rpc/ns-txpool#txpool_inspect.
 func (t *TxPool) Inspect() (interface{}, error) {
@@ -171,6 +208,7 @@ func (t *TxPool) Status() (interface{}, error) {
 	resp := StatusResponse{
 		Pending: pendingCount,
 		Queued:  queuedCount,
+		Expired: t.store.GetExpiredCount(),
 	}
 
 	return resp, nil
@@ -117,6 +117,52 @@ func TestContentEndpoint(t *testing.T) {
 	})
 }
 
+func TestContentFromEndpoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns empty ContentFromResponse if the account has no transactions", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := newMockTxPoolStore()
+		mockStore.pending[types.Address{0x2}] = []*types.Transaction{newTestTransaction(0, types.Address{0x2})}
+		txPoolEndpoint := &TxPool{mockStore}
+
+		result, _ := txPoolEndpoint.ContentFrom(types.Address{0x1})
+		//nolint:forcetypeassert
+		response := result.(ContentFromResponse)
+
+		assert.Equal(t, 0, len(response.Pending))
+		assert.Equal(t, 0, len(response.Queued))
+	})
+
+	t.Run("returns the transactions of the account only", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := newMockTxPoolStore()
+		address1 := types.Address{0x1}
+		address2 := types.Address{0x2}
+		mockStore.pending[address1] = []*types.Transaction{
+			newTestTransaction(0, address1),
+			newTestTransaction(1, address1),
+		}
+		mockStore.queued[address1] = []*types.Transaction{newTestTransaction(3, address1)}
+		mockStore.pending[address2] = []*types.Transaction{newTestTransaction(0, address2)}
+		txPoolEndpoint := &TxPool{mockStore}
+
+		result, _ := txPoolEndpoint.ContentFrom(address1)
+		//nolint:forcetypeassert
+		response := result.(ContentFromResponse)
+
+		assert.Equal(t, 2, len(response.Pending))
+		assert.Equal(t, 1, len(response.Queued))
+
+		txData := response.Queued[3]
+		assert.NotNil(t, txData)
+		assert.Equal(t, address1, txData.From)
+		assert.Equal(t, argUint64(3), txData.Nonce)
+	})
+}
+
 func TestInspectEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -217,6 +263,7 @@ func TestStatusEndpoint(t *testing.T) {
 		mockStore.pending[address2] = []*types.Transaction{testTx4}
 		mockStore.queued[address1] = []*types.Transaction{testTx3}
 		mockStore.queued[address2] = []*types.Transaction{testTx5}
+		mockStore.expired = 4
 		txPoolEndpoint := &TxPool{mockStore}
 
 		result, _ := txPoolEndpoint.Status()
@@ -225,6 +272,7 @@ func TestStatusEndpoint(t *testing.T) {
 
 		assert.Equal(t, uint64(3), response.Pending)
 		assert.Equal(t, uint64(2), response.Queued)
+		assert.Equal(t, uint64(4), response.Expired)
 	})
 }
 
@@ -233,6 +281,7 @@ type mockTxPoolStore struct {
 	queued        map[types.Address][]*types.Transaction
 	capacity      uint64
 	maxSlots      uint64
+	expired       uint64
 	includeQueued bool
 }
 
@@ -253,6 +302,14 @@ func (s *mockTxPoolStore) GetCapacity() (uint64, uint64) {
 	return s.capacity, s.maxSlots
 }
 
+func (s *mockTxPoolStore) GetExpiredCount() uint64 {
+	return s.expired
+}
+
+func (s *mockTxPoolStore) GetAccountTxs(addr types.Address) ([]*types.Transaction, []*types.Transaction) {
+	return s.pending[addr], s.queued[addr]
+}
+
 func newTestTransaction(nonce uint64, from types.Address) *types.Transaction {
 	txn := &types.Transaction{
 		Nonce:    nonce,
@@ -29,6 +29,13 @@ type transaction struct {
 	BlockHash   *types.Hash    `json:"blockHash"`
 	BlockNumber *argUint64     `json:"blockNumber"`
 	TxIndex     *argUint64     `json:"transactionIndex"`
+
+	// fee delegated transactions only
+	Type     *argUint64     `json:"type,omitempty"`
+	FeePayer *types.Address `json:"feePayer,omitempty"`
+	PayerV   *argBig        `json:"feePayerV,omitempty"`
+	PayerR   *argBig        `json:"feePayerR,omitempty"`
+	PayerS   *argBig        `json:"feePayerS,omitempty"`
 }
 
 func (t transaction) getHash() types.Hash { return t.Hash }
@@ -78,6 +85,14 @@ func toTransaction(
 		res.TxIndex = argUintPtr(uint64(*txIndex))
 	}
 
+	if t.IsFeeDelegated() {
+		res.Type = argUintPtr(uint64(t.Type))
+		res.FeePayer = argAddrPtr(t.FeePayer)
+		res.PayerV = argBigPtr(t.PayerV)
+		res.PayerR = argBigPtr(t.PayerR)
+		res.PayerS = argBigPtr(t.PayerS)
+	}
+
 	return res
 }
 
@@ -182,6 +197,7 @@ type receipt struct {
 	ContractAddress   *types.Address `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	FeePayer          *types.Address `json:"feePayer,omitempty"`
 }
 
 type Log struct {
@@ -249,4 +249,14 @@ func TestTransaction_Encoding(t *testing.T) {
 
 		testTransaction("testsuite/transaction-pending.json")
 	})
+
+	t.Run("fee delegated", func(t *testing.T) {
+		tt.Type = argUintPtr(uint64(types.FeeDelegatedTx))
+		tt.FeePayer = &types.Address{0x4}
+		tt.PayerV = argBigPtr(big.NewInt(4))
+		tt.PayerR = argBigPtr(big.NewInt(5))
+		tt.PayerS = argBigPtr(big.NewInt(6))
+
+		testTransaction("testsuite/transaction-fee-delegated.json")
+	})
 }
@@ -65,6 +65,14 @@ func (t *Topic) Publish(obj proto.Message) error {
 }
 
 func (t *Topic) Subscribe(handler func(obj interface{}, from peer.ID)) error {
+	return t.SubscribeRelayed(func(obj interface{}, from, _ peer.ID) {
+		handler(obj, from)
+	})
+}
+
+// SubscribeRelayed subscribes to the topic, passing to the handler both the originator of a message
+// and the connected peer which relayed it. Only the relaying peer is guaranteed to be reachable.
+func (t *Topic) SubscribeRelayed(handler func(obj interface{}, from, receivedFrom peer.ID)) error {
 	sub, err := t.topic.Subscribe(pubsub.WithBufferSize(subscribeOutputBufferSize))
 	if err != nil {
 		return err
@@ -78,7 +86,7 @@ func (t *Topic) Subscribe(handler func(obj interface{}, from peer.ID)) error {
 	return nil
 }
 
-func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{}, from peer.ID)) {
+func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{}, from, receivedFrom peer.ID)) {
 	t.waitGroup.Add(1)
 	defer t.waitGroup.Done()
 
@@ -110,7 +118,7 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 				return
 			}
 
-			handler(obj, msg.GetFrom())
+			handler(obj, msg.GetFrom(), msg.ReceivedFrom)
 		}()
 	}
 }
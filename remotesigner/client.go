@@ -0,0 +1,125 @@
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/plingatech/go-plgchain/remotesigner/proto"
+	"github.com/plingatech/go-plgchain/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// connectTimeout is the timeout of the initial request to the remote signer
+	connectTimeout = 10 * time.Second
+
+	// signTimeout is the timeout of the signing request, which has to be shorter than the IBFT round
+	signTimeout = 5 * time.Second
+)
+
+// Client is the signer which delegates signing to the remote signing process over gRPC
+type Client struct {
+	conn    *grpc.ClientConn
+	client  proto.RemoteSignerClient
+	address types.Address
+}
+
+// NewClient connects to the remote signer listening on the given address.
+// The connection is secured by TLS if the config sets any of its files
+func NewClient(addr string, tlsConfig *TLSConfig) (*Client, error) {
+	creds, err := ClientCredentials(addr, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %w", err)
+	}
+
+	c := &Client{
+		conn:   conn,
+		client: proto.NewRemoteSignerClient(conn),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetAddress(ctx, &emptypb.Empty{}, grpc.WaitForReady(true))
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("failed to get address from remote signer: %w", err)
+	}
+
+	c.address = types.BytesToAddress(resp.Address)
+
+	return c, nil
+}
+
+// Address returns the address of the validator ECDSA key
+func (c *Client) Address() types.Address {
+	return c.address
+}
+
+// Sign signs the request by the validator key held by the remote signer
+func (c *Client) Sign(req *Request) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+
+	resp, err := c.client.Sign(ctx, &proto.SignRequest{
+		KeyType:      proto.SignRequest_KeyType(req.KeyType),
+		Kind:         proto.SignRequest_Kind(req.Kind),
+		Data:         req.Data,
+		Domain:       req.Domain,
+		Height:       req.Height,
+		Round:        req.Round,
+		ChainId:      req.ChainID,
+		BlsPublicKey: req.BLSPublicKey,
+	})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+
+	return resp.Signature, nil
+}
+
+// Close closes the connection to the remote signer
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// remoteError is the error returned by the remote signer
+type remoteError struct {
+	msg string
+
+	// err is the signing error matching the error of the remote signer
+	err error
+}
+
+func (e *remoteError) Error() string {
+	return "remote signer: " + e.msg
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.err
+}
+
+// fromStatusError converts the gRPC status error of the signing request back to the signing error
+func fromStatusError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	remoteErr := &remoteError{msg: st.Message()}
+	if st.Code() == codes.FailedPrecondition {
+		remoteErr.err = ErrDoubleSign
+	}
+
+	return remoteErr
+}
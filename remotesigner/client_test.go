@@ -0,0 +1,95 @@
+package remotesigner
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/crypto"
+	protoIBFT "github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// startTestServer starts the reference signing server and returns its address
+func startTestServer(t *testing.T, signer Signer, tlsConfig *TLSConfig) string {
+	t.Helper()
+
+	protection, err := NewSlashingProtection("")
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	creds, err := ServerCredentials(listener.Addr().String(), tlsConfig)
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(grpc.Creds(creds))
+	NewServer(hclog.NewNullLogger(), signer, protection).Register(grpcServer)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestClient_Sign(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestLocalSigner(t)
+
+	client, err := NewClient(startTestServer(t, signer, nil), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	require.Equal(t, signer.Address(), client.Address())
+
+	// the remote signature is the same as the local one
+	for _, req := range []*Request{
+		{KeyType: KeyBLS, Kind: KindDigest, Data: crypto.Keccak256([]byte("digest")), Domain: []byte("domain")},
+		{KeyType: KeyECDSA, Kind: KindProposerSeal, Data: crypto.Keccak256([]byte("block")), Height: 1},
+		{KeyType: KeyBLS, Kind: KindStateSyncVote, Data: crypto.Keccak256([]byte("commitment")), Height: 1, Round: 5},
+	} {
+		expected, err := signer.Sign(req)
+		require.NoError(t, err)
+
+		signature, err := client.Sign(req)
+		require.NoError(t, err)
+		require.Equal(t, expected, signature)
+	}
+
+	// IBFT messages are checked by the slashing protection of the signing server
+	prepare := newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 3, 0, "a")
+
+	_, err = client.Sign(&Request{KeyType: KeyECDSA, Kind: KindIBFTMessage, Data: prepare})
+	require.NoError(t, err)
+
+	_, err = client.Sign(&Request{
+		KeyType: KeyECDSA,
+		Kind:    KindIBFTMessage,
+		Data:    newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 3, 0, "b"),
+	})
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	_, err = client.Sign(&Request{KeyType: KeyECDSA, Kind: KindIBFTMessage, Data: []byte{0xff}})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrDoubleSign)
+
+	// committed seals are checked by the slashing protection of the signing server
+	_, err = client.Sign(&Request{KeyType: KeyBLS, Kind: KindCommittedSeal, Data: []byte("a"), Height: 3, Round: 0})
+	require.NoError(t, err)
+
+	_, err = client.Sign(&Request{KeyType: KeyBLS, Kind: KindCommittedSeal, Data: []byte("b"), Height: 3, Round: 0})
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	// raw ECDSA digest could be the signature of the IBFT message or seal bypassing the slashing protection
+	_, err = client.Sign(&Request{KeyType: KeyECDSA, Kind: KindDigest, Data: crypto.Keccak256(prepare)})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrDoubleSign)
+}
@@ -0,0 +1,170 @@
+package remotesigner
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/secrets"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// LocalSigner signs by the validator keys held in the memory of the signing process
+type LocalSigner struct {
+	ecdsaKey *ecdsa.PrivateKey
+	address  types.Address
+
+	// secretsManager is the source of the keys, the BLS keys are reloaded from it after the key rotation
+	secretsManager secrets.SecretsManager
+
+	// blsKey is the current BLS key, and previousBlsKey is the key replaced by the key rotation
+	blsKey         *bls.PrivateKey
+	previousBlsKey *bls.PrivateKey
+	lock           sync.RWMutex
+}
+
+// NewLocalSigner creates a new signer from the given keys, the BLS key is optional
+func NewLocalSigner(ecdsaKey *ecdsa.PrivateKey, blsKey *bls.PrivateKey) *LocalSigner {
+	return &LocalSigner{
+		ecdsaKey: ecdsaKey,
+		blsKey:   blsKey,
+		address:  crypto.PubKeyToAddress(&ecdsaKey.PublicKey),
+	}
+}
+
+// NewLocalSignerFromSecrets creates a new signer from the validator keys stored in the given SecretsManager.
+// The BLS keys are loaded only if they are present, since IBFT validators of ECDSA type don't have them
+func NewLocalSignerFromSecrets(manager secrets.SecretsManager) (*LocalSigner, error) {
+	encodedKey, err := manager.GetSecret(secrets.ValidatorKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validator key: %w", err)
+	}
+
+	ecdsaKey, err := crypto.BytesToECDSAPrivateKey(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse validator key: %w", err)
+	}
+
+	signer := NewLocalSigner(ecdsaKey, nil)
+	signer.secretsManager = manager
+
+	if err := signer.loadBlsKeys(); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// Address returns the address of the validator ECDSA key
+func (s *LocalSigner) Address() types.Address {
+	return s.address
+}
+
+// Sign signs the request by the validator key
+func (s *LocalSigner) Sign(req *Request) ([]byte, error) {
+	digest, domain, err := req.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.KeyType {
+	case KeyECDSA:
+		return crypto.Sign(s.ecdsaKey, digest)
+	case KeyBLS:
+		blsKey, err := s.getBlsKey(req.BLSPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		signature, err := blsKey.Sign(digest, domain)
+		if err != nil {
+			return nil, err
+		}
+
+		return signature.Marshal()
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedKeyType, req.KeyType)
+	}
+}
+
+// getBlsKey returns the BLS key matching the given public key, or the current one if the public key is empty.
+// The keys are reloaded from the secrets manager if none of them matches, since the key may have been rotated
+func (s *LocalSigner) getBlsKey(pubKey []byte) (*bls.PrivateKey, error) {
+	if blsKey := s.findBlsKey(pubKey); blsKey != nil {
+		return blsKey, nil
+	}
+
+	if s.secretsManager != nil {
+		if err := s.loadBlsKeys(); err != nil {
+			return nil, err
+		}
+
+		if blsKey := s.findBlsKey(pubKey); blsKey != nil {
+			return blsKey, nil
+		}
+	}
+
+	if len(pubKey) == 0 {
+		return nil, fmt.Errorf("%w: BLS key is not loaded", ErrUnsupportedKeyType)
+	}
+
+	return nil, fmt.Errorf("%w: %x", ErrUnknownBLSKey, pubKey)
+}
+
+func (s *LocalSigner) findBlsKey(pubKey []byte) *bls.PrivateKey {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(pubKey) == 0 {
+		return s.blsKey
+	}
+
+	for _, blsKey := range []*bls.PrivateKey{s.blsKey, s.previousBlsKey} {
+		if blsKey != nil && bytes.Equal(blsKey.PublicKey().Marshal(), pubKey) {
+			return blsKey
+		}
+	}
+
+	return nil
+}
+
+// loadBlsKeys loads the current and the previous BLS key from the secrets manager
+func (s *LocalSigner) loadBlsKeys() error {
+	blsKey, err := loadBlsKey(s.secretsManager, secrets.ValidatorBLSKey)
+	if err != nil {
+		return err
+	}
+
+	previousBlsKey, err := loadBlsKey(s.secretsManager, secrets.ValidatorBLSKeyPrevious)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	s.blsKey, s.previousBlsKey = blsKey, previousBlsKey
+	s.lock.Unlock()
+
+	return nil
+}
+
+// loadBlsKey loads the BLS key of the given name from the secrets manager, if it is present
+func loadBlsKey(manager secrets.SecretsManager, name string) (*bls.PrivateKey, error) {
+	if !manager.HasSecret(name) {
+		return nil, nil
+	}
+
+	encodedKey, err := manager.GetSecret(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validator BLS key: %w", err)
+	}
+
+	blsKey, err := bls.UnmarshalPrivateKey(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse validator BLS key: %w", err)
+	}
+
+	return blsKey, nil
+}
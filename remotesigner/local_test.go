@@ -0,0 +1,215 @@
+package remotesigner
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/secrets"
+	"github.com/plingatech/go-plgchain/secrets/local"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+func newTestLocalSigner(t *testing.T) *LocalSigner {
+	t.Helper()
+
+	ecdsaKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	blsKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	return NewLocalSigner(ecdsaKey, blsKey)
+}
+
+func TestLocalSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestLocalSigner(t)
+	digest := crypto.Keccak256([]byte("digest"))
+
+	// ECDSA signature of the IBFT message is the signature of its hash
+	signature, err := signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindIBFTMessage, Data: []byte("digest")})
+	require.NoError(t, err)
+
+	pub, err := crypto.RecoverPubkey(signature, digest)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), crypto.PubKeyToAddress(pub))
+
+	// ECDSA signature of the proposer seal is the signature of the hash of the header hash
+	signature, err = signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindProposerSeal, Data: []byte("digest"), Height: 1})
+	require.NoError(t, err)
+
+	pub, err = crypto.RecoverPubkey(signature, digest)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), crypto.PubKeyToAddress(pub))
+
+	// BLS signatures of the digest, the committed seal and the state sync vote
+	for _, test := range []struct {
+		req    *Request
+		domain []byte
+	}{
+		{&Request{KeyType: KeyBLS, Kind: KindDigest, Data: digest, Domain: bls.DomainCommonSigning}, bls.DomainCommonSigning},
+		{&Request{KeyType: KeyBLS, Kind: KindCommittedSeal, Data: digest, Height: 1}, bls.DomainCheckpointManager},
+		{&Request{KeyType: KeyBLS, Kind: KindStateSyncVote, Data: digest, Height: 1, Round: 5}, bls.DomainStateReceiver},
+	} {
+		signature, err = signer.Sign(test.req)
+		require.NoError(t, err)
+
+		blsSignature, err := bls.UnmarshalSignature(signature)
+		require.NoError(t, err)
+		require.True(t, blsSignature.Verify(signer.blsKey.PublicKey(), digest, test.domain))
+	}
+
+	// raw digests which may be the signatures of the consensus data are refused
+	_, err = signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindDigest, Data: digest})
+	require.ErrorIs(t, err, ErrRawDigest)
+
+	_, err = signer.Sign(&Request{KeyType: KeyBLS, Kind: KindDigest, Data: digest, Domain: bls.DomainStateReceiver})
+	require.ErrorIs(t, err, ErrRawDigest)
+
+	_, err = signer.Sign(&Request{KeyType: KeyBLS, Kind: KindDigest, Data: digest, Domain: bls.DomainCheckpointManager})
+	require.ErrorIs(t, err, ErrRawDigest)
+
+	_, err = signer.Sign(&Request{KeyType: KeyBLS, Kind: KindIBFTMessage, Data: digest})
+	require.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+	_, err = signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindStateSyncVote, Data: digest})
+	require.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+	_, err = signer.Sign(&Request{KeyType: KeyECDSA, Kind: Kind(10), Data: digest})
+	require.ErrorIs(t, err, ErrUnsupportedSignKind)
+}
+
+func TestLocalSigner_SignTransaction(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	signer := newTestLocalSigner(t)
+	to := ethgo.Address{0x1}
+
+	tx := &ethgo.Transaction{
+		Nonce:    1,
+		GasPrice: 2,
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(3),
+		Input:    []byte{0x4},
+	}
+
+	raw, err := tx.MarshalRLPTo(nil)
+	require.NoError(t, err)
+
+	signature, err := signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindTransaction, Data: raw, ChainID: chainID})
+	require.NoError(t, err)
+
+	// the signature is the EIP-155 signature of the transaction
+	txSigner := wallet.NewEIP155Signer(chainID)
+
+	tx, err = txSigner.SignTx(tx, &testSignatureKey{signature: signature})
+	require.NoError(t, err)
+
+	sender, err := txSigner.RecoverSender(tx)
+	require.NoError(t, err)
+	require.Equal(t, ethgo.Address(signer.Address()), sender)
+
+	// signed transaction is refused
+	raw, err = tx.MarshalRLPTo(nil)
+	require.NoError(t, err)
+
+	_, err = signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindTransaction, Data: raw, ChainID: chainID})
+	require.ErrorIs(t, err, ErrInvalidTransaction)
+
+	_, err = signer.Sign(&Request{KeyType: KeyECDSA, Kind: KindTransaction, Data: []byte{0x1}, ChainID: chainID})
+	require.ErrorIs(t, err, ErrInvalidTransaction)
+}
+
+// testSignatureKey implements ethgo.Key interface and it returns the given signature
+type testSignatureKey struct {
+	signature []byte
+}
+
+func (k *testSignatureKey) Address() ethgo.Address {
+	return ethgo.ZeroAddress
+}
+
+func (k *testSignatureKey) Sign([]byte) ([]byte, error) {
+	return k.signature, nil
+}
+
+func TestLocalSigner_FromSecrets(t *testing.T) {
+	t.Parallel()
+
+	secretsManager, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  map[string]interface{}{secrets.Path: t.TempDir()},
+	})
+	require.NoError(t, err)
+
+	ecdsaKey, ecdsaRaw, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+	require.NoError(t, secretsManager.SetSecret(secrets.ValidatorKey, ecdsaRaw))
+
+	// IBFT validator of ECDSA type has no BLS key
+	signer, err := NewLocalSignerFromSecrets(secretsManager)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubKeyToAddress(&ecdsaKey.PublicKey), signer.Address())
+	require.Nil(t, signer.blsKey)
+
+	_, err = signer.Sign(&Request{KeyType: KeyBLS, Kind: KindCommittedSeal, Data: []byte("digest")})
+	require.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+	blsKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	blsRaw, err := blsKey.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, secretsManager.SetSecret(secrets.ValidatorBLSKey, blsRaw))
+
+	signer, err = NewLocalSignerFromSecrets(secretsManager)
+	require.NoError(t, err)
+	require.Equal(t, blsKey.PublicKey(), signer.blsKey.PublicKey())
+
+	// the key is rotated while the signer is running
+	rotatedKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	rotatedRaw, err := rotatedKey.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, secretsManager.SetSecret(secrets.ValidatorBLSKeyPrevious, blsRaw))
+	require.NoError(t, secretsManager.RemoveSecret(secrets.ValidatorBLSKey))
+	require.NoError(t, secretsManager.SetSecret(secrets.ValidatorBLSKey, rotatedRaw))
+
+	digest := crypto.Keccak256([]byte("digest"))
+
+	// the signer signs by the requested key, and it reloads the keys if it doesn't hold the requested one
+	for _, key := range []*bls.PrivateKey{rotatedKey, blsKey} {
+		signature, err := signer.Sign(&Request{
+			KeyType:      KeyBLS,
+			Kind:         KindCommittedSeal,
+			Data:         digest,
+			BLSPublicKey: key.PublicKey().Marshal(),
+		})
+		require.NoError(t, err)
+
+		blsSignature, err := bls.UnmarshalSignature(signature)
+		require.NoError(t, err)
+		require.True(t, blsSignature.Verify(key.PublicKey(), digest, bls.DomainCheckpointManager))
+	}
+
+	unknownKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	_, err = signer.Sign(&Request{
+		KeyType:      KeyBLS,
+		Kind:         KindCommittedSeal,
+		Data:         digest,
+		BLSPublicKey: unknownKey.PublicKey().Marshal(),
+	})
+	require.ErrorIs(t, err, ErrUnknownBLSKey)
+}
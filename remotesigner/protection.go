@@ -0,0 +1,164 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/types"
+	protoIBFT "github.com/plingatech/plg-ibft/messages/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// signedView is the last view in which the data of some kind was signed
+type signedView struct {
+	Height uint64     `json:"height"`
+	Round  uint64     `json:"round"`
+	Digest types.Hash `json:"digest"`
+}
+
+// SlashingProtection keeps track of the signed IBFT messages, seals and state sync votes and refuses to sign
+// the data which conflicts with the data of the same kind signed before, that is:
+//   - the data of a different content for the same height and round
+//   - the data of a lower height, or a lower round of the same height
+//
+// State sync votes are tracked by the start and the end ID of the commitment instead of the height and the round
+type SlashingProtection struct {
+	// path is the file the signed views are persisted to, they are kept only in memory if empty
+	path string
+
+	lock sync.Mutex
+
+	// views are the last signed views by the IBFT message type or the kind of the signed data
+	views map[string]*signedView
+}
+
+// NewSlashingProtection creates a new slashing protection, which persists the signed views to the given file
+func NewSlashingProtection(path string) (*SlashingProtection, error) {
+	p := &SlashingProtection{
+		path:  path,
+		views: make(map[string]*signedView),
+	}
+
+	if path == "" {
+		return p, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read slashing protection data: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &p.views); err != nil {
+		return nil, fmt.Errorf("failed to parse slashing protection data: %w", err)
+	}
+
+	return p, nil
+}
+
+// Check checks the given request against the requests signed before and records it as signed
+// if it doesn't conflict with them. Requests of the kinds which can't be double signed are not checked
+func (p *SlashingProtection) Check(req *Request) error {
+	var viewKey string
+
+	switch req.Kind {
+	case KindIBFTMessage:
+		return p.CheckIBFTMessage(req.Data)
+	case KindProposerSeal:
+		viewKey = "PROPOSER_SEAL"
+	case KindCommittedSeal:
+		// PlgBFT validators sign COMMIT messages by ECDSA key and committed seals by BLS key
+		viewKey = "COMMITTED_SEAL"
+		if req.KeyType == KeyBLS {
+			viewKey = "BLS_COMMITTED_SEAL"
+		}
+	case KindStateSyncVote:
+		viewKey = "STATE_SYNC_VOTE"
+	default:
+		return nil
+	}
+
+	return p.check(viewKey, &signedView{
+		Height: req.Height,
+		Round:  req.Round,
+		Digest: types.BytesToHash(crypto.Keccak256(req.Data)),
+	})
+}
+
+// CheckIBFTMessage checks the given marshaled IBFT message against the messages signed before
+// and records it as signed if it doesn't conflict with them
+func (p *SlashingProtection) CheckIBFTMessage(data []byte) error {
+	msg := &protoIBFT.Message{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidIBFTMessage, err)
+	}
+
+	if msg.View == nil {
+		return fmt.Errorf("%w: view is missing", ErrInvalidIBFTMessage)
+	}
+
+	if len(msg.Signature) != 0 {
+		return fmt.Errorf("%w: message is already signed", ErrInvalidIBFTMessage)
+	}
+
+	return p.check(msg.Type.String(), &signedView{
+		Height: msg.View.Height,
+		Round:  msg.View.Round,
+		Digest: types.BytesToHash(crypto.Keccak256(data)),
+	})
+}
+
+// check checks the given view against the last signed view of the given key and records it as signed
+func (p *SlashingProtection) check(viewKey string, view *signedView) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if last, ok := p.views[viewKey]; ok {
+		if view.Height < last.Height || (view.Height == last.Height && view.Round < last.Round) {
+			return fmt.Errorf("%w: %s of height %d round %d is older than the signed one of height %d round %d",
+				ErrDoubleSign, viewKey, view.Height, view.Round, last.Height, last.Round)
+		}
+
+		if view.Height == last.Height && view.Round == last.Round {
+			if view.Digest != last.Digest {
+				return fmt.Errorf("%w: %s of height %d round %d conflicts with the signed one",
+					ErrDoubleSign, viewKey, view.Height, view.Round)
+			}
+
+			// the same data is signed again
+			return nil
+		}
+	}
+
+	p.views[viewKey] = view
+
+	return p.persist()
+}
+
+// persist writes the signed views to the file, the file is replaced atomically
+// so that the signed views are never lost if the process crashes while writing
+func (p *SlashingProtection) persist() error {
+	if p.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(p.views)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := p.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write slashing protection data: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, p.path); err != nil {
+		return fmt.Errorf("failed to write slashing protection data: %w", err)
+	}
+
+	return nil
+}
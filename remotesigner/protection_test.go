@@ -0,0 +1,156 @@
+package remotesigner
+
+import (
+	"path/filepath"
+	"testing"
+
+	protoIBFT "github.com/plingatech/plg-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestIBFTMessage(t *testing.T, msgType protoIBFT.MessageType, height, round uint64, hash string) []byte {
+	t.Helper()
+
+	msg := &protoIBFT.Message{
+		View: &protoIBFT.View{Height: height, Round: round},
+		From: []byte("validator"),
+		Type: msgType,
+		Payload: &protoIBFT.Message_PrepareData{
+			PrepareData: &protoIBFT.PrepareMessage{ProposalHash: []byte(hash)},
+		},
+	}
+
+	raw, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	return raw
+}
+
+func TestSlashingProtection_CheckIBFTMessage(t *testing.T) {
+	t.Parallel()
+
+	protection, err := NewSlashingProtection("")
+	require.NoError(t, err)
+
+	prepare := newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 10, 1, "a")
+
+	require.NoError(t, protection.CheckIBFTMessage(prepare))
+
+	// the same message may be signed again
+	require.NoError(t, protection.CheckIBFTMessage(prepare))
+
+	// conflicting message of the same view
+	require.ErrorIs(t,
+		protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 10, 1, "b")),
+		ErrDoubleSign)
+
+	// messages of the lower views
+	require.ErrorIs(t,
+		protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 10, 0, "a")),
+		ErrDoubleSign)
+	require.ErrorIs(t,
+		protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 9, 5, "a")),
+		ErrDoubleSign)
+
+	// messages of the other types and of the higher views
+	require.NoError(t, protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_COMMIT, 10, 1, "b")))
+	require.NoError(t, protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 10, 2, "b")))
+	require.NoError(t, protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_PREPARE, 11, 0, "c")))
+}
+
+func TestSlashingProtection_Check(t *testing.T) {
+	t.Parallel()
+
+	protection, err := NewSlashingProtection("")
+	require.NoError(t, err)
+
+	committedSeal := func(keyType KeyType, height, round uint64, hash string) *Request {
+		return &Request{KeyType: keyType, Kind: KindCommittedSeal, Data: []byte(hash), Height: height, Round: round}
+	}
+
+	require.NoError(t, protection.Check(committedSeal(KeyBLS, 10, 1, "a")))
+
+	// the same seal may be signed again
+	require.NoError(t, protection.Check(committedSeal(KeyBLS, 10, 1, "a")))
+
+	// conflicting seal of the same view, and the seals of the lower views
+	require.ErrorIs(t, protection.Check(committedSeal(KeyBLS, 10, 1, "b")), ErrDoubleSign)
+	require.ErrorIs(t, protection.Check(committedSeal(KeyBLS, 10, 0, "a")), ErrDoubleSign)
+	require.ErrorIs(t, protection.Check(committedSeal(KeyBLS, 9, 3, "a")), ErrDoubleSign)
+
+	// seals of the other key and of the higher views
+	require.NoError(t, protection.Check(committedSeal(KeyECDSA, 10, 1, "b")))
+	require.NoError(t, protection.Check(committedSeal(KeyBLS, 10, 2, "b")))
+
+	// proposer seals and state sync votes are tracked apart from the committed seals
+	proposerSeal := &Request{KeyType: KeyECDSA, Kind: KindProposerSeal, Data: []byte("c"), Height: 10, Round: 2}
+	require.NoError(t, protection.Check(proposerSeal))
+	require.ErrorIs(t,
+		protection.Check(&Request{KeyType: KeyECDSA, Kind: KindProposerSeal, Data: []byte("d"), Height: 10, Round: 2}),
+		ErrDoubleSign)
+
+	stateSyncVote := &Request{KeyType: KeyBLS, Kind: KindStateSyncVote, Data: []byte("e"), Height: 1, Round: 10}
+	require.NoError(t, protection.Check(stateSyncVote))
+	require.ErrorIs(t,
+		protection.Check(&Request{KeyType: KeyBLS, Kind: KindStateSyncVote, Data: []byte("f"), Height: 1, Round: 10}),
+		ErrDoubleSign)
+	require.NoError(t,
+		protection.Check(&Request{KeyType: KeyBLS, Kind: KindStateSyncVote, Data: []byte("f"), Height: 11, Round: 20}))
+
+	// IBFT messages are checked by their type
+	require.NoError(t, protection.Check(&Request{
+		KeyType: KeyECDSA,
+		Kind:    KindIBFTMessage,
+		Data:    newTestIBFTMessage(t, protoIBFT.MessageType_COMMIT, 10, 1, "a"),
+	}))
+	require.ErrorIs(t, protection.Check(&Request{
+		KeyType: KeyECDSA,
+		Kind:    KindIBFTMessage,
+		Data:    newTestIBFTMessage(t, protoIBFT.MessageType_COMMIT, 10, 1, "b"),
+	}), ErrDoubleSign)
+
+	// transactions and digests are not checked
+	require.NoError(t, protection.Check(&Request{KeyType: KeyECDSA, Kind: KindTransaction, Data: []byte("a")}))
+	require.NoError(t, protection.Check(&Request{KeyType: KeyBLS, Kind: KindDigest, Data: []byte("a")}))
+}
+
+func TestSlashingProtection_InvalidMessage(t *testing.T) {
+	t.Parallel()
+
+	protection, err := NewSlashingProtection("")
+	require.NoError(t, err)
+
+	require.ErrorIs(t, protection.CheckIBFTMessage([]byte{0xff}), ErrInvalidIBFTMessage)
+
+	raw, err := proto.Marshal(&protoIBFT.Message{Type: protoIBFT.MessageType_PREPARE})
+	require.NoError(t, err)
+	require.ErrorIs(t, protection.CheckIBFTMessage(raw), ErrInvalidIBFTMessage)
+
+	raw, err = proto.Marshal(&protoIBFT.Message{
+		View:      &protoIBFT.View{Height: 1},
+		Type:      protoIBFT.MessageType_PREPARE,
+		Signature: []byte{1},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, protection.CheckIBFTMessage(raw), ErrInvalidIBFTMessage)
+}
+
+func TestSlashingProtection_Persistence(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "protection.json")
+
+	protection, err := NewSlashingProtection(path)
+	require.NoError(t, err)
+	require.NoError(t, protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_COMMIT, 5, 0, "a")))
+
+	// the signed views survive the restart of the signer
+	protection, err = NewSlashingProtection(path)
+	require.NoError(t, err)
+
+	require.ErrorIs(t,
+		protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_COMMIT, 5, 0, "b")),
+		ErrDoubleSign)
+	require.NoError(t, protection.CheckIBFTMessage(newTestIBFTMessage(t, protoIBFT.MessageType_COMMIT, 5, 0, "a")))
+}
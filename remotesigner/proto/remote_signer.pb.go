@@ -0,0 +1,490 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: remotesigner/proto/remote_signer.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SignRequest_KeyType int32
+
+const (
+	SignRequest_ECDSA SignRequest_KeyType = 0
+	SignRequest_BLS   SignRequest_KeyType = 1
+)
+
+// Enum value maps for SignRequest_KeyType.
+var (
+	SignRequest_KeyType_name = map[int32]string{
+		0: "ECDSA",
+		1: "BLS",
+	}
+	SignRequest_KeyType_value = map[string]int32{
+		"ECDSA": 0,
+		"BLS":   1,
+	}
+)
+
+func (x SignRequest_KeyType) Enum() *SignRequest_KeyType {
+	p := new(SignRequest_KeyType)
+	*p = x
+	return p
+}
+
+func (x SignRequest_KeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignRequest_KeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_remotesigner_proto_remote_signer_proto_enumTypes[0].Descriptor()
+}
+
+func (SignRequest_KeyType) Type() protoreflect.EnumType {
+	return &file_remotesigner_proto_remote_signer_proto_enumTypes[0]
+}
+
+func (x SignRequest_KeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignRequest_KeyType.Descriptor instead.
+func (SignRequest_KeyType) EnumDescriptor() ([]byte, []int) {
+	return file_remotesigner_proto_remote_signer_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type SignRequest_Kind int32
+
+const (
+	// DIGEST is the BLS signature of the arbitrary digest outside of the consensus domains
+	SignRequest_DIGEST SignRequest_Kind = 0
+	// IBFT_MESSAGE is the signature of the IBFT consensus message,
+	// which is checked against the slashing protection rules
+	SignRequest_IBFT_MESSAGE SignRequest_Kind = 1
+	// PROPOSER_SEAL is the ECDSA signature of the header hash of the proposed block,
+	// which is checked against the slashing protection rules
+	SignRequest_PROPOSER_SEAL SignRequest_Kind = 2
+	// COMMITTED_SEAL is the signature of the hash of the committed proposal,
+	// which is checked against the slashing protection rules
+	SignRequest_COMMITTED_SEAL SignRequest_Kind = 3
+	// STATE_SYNC_VOTE is the BLS signature of the hash of the state sync commitment,
+	// which is checked against the slashing protection rules
+	SignRequest_STATE_SYNC_VOTE SignRequest_Kind = 4
+	// TRANSACTION is the ECDSA signature of the RLP encoded unsigned transaction
+	SignRequest_TRANSACTION SignRequest_Kind = 5
+)
+
+// Enum value maps for SignRequest_Kind.
+var (
+	SignRequest_Kind_name = map[int32]string{
+		0: "DIGEST",
+		1: "IBFT_MESSAGE",
+		2: "PROPOSER_SEAL",
+		3: "COMMITTED_SEAL",
+		4: "STATE_SYNC_VOTE",
+		5: "TRANSACTION",
+	}
+	SignRequest_Kind_value = map[string]int32{
+		"DIGEST":          0,
+		"IBFT_MESSAGE":    1,
+		"PROPOSER_SEAL":   2,
+		"COMMITTED_SEAL":  3,
+		"STATE_SYNC_VOTE": 4,
+		"TRANSACTION":     5,
+	}
+)
+
+func (x SignRequest_Kind) Enum() *SignRequest_Kind {
+	p := new(SignRequest_Kind)
+	*p = x
+	return p
+}
+
+func (x SignRequest_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignRequest_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_remotesigner_proto_remote_signer_proto_enumTypes[1].Descriptor()
+}
+
+func (SignRequest_Kind) Type() protoreflect.EnumType {
+	return &file_remotesigner_proto_remote_signer_proto_enumTypes[1]
+}
+
+func (x SignRequest_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignRequest_Kind.Descriptor instead.
+func (SignRequest_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_remotesigner_proto_remote_signer_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type AddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AddressResponse) Reset() {
+	*x = AddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesigner_proto_remote_signer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressResponse) ProtoMessage() {}
+
+func (x *AddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_remote_signer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressResponse.ProtoReflect.Descriptor instead.
+func (*AddressResponse) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_remote_signer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddressResponse) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type SignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyType SignRequest_KeyType `protobuf:"varint,1,opt,name=key_type,json=keyType,proto3,enum=v1.SignRequest_KeyType" json:"key_type,omitempty"`
+	Kind    SignRequest_Kind    `protobuf:"varint,2,opt,name=kind,proto3,enum=v1.SignRequest_Kind" json:"kind,omitempty"`
+	// data is the signed data of the given kind, see Kind
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// domain is the domain of the BLS signature of the digest
+	Domain []byte `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	// height is the height of the signed seal, or the start ID of the signed state sync commitment
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// round is the round of the signed seal, or the end ID of the signed state sync commitment
+	Round uint64 `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	// chain_id is the chain ID of the signed transaction
+	ChainId uint64 `protobuf:"varint,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// bls_public_key is the public key of the BLS key to sign by, the current BLS key is used if it is empty
+	BlsPublicKey []byte `protobuf:"bytes,8,opt,name=bls_public_key,json=blsPublicKey,proto3" json:"bls_public_key,omitempty"`
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesigner_proto_remote_signer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_remote_signer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_remote_signer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SignRequest) GetKeyType() SignRequest_KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return SignRequest_ECDSA
+}
+
+func (x *SignRequest) GetKind() SignRequest_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return SignRequest_DIGEST
+}
+
+func (x *SignRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SignRequest) GetDomain() []byte {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+func (x *SignRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SignRequest) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *SignRequest) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SignRequest) GetBlsPublicKey() []byte {
+	if x != nil {
+		return x.BlsPublicKey
+	}
+	return nil
+}
+
+type SignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesigner_proto_remote_signer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_remote_signer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_remote_signer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_remotesigner_proto_remote_signer_proto protoreflect.FileDescriptor
+
+var file_remotesigner_proto_remote_signer_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x98, 0x03, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
+	0x1d, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x43,
+	0x44, 0x53, 0x41, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4c, 0x53, 0x10, 0x01, 0x22, 0x71,
+	0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x42, 0x46, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x52,
+	0x5f, 0x53, 0x45, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x54, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x04,
+	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x05, 0x22, 0x2c, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32,
+	0x74, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x53, 0x69,
+	0x67, 0x6e, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_remotesigner_proto_remote_signer_proto_rawDescOnce sync.Once
+	file_remotesigner_proto_remote_signer_proto_rawDescData = file_remotesigner_proto_remote_signer_proto_rawDesc
+)
+
+func file_remotesigner_proto_remote_signer_proto_rawDescGZIP() []byte {
+	file_remotesigner_proto_remote_signer_proto_rawDescOnce.Do(func() {
+		file_remotesigner_proto_remote_signer_proto_rawDescData = protoimpl.X.CompressGZIP(file_remotesigner_proto_remote_signer_proto_rawDescData)
+	})
+	return file_remotesigner_proto_remote_signer_proto_rawDescData
+}
+
+var file_remotesigner_proto_remote_signer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_remotesigner_proto_remote_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_remotesigner_proto_remote_signer_proto_goTypes = []interface{}{
+	(SignRequest_KeyType)(0), // 0: v1.SignRequest.KeyType
+	(SignRequest_Kind)(0),    // 1: v1.SignRequest.Kind
+	(*AddressResponse)(nil),  // 2: v1.AddressResponse
+	(*SignRequest)(nil),      // 3: v1.SignRequest
+	(*SignResponse)(nil),     // 4: v1.SignResponse
+	(*emptypb.Empty)(nil),    // 5: google.protobuf.Empty
+}
+var file_remotesigner_proto_remote_signer_proto_depIdxs = []int32{
+	0, // 0: v1.SignRequest.key_type:type_name -> v1.SignRequest.KeyType
+	1, // 1: v1.SignRequest.kind:type_name -> v1.SignRequest.Kind
+	5, // 2: v1.RemoteSigner.GetAddress:input_type -> google.protobuf.Empty
+	3, // 3: v1.RemoteSigner.Sign:input_type -> v1.SignRequest
+	2, // 4: v1.RemoteSigner.GetAddress:output_type -> v1.AddressResponse
+	4, // 5: v1.RemoteSigner.Sign:output_type -> v1.SignResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_remotesigner_proto_remote_signer_proto_init() }
+func file_remotesigner_proto_remote_signer_proto_init() {
+	if File_remotesigner_proto_remote_signer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_remotesigner_proto_remote_signer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesigner_proto_remote_signer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesigner_proto_remote_signer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_remotesigner_proto_remote_signer_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_remotesigner_proto_remote_signer_proto_goTypes,
+		DependencyIndexes: file_remotesigner_proto_remote_signer_proto_depIdxs,
+		EnumInfos:         file_remotesigner_proto_remote_signer_proto_enumTypes,
+		MessageInfos:      file_remotesigner_proto_remote_signer_proto_msgTypes,
+	}.Build()
+	File_remotesigner_proto_remote_signer_proto = out.File
+	file_remotesigner_proto_remote_signer_proto_rawDesc = nil
+	file_remotesigner_proto_remote_signer_proto_goTypes = nil
+	file_remotesigner_proto_remote_signer_proto_depIdxs = nil
+}
@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: remotesigner/proto/remote_signer.proto
+
+package proto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AddressResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressResponseMultiError, or
+// nil if none found.
+func (m *AddressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AddressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressResponseMultiError is an error wrapping multiple validation errors
+// returned by AddressResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressResponseMultiError) AllErrors() []error { return m }
+
+// AddressResponseValidationError is the validation error returned by
+// AddressResponse.Validate if the designated constraints aren't met.
+type AddressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressResponseValidationError) ErrorName() string {
+	return "AddressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressResponseValidationError{}
+
+// Validate checks the field values on SignRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *SignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in SignRequestMultiError, or nil if
+// none found.
+func (m *SignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyType
+
+	// no validation rules for Kind
+
+	// no validation rules for Data
+
+	// no validation rules for Domain
+
+	// no validation rules for Height
+
+	// no validation rules for Round
+
+	// no validation rules for ChainId
+
+	// no validation rules for BlsPublicKey
+
+	if len(errors) > 0 {
+		return SignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignRequestMultiError is an error wrapping multiple validation errors
+// returned by SignRequest.ValidateAll() if the designated constraints aren't
+// met.
+type SignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignRequestMultiError) AllErrors() []error { return m }
+
+// SignRequestValidationError is the validation error returned by
+// SignRequest.Validate if the designated constraints aren't met.
+type SignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignRequestValidationError) ErrorName() string {
+	return "SignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignRequestValidationError{}
+
+// Validate checks the field values on SignResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in SignResponseMultiError, or nil if
+// none found.
+func (m *SignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return SignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignResponseMultiError is an error wrapping multiple validation errors
+// returned by SignResponse.ValidateAll() if the designated constraints aren't
+// met.
+type SignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignResponseMultiError) AllErrors() []error { return m }
+
+// SignResponseValidationError is the validation error returned by
+// SignResponse.Validate if the designated constraints aren't met.
+type SignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignResponseValidationError) ErrorName() string {
+	return "SignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignResponseValidationError{}
@@ -0,0 +1,92 @@
+package remotesigner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/remotesigner/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server is the reference signing process. It serves the signing by the given signer over gRPC
+// and enforces the slashing protection rules on the signed IBFT messages, seals and state sync votes
+type Server struct {
+	proto.UnimplementedRemoteSignerServer
+
+	logger     hclog.Logger
+	signer     Signer
+	protection *SlashingProtection
+}
+
+// NewServer creates a new signing server
+func NewServer(logger hclog.Logger, signer Signer, protection *SlashingProtection) *Server {
+	return &Server{
+		logger:     logger.Named("remote_signer"),
+		signer:     signer,
+		protection: protection,
+	}
+}
+
+// Register registers the signing service on the given gRPC server
+func (s *Server) Register(grpcServer *grpc.Server) {
+	proto.RegisterRemoteSignerServer(grpcServer, s)
+}
+
+// GetAddress returns the address of the validator ECDSA key
+func (s *Server) GetAddress(context.Context, *emptypb.Empty) (*proto.AddressResponse, error) {
+	return &proto.AddressResponse{Address: s.signer.Address().Bytes()}, nil
+}
+
+// Sign signs the request by the validator key
+func (s *Server) Sign(_ context.Context, req *proto.SignRequest) (*proto.SignResponse, error) {
+	signReq := &Request{
+		KeyType:      KeyType(req.KeyType),
+		Kind:         Kind(req.Kind),
+		Data:         req.Data,
+		Domain:       req.Domain,
+		Height:       req.Height,
+		Round:        req.Round,
+		ChainID:      req.ChainId,
+		BLSPublicKey: req.BlsPublicKey,
+	}
+
+	// the request is validated before it is recorded by the slashing protection
+	if _, _, err := signReq.Digest(); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if err := s.protection.Check(signReq); err != nil {
+		s.logger.Warn("refused to sign", "kind", signReq.Kind, "error", err)
+
+		return nil, toStatusError(err)
+	}
+
+	signature, err := s.signer.Sign(signReq)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.SignResponse{Signature: signature}, nil
+}
+
+// toStatusError converts the signing error to the gRPC status error,
+// so that the client is able to tell the refused signing from the failure of the signer
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, ErrDoubleSign):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrInvalidIBFTMessage),
+		errors.Is(err, ErrInvalidTransaction),
+		errors.Is(err, ErrRawDigest),
+		errors.Is(err, ErrUnknownBLSKey),
+		errors.Is(err, ErrUnsupportedKeyType),
+		errors.Is(err, ErrUnsupportedSignKind):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,215 @@
+// Package remotesigner implements signing by the validator keys which are held by a separate signing process
+package remotesigner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	bls "github.com/plingatech/go-plgchain/consensus/plgbft/signer"
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+// KeyType is the type of the validator key
+type KeyType uint8
+
+const (
+	// KeyECDSA is the ECDSA key of the validator, which signs consensus messages, seals and transactions
+	KeyECDSA KeyType = iota
+
+	// KeyBLS is the BLS key of the PlgBFT validator, which signs committed seals and state sync votes
+	KeyBLS
+)
+
+// Kind is the kind of the signed data
+type Kind uint8
+
+const (
+	// KindDigest is the BLS signature of the arbitrary digest. The digests of the consensus domains
+	// are refused, as well as the ECDSA signatures of the digests, since they could be the signatures
+	// of the consensus messages or seals bypassing the slashing protection
+	KindDigest Kind = iota
+
+	// KindIBFTMessage is the signature of the IBFT consensus message. The data is the marshaled message
+	// without the signature, the signer derives the view of the message from it and signs its Keccak256 hash
+	KindIBFTMessage
+
+	// KindProposerSeal is the ECDSA signature of the proposed block. The data is the header hash,
+	// the height and the round are the view in which the block is proposed
+	KindProposerSeal
+
+	// KindCommittedSeal is the signature of the committed proposal. The data is the proposal hash,
+	// the height and the round are the view in which the proposal is committed
+	KindCommittedSeal
+
+	// KindStateSyncVote is the BLS signature of the state sync commitment. The data is the commitment hash,
+	// the height and the round are the start and the end ID of the commitment
+	KindStateSyncVote
+
+	// KindTransaction is the ECDSA signature of the transaction. The data is the RLP encoded
+	// unsigned transaction, which is signed according to EIP-155 for the given chain ID
+	KindTransaction
+)
+
+var (
+	ErrDoubleSign          = errors.New("signing refused by slashing protection")
+	ErrInvalidIBFTMessage  = errors.New("invalid IBFT message")
+	ErrInvalidTransaction  = errors.New("invalid transaction")
+	ErrRawDigest           = errors.New("digest of the consensus domain must be signed by its kind")
+	ErrUnknownBLSKey       = errors.New("BLS key matching the public key is not loaded")
+	ErrUnsupportedKeyType  = errors.New("unsupported key type")
+	ErrUnsupportedSignKind = errors.New("unsupported sign kind")
+)
+
+// consensusDomains are the BLS domains of the consensus data, which are never signed as raw digests
+var consensusDomains = [][]byte{
+	bls.DomainCheckpointManager,
+	bls.DomainStateReceiver,
+}
+
+// Request is the request to sign the data by the validator key
+type Request struct {
+	// KeyType is the type of the key to sign by
+	KeyType KeyType
+
+	// Kind is the kind of the signed data
+	Kind Kind
+
+	// Data is the signed data of the given kind
+	Data []byte
+
+	// Domain is the domain of the BLS signature of the digest
+	Domain []byte
+
+	// Height is the height of the seal, or the start ID of the state sync commitment
+	Height uint64
+
+	// Round is the round of the seal, or the end ID of the state sync commitment
+	Round uint64
+
+	// ChainID is the chain ID of the transaction
+	ChainID uint64
+
+	// BLSPublicKey is the marshaled public key of the BLS key to sign by, that is either the current key
+	// or the key replaced by the key rotation which is not activated yet. The current key is used if it is empty
+	BLSPublicKey []byte
+}
+
+// Digest returns the digest signed for the request, and the domain of the digest if it is signed by BLS key
+func (r *Request) Digest() ([]byte, []byte, error) {
+	switch r.Kind {
+	case KindDigest:
+		if r.KeyType != KeyBLS {
+			return nil, nil, fmt.Errorf("%w: ECDSA key doesn't sign raw digests", ErrRawDigest)
+		}
+
+		for _, domain := range consensusDomains {
+			if bytes.Equal(r.Domain, domain) {
+				return nil, nil, fmt.Errorf("%w: domain %x", ErrRawDigest, r.Domain)
+			}
+		}
+
+		return r.Data, r.Domain, nil
+	case KindIBFTMessage, KindProposerSeal:
+		if r.KeyType != KeyECDSA {
+			return nil, nil, fmt.Errorf("%w: %s is signed by ECDSA key", ErrUnsupportedKeyType, r.Kind)
+		}
+
+		return crypto.Keccak256(r.Data), nil, nil
+	case KindCommittedSeal:
+		if r.KeyType == KeyBLS {
+			return r.Data, bls.DomainCheckpointManager, nil
+		}
+
+		// the legacy IBFT committed seal is the signature of the proposal hash wrapped with the commit code
+		return crypto.Keccak256(crypto.Keccak256(r.Data, []byte{legacyCommitCode})), nil, nil
+	case KindStateSyncVote:
+		if r.KeyType != KeyBLS {
+			return nil, nil, fmt.Errorf("%w: %s is signed by BLS key", ErrUnsupportedKeyType, r.Kind)
+		}
+
+		return r.Data, bls.DomainStateReceiver, nil
+	case KindTransaction:
+		if r.KeyType != KeyECDSA {
+			return nil, nil, fmt.Errorf("%w: %s is signed by ECDSA key", ErrUnsupportedKeyType, r.Kind)
+		}
+
+		digest, err := transactionDigest(r.Data, r.ChainID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return digest, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedSignKind, r.Kind)
+	}
+}
+
+// Signer signs by the validator keys
+type Signer interface {
+	// Address returns the address of the validator ECDSA key
+	Address() types.Address
+
+	// Sign signs the request by the validator key
+	Sign(req *Request) ([]byte, error)
+}
+
+// legacyCommitCode is the code which the legacy IBFT committed seals are wrapped with
+const legacyCommitCode = 2
+
+func (k Kind) String() string {
+	switch k {
+	case KindDigest:
+		return "digest"
+	case KindIBFTMessage:
+		return "IBFT message"
+	case KindProposerSeal:
+		return "proposer seal"
+	case KindCommittedSeal:
+		return "committed seal"
+	case KindStateSyncVote:
+		return "state sync vote"
+	case KindTransaction:
+		return "transaction"
+	default:
+		return fmt.Sprintf("kind %d", uint8(k))
+	}
+}
+
+// transactionDigest returns the EIP-155 signing hash of the given RLP encoded unsigned transaction
+func transactionDigest(data []byte, chainID uint64) ([]byte, error) {
+	tx := &ethgo.Transaction{}
+	if err := tx.UnmarshalRLP(data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+
+	if len(tx.V) != 0 || len(tx.R) != 0 || len(tx.S) != 0 {
+		return nil, fmt.Errorf("%w: transaction is already signed", ErrInvalidTransaction)
+	}
+
+	// the signing hash is calculated by the EIP-155 signer only, so it is captured by the key passed to it
+	key := &digestKey{}
+	if _, err := wallet.NewEIP155Signer(chainID).SignTx(tx, key); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+
+	return key.digest, nil
+}
+
+// digestKey implements ethgo.Key interface and it captures the digest it is asked to sign
+type digestKey struct {
+	digest []byte
+}
+
+func (k *digestKey) Address() ethgo.Address {
+	return ethgo.ZeroAddress
+}
+
+func (k *digestKey) Sign(digest []byte) ([]byte, error) {
+	k.digest = digest
+
+	return make([]byte, 65), nil
+}
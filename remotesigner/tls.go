@@ -0,0 +1,147 @@
+package remotesigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	// ErrInsecureAddress is returned if the connection without TLS would leave the host
+	ErrInsecureAddress = errors.New("the remote signer connection without TLS is only allowed on the loopback addresses")
+
+	errTLSKeyPairMissing = errors.New("both the TLS certificate and key files have to be set")
+)
+
+// TLSConfig are the files securing the connection between the node and the remote signer.
+// The connection is mutually authenticated if both sides set the certificate authority
+type TLSConfig struct {
+	// CertFile is the PEM encoded certificate presented to the other side
+	CertFile string
+
+	// KeyFile is the PEM encoded private key of the certificate
+	KeyFile string
+
+	// CAFile is the PEM encoded certificate authority verifying the certificate of the other side
+	CAFile string
+}
+
+// Enabled returns true if any of the TLS files is set
+func (c *TLSConfig) Enabled() bool {
+	return c != nil && (c.CertFile != "" || c.KeyFile != "" || c.CAFile != "")
+}
+
+// ServerCredentials returns the credentials of the remote signer listening on the given address.
+// The signer requires the nodes to present a certificate signed by the certificate authority, if it is set.
+// Without TLS, the signer is only allowed to listen on a loopback address
+func ServerCredentials(addr string, config *TLSConfig) (credentials.TransportCredentials, error) {
+	if !config.Enabled() {
+		if !isLoopbackAddress(addr) {
+			return nil, ErrInsecureAddress
+		}
+
+		return insecure.NewCredentials(), nil
+	}
+
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, errTLSKeyPairMissing
+	}
+
+	cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS key pair: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if config.CAFile != "" {
+		if tlsConfig.ClientCAs, err = loadCertPool(config.CAFile); err != nil {
+			return nil, err
+		}
+
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// ClientCredentials returns the credentials of the node connecting to the remote signer on the given address.
+// The node presents its certificate if it is set, and verifies the signer by the certificate authority,
+// or by the system roots if it is not set. Without TLS, only a loopback address is allowed
+func ClientCredentials(addr string, config *TLSConfig) (credentials.TransportCredentials, error) {
+	if !config.Enabled() {
+		if !isLoopbackAddress(addr) {
+			return nil, ErrInsecureAddress
+		}
+
+		return insecure.NewCredentials(), nil
+	}
+
+	if (config.CertFile == "") != (config.KeyFile == "") {
+		return nil, errTLSKeyPairMissing
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if config.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS key pair: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if config.CAFile != "" {
+		pool, err := loadCertPool(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// loadCertPool reads the PEM encoded certificates from the given file
+func loadCertPool(path string) (*x509.CertPool, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the TLS certificate authority: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(raw) {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+
+	return pool, nil
+}
+
+// isLoopbackAddress checks if the host of the given address is a loopback one
+func isLoopbackAddress(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
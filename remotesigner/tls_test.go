@@ -0,0 +1,150 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA issues the certificates of the test connections
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	file := filepath.Join(dir, "ca.pem")
+	writeTestPEM(t, file, "CERTIFICATE", raw)
+
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue writes the certificate and the key of the given name signed by the authority
+func (ca *testCA) issue(t *testing.T, dir, name string) *TLSConfig {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	config := &TLSConfig{
+		CertFile: filepath.Join(dir, name+".pem"),
+		KeyFile:  filepath.Join(dir, name+".key"),
+		CAFile:   ca.file,
+	}
+
+	writeTestPEM(t, config.CertFile, "CERTIFICATE", raw)
+	writeTestPEM(t, config.KeyFile, "EC PRIVATE KEY", rawKey)
+
+	return config
+}
+
+func writeTestPEM(t *testing.T, path, blockType string, raw []byte) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: raw}), 0600))
+}
+
+func TestClient_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	signer := newTestLocalSigner(t)
+
+	addr := startTestServer(t, signer, ca.issue(t, dir, "signer"))
+
+	client, err := NewClient(addr, ca.issue(t, dir, "node"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	require.Equal(t, signer.Address(), client.Address())
+
+	// the node without a certificate signed by the authority is rejected
+	_, err = NewClient(addr, &TLSConfig{CAFile: ca.file})
+	require.Error(t, err)
+
+	otherCA := newTestCA(t, t.TempDir())
+
+	_, err = NewClient(addr, otherCA.issue(t, t.TempDir(), "node"))
+	require.Error(t, err)
+}
+
+func TestCredentials_InsecureAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, addr := range []string{"127.0.0.1:9633", "localhost:9633", "[::1]:9633"} {
+		_, err := ServerCredentials(addr, nil)
+		assert.NoError(t, err, addr)
+
+		_, err = ClientCredentials(addr, &TLSConfig{})
+		assert.NoError(t, err, addr)
+	}
+
+	for _, addr := range []string{"0.0.0.0:9633", ":9633", "10.0.0.1:9633", "signer:9633"} {
+		_, err := ServerCredentials(addr, nil)
+		assert.ErrorIs(t, err, ErrInsecureAddress, addr)
+
+		_, err = ClientCredentials(addr, nil)
+		assert.ErrorIs(t, err, ErrInsecureAddress, addr)
+	}
+
+	// the signer can't serve TLS without its certificate
+	_, err := ServerCredentials("0.0.0.0:9633", &TLSConfig{CAFile: "ca.pem"})
+	assert.ErrorIs(t, err, errTLSKeyPairMissing)
+
+	_, err = ClientCredentials("10.0.0.1:9633", &TLSConfig{CertFile: "node.pem"})
+	assert.ErrorIs(t, err, errTLSKeyPairMissing)
+}
@@ -84,6 +84,13 @@ func (l *LocalSecretsManager) Setup() error {
 		secrets.ValidatorBLSKeyLocal,
 	)
 
+	// baseDir/consensus/validator-bls-previous.key
+	l.secretPathMap[secrets.ValidatorBLSKeyPrevious] = filepath.Join(
+		l.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorBLSKeyPreviousLocal,
+	)
+
 	// baseDir/consensus/validator.sig
 	l.secretPathMap[secrets.ValidatorBLSSignature] = filepath.Join(
 		l.path,
@@ -175,8 +182,6 @@ func (l *LocalSecretsManager) RemoveSecret(name string) error {
 		return secrets.ErrSecretNotFound
 	}
 
-	delete(l.secretPathMap, name)
-
 	if removeErr := os.Remove(secretPath); removeErr != nil {
 		return fmt.Errorf("unable to remove secret, %w", removeErr)
 	}
@@ -29,6 +29,10 @@ const (
 	// ValidatorBLSKey is the bls secret key of the validator node
 	ValidatorBLSKey = "validator-bls-key"
 
+	// ValidatorBLSKeyPrevious is the bls secret key of the validator node replaced by the key rotation,
+	// it is kept until the new key is activated by the validator set
+	ValidatorBLSKeyPrevious = "validator-bls-key-previous"
+
 	// NetworkKey is the libp2p private key secret used for networking
 	NetworkKey = "network-key"
 
@@ -38,10 +42,11 @@ const (
 
 // Define constant file names for the local StorageManager
 const (
-	ValidatorKeyLocal          = "validator.key"
-	ValidatorBLSKeyLocal       = "validator-bls.key"
-	NetworkKeyLocal            = "libp2p.key"
-	ValidatorBLSSignatureLocal = "validator.sig"
+	ValidatorKeyLocal            = "validator.key"
+	ValidatorBLSKeyLocal         = "validator-bls.key"
+	ValidatorBLSKeyPreviousLocal = "validator-bls-previous.key"
+	NetworkKeyLocal              = "libp2p.key"
+	ValidatorBLSSignatureLocal   = "validator.sig"
 )
 
 // Define constant folder names for the local StorageManager
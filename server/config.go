@@ -2,11 +2,15 @@ package server
 
 import (
 	"net"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 
+	"github.com/plingatech/go-plgchain/blockchain/storage"
 	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/consensus/roundchange"
 	"github.com/plingatech/go-plgchain/network"
+	"github.com/plingatech/go-plgchain/remotesigner"
 	"github.com/plingatech/go-plgchain/secrets"
 )
 
@@ -24,12 +28,20 @@ type Config struct {
 	PriceLimit         uint64
 	MaxAccountEnqueued uint64
 	MaxSlots           uint64
+	PriceBump          uint64
+	LocalSlots         uint64
+	NoJournal          bool
+	RejournalInterval  time.Duration
+	TxLifetime         time.Duration
+	AnnounceThreshold  uint64
 
 	Telemetry *Telemetry
 	Network   *network.Config
 
-	DataDir     string
-	RestoreFile *string
+	DataDir          string
+	StorageBackend   storage.Backend
+	AncientThreshold uint64
+	RestoreFile      *string
 
 	Seal bool
 
@@ -44,6 +56,11 @@ type Config struct {
 	Relayer bool
 
 	NumBlockConfirmations uint64
+
+	RoundTimeout *roundchange.Timeout
+
+	RemoteSignerAddr string
+	RemoteSignerTLS  *remotesigner.TLSConfig
 }
 
 // Telemetry holds the config details for metric services
@@ -57,4 +74,5 @@ type JSONRPC struct {
 	AccessControlAllowOrigin []string
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	EnableAdmin              bool
 }
@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/plingatech/go-plgchain/blockchain/storage"
-	"github.com/plingatech/go-plgchain/blockchain/storage/leveldb"
 	"github.com/plingatech/go-plgchain/blockchain/storage/memory"
 	consensusPlgBFT "github.com/plingatech/go-plgchain/consensus/plgbft"
 
@@ -31,6 +30,7 @@ import (
 	"github.com/plingatech/go-plgchain/helper/progress"
 	"github.com/plingatech/go-plgchain/jsonrpc"
 	"github.com/plingatech/go-plgchain/network"
+	"github.com/plingatech/go-plgchain/remotesigner"
 	"github.com/plingatech/go-plgchain/secrets"
 	"github.com/plingatech/go-plgchain/server/proto"
 	"github.com/plingatech/go-plgchain/state"
@@ -85,6 +85,9 @@ type Server struct {
 	// secrets manager
 	secretsManager secrets.SecretsManager
 
+	// remoteSigner signs by the validator keys held by the remote signer, if it is configured
+	remoteSigner *remotesigner.Client
+
 	// restore
 	restoreProgression *progress.ProgressionWrapper
 
@@ -153,6 +156,18 @@ func NewServer(config *Config) (*Server, error) {
 	var dirPaths = []string{
 		"blockchain",
 		"trie",
+		"txpool",
+	}
+
+	if m.config.StorageBackend == "" {
+		m.config.StorageBackend = storage.DefaultBackend
+	}
+
+	// make sure the data directory is not opened with a different storage backend
+	if m.config.DataDir != "" {
+		if err := storage.CheckBackend(m.config.DataDir, m.config.StorageBackend); err != nil {
+			return nil, err
+		}
 	}
 
 	// Generate all the paths in the dataDir
@@ -179,6 +194,18 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to set up the secrets manager: %w", err)
 	}
 
+	if m.config.RemoteSignerAddr != "" {
+		remoteSigner, err := remotesigner.NewClient(m.config.RemoteSignerAddr, m.config.RemoteSignerTLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up the remote signer: %w", err)
+		}
+
+		m.remoteSigner = remoteSigner
+
+		m.logger.Info("validator keys are held by the remote signer",
+			"address", m.config.RemoteSignerAddr, "validator", remoteSigner.Address())
+	}
+
 	// start libp2p
 	{
 		netConfig := config.Network
@@ -194,11 +221,15 @@ func NewServer(config *Config) (*Server, error) {
 	}
 
 	// start blockchain object
-	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
+	stateStorage, err := NewStateStorage(m.config.StorageBackend, filepath.Join(m.config.DataDir, "trie"), logger)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := itrie.CheckSchemaVersion(stateStorage); err != nil {
+		return nil, err
+	}
+
 	m.stateStorage = stateStorage
 
 	st := itrie.NewState(stateStorage)
@@ -224,6 +255,12 @@ func NewServer(config *Config) (*Server, error) {
 			m.config.Chain.Params.TransactionsAllowList)
 	}
 
+	// apply transactions block list genesis data
+	if m.config.Chain.Params.TransactionsBlockList != nil {
+		allowlist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.BlockListTransactionsAddr,
+			m.config.Chain.Params.TransactionsBlockList)
+	}
+
 	var initialStateRoot = types.ZeroHash
 
 	if ConsensusType(engineName) == PlgBFTConsensus {
@@ -268,13 +305,18 @@ func NewServer(config *Config) (*Server, error) {
 				return nil, err
 			}
 		} else {
-			db, err = leveldb.NewLevelDBStorage(
+			db, err = NewBlockchainStorage(
+				m.config.StorageBackend,
 				filepath.Join(m.config.DataDir, "blockchain"),
 				m.logger,
 			)
 			if err != nil {
 				return nil, err
 			}
+
+			if err := SetupAncientStore(db, filepath.Join(m.config.DataDir, storage.AncientDir)); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -284,6 +326,8 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	m.blockchain.SetAncientThreshold(m.config.AncientThreshold)
+
 	m.executor.GetHash = m.blockchain.GetHashHelper
 
 	{
@@ -300,7 +344,7 @@ func NewServer(config *Config) (*Server, error) {
 		// start transaction pool
 		m.txpool, err = txpool.NewTxPool(
 			logger,
-			m.chain.Params.Forks.At(0),
+			m.chain.Params.Forks,
 			hub,
 			m.grpcServer,
 			m.network,
@@ -308,7 +352,15 @@ func NewServer(config *Config) (*Server, error) {
 				MaxSlots:            m.config.MaxSlots,
 				PriceLimit:          m.config.PriceLimit,
 				MaxAccountEnqueued:  m.config.MaxAccountEnqueued,
+				PriceBump:           m.config.PriceBump,
+				LocalSlots:          m.config.LocalSlots,
+				JournalPath:         m.txpoolJournalPath(),
+				RejournalInterval:   m.config.RejournalInterval,
+				Lifetime:            m.config.TxLifetime,
+				AnnounceThreshold:   m.config.AnnounceThreshold,
 				DeploymentWhitelist: deploymentWhitelist,
+				BlockList:           m.chain.Params.TransactionsBlockList != nil,
+				TxOrdering:          m.chain.Params.TxOrdering,
 			},
 		)
 		if err != nil {
@@ -400,6 +452,16 @@ func (s *Server) restoreChain() error {
 	return nil
 }
 
+// txpoolJournalPath returns the path of the local transactions journal,
+// or an empty string if the journal is disabled
+func (s *Server) txpoolJournalPath() string {
+	if s.config.NoJournal || s.config.DataDir == "" {
+		return ""
+	}
+
+	return filepath.Join(s.config.DataDir, "txpool", "journal.rlp")
+}
+
 type txpoolHub struct {
 	state state.State
 	*blockchain.Blockchain
@@ -448,6 +510,20 @@ func (t *txpoolHub) GetBalance(root types.Hash, addr types.Address) (*big.Int, e
 	return account.Balance, nil
 }
 
+func (t *txpoolHub) GetStorage(root types.Hash, addr types.Address, slot types.Hash) types.Hash {
+	account, err := getAccountImpl(t.state, root, addr)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	snap, err := t.state.NewSnapshotAt(root)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	return snap.GetStorage(addr, account.Root, slot)
+}
+
 // setupSecretsManager sets up the secrets manager
 func (s *Server) setupSecretsManager() error {
 	secretsManagerConfig := s.config.SecretsManager
@@ -524,21 +600,26 @@ func (s *Server) setupConsensus() error {
 		Path:   filepath.Join(s.config.DataDir, "consensus"),
 	}
 
-	consensus, err := engine(
-		&consensus.Params{
-			Context:               context.Background(),
-			Config:                config,
-			TxPool:                s.txpool,
-			Network:               s.network,
-			Blockchain:            s.blockchain,
-			Executor:              s.executor,
-			Grpc:                  s.grpcServer,
-			Logger:                s.logger,
-			SecretsManager:        s.secretsManager,
-			BlockTime:             uint64(blockTime.Seconds()),
-			NumBlockConfirmations: s.config.NumBlockConfirmations,
-		},
-	)
+	params := &consensus.Params{
+		Context:               context.Background(),
+		Config:                config,
+		TxPool:                s.txpool,
+		Network:               s.network,
+		Blockchain:            s.blockchain,
+		Executor:              s.executor,
+		Grpc:                  s.grpcServer,
+		Logger:                s.logger,
+		SecretsManager:        s.secretsManager,
+		BlockTime:             uint64(blockTime.Seconds()),
+		NumBlockConfirmations: s.config.NumBlockConfirmations,
+		RoundTimeout:          s.config.RoundTimeout,
+	}
+
+	if s.remoteSigner != nil {
+		params.RemoteSigner = s.remoteSigner
+	}
+
+	consensus, err := engine(params)
 
 	if err != nil {
 		return err
@@ -577,9 +658,17 @@ func extractBlockTime(engineConfig map[string]interface{}) (common.Duration, err
 
 // setupRelayer sets up the relayer
 func (s *Server) setupRelayer() error {
-	account, err := wallet.NewAccountFromSecret(s.secretsManager)
-	if err != nil {
-		return fmt.Errorf("failed to create account from secret: %w", err)
+	var key *wallet.Key
+
+	if s.remoteSigner != nil {
+		key = wallet.NewRemoteKey(s.remoteSigner)
+	} else {
+		account, err := wallet.NewAccountFromSecret(s.secretsManager)
+		if err != nil {
+			return fmt.Errorf("failed to create account from secret: %w", err)
+		}
+
+		key = wallet.NewKey(account)
 	}
 
 	plgBFTConfig, err := consensusPlgBFT.GetPlgBFTConfig(s.config.Chain)
@@ -598,7 +687,7 @@ func (s *Server) setupRelayer() error {
 		ethgo.Address(contracts.StateReceiverContract),
 		trackerStartBlockConfig[contracts.StateReceiverContract],
 		s.logger.Named("relayer"),
-		wallet.NewEcdsaSigner(wallet.NewKey(account)),
+		wallet.NewEcdsaSigner(key),
 	)
 
 	// start relayer
@@ -639,6 +728,16 @@ func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.A
 	return account, nil
 }
 
+// SetHead rewinds the chain to the block with the given number,
+// if the state of the block is still present
+func (j *jsonRPCHub) SetHead(number uint64) error {
+	return j.Blockchain.SetHead(number, func(root types.Hash) bool {
+		_, err := j.state.NewSnapshotAt(root)
+
+		return err == nil
+	})
+}
+
 // GetForksInTime returns the active forks at the given block height
 func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return j.Executor.GetForksInTime(blockNumber)
@@ -851,6 +950,7 @@ func (s *Server) setupJSONRPC() error {
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
+		EnableAdmin:              s.config.JSONRPC.EnableAdmin,
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -931,6 +1031,13 @@ func (s *Server) Close() {
 
 	// Close DataDog profiler
 	s.closeDataDogProfiler()
+
+	// Close the connection to the remote signer
+	if s.remoteSigner != nil {
+		if err := s.remoteSigner.Close(); err != nil {
+			s.logger.Error("failed to close remote signer connection", "err", err.Error())
+		}
+	}
 }
 
 // Entry is a consensus configuration entry
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/blockchain/storage"
+	"github.com/plingatech/go-plgchain/blockchain/storage/ancient"
+	"github.com/plingatech/go-plgchain/blockchain/storage/leveldb"
+	"github.com/plingatech/go-plgchain/blockchain/storage/pebble"
+	itrie "github.com/plingatech/go-plgchain/state/immutable-trie"
+)
+
+// NewBlockchainStorage opens the blockchain storage at the given path using the given backend
+func NewBlockchainStorage(backend storage.Backend, path string, logger hclog.Logger) (storage.Storage, error) {
+	switch backend {
+	case storage.LevelDBBackend:
+		return leveldb.NewLevelDBStorage(path, logger)
+	case storage.PebbleBackend:
+		return pebble.NewPebbleStorage(path, logger)
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrUnknownBackend, backend)
+	}
+}
+
+// NewStateStorage opens the trie storage at the given path using the given backend
+func NewStateStorage(backend storage.Backend, path string, logger hclog.Logger) (itrie.Storage, error) {
+	switch backend {
+	case storage.LevelDBBackend:
+		return itrie.NewLevelDBStorage(path, logger)
+	case storage.PebbleBackend:
+		return itrie.NewPebbleStorage(path, logger)
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrUnknownBackend, backend)
+	}
+}
+
+// SetupAncientStore opens the ancient store at the given path and attaches it to the blockchain storage.
+// The store is attached even if moving the blocks is disabled, so the already moved blocks stay readable
+func SetupAncientStore(db storage.Storage, path string) error {
+	ancientDB, ok := db.(storage.AncientStorage)
+	if !ok {
+		return nil
+	}
+
+	ancients, err := ancient.NewStore(path)
+	if err != nil {
+		return fmt.Errorf("failed to open ancient store: %w", err)
+	}
+
+	ancientDB.SetAncientStore(ancients)
+
+	return nil
+}
@@ -218,6 +218,11 @@ func (e *Executor) BeginTxn(
 		txn.txnAllowList = allowlist.NewAllowList(txn, contracts.AllowListTransactionsAddr)
 	}
 
+	// enable transactions block list (if any)
+	if e.config.TransactionsBlockList != nil {
+		txn.txnBlockList = allowlist.NewAllowList(txn, contracts.BlockListTransactionsAddr)
+	}
+
 	return txn, nil
 }
 
@@ -247,6 +252,9 @@ type Transition struct {
 	// allow list runtimes
 	deploymentAllowlist *allowlist.AllowList
 	txnAllowList        *allowlist.AllowList
+
+	// block list runtime
+	txnBlockList *allowlist.AllowList
 }
 
 func NewTransition(config chain.ForksInTime, snap Snapshot, radix *Txn) *Transition {
@@ -272,7 +280,7 @@ var emptyFrom = types.Address{}
 func (t *Transition) WriteFailedReceipt(txn *types.Transaction) error {
 	signer := crypto.NewSigner(t.config, uint64(t.ctx.ChainID))
 
-	if txn.From == emptyFrom && txn.Type == types.LegacyTx {
+	if txn.From == emptyFrom && txn.Type != types.StateTx {
 		// Decrypt the from address
 		from, err := signer.Sender(txn)
 		if err != nil {
@@ -304,7 +312,7 @@ func (t *Transition) WriteFailedReceipt(txn *types.Transaction) error {
 func (t *Transition) Write(txn *types.Transaction) error {
 	var err error
 
-	if txn.From == emptyFrom && txn.Type == types.LegacyTx {
+	if txn.From == emptyFrom && txn.Type != types.StateTx {
 		// Decrypt the from address
 		signer := crypto.NewSigner(t.config, uint64(t.ctx.ChainID))
 
@@ -410,7 +418,7 @@ func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
 	upfrontGasCost := new(big.Int).Set(msg.GasPrice)
 	upfrontGasCost.Mul(upfrontGasCost, new(big.Int).SetUint64(msg.Gas))
 
-	if err := t.state.SubBalance(msg.From, upfrontGasCost); err != nil {
+	if err := t.state.SubBalance(msg.GasPayer(), upfrontGasCost); err != nil {
 		if errors.Is(err, runtime.ErrNotEnoughFunds) {
 			return ErrNotEnoughFundsForGas
 		}
@@ -421,6 +429,67 @@ func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
 	return nil
 }
 
+func (t *Transition) blockListCheck(msg *types.Transaction) error {
+	if t.txnBlockList == nil || msg.Type == types.StateTx {
+		return nil
+	}
+
+	if t.txnBlockList.GetRole(msg.From).Blocked() {
+		return ErrSenderBlocked
+	}
+
+	if msg.To != nil && t.txnBlockList.GetRole(*msg.To).Blocked() {
+		return ErrReceiverBlocked
+	}
+
+	return nil
+}
+
+// blockListCallCheck checks that neither the caller nor the callee of a call is blocked,
+// so the block list can't be bypassed by the internal calls of a contract.
+// The calls of the state transactions are not checked.
+func (t *Transition) blockListCallCheck(contract *runtime.Contract) error {
+	if t.txnBlockList == nil || contract.Origin == contracts.SystemCaller {
+		return nil
+	}
+
+	if t.txnBlockList.GetRole(contract.Caller).Blocked() {
+		return ErrSenderBlocked
+	}
+
+	if t.txnBlockList.GetRole(contract.Address).Blocked() ||
+		t.txnBlockList.GetRole(contract.CodeAddress).Blocked() {
+		return ErrReceiverBlocked
+	}
+
+	return nil
+}
+
+// feeDelegationCheck checks that the fee delegated transactions are enabled
+// and that the transaction is signed by its fee payer
+func (t *Transition) feeDelegationCheck(msg *types.Transaction) error {
+	if !msg.IsFeeDelegated() {
+		return nil
+	}
+
+	if !t.config.FeeDelegation {
+		return ErrFeeDelegationDisabled
+	}
+
+	signer := crypto.NewSigner(t.config, uint64(t.ctx.ChainID))
+
+	payer, err := signer.FeePayer(msg)
+	if err != nil {
+		return err
+	}
+
+	if payer != msg.FeePayer {
+		return ErrInvalidFeePayer
+	}
+
+	return nil
+}
+
 func (t *Transition) nonceCheck(msg *types.Transaction) error {
 	nonce := t.state.GetNonce(msg.From)
 
@@ -441,6 +510,10 @@ var (
 	ErrIntrinsicGasOverflow  = fmt.Errorf("overflow in intrinsic gas calculation")
 	ErrNotEnoughIntrinsicGas = fmt.Errorf("not enough gas supplied for intrinsic gas costs")
 	ErrNotEnoughFunds        = fmt.Errorf("not enough funds for transfer with given value")
+	ErrSenderBlocked         = fmt.Errorf("sender is blocked")
+	ErrReceiverBlocked       = fmt.Errorf("receiver is blocked")
+	ErrFeeDelegationDisabled = fmt.Errorf("fee delegated transactions are not enabled")
+	ErrInvalidFeePayer       = fmt.Errorf("fee payer signature does not match the fee payer")
 )
 
 type TransitionApplicationError struct {
@@ -480,6 +553,11 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		}
 	}
 
+	// the sender and the receiver are not blocked
+	if err := t.blockListCheck(msg); err != nil {
+		return nil, NewTransitionApplicationError(err, false)
+	}
+
 	// the amount of gas required is available in the block
 	if err := t.subGasPool(msg.Gas); err != nil {
 		return nil, NewGasLimitReachedTransitionApplicationError(err)
@@ -524,9 +602,9 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		t.ctx.Tracer.TxEnd(result.GasLeft)
 	}
 
-	// refund the sender
+	// refund the sender, or the fee payer of a fee delegated transaction
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(result.GasLeft), msg.GasPrice)
-	t.state.AddBalance(msg.From, remaining)
+	t.state.AddBalance(msg.GasPayer(), remaining)
 
 	// pay the coinbase
 	coinbaseFee := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), msg.GasPrice)
@@ -568,6 +646,10 @@ func (t *Transition) run(contract *runtime.Contract, host runtime.Host) *runtime
 		return t.deploymentAllowlist.Run(contract, host, &t.config)
 	}
 
+	if t.txnBlockList != nil && t.txnBlockList.Addr() == contract.CodeAddress {
+		return t.txnBlockList.Run(contract, host, &t.config)
+	}
+
 	if t.txnAllowList != nil {
 		if t.txnAllowList.Addr() == contract.CodeAddress {
 			return t.txnAllowList.Run(contract, host, &t.config)
@@ -583,6 +665,12 @@ func (t *Transition) run(contract *runtime.Contract, host runtime.Host) *runtime
 		}
 	}
 
+	if err := t.blockListCallCheck(contract); err != nil {
+		return &runtime.ExecutionResult{
+			Err: err,
+		}
+	}
+
 	// check the precompiles
 	if t.precompiles.CanRun(contract, host, &t.config) {
 		return t.precompiles.Run(contract, host, &t.config)
@@ -935,14 +1023,19 @@ func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (u
 // checkAndProcessLegacyTx - first check if this message satisfies all consensus rules before
 // applying the message. The rules include these clauses:
 // 1. the nonce of the message caller is correct
-// 2. caller has enough balance to cover transaction fee(gaslimit * gasprice)
+// 2. caller (or fee payer) has enough balance to cover transaction fee(gaslimit * gasprice)
 func checkAndProcessLegacyTx(msg *types.Transaction, t *Transition) error {
+	// 0. fee delegated transaction is enabled and signed by its fee payer
+	if err := t.feeDelegationCheck(msg); err != nil {
+		return NewTransitionApplicationError(err, false)
+	}
+
 	// 1. the nonce of the message caller is correct
 	if err := t.nonceCheck(msg); err != nil {
 		return NewTransitionApplicationError(err, true)
 	}
 
-	// 2. caller has enough balance to cover transaction fee(gaslimit * gasprice)
+	// 2. caller (or fee payer) has enough balance to cover transaction fee(gaslimit * gasprice)
 	if err := t.subGasLimitPrice(msg); err != nil {
 		return NewTransitionApplicationError(err, true)
 	}
@@ -1,9 +1,11 @@
 package itrie
 
 import (
+	"encoding/binary"
 	"testing"
 
 	"github.com/plingatech/go-plgchain/state"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestState(t *testing.T) {
@@ -17,3 +19,19 @@ func buildPreState(pre state.PreStates) state.Snapshot {
 
 	return snap
 }
+
+func TestCheckSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+
+	assert.NoError(t, CheckSchemaVersion(storage))
+
+	data, ok := storage.Get(schemaVersionKey)
+	assert.True(t, ok)
+	assert.Equal(t, SchemaVersion, binary.BigEndian.Uint64(data))
+
+	storage.Put(schemaVersionKey, binary.BigEndian.AppendUint64(nil, SchemaVersion+1))
+
+	assert.ErrorIs(t, CheckSchemaVersion(storage), ErrNewerSchema)
+}
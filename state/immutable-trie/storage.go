@@ -1,6 +1,8 @@
 package itrie
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -16,8 +18,32 @@ var parserPool fastrlp.ParserPool
 var (
 	// codePrefix is the code prefix for leveldb
 	codePrefix = []byte("code")
+
+	// schemaVersionKey is the key of the trie database schema version.
+	// It doesn't collide with the node keys, as they are hashes
+	schemaVersionKey = []byte("schema-version")
 )
 
+// SchemaVersion is the version of the trie storage schema used by this binary
+const SchemaVersion uint64 = 1
+
+// ErrNewerSchema is returned when the trie database was written by a newer binary
+var ErrNewerSchema = errors.New("trie database schema is newer than supported")
+
+// CheckSchemaVersion refuses the trie database written with a newer schema,
+// otherwise it records the current schema version
+func CheckSchemaVersion(storage Storage) error {
+	if data, ok := storage.Get(schemaVersionKey); ok && len(data) == 8 {
+		if version := binary.BigEndian.Uint64(data); version > SchemaVersion {
+			return fmt.Errorf("%w: database version %d, supported version %d", ErrNewerSchema, version, SchemaVersion)
+		}
+	}
+
+	storage.Put(schemaVersionKey, binary.BigEndian.AppendUint64(nil, SchemaVersion))
+
+	return nil
+}
+
 type Batch interface {
 	Put(k, v []byte)
 	Write()
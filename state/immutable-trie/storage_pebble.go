@@ -0,0 +1,76 @@
+package itrie
+
+import (
+	"errors"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// PebbleStorage is a k/v storage using pebble
+type PebbleStorage struct {
+	db *pebble.DB
+}
+
+// PebbleBatch is a batch write for pebble
+type PebbleBatch struct {
+	batch *pebble.Batch
+}
+
+func (b *PebbleBatch) Put(k, v []byte) {
+	_ = b.batch.Set(k, v, nil)
+}
+
+func (b *PebbleBatch) Write() {
+	_ = b.batch.Commit(pebble.NoSync)
+	_ = b.batch.Close()
+}
+
+func (kv *PebbleStorage) SetCode(hash types.Hash, code []byte) {
+	kv.Put(append(codePrefix, hash.Bytes()...), code)
+}
+
+func (kv *PebbleStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	return kv.Get(append(codePrefix, hash.Bytes()...))
+}
+
+func (kv *PebbleStorage) Batch() Batch {
+	return &PebbleBatch{batch: kv.db.NewBatch()}
+}
+
+func (kv *PebbleStorage) Put(k, v []byte) {
+	_ = kv.db.Set(k, v, pebble.NoSync)
+}
+
+func (kv *PebbleStorage) Get(k []byte) ([]byte, bool) {
+	data, closer, err := kv.db.Get(k)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, false
+		} else {
+			panic(err) //nolint:gocritic
+		}
+	}
+
+	defer closer.Close()
+
+	// the returned slice is only valid until closer is closed
+	value := make([]byte, len(data))
+	copy(value, data)
+
+	return value, true
+}
+
+func (kv *PebbleStorage) Close() error {
+	return kv.db.Close()
+}
+
+func NewPebbleStorage(path string, logger hclog.Logger) (Storage, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PebbleStorage{db}, nil
+}
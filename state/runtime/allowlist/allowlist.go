@@ -167,6 +167,12 @@ func (r Role) Enabled() bool {
 	return r == AdminRole || r == EnabledRole
 }
 
+// Blocked returns true if the role marks the address as blocked
+// when the list is used as a block list. Admins are never blocked.
+func (r Role) Blocked() bool {
+	return r == EnabledRole
+}
+
 type stateRef interface {
 	SetState(addr types.Address, key, value types.Hash)
 	GetStorage(addr types.Address, key types.Hash) types.Hash
@@ -0,0 +1,87 @@
+package tracer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrTracerNotFound is returned when no tracer is registered under the requested name
+	ErrTracerNotFound = errors.New("tracer not found")
+	// ErrTracerAlreadyRegistered is returned when a tracer name is registered twice
+	ErrTracerAlreadyRegistered = errors.New("tracer already registered")
+)
+
+// Factory creates a new Tracer instance from the raw JSON tracer config.
+// The config is nil if the caller didn't provide one
+type Factory func(config json.RawMessage) (Tracer, error)
+
+// registry holds the tracer factories by name
+var registry = struct {
+	sync.RWMutex
+	factories map[string]Factory
+}{
+	factories: make(map[string]Factory),
+}
+
+// Register adds a tracer factory under the given name,
+// so it can be selected by the debug endpoints
+func Register(name string, factory Factory) error {
+	if name == "" {
+		return errors.New("tracer name is empty")
+	}
+
+	if factory == nil {
+		return fmt.Errorf("factory for tracer %s is nil", name)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.factories[name]; ok {
+		return fmt.Errorf("%w: %s", ErrTracerAlreadyRegistered, name)
+	}
+
+	registry.factories[name] = factory
+
+	return nil
+}
+
+// MustRegister is like Register, but panics on error.
+// It is meant to be called from the init function of the tracer package
+func MustRegister(name string, factory Factory) {
+	if err := Register(name, factory); err != nil {
+		panic(err)
+	}
+}
+
+// New creates a new instance of the tracer registered under the given name
+func New(name string, config json.RawMessage) (Tracer, error) {
+	registry.RLock()
+	factory, ok := registry.factories[name]
+	registry.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTracerNotFound, name)
+	}
+
+	return factory(config)
+}
+
+// Registered returns the sorted names of all registered tracers
+func Registered() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
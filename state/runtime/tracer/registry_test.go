@@ -0,0 +1,51 @@
+package tracer
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	var receivedConfig json.RawMessage
+
+	factory := func(config json.RawMessage) (Tracer, error) {
+		receivedConfig = config
+
+		return nil, nil
+	}
+
+	require.NoError(t, Register("registryTestTracer", factory))
+
+	t.Run("should reject duplicated name", func(t *testing.T) {
+		err := Register("registryTestTracer", factory)
+
+		assert.ErrorIs(t, err, ErrTracerAlreadyRegistered)
+	})
+
+	t.Run("should reject empty name and nil factory", func(t *testing.T) {
+		assert.Error(t, Register("", factory))
+		assert.Error(t, Register("registryTestNilTracer", nil))
+	})
+
+	t.Run("should pass config to factory", func(t *testing.T) {
+		config := json.RawMessage(`{"key":"value"}`)
+
+		_, err := New("registryTestTracer", config)
+
+		assert.NoError(t, err)
+		assert.Equal(t, config, receivedConfig)
+		assert.Contains(t, Registered(), "registryTestTracer")
+	})
+
+	t.Run("should return error for unknown tracer", func(t *testing.T) {
+		_, err := New("registryTestUnknown", nil)
+
+		assert.True(t, errors.Is(err, ErrTracerNotFound))
+	})
+}
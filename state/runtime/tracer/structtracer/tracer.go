@@ -1,6 +1,7 @@
 package structtracer
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -13,6 +14,13 @@ import (
 	"github.com/plingatech/go-plgchain/types"
 )
 
+// Name is the name under which StructTracer is registered in the tracer registry
+const Name = "structTracer"
+
+func init() {
+	tracer.MustRegister(Name, newFromJSON)
+}
+
 type Config struct {
 	EnableMemory     bool // enable memory capture
 	EnableStack      bool // enable stack capture
@@ -20,6 +28,33 @@ type Config struct {
 	EnableReturnData bool // enable return data capture
 }
 
+// jsonConfig is the JSON representation of Config used in tracerConfig,
+// it follows the same flags as the default debug trace config
+type jsonConfig struct {
+	EnableMemory     bool `json:"enableMemory"`
+	DisableStack     bool `json:"disableStack"`
+	DisableStorage   bool `json:"disableStorage"`
+	EnableReturnData bool `json:"enableReturnData"`
+}
+
+// newFromJSON creates StructTracer from the raw JSON tracer config
+func newFromJSON(raw json.RawMessage) (tracer.Tracer, error) {
+	config := jsonConfig{}
+
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &config); err != nil {
+			return nil, fmt.Errorf("invalid %s config: %w", Name, err)
+		}
+	}
+
+	return NewStructTracer(Config{
+		EnableMemory:     config.EnableMemory,
+		EnableStack:      !config.DisableStack,
+		EnableStorage:    !config.DisableStorage,
+		EnableReturnData: config.EnableReturnData,
+	}), nil
+}
+
 type StructLog struct {
 	Pc            uint64                    `json:"pc"`
 	Op            string                    `json:"op"`
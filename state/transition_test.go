@@ -5,7 +5,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/contracts"
+	"github.com/plingatech/go-plgchain/crypto"
 	"github.com/plingatech/go-plgchain/state/runtime"
+	"github.com/plingatech/go-plgchain/state/runtime/allowlist"
+	"github.com/plingatech/go-plgchain/state/runtime/evm"
+	"github.com/plingatech/go-plgchain/state/runtime/precompiled"
 	"github.com/plingatech/go-plgchain/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -159,3 +165,197 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockListCheck(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(nil)
+	transition.txnBlockList = allowlist.NewAllowList(transition, contracts.BlockListTransactionsAddr)
+
+	blocked := types.StringToAddress("0xb1")
+	admin := types.StringToAddress("0xa1")
+
+	transition.txnBlockList.SetRole(blocked, allowlist.EnabledRole)
+	transition.txnBlockList.SetRole(admin, allowlist.AdminRole)
+
+	tests := []struct {
+		name        string
+		msg         *types.Transaction
+		expectedErr error
+	}{
+		{
+			name:        "should succeed for not blocked addresses",
+			msg:         &types.Transaction{From: addr1, To: &addr2},
+			expectedErr: nil,
+		},
+		{
+			name:        "should succeed for contract creation",
+			msg:         &types.Transaction{From: addr1},
+			expectedErr: nil,
+		},
+		{
+			name:        "should succeed for the admin",
+			msg:         &types.Transaction{From: admin, To: &addr2},
+			expectedErr: nil,
+		},
+		{
+			name:        "should fail by ErrSenderBlocked",
+			msg:         &types.Transaction{From: blocked, To: &addr2},
+			expectedErr: ErrSenderBlocked,
+		},
+		{
+			name:        "should fail by ErrReceiverBlocked",
+			msg:         &types.Transaction{From: addr1, To: &blocked},
+			expectedErr: ErrReceiverBlocked,
+		},
+		{
+			name:        "should skip state transactions",
+			msg:         &types.Transaction{Type: types.StateTx, From: blocked, To: &blocked},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectedErr, transition.blockListCheck(tt.msg))
+		})
+	}
+}
+
+func TestBlockListCheck_InternalCall(t *testing.T) {
+	t.Parallel()
+
+	blocked := types.StringToAddress("0xb1")
+	proxy := types.StringToAddress("0xc1")
+
+	// calls the blocked address and stores the success flag of the call in the slot 0
+	code := []byte{
+		0x60, 0x00, // PUSH1 0 (ret size)
+		0x60, 0x00, // PUSH1 0 (ret offset)
+		0x60, 0x00, // PUSH1 0 (args size)
+		0x60, 0x00, // PUSH1 0 (args offset)
+		0x60, 0x00, // PUSH1 0 (value)
+		0x73, // PUSH20 blocked
+	}
+	code = append(code, blocked.Bytes()...)
+	code = append(code,
+		0x5a,       // GAS
+		0xf1,       // CALL
+		0x60, 0x00, // PUSH1 0
+		0x55, // SSTORE
+		0x00, // STOP
+	)
+
+	callSucceeded := func(t *testing.T, block bool) bool {
+		t.Helper()
+
+		transition := newTestTransition(nil)
+		transition.config = chain.AllForksEnabled.At(0)
+		transition.evm = evm.NewEVM()
+		transition.precompiles = precompiled.NewPrecompiled()
+		transition.txnBlockList = allowlist.NewAllowList(transition, contracts.BlockListTransactionsAddr)
+		transition.state.SetCode(proxy, code)
+
+		if block {
+			transition.txnBlockList.SetRole(blocked, allowlist.EnabledRole)
+		}
+
+		result := transition.Call2(addr1, proxy, nil, big.NewInt(0), 1000000)
+		assert.NoError(t, result.Err)
+
+		return transition.state.GetState(proxy, types.Hash{}) == types.BytesToHash([]byte{1})
+	}
+
+	assert.True(t, callSucceeded(t, false))
+	assert.False(t, callSucceeded(t, true))
+}
+
+func TestFeeDelegatedTransaction(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	senderKey, err := crypto.GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	payerKey, err := crypto.GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	sender := crypto.PubKeyToAddress(&senderKey.PublicKey)
+	payer := crypto.PubKeyToAddress(&payerKey.PublicKey)
+	signer := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), chainID)
+
+	newSignedTx := func(t *testing.T) *types.Transaction {
+		t.Helper()
+
+		tx, err := signer.SignTx(&types.Transaction{
+			To:       &addr2,
+			Value:    big.NewInt(100),
+			GasPrice: big.NewInt(2),
+			Gas:      50000,
+			Type:     types.FeeDelegatedTx,
+			FeePayer: payer,
+		}, senderKey)
+		assert.NoError(t, err)
+
+		tx, err = signer.SignFeePayerTx(tx, payerKey)
+		assert.NoError(t, err)
+
+		tx.From = sender
+
+		return tx
+	}
+
+	newTransition := func(forks chain.ForksInTime) *Transition {
+		transition := newTestTransition(map[types.Address]*PreState{
+			sender: {Balance: 100},
+			payer:  {Balance: 1000000},
+		})
+		transition.config = forks
+		transition.ctx = runtime.TxContext{ChainID: chainID, GasLimit: 5000000}
+		transition.gasPool = 5000000
+		transition.evm = evm.NewEVM()
+		transition.precompiles = precompiled.NewPrecompiled()
+
+		return transition
+	}
+
+	t.Run("should charge and refund the fee payer", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(chain.AllForksEnabled.At(0))
+
+		result, err := transition.Apply(newSignedTx(t))
+		assert.NoError(t, err)
+		assert.NoError(t, result.Err)
+
+		fee := new(big.Int).Mul(big.NewInt(2), new(big.Int).SetUint64(result.GasUsed))
+
+		assert.Zero(t, transition.GetBalance(sender).Sign())
+		assert.Equal(t, big.NewInt(100), transition.GetBalance(addr2))
+		assert.Equal(t, new(big.Int).Sub(big.NewInt(1000000), fee), transition.GetBalance(payer))
+	})
+
+	t.Run("should fail by ErrInvalidFeePayer", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newSignedTx(t)
+		tx.FeePayer = addr1
+
+		_, err := newTransition(chain.AllForksEnabled.At(0)).Apply(tx)
+		assert.Equal(t, NewTransitionApplicationError(ErrInvalidFeePayer, false), err)
+	})
+
+	t.Run("should fail by ErrFeeDelegationDisabled", func(t *testing.T) {
+		t.Parallel()
+
+		forks := chain.AllForksEnabled.At(0)
+		forks.FeeDelegation = false
+
+		_, err := newTransition(forks).Apply(newSignedTx(t))
+		assert.Equal(t, NewTransitionApplicationError(ErrFeeDelegationDisabled, false), err)
+	})
+}
@@ -1,6 +1,8 @@
 package txpool
 
 import (
+	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -136,6 +138,41 @@ func (m *accountsMap) allTxs(includeEnqueued bool) (
 	return
 }
 
+// localTxs returns the promoted and enqueued local transactions of all accounts,
+// sorted by nonce for each account.
+func (m *accountsMap) localTxs(index *lookupMap) (txs []*types.Transaction) {
+	m.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account := m.get(addr)
+
+		account.promoted.lock(false)
+		defer account.promoted.unlock()
+
+		account.enqueued.lock(false)
+		defer account.enqueued.unlock()
+
+		var accountTxs []*types.Transaction
+
+		for _, queue := range []*accountQueue{account.promoted, account.enqueued} {
+			for _, tx := range queue.queue {
+				if index.isLocal(tx.Hash) {
+					accountTxs = append(accountTxs, tx)
+				}
+			}
+		}
+
+		sort.Slice(accountTxs, func(i, j int) bool {
+			return accountTxs[i].Nonce < accountTxs[j].Nonce
+		})
+
+		txs = append(txs, accountTxs...)
+
+		return true
+	})
+
+	return
+}
+
 // An account is the core structure for processing
 // transactions from a specific address. The nextNonce
 // field is what separates the enqueued from promoted transactions:
@@ -158,6 +195,26 @@ type account struct {
 	maxEnqueued uint64
 }
 
+// hasLocal returns true if any of the account's transactions in the pool
+// was submitted through the local endpoints.
+func (a *account) hasLocal(index *lookupMap) bool {
+	for _, queue := range []*accountQueue{a.promoted, a.enqueued} {
+		queue.lock(false)
+
+		for _, tx := range queue.queue {
+			if index.isLocal(tx.Hash) {
+				queue.unlock()
+
+				return true
+			}
+		}
+
+		queue.unlock()
+	}
+
+	return false
+}
+
 // getNonce returns the next expected nonce for this account.
 func (a *account) getNonce() uint64 {
 	return atomic.LoadUint64(&a.nextNonce)
@@ -242,6 +299,105 @@ func (a *account) enqueue(tx *types.Transaction) error {
 	return nil
 }
 
+// getByNonce returns the enqueued or promoted transaction with the given nonce, if any.
+func (a *account) getByNonce(nonce uint64) *types.Transaction {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	for _, queue := range []*accountQueue{a.promoted, a.enqueued} {
+		if existing, _ := queue.getByNonce(nonce); existing != nil {
+			return existing
+		}
+	}
+
+	return nil
+}
+
+// replace swaps the queued transaction having the nonce of the given one for it,
+// if the new transaction pays a gas price higher by at least priceBump percent.
+// Returns the replaced transaction, or nil if the account has no transaction with the nonce,
+// and whether it was promoted.
+func (a *account) replace(tx *types.Transaction, priceBump uint64) (
+	replaced *types.Transaction,
+	promoted bool,
+	err error,
+) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	for _, queue := range []*accountQueue{a.promoted, a.enqueued} {
+		existing, i := queue.getByNonce(tx.Nonce)
+		if existing == nil {
+			continue
+		}
+
+		if !isReplacementPriced(existing, tx, priceBump) {
+			return nil, false, ErrReplacementUnderpriced
+		}
+
+		return queue.replace(i, tx), queue == a.promoted, nil
+	}
+
+	return nil, false, nil
+}
+
+// removeFrom removes the given transaction and the ones with a higher nonce
+// from both queues. If promoted transactions are removed,
+// the account's next nonce is lowered to the nonce of the given one.
+// Nothing is removed if the transaction is no longer queued.
+func (a *account) removeFrom(tx *types.Transaction) (
+	removedPromoted,
+	removedEnqueued []*types.Transaction,
+) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if queued, _ := a.promoted.getByNonce(tx.Nonce); queued != tx {
+		if queued, _ = a.enqueued.getByNonce(tx.Nonce); queued != tx {
+			return
+		}
+	}
+
+	nonce := tx.Nonce
+
+	removedPromoted = a.promoted.removeFrom(nonce)
+	removedEnqueued = a.enqueued.removeFrom(nonce)
+
+	if nonce < a.getNonce() {
+		a.setNonce(nonce)
+	}
+
+	return
+}
+
+// isReplacementPriced checks the gas price of the replacement
+// is higher than the existing one by at least priceBump percent.
+func isReplacementPriced(existing, replacement *types.Transaction, priceBump uint64) bool {
+	if replacement.GasPrice.Cmp(existing.GasPrice) <= 0 {
+		return false
+	}
+
+	minPrice := new(big.Int).Mul(existing.GasPrice, new(big.Int).SetUint64(100+priceBump))
+	minPrice.Div(minPrice, big.NewInt(100))
+
+	return replacement.GasPrice.Cmp(minPrice) >= 0
+}
+
 // Promote moves eligible transactions from enqueued to promoted.
 //
 // Eligible transactions are all sequential in order of nonce
@@ -327,3 +483,26 @@ func (a *account) getLowestTx() *types.Transaction {
 
 	return nil
 }
+
+// txs returns the promoted and enqueued transactions, each sorted by nonce.
+func (a *account) txs() (promoted, enqueued []*types.Transaction) {
+	a.promoted.lock(false)
+	defer a.promoted.unlock()
+
+	a.enqueued.lock(false)
+	defer a.enqueued.unlock()
+
+	return sortedByNonce(a.promoted.queue), sortedByNonce(a.enqueued.queue)
+}
+
+// sortedByNonce returns a copy of the transactions sorted by nonce.
+func sortedByNonce(txs []*types.Transaction) []*types.Transaction {
+	sorted := make([]*types.Transaction, len(txs))
+	copy(sorted, txs)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Nonce < sorted[j].Nonce
+	})
+
+	return sorted
+}
@@ -0,0 +1,87 @@
+package txpool
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/plingatech/go-plgchain/txpool/proto"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// dropTx removes the transaction with the given hash from the pool.
+// The transactions of the same account with a higher nonce are removed as well,
+// so no nonce gaps are left in the account queues.
+func (p *TxPool) dropTx(hash types.Hash) ([]*types.Transaction, error) {
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	dropped := p.removeFrom(tx)
+	if len(dropped) == 0 {
+		return nil, ErrTxNotFound
+	}
+
+	p.signalDropped(tx.From, dropped)
+
+	return dropped, nil
+}
+
+// clearAccount removes all the transactions of the account from the pool.
+func (p *TxPool) clearAccount(addr types.Address) []*types.Transaction {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil
+	}
+
+	lowest := account.getLowestTx()
+	if lowest == nil {
+		return nil
+	}
+
+	dropped := p.removeFrom(lowest)
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	p.signalDropped(addr, dropped)
+
+	return dropped
+}
+
+// signalDropped reports the transactions removed by the operator.
+func (p *TxPool) signalDropped(addr types.Address, dropped []*types.Transaction) {
+	metrics.IncrCounter([]string{txPoolMetrics, "operator_dropped_transactions"}, float32(len(dropped)))
+
+	p.eventManager.signalEvent(proto.EventType_DROPPED, toHash(dropped...)...)
+	p.logger.Info("dropped txs on operator request",
+		"num", len(dropped),
+		"address", addr.String(),
+	)
+}
+
+// rebroadcast gossips the promoted transactions to the network again,
+// in case they didn't reach the block producers.
+// Returns the number of gossiped transactions.
+func (p *TxPool) rebroadcast() int {
+	if p.topic == nil {
+		return 0
+	}
+
+	txs := []*types.Transaction{}
+
+	p.accounts.Range(func(_, value interface{}) bool {
+		account, _ := value.(*account)
+
+		promoted, _ := account.txs()
+		txs = append(txs, promoted...)
+
+		return true
+	})
+
+	for _, tx := range txs {
+		p.publish(tx)
+	}
+
+	p.logger.Info("rebroadcast txs on operator request", "num", len(txs))
+
+	return len(txs)
+}
@@ -0,0 +1,45 @@
+package txpool
+
+import (
+	"github.com/plingatech/go-plgchain/contracts"
+	"github.com/plingatech/go-plgchain/state/runtime/allowlist"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// blockListState is a read-only view of the block list storage at a state root
+type blockListState struct {
+	store store
+	root  types.Hash
+}
+
+func (s *blockListState) GetStorage(addr types.Address, key types.Hash) types.Hash {
+	return s.store.GetStorage(s.root, addr, key)
+}
+
+// SetState is a no-op, the block list is only updated by the transactions
+func (s *blockListState) SetState(types.Address, types.Hash, types.Hash) {}
+
+// checkBlockList returns ErrBlockedAddress if the sender or the receiver
+// of the transaction is blocked at the given state root.
+// The same check rejects the transaction on execution,
+// so blocked transactions can't end up in a block anyway.
+func (p *TxPool) checkBlockList(stateRoot types.Hash, tx *types.Transaction) error {
+	if !p.blockList {
+		return nil
+	}
+
+	list := allowlist.NewAllowList(
+		&blockListState{store: p.store, root: stateRoot},
+		contracts.BlockListTransactionsAddr,
+	)
+
+	if list.GetRole(tx.From).Blocked() {
+		return ErrBlockedAddress
+	}
+
+	if tx.To != nil && list.GetRole(*tx.To).Blocked() {
+		return ErrBlockedAddress
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package txpool
+
+import (
+	"container/heap"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/plingatech/go-plgchain/txpool/proto"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// evictionIndex is a global index of the transactions
+// in the enqueued and promoted queues of all accounts, sorted by min gas price.
+// Removed transactions are not deleted from the index right away,
+// they are skipped when found to be no longer present in the pool.
+type evictionIndex struct {
+	sync.Mutex
+	queue minPriceQueue
+}
+
+func newEvictionIndex() *evictionIndex {
+	q := evictionIndex{
+		queue: make(minPriceQueue, 0),
+	}
+
+	heap.Init(&q.queue)
+
+	return &q
+}
+
+// push adds the given transaction to the index.
+func (q *evictionIndex) push(tx *types.Transaction) {
+	q.Lock()
+	defer q.Unlock()
+
+	heap.Push(&q.queue, tx)
+}
+
+// pop removes the cheapest transaction from the index
+// or returns nil if the index is empty.
+func (q *evictionIndex) pop() *types.Transaction {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.queue.Len() == 0 {
+		return nil
+	}
+
+	tx, ok := heap.Pop(&q.queue).(*types.Transaction)
+	if !ok {
+		return nil
+	}
+
+	return tx
+}
+
+// length returns the number of transactions in the index, including the removed ones.
+func (q *evictionIndex) length() uint64 {
+	q.Lock()
+	defer q.Unlock()
+
+	return uint64(q.queue.Len())
+}
+
+// filter keeps only the transactions satisfying the keep condition.
+func (q *evictionIndex) filter(keep func(tx *types.Transaction) bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	kept := q.queue[:0]
+
+	for _, tx := range q.queue {
+		if keep(tx) {
+			kept = append(kept, tx)
+		}
+	}
+
+	q.queue = kept
+
+	heap.Init(&q.queue)
+}
+
+// transactions sorted by gas price (ascending)
+type minPriceQueue []*types.Transaction
+
+/* Queue methods required by the heap interface */
+
+func (q *minPriceQueue) Len() int {
+	return len(*q)
+}
+
+func (q *minPriceQueue) Swap(i, j int) {
+	(*q)[i], (*q)[j] = (*q)[j], (*q)[i]
+}
+
+func (q *minPriceQueue) Less(i, j int) bool {
+	return (*q)[i].GasPrice.Cmp((*q)[j].GasPrice) < 0
+}
+
+func (q *minPriceQueue) Push(x interface{}) {
+	transaction, ok := x.(*types.Transaction)
+	if !ok {
+		return
+	}
+
+	*q = append(*q, transaction)
+}
+
+func (q *minPriceQueue) Pop() interface{} {
+	old := q
+	n := len(*old)
+	x := (*old)[n-1]
+	*q = (*old)[0 : n-1]
+
+	return x
+}
+
+// trackEvictable adds the transaction to the eviction index. Whether it can be evicted
+// is checked once it is picked, since the locality of its account changes over time.
+// The index is compacted once it holds too many removed transactions.
+func (p *TxPool) trackEvictable(tx *types.Transaction) {
+	p.evictables.push(tx)
+
+	// every transaction takes at least one slot,
+	// so the index holds mostly removed transactions beyond this limit
+	if p.evictables.length() > 2*p.gauge.max {
+		p.evictables.filter(p.isPooled)
+	}
+}
+
+// isPooled checks the transaction is still in the pool.
+func (p *TxPool) isPooled(tx *types.Transaction) bool {
+	pooled, ok := p.index.get(tx.Hash)
+
+	return ok && pooled == tx
+}
+
+// hasLocalTxs checks the account of the transaction has local transactions in the pool.
+// Such accounts are not evicted, since evicting a transaction evicts the higher nonces as well.
+func (p *TxPool) hasLocalTxs(tx *types.Transaction) bool {
+	account := p.accounts.get(tx.From)
+
+	return account == nil || account.hasLocal(&p.index)
+}
+
+// ensureCapacity makes room for the required slots of the given transaction.
+// Remote transactions can't use the slots reserved for the local ones.
+// If the pool is full, the cheapest remote transactions are evicted
+// as long as the given transaction pays a higher gas price.
+func (p *TxPool) ensureCapacity(origin txOrigin, tx *types.Transaction, required uint64) error {
+	if required == 0 {
+		return nil
+	}
+
+	limit := p.gauge.max
+	if origin != local {
+		if p.localSlots >= limit {
+			return ErrTxPoolOverflow
+		}
+
+		limit -= p.localSlots
+	}
+
+	if required > limit {
+		return ErrTxPoolOverflow
+	}
+
+	height := p.gauge.read()
+	if height+required <= limit {
+		return nil
+	}
+
+	if err := p.evictUnderpriced(tx, height+required-limit); err != nil {
+		return err
+	}
+
+	// a candidate could have left the account queues in the meantime
+	if p.gauge.read()+required > limit {
+		return ErrTxPoolOverflow
+	}
+
+	return nil
+}
+
+// evictUnderpriced evicts the cheapest remote transactions, paying less than the given one,
+// to free at least the given number of slots. Transactions of the same account
+// with a higher nonce are evicted as well, so no nonce gaps are left in the account queues.
+// If not enough slots can be freed, nothing is evicted.
+func (p *TxPool) evictUnderpriced(tx *types.Transaction, slots uint64) error {
+	var (
+		candidates []*types.Transaction
+		skipped    []*types.Transaction
+		freed      uint64
+	)
+
+	for freed < slots {
+		cheapest := p.evictables.pop()
+		if cheapest == nil {
+			break
+		}
+
+		if !p.isPooled(cheapest) {
+			continue
+		}
+
+		// evicting the transactions of the same account would leave a nonce gap before the new one
+		if cheapest.From == tx.From || p.hasLocalTxs(cheapest) {
+			skipped = append(skipped, cheapest)
+
+			continue
+		}
+
+		if cheapest.GasPrice.Cmp(tx.GasPrice) >= 0 {
+			skipped = append(skipped, cheapest)
+
+			break
+		}
+
+		candidates = append(candidates, cheapest)
+		freed += slotsRequired(cheapest)
+	}
+
+	for _, skippedTx := range skipped {
+		p.evictables.push(skippedTx)
+	}
+
+	if freed < slots {
+		// not enough cheaper transactions, keep them all
+		for _, candidate := range candidates {
+			p.evictables.push(candidate)
+		}
+
+		return ErrTxPoolOverflow
+	}
+
+	for _, candidate := range candidates {
+		p.evictFrom(candidate)
+	}
+
+	return nil
+}
+
+// evictFrom removes the given transaction together with the transactions
+// of the same account having a higher nonce.
+func (p *TxPool) evictFrom(tx *types.Transaction) {
+	evicted := p.removeFrom(tx)
+	if len(evicted) == 0 {
+		// already removed, e.g. by the previous candidate of the same account
+		return
+	}
+
+	metrics.IncrCounter([]string{txPoolMetrics, "evicted_transactions"}, float32(len(evicted)))
+
+	p.eventManager.signalEvent(proto.EventType_EVICTED, toHash(evicted...)...)
+	p.logger.Debug("evicted underpriced txs",
+		"num", len(evicted),
+		"address", tx.From.String(),
+		"from_nonce", tx.Nonce,
+	)
+}
@@ -0,0 +1,92 @@
+package txpool
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/plingatech/go-plgchain/txpool/proto"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+const (
+	// maximum period of checking the pool for expired transactions
+	expiryCheckInterval = time.Minute
+)
+
+// expiryTicker returns the ticker of the expiry checks
+// or nil if the transactions never expire.
+func (p *TxPool) expiryTicker() *time.Ticker {
+	if p.lifetime <= 0 {
+		return nil
+	}
+
+	interval := expiryCheckInterval
+	if p.lifetime < interval {
+		interval = p.lifetime
+	}
+
+	return time.NewTicker(interval)
+}
+
+// expireTxs removes the transactions which spent more than the lifetime in the pool.
+// The transactions of the same account with a higher nonce are removed as well,
+// so no nonce gaps are left in the account queues. The accounts with local transactions
+// don't expire, as the journal would replay their transactions on every restart.
+func (p *TxPool) expireTxs() {
+	deadline := time.Now().Add(-p.lifetime)
+
+	p.accounts.Range(func(_, value interface{}) bool {
+		account, _ := value.(*account)
+
+		if account.hasLocal(&p.index) {
+			return true
+		}
+
+		oldest := p.firstExpired(account, deadline)
+		if oldest == nil {
+			return true
+		}
+
+		expired := p.removeFrom(oldest)
+		if len(expired) == 0 {
+			return true
+		}
+
+		atomic.AddUint64(&p.expired, uint64(len(expired)))
+		metrics.IncrCounter([]string{txPoolMetrics, "expired_transactions"}, float32(len(expired)))
+
+		p.eventManager.signalEvent(proto.EventType_EXPIRED, toHash(expired...)...)
+		p.logger.Debug("expired txs",
+			"num", len(expired),
+			"address", oldest.From.String(),
+			"from_nonce", oldest.Nonce,
+		)
+
+		return true
+	})
+}
+
+// firstExpired returns the account's transaction with the lowest nonce
+// added to the pool before the deadline, or nil if there is none.
+func (p *TxPool) firstExpired(account *account, deadline time.Time) (first *types.Transaction) {
+	account.promoted.lock(false)
+	defer account.promoted.unlock()
+
+	account.enqueued.lock(false)
+	defer account.enqueued.unlock()
+
+	for _, queue := range []*accountQueue{account.promoted, account.enqueued} {
+		for _, tx := range queue.queue {
+			if first != nil && tx.Nonce >= first.Nonce {
+				continue
+			}
+
+			if added, ok := p.index.addedAt(tx.Hash); ok && added.Before(deadline) {
+				first = tx
+			}
+		}
+	}
+
+	return first
+}
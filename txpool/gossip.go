@@ -0,0 +1,411 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/plingatech/go-plgchain/network"
+	"github.com/plingatech/go-plgchain/network/grpc"
+	"github.com/plingatech/go-plgchain/txpool/proto"
+	"github.com/plingatech/go-plgchain/types"
+	rawGrpc "google.golang.org/grpc"
+)
+
+const (
+	topicAnnounceV1 = "txpool/announce/0.1"
+	fetcherProto    = "/txpool-fetcher/0.1"
+
+	// timeout of fetching the announced transactions from a peer
+	fetchTimeout = 5 * time.Second
+
+	// maximum number of transactions requested from a peer at once
+	maxFetchTxs = 256
+)
+
+var (
+	errInvalidAnnouncement = errors.New("malformed transaction announcement")
+)
+
+// fetcherNetwork opens the connections to the peers
+// for fetching the announced transactions
+type fetcherNetwork interface {
+	NewProtoConnection(protocol string, peerID peer.ID) (*rawGrpc.ClientConn, error)
+}
+
+// fetchTracker keeps track of the announced transactions being fetched
+// along with the peers which announced them, so the same transaction
+// isn't requested from several peers at once and a failed fetch
+// can be retried against another announcer.
+type fetchTracker struct {
+	sync.Mutex
+	inflight map[types.Hash]*announcers
+}
+
+// announcers are the peers a transaction can be fetched from
+type announcers struct {
+	pending []peer.ID
+	seen    map[peer.ID]struct{}
+}
+
+func newFetchTracker() *fetchTracker {
+	return &fetchTracker{
+		inflight: make(map[types.Hash]*announcers),
+	}
+}
+
+// track records the peers as announcers of the given hashes
+// and returns the hashes which weren't already being fetched.
+func (f *fetchTracker) track(hashes []types.Hash, peers ...peer.ID) (tracked []types.Hash) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, hash := range hashes {
+		entry, ok := f.inflight[hash]
+		if !ok {
+			entry = &announcers{
+				seen: make(map[peer.ID]struct{}),
+			}
+			f.inflight[hash] = entry
+
+			tracked = append(tracked, hash)
+		}
+
+		for _, peerID := range peers {
+			if _, ok := entry.seen[peerID]; ok {
+				continue
+			}
+
+			entry.seen[peerID] = struct{}{}
+			entry.pending = append(entry.pending, peerID)
+		}
+	}
+
+	return
+}
+
+// next returns the next announcer to fetch the given hash from,
+// if there is any left which wasn't tried yet.
+func (f *fetchTracker) next(hash types.Hash) (peer.ID, bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	entry, ok := f.inflight[hash]
+	if !ok || len(entry.pending) == 0 {
+		return "", false
+	}
+
+	peerID := entry.pending[0]
+	entry.pending = entry.pending[1:]
+
+	return peerID, true
+}
+
+// untrack marks the given hashes as no longer being fetched.
+func (f *fetchTracker) untrack(hashes []types.Hash) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, hash := range hashes {
+		delete(f.inflight, hash)
+	}
+}
+
+// fetcherService serves the announced transactions to the peers
+type fetcherService struct {
+	proto.UnimplementedTxnFetcherServer
+
+	pool   *TxPool
+	stream *grpc.GrpcStream
+}
+
+// GetTxns returns the requested transactions present in the pool
+func (s *fetcherService) GetTxns(_ context.Context, req *proto.GetTxnsRequest) (*proto.GetTxnsResponse, error) {
+	if len(req.Hashes) > maxFetchTxs {
+		return nil, fmt.Errorf("too many transactions requested, limit is %d", maxFetchTxs)
+	}
+
+	resp := &proto.GetTxnsResponse{}
+
+	for _, raw := range req.Hashes {
+		if tx, ok := s.pool.index.get(types.BytesToHash(raw)); ok {
+			resp.Txns = append(resp.Txns, tx.MarshalRLP())
+		}
+	}
+
+	return resp, nil
+}
+
+// setupGossip subscribes to the transaction and announcement gossip topics
+// and registers the protocol serving the announced transactions.
+func (p *TxPool) setupGossip(network *network.Server) error {
+	topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
+	if err != nil {
+		return err
+	}
+
+	if subscribeErr := topic.Subscribe(p.addGossipTx); subscribeErr != nil {
+		return fmt.Errorf("unable to subscribe to gossip topic, %w", subscribeErr)
+	}
+
+	announceTopic, err := network.NewTopic(topicAnnounceV1, &proto.TxnAnnouncement{})
+	if err != nil {
+		return err
+	}
+
+	if subscribeErr := announceTopic.SubscribeRelayed(p.addAnnouncement); subscribeErr != nil {
+		return fmt.Errorf("unable to subscribe to announcement topic, %w", subscribeErr)
+	}
+
+	p.fetcher = &fetcherService{
+		pool:   p,
+		stream: grpc.NewGrpcStream(),
+	}
+
+	proto.RegisterTxnFetcherServer(p.fetcher.stream.GrpcServer(), p.fetcher)
+	p.fetcher.stream.Serve()
+	network.RegisterProtocol(fetcherProto, p.fetcher.stream)
+
+	p.topic = topic
+	p.announceTopic = announceTopic
+	p.fetcherNetwork = network
+	p.localPeerID = network.AddrInfo().ID
+
+	return nil
+}
+
+// publish gossips the transaction to the network, only if a topic subscription is present.
+// Transactions larger than the announce threshold are only announced,
+// the peers fetch their bodies if missing.
+func (p *TxPool) publish(tx *types.Transaction) {
+	if p.topic == nil {
+		return
+	}
+
+	raw := tx.MarshalRLP()
+
+	if p.announceTopic != nil && uint64(len(raw)) > p.announceThreshold {
+		p.announce(tx.Hash)
+
+		return
+	}
+
+	msg := &proto.Txn{
+		Raw: &any.Any{
+			Value: raw,
+		},
+	}
+
+	if err := p.topic.Publish(msg); err != nil {
+		p.logger.Error("failed to topic tx", "err", err)
+	}
+}
+
+// announce gossips the hashes of the transactions present in the pool.
+func (p *TxPool) announce(hashes ...types.Hash) {
+	msg := &proto.TxnAnnouncement{
+		Hashes: make([][]byte, len(hashes)),
+	}
+
+	for i, hash := range hashes {
+		msg.Hashes[i] = hash.Bytes()
+	}
+
+	if err := p.announceTopic.Publish(msg); err != nil {
+		p.logger.Error("failed to announce txs", "err", err)
+	}
+}
+
+// addAnnouncement handles the transaction hashes announced by the network,
+// fetching the missing transactions from the peer which relayed the announcement
+// first, since the announcing one isn't necessarily connected.
+func (p *TxPool) addAnnouncement(obj interface{}, from, receivedFrom peer.ID) {
+	if !p.getSealing() || (from != "" && from == p.localPeerID) {
+		return
+	}
+
+	hashes, err := decodeAnnouncement(obj)
+	if err != nil {
+		p.logger.Error("failed to decode tx announcement", "err", err)
+
+		return
+	}
+
+	peers := []peer.ID{receivedFrom}
+	if from != "" && from != receivedFrom {
+		peers = append(peers, from)
+	}
+
+	p.handleAnnouncement(hashes, peers...)
+}
+
+// handleAnnouncement fetches the announced transactions missing from the pool.
+// Transactions a peer fails to serve are requested from the next peer
+// which announced them, until there is none left.
+// The fetched transactions aren't announced further,
+// the announcement itself is relayed by the pubsub.
+func (p *TxPool) handleAnnouncement(hashes []types.Hash, peers ...peer.ID) {
+	unknown := make([]types.Hash, 0, len(hashes))
+
+	for _, hash := range hashes {
+		if _, ok := p.index.get(hash); !ok {
+			unknown = append(unknown, hash)
+		}
+	}
+
+	if known := len(hashes) - len(unknown); known > 0 {
+		metrics.IncrCounter([]string{txPoolMetrics, "duplicate_announcements"}, float32(known))
+	}
+
+	missing := p.fetches.track(unknown, peers...)
+	if len(missing) == 0 {
+		return
+	}
+
+	defer p.fetches.untrack(missing)
+
+	for pending := missing; len(pending) > 0; {
+		batches := make(map[peer.ID][]types.Hash)
+
+		for _, hash := range pending {
+			if peerID, ok := p.fetches.next(hash); ok {
+				batches[peerID] = append(batches[peerID], hash)
+			}
+		}
+
+		pending = nil
+
+		for peerID, batch := range batches {
+			pending = append(pending, p.fetchAndAdd(peerID, batch)...)
+		}
+	}
+}
+
+// fetchAndAdd fetches the transactions from the peer and adds them to the pool.
+// It returns the hashes of the transactions the peer didn't serve.
+func (p *TxPool) fetchAndAdd(peerID peer.ID, hashes []types.Hash) (missed []types.Hash) {
+	for len(hashes) > 0 {
+		chunk := hashes
+		if len(chunk) > maxFetchTxs {
+			chunk = chunk[:maxFetchTxs]
+		}
+
+		hashes = hashes[len(chunk):]
+
+		txs, err := p.fetchTxs(peerID, chunk)
+		if err != nil {
+			p.logger.Debug("failed to fetch announced txs", "peer", peerID, "err", err)
+
+			missed = append(missed, chunk...)
+
+			continue
+		}
+
+		fetched := make(map[types.Hash]struct{}, len(txs))
+
+		for _, tx := range txs {
+			fetched[tx.Hash] = struct{}{}
+
+			if err := p.addTx(gossip, tx); err != nil {
+				if errors.Is(err, ErrAlreadyKnown) {
+					metrics.IncrCounter([]string{txPoolMetrics, "duplicate_transactions"}, 1)
+
+					continue
+				}
+
+				p.logger.Error("failed to add fetched tx", "err", err, "hash", tx.Hash.String())
+			}
+		}
+
+		for _, hash := range chunk {
+			if _, ok := fetched[hash]; !ok {
+				missed = append(missed, hash)
+			}
+		}
+	}
+
+	return missed
+}
+
+// fetchTxs requests the transactions with the given hashes from the peer.
+// Transactions the peer sends but which weren't requested are dropped.
+func (p *TxPool) fetchTxs(peerID peer.ID, hashes []types.Hash) ([]*types.Transaction, error) {
+	if p.fetcherNetwork == nil {
+		return nil, errors.New("no network to fetch from")
+	}
+
+	conn, err := p.fetcherNetwork.NewProtoConnection(fetcherProto, peerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancelFn()
+
+	requested := make(map[types.Hash]struct{}, len(hashes))
+	req := &proto.GetTxnsRequest{}
+
+	for _, hash := range hashes {
+		if len(req.Hashes) == maxFetchTxs {
+			break
+		}
+
+		requested[hash] = struct{}{}
+		req.Hashes = append(req.Hashes, hash.Bytes())
+	}
+
+	resp, err := proto.NewTxnFetcherClient(conn).GetTxns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*types.Transaction, 0, len(resp.Txns))
+
+	for _, raw := range resp.Txns {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, fmt.Errorf("failed to decode fetched tx: %w", err)
+		}
+
+		tx.ComputeHash()
+
+		if _, ok := requested[tx.Hash]; !ok {
+			p.logger.Debug("dropping unrequested tx", "peer", peerID, "hash", tx.Hash.String())
+
+			continue
+		}
+
+		delete(requested, tx.Hash)
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// decodeAnnouncement returns the hashes of the announced transactions.
+func decodeAnnouncement(obj interface{}) ([]types.Hash, error) {
+	msg, ok := obj.(*proto.TxnAnnouncement)
+	if !ok || msg == nil || len(msg.Hashes) == 0 {
+		return nil, errInvalidAnnouncement
+	}
+
+	hashes := make([]types.Hash, len(msg.Hashes))
+
+	for i, raw := range msg.Hashes {
+		if len(raw) != types.HashLength {
+			return nil, errInvalidAnnouncement
+		}
+
+		hashes[i] = types.BytesToHash(raw)
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,266 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/helper/tests"
+	"github.com/plingatech/go-plgchain/txpool/proto"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// mockFetcherNetwork connects to the fetcher services of other pools
+type mockFetcherNetwork struct {
+	listeners map[peer.ID]*bufconn.Listener
+
+	lock  sync.Mutex
+	peers []peer.ID
+}
+
+func newMockFetcherNetwork(t *testing.T, pools map[peer.ID]*TxPool) *mockFetcherNetwork {
+	t.Helper()
+
+	m := &mockFetcherNetwork{
+		listeners: make(map[peer.ID]*bufconn.Listener, len(pools)),
+	}
+
+	for peerID, pool := range pools {
+		lis := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer()
+		proto.RegisterTxnFetcherServer(s, &fetcherService{pool: pool})
+
+		go func() {
+			_ = s.Serve(lis)
+		}()
+
+		t.Cleanup(s.Stop)
+
+		m.listeners[peerID] = lis
+	}
+
+	return m
+}
+
+func (m *mockFetcherNetwork) NewProtoConnection(_ string, peerID peer.ID) (*grpc.ClientConn, error) {
+	m.lock.Lock()
+	m.peers = append(m.peers, peerID)
+	m.lock.Unlock()
+
+	lis, ok := m.listeners[peerID]
+	if !ok {
+		return nil, errors.New("peer not connected")
+	}
+
+	return grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(
+			func(ctx context.Context, address string) (net.Conn, error) {
+				return lis.Dial()
+			},
+		),
+	)
+}
+
+func TestFetcherService_GetTxns(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	tx := newTx(addr1, 0, 1)
+	tx.ComputeHash()
+	require.True(t, pool.index.add(tx))
+
+	service := &fetcherService{pool: pool}
+
+	resp, err := service.GetTxns(context.Background(), &proto.GetTxnsRequest{
+		Hashes: [][]byte{tx.Hash.Bytes(), types.StringToHash("0x1").Bytes()},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Txns, 1)
+	assert.Equal(t, tx.MarshalRLP(), resp.Txns[0])
+
+	_, err = service.GetTxns(context.Background(), &proto.GetTxnsRequest{
+		Hashes: make([][]byte, maxFetchTxs+1),
+	})
+	assert.Error(t, err)
+}
+
+func TestHandleAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	poolSigner := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
+	key, addr := tests.GenerateKeyAndAddr(t)
+
+	tx, err := poolSigner.SignTx(newTx(addr, 0, 1), key)
+	require.NoError(t, err)
+	tx.ComputeHash()
+
+	// the announcing peer has the transaction
+	source, err := newTestPool()
+	require.NoError(t, err)
+	require.True(t, source.index.add(tx))
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	pool.SetSigner(poolSigner)
+	pool.SetSealing(true)
+	fetcherNetwork := newMockFetcherNetwork(t, map[peer.ID]*TxPool{"relayer": source})
+	pool.fetcherNetwork = fetcherNetwork
+	pool.Start()
+
+	defer pool.Close()
+
+	// the announcing peer isn't connected, so the transaction is fetched from the relaying one
+	pool.addAnnouncement(
+		&proto.TxnAnnouncement{Hashes: [][]byte{tx.Hash.Bytes()}},
+		peer.ID("announcer"),
+		peer.ID("relayer"),
+	)
+
+	require.Eventually(t, func() bool {
+		account := pool.accounts.get(addr)
+		if account == nil {
+			return false
+		}
+
+		account.promoted.lock(false)
+		defer account.promoted.unlock()
+
+		return account.promoted.length() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, ok := pool.index.get(tx.Hash)
+	assert.True(t, ok)
+
+	// the fetch is done, so the hash is no longer tracked
+	pool.fetches.Lock()
+	assert.Empty(t, pool.fetches.inflight)
+	pool.fetches.Unlock()
+
+	fetcherNetwork.lock.Lock()
+	assert.Equal(t, []peer.ID{"relayer"}, fetcherNetwork.peers)
+	fetcherNetwork.lock.Unlock()
+}
+
+func TestHandleAnnouncement_Retry(t *testing.T) {
+	t.Parallel()
+
+	poolSigner := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
+	key, addr := tests.GenerateKeyAndAddr(t)
+
+	tx, err := poolSigner.SignTx(newTx(addr, 0, 1), key)
+	require.NoError(t, err)
+	tx.ComputeHash()
+
+	// only the announcing peer has the transaction
+	source, err := newTestPool()
+	require.NoError(t, err)
+	require.True(t, source.index.add(tx))
+
+	relayer, err := newTestPool()
+	require.NoError(t, err)
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	pool.SetSigner(poolSigner)
+	pool.SetSealing(true)
+	fetcherNetwork := newMockFetcherNetwork(t, map[peer.ID]*TxPool{
+		"relayer":   relayer,
+		"announcer": source,
+	})
+	pool.fetcherNetwork = fetcherNetwork
+	pool.Start()
+
+	defer pool.Close()
+
+	// the relaying peer doesn't serve the transaction, so it is fetched from the announcing one
+	pool.addAnnouncement(
+		&proto.TxnAnnouncement{Hashes: [][]byte{tx.Hash.Bytes()}},
+		peer.ID("announcer"),
+		peer.ID("relayer"),
+	)
+
+	require.Eventually(t, func() bool {
+		_, ok := pool.index.get(tx.Hash)
+
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	fetcherNetwork.lock.Lock()
+	assert.Equal(t, []peer.ID{"relayer", "announcer"}, fetcherNetwork.peers)
+	fetcherNetwork.lock.Unlock()
+
+	// none of the peers has the transaction, so the fetch gives up
+	missing := types.StringToHash("0x1")
+
+	pool.handleAnnouncement([]types.Hash{missing}, "relayer", "announcer", "unknown")
+
+	_, ok := pool.index.get(missing)
+	assert.False(t, ok)
+
+	pool.fetches.Lock()
+	assert.Empty(t, pool.fetches.inflight)
+	pool.fetches.Unlock()
+}
+
+func TestFetchTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := newFetchTracker()
+	hash := types.StringToHash("0x1")
+
+	assert.Equal(t, []types.Hash{hash}, tracker.track([]types.Hash{hash}, "a"))
+
+	// already in flight, only the announcer is recorded
+	assert.Empty(t, tracker.track([]types.Hash{hash}, "b", "a"))
+
+	peerID, ok := tracker.next(hash)
+	assert.True(t, ok)
+	assert.Equal(t, peer.ID("a"), peerID)
+
+	peerID, ok = tracker.next(hash)
+	assert.True(t, ok)
+	assert.Equal(t, peer.ID("b"), peerID)
+
+	_, ok = tracker.next(hash)
+	assert.False(t, ok)
+
+	tracker.untrack([]types.Hash{hash})
+
+	_, ok = tracker.next(hash)
+	assert.False(t, ok)
+}
+
+func TestDecodeAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	hash := types.StringToHash("0x1")
+
+	hashes, err := decodeAnnouncement(&proto.TxnAnnouncement{Hashes: [][]byte{hash.Bytes()}})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{hash}, hashes)
+
+	_, err = decodeAnnouncement(&proto.TxnAnnouncement{Hashes: [][]byte{{0x1, 0x2}}})
+	assert.ErrorIs(t, err, errInvalidAnnouncement)
+
+	_, err = decodeAnnouncement(&proto.TxnAnnouncement{})
+	assert.ErrorIs(t, err, errInvalidAnnouncement)
+
+	_, err = decodeAnnouncement(&proto.Txn{})
+	assert.ErrorIs(t, err, errInvalidAnnouncement)
+}
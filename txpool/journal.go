@@ -0,0 +1,236 @@
+package txpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/plingatech/go-plgchain/types"
+)
+
+var (
+	errJournalClosed = errors.New("journal is closed")
+)
+
+// journal is a file keeping the local transactions across node restarts.
+// Each transaction is stored as its RLP encoding prefixed with the encoding length.
+// New transactions are appended to the file, while rotate rewrites it
+// with the transactions still present in the pool.
+type journal struct {
+	sync.Mutex
+
+	path   string
+	writer *os.File
+}
+
+func newJournal(path string) *journal {
+	return &journal{
+		path: path,
+	}
+}
+
+// load reads the transactions from the journal and passes them to the add callback.
+// A missing journal is not an error. A truncated last record, left by an unclean shutdown, is skipped.
+// Returns the number of loaded transactions and the number of the ones the callback rejected.
+func (j *journal) load(add func(tx *types.Transaction) error) (loaded, rejected int, err error) {
+	file, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	lengthBuf := make([]byte, 4)
+
+	for {
+		if _, err := io.ReadFull(reader, lengthBuf); err != nil {
+			// io.ErrUnexpectedEOF means a truncated record
+			return loaded, rejected, nil
+		}
+
+		raw := make([]byte, binary.BigEndian.Uint32(lengthBuf))
+		if _, err := io.ReadFull(reader, raw); err != nil {
+			return loaded, rejected, nil
+		}
+
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return loaded, rejected, fmt.Errorf("failed to decode journal transaction %d: %w", loaded, err)
+		}
+
+		loaded++
+
+		if err := add(tx); err != nil {
+			rejected++
+		}
+	}
+}
+
+// insert appends the transaction to the journal.
+func (j *journal) insert(tx *types.Transaction) error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer == nil {
+		return errJournalClosed
+	}
+
+	_, err := j.writer.Write(encodeJournalRecord(nil, tx))
+
+	return err
+}
+
+// rotate rewrites the journal with the given transactions
+// and reopens it for appending.
+func (j *journal) rotate(txs []*types.Transaction) error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+
+		j.writer = nil
+	}
+
+	var buf []byte
+	for _, tx := range txs {
+		buf = encodeJournalRecord(buf, tx)
+	}
+
+	tmpPath := j.path + ".new"
+
+	// write the new journal aside and swap it in,
+	// so a crash can't leave it half written
+	if err := writeFileSync(tmpPath, buf); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return err
+	}
+
+	writer, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	j.writer = writer
+
+	return nil
+}
+
+// close closes the journal file.
+func (j *journal) close() error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+
+	err := j.writer.Close()
+	j.writer = nil
+
+	return err
+}
+
+// encodeJournalRecord appends the length prefixed RLP encoding of the transaction to dst.
+func encodeJournalRecord(dst []byte, tx *types.Transaction) []byte {
+	raw := tx.MarshalRLP()
+
+	dst = binary.BigEndian.AppendUint32(dst, uint32(len(raw)))
+
+	return append(dst, raw...)
+}
+
+// writeFileSync writes the data to the file at the given path and flushes it to the disk.
+func writeFileSync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+// startJournal replays the journaled transactions into the pool,
+// rewrites the journal with the accepted ones and keeps rewriting it
+// periodically, to drop the transactions no longer in the pool.
+func (p *TxPool) startJournal() {
+	loaded, rejected, err := p.journal.load(func(tx *types.Transaction) error {
+		if err := p.addTx(local, tx); err != nil {
+			return err
+		}
+
+		p.publish(tx)
+
+		return nil
+	})
+	if err != nil {
+		p.logger.Error("failed to load the transaction journal", "err", err)
+	}
+
+	p.logger.Info("loaded the transaction journal", "transactions", loaded, "rejected", rejected)
+
+	p.rotateJournal()
+
+	go func() {
+		ticker := time.NewTicker(p.rejournalInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.journalStopCh:
+				return
+			case <-ticker.C:
+				p.rotateJournal()
+			}
+		}
+	}()
+}
+
+// stopJournal stops the rejournal loop and writes the final journal.
+func (p *TxPool) stopJournal() {
+	close(p.journalStopCh)
+
+	p.rotateJournal()
+
+	if err := p.journal.close(); err != nil {
+		p.logger.Error("failed to close the transaction journal", "err", err)
+	}
+}
+
+// rotateJournal rewrites the journal with the local transactions in the pool.
+func (p *TxPool) rotateJournal() {
+	txs := p.accounts.localTxs(&p.index)
+
+	if err := p.journal.rotate(txs); err != nil {
+		p.logger.Error("failed to rotate the transaction journal", "err", err)
+
+		return
+	}
+
+	p.logger.Debug("rotated the transaction journal", "transactions", len(txs))
+}
@@ -0,0 +1,149 @@
+package txpool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/crypto"
+	"github.com/plingatech/go-plgchain/helper/tests"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadJournal(t *testing.T, j *journal) []*types.Transaction {
+	t.Helper()
+
+	txs := []*types.Transaction{}
+
+	_, _, err := j.load(func(tx *types.Transaction) error {
+		txs = append(txs, tx)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return txs
+}
+
+func TestJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing journal", func(t *testing.T) {
+		t.Parallel()
+
+		j := newJournal(filepath.Join(t.TempDir(), "journal.rlp"))
+
+		assert.Empty(t, loadJournal(t, j))
+	})
+
+	t.Run("insert and rotate", func(t *testing.T) {
+		t.Parallel()
+
+		j := newJournal(filepath.Join(t.TempDir(), "journal.rlp"))
+
+		txs := []*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 2), newTx(addr2, 0, 1)}
+		for _, tx := range txs {
+			tx.ComputeHash()
+		}
+
+		// inserting requires the journal to be opened by a rotation
+		assert.ErrorIs(t, j.insert(txs[0]), errJournalClosed)
+
+		require.NoError(t, j.rotate(txs[:1]))
+		require.NoError(t, j.insert(txs[1]))
+		require.NoError(t, j.insert(txs[2]))
+
+		loaded := loadJournal(t, j)
+		require.Len(t, loaded, 3)
+
+		for i, tx := range loaded {
+			assert.Equal(t, txs[i].Hash, tx.Hash)
+		}
+
+		// rotation drops the transactions no longer in the pool
+		require.NoError(t, j.rotate(txs[2:]))
+		require.NoError(t, j.close())
+
+		loaded = loadJournal(t, j)
+		require.Len(t, loaded, 1)
+		assert.Equal(t, txs[2].Hash, loaded[0].Hash)
+	})
+
+	t.Run("truncated record", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "journal.rlp")
+		j := newJournal(path)
+
+		tx := newTx(addr1, 0, 1)
+		tx.ComputeHash()
+
+		require.NoError(t, j.rotate([]*types.Transaction{tx, newTx(addr1, 1, 1)}))
+		require.NoError(t, j.close())
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(path, info.Size()-1))
+
+		loaded := loadJournal(t, j)
+		require.Len(t, loaded, 1)
+		assert.Equal(t, tx.Hash, loaded[0].Hash)
+	})
+}
+
+func TestJournalReplay(t *testing.T) {
+	t.Parallel()
+
+	poolSigner := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
+	key, addr := tests.GenerateKeyAndAddr(t)
+	path := filepath.Join(t.TempDir(), "journal.rlp")
+
+	startPool := func() *TxPool {
+		pool, err := newTestPool()
+		require.NoError(t, err)
+
+		pool.SetSigner(poolSigner)
+		pool.journal = newJournal(path)
+		pool.rejournalInterval = time.Hour
+		pool.journalStopCh = make(chan struct{})
+		pool.Start()
+
+		return pool
+	}
+
+	waitForPromoted := func(pool *TxPool, count uint64) {
+		require.Eventually(t, func() bool {
+			account := pool.accounts.get(addr)
+			if account == nil {
+				return false
+			}
+
+			account.promoted.lock(false)
+			defer account.promoted.unlock()
+
+			return account.promoted.length() == count
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	pool := startPool()
+
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx, err := poolSigner.SignTx(newTx(addr, nonce, 1), key)
+		require.NoError(t, err)
+		require.NoError(t, pool.AddTx(tx))
+	}
+
+	waitForPromoted(pool, 2)
+	pool.Close()
+
+	// the restarted pool replays the local transactions
+	pool = startPool()
+	defer pool.Close()
+
+	waitForPromoted(pool, 2)
+	assert.True(t, pool.accounts.get(addr).hasLocal(&pool.index))
+}
@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"sync"
+	"time"
 
 	"github.com/plingatech/go-plgchain/types"
 )
@@ -10,6 +11,20 @@ import (
 type lookupMap struct {
 	sync.RWMutex
 	all map[types.Hash]*types.Transaction
+
+	// arrival time of each transaction
+	added map[types.Hash]time.Time
+
+	// transactions submitted through the local endpoints
+	local map[types.Hash]struct{}
+}
+
+func newLookupMap() lookupMap {
+	return lookupMap{
+		all:   make(map[types.Hash]*types.Transaction),
+		added: make(map[types.Hash]time.Time),
+		local: make(map[types.Hash]struct{}),
+	}
 }
 
 // add inserts the given transaction into the map. Returns false
@@ -23,6 +38,7 @@ func (m *lookupMap) add(tx *types.Transaction) bool {
 	}
 
 	m.all[tx.Hash] = tx
+	m.added[tx.Hash] = time.Now()
 
 	return true
 }
@@ -34,6 +50,8 @@ func (m *lookupMap) remove(txs ...*types.Transaction) {
 
 	for _, tx := range txs {
 		delete(m.all, tx.Hash)
+		delete(m.added, tx.Hash)
+		delete(m.local, tx.Hash)
 	}
 }
 
@@ -49,3 +67,36 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// addedAt returns the time the transaction associated with the given hash
+// was added to the map. [thread-safe]
+func (m *lookupMap) addedAt(hash types.Hash) (time.Time, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	added, ok := m.added[hash]
+
+	return added, ok
+}
+
+// markLocal flags the transaction associated with the given hash as local,
+// if it is in the map. [thread-safe]
+func (m *lookupMap) markLocal(hash types.Hash) {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.all[hash]; ok {
+		m.local[hash] = struct{}{}
+	}
+}
+
+// isLocal returns true if the transaction associated with the given hash
+// was submitted through the local endpoints. [thread-safe]
+func (m *lookupMap) isLocal(hash types.Hash) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	_, ok := m.local[hash]
+
+	return ok
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/plingatech/go-plgchain/contracts"
+	"github.com/plingatech/go-plgchain/state/runtime/allowlist"
 	"github.com/plingatech/go-plgchain/types"
 )
 
@@ -41,6 +43,37 @@ func (m defaultMockStore) GetBalance(types.Hash, types.Address) (*big.Int, error
 	return balance, nil
 }
 
+func (m defaultMockStore) GetStorage(types.Hash, types.Address, types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
+// blockListMockStore marks the given addresses as blocked in the block list
+type blockListMockStore struct {
+	defaultMockStore
+	blocked map[types.Address]bool
+}
+
+func (m blockListMockStore) GetStorage(_ types.Hash, addr types.Address, slot types.Hash) types.Hash {
+	if addr == contracts.BlockListTransactionsAddr && m.blocked[types.BytesToAddress(slot.Bytes())] {
+		return types.Hash(allowlist.EnabledRole)
+	}
+
+	return types.ZeroHash
+}
+
+type balanceMockStore struct {
+	defaultMockStore
+	balances map[types.Address]*big.Int
+}
+
+func (m balanceMockStore) GetBalance(_ types.Hash, addr types.Address) (*big.Int, error) {
+	if balance, ok := m.balances[addr]; ok {
+		return balance, nil
+	}
+
+	return big.NewInt(0), nil
+}
+
 type faultyMockStore struct {
 }
 
@@ -60,9 +93,17 @@ func (fms faultyMockStore) GetBalance(root types.Hash, addr types.Address) (*big
 	return nil, fmt.Errorf("unable to fetch account state")
 }
 
+func (fms faultyMockStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
 type mockSigner struct {
 }
 
 func (s *mockSigner) Sender(tx *types.Transaction) (types.Address, error) {
 	return tx.From, nil
 }
+
+func (s *mockSigner) FeePayer(tx *types.Transaction) (types.Address, error) {
+	return tx.FeePayer, nil
+}
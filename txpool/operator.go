@@ -82,3 +82,75 @@ func (p *TxPool) Subscribe(
 		}
 	}
 }
+
+// DropTxn removes the transaction from the pool, together with
+// the transactions of the same account having a higher nonce
+func (p *TxPool) DropTxn(ctx context.Context, req *proto.DropTxnReq) (*proto.DropTxnResp, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	dropped, err := p.dropTx(types.StringToHash(req.Hash))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DropTxnResp{
+		TxHashes: toHashStrings(dropped),
+	}, nil
+}
+
+// ClearAccount removes all the transactions of the account from the pool
+func (p *TxPool) ClearAccount(ctx context.Context, req *proto.ClearAccountReq) (*proto.ClearAccountResp, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	dropped := p.clearAccount(types.StringToAddress(req.Address))
+
+	return &proto.ClearAccountResp{
+		TxHashes: toHashStrings(dropped),
+	}, nil
+}
+
+// Rebroadcast gossips the pending transactions to the network again
+func (p *TxPool) Rebroadcast(ctx context.Context, req *empty.Empty) (*proto.RebroadcastResp, error) {
+	return &proto.RebroadcastResp{
+		Count: uint64(p.rebroadcast()),
+	}, nil
+}
+
+// ContentFrom returns the transactions of the account in the pool
+func (p *TxPool) ContentFrom(ctx context.Context, req *proto.ContentFromReq) (*proto.ContentFromResp, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	pending, queued := p.GetAccountTxs(types.StringToAddress(req.Address))
+
+	resp := &proto.ContentFromResp{
+		Pending: make([][]byte, len(pending)),
+		Queued:  make([][]byte, len(queued)),
+	}
+
+	for i, tx := range pending {
+		resp.Pending[i] = tx.MarshalRLP()
+	}
+
+	for i, tx := range queued {
+		resp.Queued[i] = tx.MarshalRLP()
+	}
+
+	return resp, nil
+}
+
+// toHashStrings returns the hex encoded hashes of the transactions
+func toHashStrings(txs []*types.Transaction) []string {
+	hashes := make([]string, len(txs))
+
+	for i, tx := range txs {
+		hashes[i] = tx.Hash.String()
+	}
+
+	return hashes
+}
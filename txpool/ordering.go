@@ -0,0 +1,270 @@
+package txpool
+
+import (
+	"container/heap"
+	"fmt"
+	"time"
+
+	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/types"
+)
+
+// executablesQueue orders the executable transactions, the lowest nonce
+// transaction of each account, for inclusion in a block.
+// After a transaction is included, the next one of the same account is pushed.
+type executablesQueue interface {
+	clear()
+	push(tx *types.Transaction)
+	pop() *types.Transaction
+	length() uint64
+}
+
+// newExecutablesQueue returns the queue of the ordering strategy selected in the chain config.
+// Transactions are ordered by gas price if no strategy is selected.
+func newExecutablesQueue(
+	config *chain.TxOrderingConfig,
+	addedAt func(types.Hash) (time.Time, bool),
+) (executablesQueue, error) {
+	if config == nil {
+		return newPricedQueue(), nil
+	}
+
+	if config.ReservedGasShare > 0 && config.Strategy != chain.PriorityTxOrdering {
+		return nil, fmt.Errorf("reserved gas share is only supported by the '%s' strategy", chain.PriorityTxOrdering)
+	}
+
+	if config.ReservedGasShare > 100 {
+		return nil, fmt.Errorf("reserved gas share must be at most 100, got %d", config.ReservedGasShare)
+	}
+
+	switch config.Strategy {
+	case "", chain.PriceTxOrdering:
+		return newPricedQueue(), nil
+	case chain.FIFOTxOrdering:
+		return newFIFOQueue(addedAt), nil
+	case chain.RoundRobinTxOrdering:
+		return newRoundRobinQueue(), nil
+	case chain.PriorityTxOrdering:
+		return newPriorityQueue(config.PrioritySenders), nil
+	default:
+		return nil, fmt.Errorf("unknown transaction ordering strategy '%s'", config.Strategy)
+	}
+}
+
+// orderedQueue is a heap of transactions ordered by the given function
+type orderedQueue struct {
+	txs  []*types.Transaction
+	less func(a, b *types.Transaction) bool
+}
+
+func (q *orderedQueue) Len() int { return len(q.txs) }
+
+func (q *orderedQueue) Swap(i, j int) { q.txs[i], q.txs[j] = q.txs[j], q.txs[i] }
+
+func (q *orderedQueue) Less(i, j int) bool { return q.less(q.txs[i], q.txs[j]) }
+
+func (q *orderedQueue) Push(x interface{}) {
+	tx, ok := x.(*types.Transaction)
+	if !ok {
+		return
+	}
+
+	q.txs = append(q.txs, tx)
+}
+
+func (q *orderedQueue) Pop() interface{} {
+	n := len(q.txs)
+	tx := q.txs[n-1]
+	q.txs[n-1] = nil
+	q.txs = q.txs[:n-1]
+
+	return tx
+}
+
+func (q *orderedQueue) clear() {
+	q.txs = q.txs[:0]
+}
+
+func (q *orderedQueue) push(tx *types.Transaction) {
+	heap.Push(q, tx)
+}
+
+func (q *orderedQueue) pop() *types.Transaction {
+	if q.Len() == 0 {
+		return nil
+	}
+
+	tx, _ := heap.Pop(q).(*types.Transaction)
+
+	return tx
+}
+
+func (q *orderedQueue) length() uint64 {
+	return uint64(q.Len())
+}
+
+// higherPrice orders the transactions by gas price (descending)
+func higherPrice(a, b *types.Transaction) bool {
+	return a.GasPrice.Cmp(b.GasPrice) > 0
+}
+
+// newFIFOQueue orders the transactions by the time they were added to the pool.
+// The gas price only breaks the ties.
+func newFIFOQueue(addedAt func(types.Hash) (time.Time, bool)) *orderedQueue {
+	arrival := func(tx *types.Transaction) time.Time {
+		added, _ := addedAt(tx.Hash)
+
+		return added
+	}
+
+	return &orderedQueue{
+		less: func(a, b *types.Transaction) bool {
+			addedA, addedB := arrival(a), arrival(b)
+			if !addedA.Equal(addedB) {
+				return addedA.Before(addedB)
+			}
+
+			return higherPrice(a, b)
+		},
+	}
+}
+
+// roundRobinQueue takes one transaction of each sender per round,
+// so a single account can't fill the block. Within a round,
+// the transactions are ordered by gas price.
+type roundRobinQueue struct {
+	*orderedQueue
+
+	// rounds keeps the number of pushed transactions per sender
+	rounds map[types.Address]uint64
+
+	// round of each queued transaction
+	txRounds map[*types.Transaction]uint64
+}
+
+func newRoundRobinQueue() *roundRobinQueue {
+	q := &roundRobinQueue{
+		rounds:   make(map[types.Address]uint64),
+		txRounds: make(map[*types.Transaction]uint64),
+	}
+
+	q.orderedQueue = &orderedQueue{
+		less: func(a, b *types.Transaction) bool {
+			if roundA, roundB := q.txRounds[a], q.txRounds[b]; roundA != roundB {
+				return roundA < roundB
+			}
+
+			return higherPrice(a, b)
+		},
+	}
+
+	return q
+}
+
+func (q *roundRobinQueue) clear() {
+	q.orderedQueue.clear()
+
+	q.rounds = make(map[types.Address]uint64)
+	q.txRounds = make(map[*types.Transaction]uint64)
+}
+
+func (q *roundRobinQueue) push(tx *types.Transaction) {
+	q.txRounds[tx] = q.rounds[tx.From]
+	q.rounds[tx.From]++
+
+	q.orderedQueue.push(tx)
+}
+
+func (q *roundRobinQueue) pop() *types.Transaction {
+	tx := q.orderedQueue.pop()
+	if tx != nil {
+		delete(q.txRounds, tx)
+	}
+
+	return tx
+}
+
+// newPriorityQueue orders the transactions of the priority senders first.
+// Otherwise, the transactions are ordered by gas price.
+// The blockspace reserved for the priority senders is enforced
+// by the block builders, see GasReservation.
+func newPriorityQueue(senders []types.Address) *orderedQueue {
+	priority := make(map[types.Address]struct{}, len(senders))
+	for _, addr := range senders {
+		priority[addr] = struct{}{}
+	}
+
+	return &orderedQueue{
+		less: func(a, b *types.Transaction) bool {
+			_, priorityA := priority[a.From]
+			_, priorityB := priority[b.From]
+
+			if priorityA != priorityB {
+				return priorityA
+			}
+
+			return higherPrice(a, b)
+		},
+	}
+}
+
+// GasReservation keeps the share of the block gas limit reserved
+// for the priority senders while a block is being filled.
+// A nil reservation allows every transaction.
+type GasReservation struct {
+	priority map[types.Address]struct{}
+
+	// available is the gas left to the other senders
+	available uint64
+}
+
+// NewGasReservation returns the reservation of the block with the given gas limit,
+// or nil if the ordering config doesn't reserve any gas.
+func NewGasReservation(config *chain.TxOrderingConfig, gasLimit uint64) *GasReservation {
+	if config == nil || config.Strategy != chain.PriorityTxOrdering || config.ReservedGasShare == 0 {
+		return nil
+	}
+
+	priority := make(map[types.Address]struct{}, len(config.PrioritySenders))
+	for _, addr := range config.PrioritySenders {
+		priority[addr] = struct{}{}
+	}
+
+	// split to not overflow on the large gas limits, the share is at most 100
+	reserved := gasLimit/100*config.ReservedGasShare + gasLimit%100*config.ReservedGasShare/100
+	if reserved > gasLimit {
+		reserved = gasLimit
+	}
+
+	return &GasReservation{
+		priority:  priority,
+		available: gasLimit - reserved,
+	}
+}
+
+// Allows checks if the transaction fits in the block without taking the reserved gas.
+// The transactions of the priority senders are always allowed.
+func (r *GasReservation) Allows(tx *types.Transaction) bool {
+	if r == nil {
+		return true
+	}
+
+	if _, ok := r.priority[tx.From]; ok {
+		return true
+	}
+
+	return tx.Gas <= r.available
+}
+
+// Use accounts the gas limit of the included transaction.
+func (r *GasReservation) Use(tx *types.Transaction) {
+	if r == nil {
+		return
+	}
+
+	if _, ok := r.priority[tx.From]; ok {
+		return
+	}
+
+	r.available -= tx.Gas
+}
@@ -0,0 +1,264 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/plingatech/go-plgchain/chain"
+	"github.com/plingatech/go-plgchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutablesQueue(t *testing.T) {
+	t.Parallel()
+
+	newOrderedTx := func(addr types.Address, nonce, price uint64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(price)
+		tx.ComputeHash()
+
+		return tx
+	}
+
+	popAll := func(q executablesQueue) (txs []*types.Transaction) {
+		for tx := q.pop(); tx != nil; tx = q.pop() {
+			txs = append(txs, tx)
+		}
+
+		return
+	}
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newExecutablesQueue(&chain.TxOrderingConfig{Strategy: "random"}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid reserved gas share", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newExecutablesQueue(&chain.TxOrderingConfig{
+			Strategy:         chain.PriceTxOrdering,
+			ReservedGasShare: 10,
+		}, nil)
+		assert.Error(t, err)
+
+		_, err = newExecutablesQueue(&chain.TxOrderingConfig{
+			Strategy:         chain.PriorityTxOrdering,
+			ReservedGasShare: 101,
+		}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("price", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := newExecutablesQueue(&chain.TxOrderingConfig{Strategy: chain.PriceTxOrdering}, nil)
+		require.NoError(t, err)
+
+		cheap, expensive := newOrderedTx(addr1, 0, 1), newOrderedTx(addr2, 0, 10)
+		q.push(cheap)
+		q.push(expensive)
+
+		assert.Equal(t, []*types.Transaction{expensive, cheap}, popAll(q))
+	})
+
+	t.Run("fifo", func(t *testing.T) {
+		t.Parallel()
+
+		first, second := newOrderedTx(addr1, 0, 1), newOrderedTx(addr2, 0, 10)
+		added := map[types.Hash]time.Time{
+			first.Hash:  time.Now().Add(-time.Minute),
+			second.Hash: time.Now(),
+		}
+
+		q, err := newExecutablesQueue(
+			&chain.TxOrderingConfig{Strategy: chain.FIFOTxOrdering},
+			func(hash types.Hash) (time.Time, bool) {
+				addedAt, ok := added[hash]
+
+				return addedAt, ok
+			},
+		)
+		require.NoError(t, err)
+
+		q.push(second)
+		q.push(first)
+
+		assert.Equal(t, []*types.Transaction{first, second}, popAll(q))
+	})
+
+	t.Run("round-robin", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := newExecutablesQueue(&chain.TxOrderingConfig{Strategy: chain.RoundRobinTxOrdering}, nil)
+		require.NoError(t, err)
+
+		// addr1 pays more, but can't take the block space of addr2
+		txs1 := []*types.Transaction{newOrderedTx(addr1, 0, 10), newOrderedTx(addr1, 1, 10)}
+		txs2 := []*types.Transaction{newOrderedTx(addr2, 0, 1), newOrderedTx(addr2, 1, 1)}
+
+		q.push(txs1[0])
+		q.push(txs2[0])
+
+		// the next transaction of the account is pushed once the previous one is included
+		order := []*types.Transaction{}
+		next := map[*types.Transaction]*types.Transaction{txs1[0]: txs1[1], txs2[0]: txs2[1]}
+
+		for tx := q.pop(); tx != nil; tx = q.pop() {
+			order = append(order, tx)
+
+			if nextTx, ok := next[tx]; ok {
+				q.push(nextTx)
+			}
+		}
+
+		assert.Equal(t, []*types.Transaction{txs1[0], txs2[0], txs1[1], txs2[1]}, order)
+
+		// a new block starts from the first round
+		q.clear()
+		q.push(txs2[1])
+		q.push(txs1[1])
+
+		assert.Equal(t, []*types.Transaction{txs1[1], txs2[1]}, popAll(q))
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := newExecutablesQueue(&chain.TxOrderingConfig{
+			Strategy:        chain.PriorityTxOrdering,
+			PrioritySenders: []types.Address{addr3},
+		}, nil)
+		require.NoError(t, err)
+
+		cheap, expensive := newOrderedTx(addr1, 0, 1), newOrderedTx(addr2, 0, 10)
+		priority := newOrderedTx(addr3, 0, 1)
+		q.push(cheap)
+		q.push(expensive)
+		q.push(priority)
+
+		assert.Equal(t, []*types.Transaction{priority, expensive, cheap}, popAll(q))
+	})
+}
+
+func TestTxOrderingConfig(t *testing.T) {
+	t.Parallel()
+
+	pool, err := NewTxPool(
+		hclog.NewNullLogger(),
+		forks,
+		defaultMockStore{DefaultHeader: mockHeader},
+		nil,
+		nil,
+		&Config{
+			PriceLimit: defaultPriceLimit,
+			MaxSlots:   defaultMaxSlots,
+			TxOrdering: &chain.TxOrderingConfig{Strategy: chain.RoundRobinTxOrdering},
+		},
+	)
+	require.NoError(t, err)
+	assert.IsType(t, &roundRobinQueue{}, pool.executables)
+
+	_, err = NewTxPool(
+		hclog.NewNullLogger(),
+		forks,
+		defaultMockStore{DefaultHeader: mockHeader},
+		nil,
+		nil,
+		&Config{
+			TxOrdering: &chain.TxOrderingConfig{Strategy: "random"},
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestGasReservation(t *testing.T) {
+	t.Parallel()
+
+	newGasTx := func(addr types.Address, gas uint64) *types.Transaction {
+		tx := newTx(addr, 0, 1)
+		tx.Gas = gas
+
+		return tx
+	}
+
+	// nothing is reserved if the priority strategy isn't selected
+	assert.Nil(t, NewGasReservation(nil, 1000))
+	assert.Nil(t, NewGasReservation(&chain.TxOrderingConfig{Strategy: chain.PriceTxOrdering}, 1000))
+	assert.Nil(t, NewGasReservation(&chain.TxOrderingConfig{Strategy: chain.PriorityTxOrdering}, 1000))
+
+	var disabled *GasReservation
+	assert.True(t, disabled.Allows(newGasTx(addr1, 1000)))
+	disabled.Use(newGasTx(addr1, 1000))
+
+	reservation := NewGasReservation(&chain.TxOrderingConfig{
+		Strategy:         chain.PriorityTxOrdering,
+		PrioritySenders:  []types.Address{addr3},
+		ReservedGasShare: 30,
+	}, 1000)
+	require.NotNil(t, reservation)
+
+	// the other senders can use 700 gas
+	tx := newGasTx(addr1, 600)
+	require.True(t, reservation.Allows(tx))
+	reservation.Use(tx)
+
+	assert.False(t, reservation.Allows(newGasTx(addr2, 101)))
+	assert.True(t, reservation.Allows(newGasTx(addr2, 100)))
+
+	// the priority senders can use the whole block
+	priority := newGasTx(addr3, 1000)
+	assert.True(t, reservation.Allows(priority))
+	reservation.Use(priority)
+	assert.True(t, reservation.Allows(newGasTx(addr2, 100)))
+
+	// the whole block reserved
+	reservation = NewGasReservation(&chain.TxOrderingConfig{
+		Strategy:         chain.PriorityTxOrdering,
+		PrioritySenders:  []types.Address{addr3},
+		ReservedGasShare: 100,
+	}, 1000)
+	assert.False(t, reservation.Allows(newGasTx(addr1, 1)))
+}
+
+func TestRoundRobinOrdering_Blocks(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.executables = newRoundRobinQueue()
+	pool.SetSigner(&mockSigner{})
+
+	addAndPromote := func(addr types.Address, price uint64) *types.Transaction {
+		tx := newTx(addr, 0, 1)
+		tx.GasPrice = new(big.Int).SetUint64(price)
+		tx.ComputeHash()
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, tx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		return tx
+	}
+
+	expensive := addAndPromote(addr1, 10)
+
+	// the first block drains the executables queue
+	pool.Prepare()
+	assert.Equal(t, expensive, pool.Peek())
+	assert.Nil(t, pool.Peek())
+
+	cheap := addAndPromote(addr2, 1)
+
+	// the next block starts from the first round for every sender
+	pool.Prepare()
+	assert.Equal(t, expensive, pool.Peek())
+	assert.Equal(t, cheap, pool.Peek())
+}
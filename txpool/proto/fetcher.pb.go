@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: txpool/proto/fetcher.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TxnAnnouncement is gossiped instead of the large transactions
+type TxnAnnouncement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hashes of the announced transactions
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *TxnAnnouncement) Reset() {
+	*x = TxnAnnouncement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_fetcher_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnAnnouncement) ProtoMessage() {}
+
+func (x *TxnAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_fetcher_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnAnnouncement.ProtoReflect.Descriptor instead.
+func (*TxnAnnouncement) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_fetcher_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TxnAnnouncement) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// GetTxnsRequest is a request for GetTxns
+type GetTxnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hashes of the requested transactions
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *GetTxnsRequest) Reset() {
+	*x = GetTxnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_fetcher_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxnsRequest) ProtoMessage() {}
+
+func (x *GetTxnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_fetcher_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxnsRequest.ProtoReflect.Descriptor instead.
+func (*GetTxnsRequest) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_fetcher_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTxnsRequest) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// GetTxnsResponse contains the requested transactions
+type GetTxnsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RLP encoded transactions
+	Txns [][]byte `protobuf:"bytes,1,rep,name=txns,proto3" json:"txns,omitempty"`
+}
+
+func (x *GetTxnsResponse) Reset() {
+	*x = GetTxnsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_fetcher_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxnsResponse) ProtoMessage() {}
+
+func (x *GetTxnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_fetcher_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxnsResponse.ProtoReflect.Descriptor instead.
+func (*GetTxnsResponse) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_fetcher_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTxnsResponse) GetTxns() [][]byte {
+	if x != nil {
+		return x.Txns
+	}
+	return nil
+}
+
+var File_txpool_proto_fetcher_proto protoreflect.FileDescriptor
+
+var file_txpool_proto_fetcher_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31,
+	0x22, 0x29, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x54, 0x78, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x6e, 0x73, 0x32, 0x40, 0x0a, 0x0a,
+	0x54, 0x78, 0x6e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x54, 0x78, 0x6e, 0x73, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x78, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f,
+	0x5a, 0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_txpool_proto_fetcher_proto_rawDescOnce sync.Once
+	file_txpool_proto_fetcher_proto_rawDescData = file_txpool_proto_fetcher_proto_rawDesc
+)
+
+func file_txpool_proto_fetcher_proto_rawDescGZIP() []byte {
+	file_txpool_proto_fetcher_proto_rawDescOnce.Do(func() {
+		file_txpool_proto_fetcher_proto_rawDescData = protoimpl.X.CompressGZIP(file_txpool_proto_fetcher_proto_rawDescData)
+	})
+	return file_txpool_proto_fetcher_proto_rawDescData
+}
+
+var file_txpool_proto_fetcher_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_txpool_proto_fetcher_proto_goTypes = []interface{}{
+	(*TxnAnnouncement)(nil), // 0: v1.TxnAnnouncement
+	(*GetTxnsRequest)(nil),  // 1: v1.GetTxnsRequest
+	(*GetTxnsResponse)(nil), // 2: v1.GetTxnsResponse
+}
+var file_txpool_proto_fetcher_proto_depIdxs = []int32{
+	1, // 0: v1.TxnFetcher.GetTxns:input_type -> v1.GetTxnsRequest
+	2, // 1: v1.TxnFetcher.GetTxns:output_type -> v1.GetTxnsResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_txpool_proto_fetcher_proto_init() }
+func file_txpool_proto_fetcher_proto_init() {
+	if File_txpool_proto_fetcher_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_txpool_proto_fetcher_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_fetcher_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxnsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_fetcher_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxnsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_txpool_proto_fetcher_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_txpool_proto_fetcher_proto_goTypes,
+		DependencyIndexes: file_txpool_proto_fetcher_proto_depIdxs,
+		MessageInfos:      file_txpool_proto_fetcher_proto_msgTypes,
+	}.Build()
+	File_txpool_proto_fetcher_proto = out.File
+	file_txpool_proto_fetcher_proto_rawDesc = nil
+	file_txpool_proto_fetcher_proto_goTypes = nil
+	file_txpool_proto_fetcher_proto_depIdxs = nil
+}
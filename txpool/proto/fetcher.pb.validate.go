@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: txpool/proto/fetcher.proto
+
+package proto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TxnAnnouncement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TxnAnnouncement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxnAnnouncement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxnAnnouncementMultiError, or
+// nil if none found.
+func (m *TxnAnnouncement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxnAnnouncement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TxnAnnouncementMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxnAnnouncementMultiError is an error wrapping multiple validation errors
+// returned by TxnAnnouncement.ValidateAll() if the designated constraints
+// aren't met.
+type TxnAnnouncementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxnAnnouncementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxnAnnouncementMultiError) AllErrors() []error { return m }
+
+// TxnAnnouncementValidationError is the validation error returned by
+// TxnAnnouncement.Validate if the designated constraints aren't met.
+type TxnAnnouncementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxnAnnouncementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxnAnnouncementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxnAnnouncementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxnAnnouncementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxnAnnouncementValidationError) ErrorName() string { return "TxnAnnouncementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxnAnnouncementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxnAnnouncement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxnAnnouncementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxnAnnouncementValidationError{}
+
+// Validate checks the field values on GetTxnsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTxnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTxnsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTxnsRequestMultiError, or
+// nil if none found.
+func (m *GetTxnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTxnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTxnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTxnsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTxnsRequest.ValidateAll() if the designated constraints aren't
+// met.
+type GetTxnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTxnsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTxnsRequestMultiError) AllErrors() []error { return m }
+
+// GetTxnsRequestValidationError is the validation error returned by
+// GetTxnsRequest.Validate if the designated constraints aren't met.
+type GetTxnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTxnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTxnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTxnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTxnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTxnsRequestValidationError) ErrorName() string { return "GetTxnsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTxnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTxnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTxnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTxnsRequestValidationError{}
+
+// Validate checks the field values on GetTxnsResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTxnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTxnsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTxnsResponseMultiError, or
+// nil if none found.
+func (m *GetTxnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTxnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTxnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTxnsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTxnsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTxnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTxnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTxnsResponseMultiError) AllErrors() []error { return m }
+
+// GetTxnsResponseValidationError is the validation error returned by
+// GetTxnsResponse.Validate if the designated constraints aren't met.
+type GetTxnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTxnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTxnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTxnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTxnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTxnsResponseValidationError) ErrorName() string { return "GetTxnsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTxnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTxnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTxnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTxnsResponseValidationError{}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: txpool/proto/fetcher.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TxnFetcherClient is the client API for TxnFetcher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxnFetcherClient interface {
+	// Returns the known transactions among the requested ones
+	GetTxns(ctx context.Context, in *GetTxnsRequest, opts ...grpc.CallOption) (*GetTxnsResponse, error)
+}
+
+type txnFetcherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxnFetcherClient(cc grpc.ClientConnInterface) TxnFetcherClient {
+	return &txnFetcherClient{cc}
+}
+
+func (c *txnFetcherClient) GetTxns(ctx context.Context, in *GetTxnsRequest, opts ...grpc.CallOption) (*GetTxnsResponse, error) {
+	out := new(GetTxnsResponse)
+	err := c.cc.Invoke(ctx, "/v1.TxnFetcher/GetTxns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxnFetcherServer is the server API for TxnFetcher service.
+// All implementations must embed UnimplementedTxnFetcherServer
+// for forward compatibility
+type TxnFetcherServer interface {
+	// Returns the known transactions among the requested ones
+	GetTxns(context.Context, *GetTxnsRequest) (*GetTxnsResponse, error)
+	mustEmbedUnimplementedTxnFetcherServer()
+}
+
+// UnimplementedTxnFetcherServer must be embedded to have forward compatible implementations.
+type UnimplementedTxnFetcherServer struct {
+}
+
+func (UnimplementedTxnFetcherServer) GetTxns(context.Context, *GetTxnsRequest) (*GetTxnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxns not implemented")
+}
+func (UnimplementedTxnFetcherServer) mustEmbedUnimplementedTxnFetcherServer() {}
+
+// UnsafeTxnFetcherServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxnFetcherServer will
+// result in compilation errors.
+type UnsafeTxnFetcherServer interface {
+	mustEmbedUnimplementedTxnFetcherServer()
+}
+
+func RegisterTxnFetcherServer(s grpc.ServiceRegistrar, srv TxnFetcherServer) {
+	s.RegisterService(&TxnFetcher_ServiceDesc, srv)
+}
+
+func _TxnFetcher_GetTxns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnFetcherServer).GetTxns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnFetcher/GetTxns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnFetcherServer).GetTxns(ctx, req.(*GetTxnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TxnFetcher_ServiceDesc is the grpc.ServiceDesc for TxnFetcher service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TxnFetcher_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.TxnFetcher",
+	HandlerType: (*TxnFetcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTxns",
+			Handler:    _TxnFetcher_GetTxns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "txpool/proto/fetcher.proto",
+}
@@ -40,6 +40,12 @@ const (
 	EventType_PRUNED_PROMOTED EventType = 5
 	// For pruned enqueued transactions
 	EventType_PRUNED_ENQUEUED EventType = 6
+	// For transactions replaced by a transaction with the same nonce and a higher gas price
+	EventType_REPLACED EventType = 7
+	// For transactions evicted to make room for better priced transactions
+	EventType_EVICTED EventType = 8
+	// For transactions removed after their lifetime in the pool passed
+	EventType_EXPIRED EventType = 9
 )
 
 // Enum value maps for EventType.
@@ -52,6 +58,9 @@ var (
 		4: "DEMOTED",
 		5: "PRUNED_PROMOTED",
 		6: "PRUNED_ENQUEUED",
+		7: "REPLACED",
+		8: "EVICTED",
+		9: "EXPIRED",
 	}
 	EventType_value = map[string]int32{
 		"ADDED":           0,
@@ -61,6 +70,9 @@ var (
 		"DEMOTED":         4,
 		"PRUNED_PROMOTED": 5,
 		"PRUNED_ENQUEUED": 6,
+		"REPLACED":        7,
+		"EVICTED":         8,
+		"EXPIRED":         9,
 	}
 )
 
@@ -240,6 +252,348 @@ func (x *TxnPoolStatusResp) GetLength() uint64 {
 	return 0
 }
 
+type DropTxnReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *DropTxnReq) Reset() {
+	*x = DropTxnReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropTxnReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropTxnReq) ProtoMessage() {}
+
+func (x *DropTxnReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropTxnReq.ProtoReflect.Descriptor instead.
+func (*DropTxnReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DropTxnReq) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type DropTxnResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hashes of the removed transactions
+	TxHashes []string `protobuf:"bytes,1,rep,name=txHashes,proto3" json:"txHashes,omitempty"`
+}
+
+func (x *DropTxnResp) Reset() {
+	*x = DropTxnResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropTxnResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropTxnResp) ProtoMessage() {}
+
+func (x *DropTxnResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropTxnResp.ProtoReflect.Descriptor instead.
+func (*DropTxnResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DropTxnResp) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+type ClearAccountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ClearAccountReq) Reset() {
+	*x = ClearAccountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAccountReq) ProtoMessage() {}
+
+func (x *ClearAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAccountReq.ProtoReflect.Descriptor instead.
+func (*ClearAccountReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClearAccountReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ClearAccountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hashes of the removed transactions
+	TxHashes []string `protobuf:"bytes,1,rep,name=txHashes,proto3" json:"txHashes,omitempty"`
+}
+
+func (x *ClearAccountResp) Reset() {
+	*x = ClearAccountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearAccountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAccountResp) ProtoMessage() {}
+
+func (x *ClearAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAccountResp.ProtoReflect.Descriptor instead.
+func (*ClearAccountResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClearAccountResp) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+type RebroadcastResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the gossiped transactions
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RebroadcastResp) Reset() {
+	*x = RebroadcastResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebroadcastResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebroadcastResp) ProtoMessage() {}
+
+func (x *RebroadcastResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebroadcastResp.ProtoReflect.Descriptor instead.
+func (*RebroadcastResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RebroadcastResp) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ContentFromReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ContentFromReq) Reset() {
+	*x = ContentFromReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentFromReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentFromReq) ProtoMessage() {}
+
+func (x *ContentFromReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentFromReq.ProtoReflect.Descriptor instead.
+func (*ContentFromReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ContentFromReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ContentFromResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RLP encoded pending transactions, sorted by nonce
+	Pending [][]byte `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	// RLP encoded queued transactions, sorted by nonce
+	Queued [][]byte `protobuf:"bytes,2,rep,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (x *ContentFromResp) Reset() {
+	*x = ContentFromResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentFromResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentFromResp) ProtoMessage() {}
+
+func (x *ContentFromResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentFromResp.ProtoReflect.Descriptor instead.
+func (*ContentFromResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ContentFromResp) GetPending() [][]byte {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *ContentFromResp) GetQueued() [][]byte {
+	if x != nil {
+		return x.Queued
+	}
+	return nil
+}
+
 type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -252,7 +606,7 @@ type SubscribeRequest struct {
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_txpool_proto_operator_proto_msgTypes[3]
+		mi := &file_txpool_proto_operator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -265,7 +619,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_txpool_proto_operator_proto_msgTypes[3]
+	mi := &file_txpool_proto_operator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +632,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{3}
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SubscribeRequest) GetTypes() []EventType {
@@ -300,7 +654,7 @@ type TxPoolEvent struct {
 func (x *TxPoolEvent) Reset() {
 	*x = TxPoolEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_txpool_proto_operator_proto_msgTypes[4]
+		mi := &file_txpool_proto_operator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -313,7 +667,7 @@ func (x *TxPoolEvent) String() string {
 func (*TxPoolEvent) ProtoMessage() {}
 
 func (x *TxPoolEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_txpool_proto_operator_proto_msgTypes[4]
+	mi := &file_txpool_proto_operator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +680,7 @@ func (x *TxPoolEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxPoolEvent.ProtoReflect.Descriptor instead.
 func (*TxPoolEvent) Descriptor() ([]byte, []int) {
-	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{4}
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TxPoolEvent) GetType() EventType {
@@ -365,36 +719,78 @@ var file_txpool_proto_operator_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x2b, 0x0a, 0x11, 0x54, 0x78,
 	0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
 	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x4a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01,
-	0x0b, 0x08, 0x01, 0x18, 0x01, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0b, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x2a, 0x76, 0x0a,
-	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44,
-	0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b,
-	0x0a, 0x07, 0x44, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50,
-	0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x05,
-	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x44, 0x10, 0x06, 0x32, 0xa9, 0x01, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f,
-	0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x12, 0x0d, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
-	0x01, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3c, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54,
+	0x78, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x30, 0x78, 0x5b,
+	0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x29, 0x0a, 0x0b, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x78, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x22, 0x47, 0x0a, 0x0f, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x30, 0x78,
+	0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x30, 0x7d, 0x24,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2e, 0x0a, 0x10, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x52, 0x65, 0x62,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x46, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x30,
+	0x78, 0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x30, 0x7d,
+	0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22,
+	0x4a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x18, 0x01, 0x08, 0x01, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0b, 0x54,
+	0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x2a, 0x9e, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52,
+	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4d, 0x4f, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x50,
+	0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55,
+	0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x09, 0x32, 0x84, 0x03, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x12, 0x0d, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x2a, 0x0a, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x78, 0x6e, 0x12, 0x0e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39,
+	0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x13,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x62,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x5a,
+	0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -410,32 +806,47 @@ func file_txpool_proto_operator_proto_rawDescGZIP() []byte {
 }
 
 var file_txpool_proto_operator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_txpool_proto_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_txpool_proto_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_txpool_proto_operator_proto_goTypes = []interface{}{
 	(EventType)(0),            // 0: v1.EventType
 	(*AddTxnReq)(nil),         // 1: v1.AddTxnReq
 	(*AddTxnResp)(nil),        // 2: v1.AddTxnResp
 	(*TxnPoolStatusResp)(nil), // 3: v1.TxnPoolStatusResp
-	(*SubscribeRequest)(nil),  // 4: v1.SubscribeRequest
-	(*TxPoolEvent)(nil),       // 5: v1.TxPoolEvent
-	(*anypb.Any)(nil),         // 6: google.protobuf.Any
-	(*emptypb.Empty)(nil),     // 7: google.protobuf.Empty
+	(*DropTxnReq)(nil),        // 4: v1.DropTxnReq
+	(*DropTxnResp)(nil),       // 5: v1.DropTxnResp
+	(*ClearAccountReq)(nil),   // 6: v1.ClearAccountReq
+	(*ClearAccountResp)(nil),  // 7: v1.ClearAccountResp
+	(*RebroadcastResp)(nil),   // 8: v1.RebroadcastResp
+	(*ContentFromReq)(nil),    // 9: v1.ContentFromReq
+	(*ContentFromResp)(nil),   // 10: v1.ContentFromResp
+	(*SubscribeRequest)(nil),  // 11: v1.SubscribeRequest
+	(*TxPoolEvent)(nil),       // 12: v1.TxPoolEvent
+	(*anypb.Any)(nil),         // 13: google.protobuf.Any
+	(*emptypb.Empty)(nil),     // 14: google.protobuf.Empty
 }
 var file_txpool_proto_operator_proto_depIdxs = []int32{
-	6, // 0: v1.AddTxnReq.raw:type_name -> google.protobuf.Any
-	0, // 1: v1.SubscribeRequest.types:type_name -> v1.EventType
-	0, // 2: v1.TxPoolEvent.type:type_name -> v1.EventType
-	7, // 3: v1.TxnPoolOperator.Status:input_type -> google.protobuf.Empty
-	1, // 4: v1.TxnPoolOperator.AddTxn:input_type -> v1.AddTxnReq
-	4, // 5: v1.TxnPoolOperator.Subscribe:input_type -> v1.SubscribeRequest
-	3, // 6: v1.TxnPoolOperator.Status:output_type -> v1.TxnPoolStatusResp
-	2, // 7: v1.TxnPoolOperator.AddTxn:output_type -> v1.AddTxnResp
-	5, // 8: v1.TxnPoolOperator.Subscribe:output_type -> v1.TxPoolEvent
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	13, // 0: v1.AddTxnReq.raw:type_name -> google.protobuf.Any
+	0,  // 1: v1.SubscribeRequest.types:type_name -> v1.EventType
+	0,  // 2: v1.TxPoolEvent.type:type_name -> v1.EventType
+	14, // 3: v1.TxnPoolOperator.Status:input_type -> google.protobuf.Empty
+	1,  // 4: v1.TxnPoolOperator.AddTxn:input_type -> v1.AddTxnReq
+	11, // 5: v1.TxnPoolOperator.Subscribe:input_type -> v1.SubscribeRequest
+	4,  // 6: v1.TxnPoolOperator.DropTxn:input_type -> v1.DropTxnReq
+	6,  // 7: v1.TxnPoolOperator.ClearAccount:input_type -> v1.ClearAccountReq
+	14, // 8: v1.TxnPoolOperator.Rebroadcast:input_type -> google.protobuf.Empty
+	9,  // 9: v1.TxnPoolOperator.ContentFrom:input_type -> v1.ContentFromReq
+	3,  // 10: v1.TxnPoolOperator.Status:output_type -> v1.TxnPoolStatusResp
+	2,  // 11: v1.TxnPoolOperator.AddTxn:output_type -> v1.AddTxnResp
+	12, // 12: v1.TxnPoolOperator.Subscribe:output_type -> v1.TxPoolEvent
+	5,  // 13: v1.TxnPoolOperator.DropTxn:output_type -> v1.DropTxnResp
+	7,  // 14: v1.TxnPoolOperator.ClearAccount:output_type -> v1.ClearAccountResp
+	8,  // 15: v1.TxnPoolOperator.Rebroadcast:output_type -> v1.RebroadcastResp
+	10, // 16: v1.TxnPoolOperator.ContentFrom:output_type -> v1.ContentFromResp
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_txpool_proto_operator_proto_init() }
@@ -481,7 +892,7 @@ func file_txpool_proto_operator_proto_init() {
 			}
 		}
 		file_txpool_proto_operator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+			switch v := v.(*DropTxnReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -493,6 +904,90 @@ func file_txpool_proto_operator_proto_init() {
 			}
 		}
 		file_txpool_proto_operator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropTxnResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebroadcastResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentFromReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentFromResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxPoolEvent); i {
 			case 0:
 				return &v.state
@@ -511,7 +1006,7 @@ func file_txpool_proto_operator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_txpool_proto_operator_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -371,6 +371,760 @@ var _ interface {
 	ErrorName() string
 } = TxnPoolStatusRespValidationError{}
 
+// Validate checks the field values on DropTxnReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *DropTxnReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DropTxnReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in DropTxnReqMultiError, or nil if none
+// found.
+func (m *DropTxnReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DropTxnReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DropTxnReq_Hash_Pattern.MatchString(m.GetHash()) {
+		err := DropTxnReqValidationError{
+			field:  "Hash",
+			reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DropTxnReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DropTxnReqMultiError is an error wrapping multiple validation errors returned
+// by DropTxnReq.ValidateAll() if the designated constraints aren't met.
+type DropTxnReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DropTxnReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DropTxnReqMultiError) AllErrors() []error { return m }
+
+// DropTxnReqValidationError is the validation error returned by
+// DropTxnReq.Validate if the designated constraints aren't met.
+type DropTxnReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DropTxnReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DropTxnReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DropTxnReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DropTxnReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DropTxnReqValidationError) ErrorName() string {
+	return "DropTxnReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DropTxnReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDropTxnReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DropTxnReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DropTxnReqValidationError{}
+
+var _DropTxnReq_Hash_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{64}$")
+
+// Validate checks the field values on DropTxnResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *DropTxnResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DropTxnResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in DropTxnRespMultiError, or nil if
+// none found.
+func (m *DropTxnResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DropTxnResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DropTxnRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DropTxnRespMultiError is an error wrapping multiple validation errors
+// returned by DropTxnResp.ValidateAll() if the designated constraints aren't
+// met.
+type DropTxnRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DropTxnRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DropTxnRespMultiError) AllErrors() []error { return m }
+
+// DropTxnRespValidationError is the validation error returned by
+// DropTxnResp.Validate if the designated constraints aren't met.
+type DropTxnRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DropTxnRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DropTxnRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DropTxnRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DropTxnRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DropTxnRespValidationError) ErrorName() string {
+	return "DropTxnRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DropTxnRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDropTxnResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DropTxnRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DropTxnRespValidationError{}
+
+// Validate checks the field values on ClearAccountReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClearAccountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearAccountReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClearAccountReqMultiError, or
+// nil if none found.
+func (m *ClearAccountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearAccountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ClearAccountReq_Address_Pattern.MatchString(m.GetAddress()) {
+		err := ClearAccountReqValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{40}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearAccountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearAccountReqMultiError is an error wrapping multiple validation errors
+// returned by ClearAccountReq.ValidateAll() if the designated constraints
+// aren't met.
+type ClearAccountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearAccountReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearAccountReqMultiError) AllErrors() []error { return m }
+
+// ClearAccountReqValidationError is the validation error returned by
+// ClearAccountReq.Validate if the designated constraints aren't met.
+type ClearAccountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearAccountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearAccountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearAccountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearAccountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearAccountReqValidationError) ErrorName() string {
+	return "ClearAccountReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearAccountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearAccountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearAccountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearAccountReqValidationError{}
+
+var _ClearAccountReq_Address_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+// Validate checks the field values on ClearAccountResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearAccountResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearAccountResp with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ClearAccountRespMultiError, or nil if none found.
+func (m *ClearAccountResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearAccountResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ClearAccountRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearAccountRespMultiError is an error wrapping multiple validation errors
+// returned by ClearAccountResp.ValidateAll() if the designated constraints
+// aren't met.
+type ClearAccountRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearAccountRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearAccountRespMultiError) AllErrors() []error { return m }
+
+// ClearAccountRespValidationError is the validation error returned by
+// ClearAccountResp.Validate if the designated constraints aren't met.
+type ClearAccountRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearAccountRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearAccountRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearAccountRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearAccountRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearAccountRespValidationError) ErrorName() string {
+	return "ClearAccountRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearAccountRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearAccountResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearAccountRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearAccountRespValidationError{}
+
+// Validate checks the field values on RebroadcastResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RebroadcastResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebroadcastResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RebroadcastRespMultiError, or
+// nil if none found.
+func (m *RebroadcastResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebroadcastResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RebroadcastRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebroadcastRespMultiError is an error wrapping multiple validation errors
+// returned by RebroadcastResp.ValidateAll() if the designated constraints
+// aren't met.
+type RebroadcastRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebroadcastRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebroadcastRespMultiError) AllErrors() []error { return m }
+
+// RebroadcastRespValidationError is the validation error returned by
+// RebroadcastResp.Validate if the designated constraints aren't met.
+type RebroadcastRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebroadcastRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebroadcastRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebroadcastRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebroadcastRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebroadcastRespValidationError) ErrorName() string {
+	return "RebroadcastRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebroadcastRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebroadcastResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebroadcastRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebroadcastRespValidationError{}
+
+// Validate checks the field values on ContentFromReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContentFromReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentFromReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContentFromReqMultiError, or
+// nil if none found.
+func (m *ContentFromReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentFromReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ContentFromReq_Address_Pattern.MatchString(m.GetAddress()) {
+		err := ContentFromReqValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{40}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ContentFromReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentFromReqMultiError is an error wrapping multiple validation errors
+// returned by ContentFromReq.ValidateAll() if the designated constraints aren't
+// met.
+type ContentFromReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentFromReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentFromReqMultiError) AllErrors() []error { return m }
+
+// ContentFromReqValidationError is the validation error returned by
+// ContentFromReq.Validate if the designated constraints aren't met.
+type ContentFromReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentFromReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentFromReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentFromReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentFromReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentFromReqValidationError) ErrorName() string {
+	return "ContentFromReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContentFromReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentFromReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentFromReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentFromReqValidationError{}
+
+var _ContentFromReq_Address_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+// Validate checks the field values on ContentFromResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContentFromResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentFromResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContentFromRespMultiError, or
+// nil if none found.
+func (m *ContentFromResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentFromResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ContentFromRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentFromRespMultiError is an error wrapping multiple validation errors
+// returned by ContentFromResp.ValidateAll() if the designated constraints
+// aren't met.
+type ContentFromRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentFromRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentFromRespMultiError) AllErrors() []error { return m }
+
+// ContentFromRespValidationError is the validation error returned by
+// ContentFromResp.Validate if the designated constraints aren't met.
+type ContentFromRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentFromRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentFromRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentFromRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentFromRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentFromRespValidationError) ErrorName() string {
+	return "ContentFromRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContentFromRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentFromResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentFromRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentFromRespValidationError{}
+
 // Validate checks the field values on SubscribeRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
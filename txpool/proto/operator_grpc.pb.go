@@ -29,6 +29,15 @@ type TxnPoolOperatorClient interface {
 	AddTxn(ctx context.Context, in *AddTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TxnPoolOperator_SubscribeClient, error)
+	// DropTxn removes the transaction from the pool, together with
+	// the transactions of the same account having a higher nonce
+	DropTxn(ctx context.Context, in *DropTxnReq, opts ...grpc.CallOption) (*DropTxnResp, error)
+	// ClearAccount removes all the transactions of the account from the pool
+	ClearAccount(ctx context.Context, in *ClearAccountReq, opts ...grpc.CallOption) (*ClearAccountResp, error)
+	// Rebroadcast gossips the pending transactions to the network again
+	Rebroadcast(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebroadcastResp, error)
+	// ContentFrom returns the transactions of the account in the pool
+	ContentFrom(ctx context.Context, in *ContentFromReq, opts ...grpc.CallOption) (*ContentFromResp, error)
 }
 
 type txnPoolOperatorClient struct {
@@ -89,6 +98,42 @@ func (x *txnPoolOperatorSubscribeClient) Recv() (*TxPoolEvent, error) {
 	return m, nil
 }
 
+func (c *txnPoolOperatorClient) DropTxn(ctx context.Context, in *DropTxnReq, opts ...grpc.CallOption) (*DropTxnResp, error) {
+	out := new(DropTxnResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/DropTxn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) ClearAccount(ctx context.Context, in *ClearAccountReq, opts ...grpc.CallOption) (*ClearAccountResp, error) {
+	out := new(ClearAccountResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/ClearAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) Rebroadcast(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebroadcastResp, error) {
+	out := new(RebroadcastResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/Rebroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) ContentFrom(ctx context.Context, in *ContentFromReq, opts ...grpc.CallOption) (*ContentFromResp, error) {
+	out := new(ContentFromResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/ContentFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxnPoolOperatorServer is the server API for TxnPoolOperator service.
 // All implementations must embed UnimplementedTxnPoolOperatorServer
 // for forward compatibility
@@ -99,6 +144,15 @@ type TxnPoolOperatorServer interface {
 	AddTxn(context.Context, *AddTxnReq) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error
+	// DropTxn removes the transaction from the pool, together with
+	// the transactions of the same account having a higher nonce
+	DropTxn(context.Context, *DropTxnReq) (*DropTxnResp, error)
+	// ClearAccount removes all the transactions of the account from the pool
+	ClearAccount(context.Context, *ClearAccountReq) (*ClearAccountResp, error)
+	// Rebroadcast gossips the pending transactions to the network again
+	Rebroadcast(context.Context, *emptypb.Empty) (*RebroadcastResp, error)
+	// ContentFrom returns the transactions of the account in the pool
+	ContentFrom(context.Context, *ContentFromReq) (*ContentFromResp, error)
 	mustEmbedUnimplementedTxnPoolOperatorServer()
 }
 
@@ -115,6 +169,18 @@ func (UnimplementedTxnPoolOperatorServer) AddTxn(context.Context, *AddTxnReq) (*
 func (UnimplementedTxnPoolOperatorServer) Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedTxnPoolOperatorServer) DropTxn(context.Context, *DropTxnReq) (*DropTxnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropTxn not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) ClearAccount(context.Context, *ClearAccountReq) (*ClearAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAccount not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) Rebroadcast(context.Context, *emptypb.Empty) (*RebroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebroadcast not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) ContentFrom(context.Context, *ContentFromReq) (*ContentFromResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContentFrom not implemented")
+}
 func (UnimplementedTxnPoolOperatorServer) mustEmbedUnimplementedTxnPoolOperatorServer() {}
 
 // UnsafeTxnPoolOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +251,78 @@ func (x *txnPoolOperatorSubscribeServer) Send(m *TxPoolEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TxnPoolOperator_DropTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropTxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).DropTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/DropTxn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).DropTxn(ctx, req.(*DropTxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_ClearAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).ClearAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/ClearAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).ClearAccount(ctx, req.(*ClearAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_Rebroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).Rebroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/Rebroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).Rebroadcast(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_ContentFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentFromReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).ContentFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/ContentFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).ContentFrom(ctx, req.(*ContentFromReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TxnPoolOperator_ServiceDesc is the grpc.ServiceDesc for TxnPoolOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -200,6 +338,22 @@ var TxnPoolOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTxn",
 			Handler:    _TxnPoolOperator_AddTxn_Handler,
 		},
+		{
+			MethodName: "DropTxn",
+			Handler:    _TxnPoolOperator_DropTxn_Handler,
+		},
+		{
+			MethodName: "ClearAccount",
+			Handler:    _TxnPoolOperator_ClearAccount_Handler,
+		},
+		{
+			MethodName: "Rebroadcast",
+			Handler:    _TxnPoolOperator_Rebroadcast_Handler,
+		},
+		{
+			MethodName: "ContentFrom",
+			Handler:    _TxnPoolOperator_ContentFrom_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
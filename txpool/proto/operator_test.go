@@ -82,6 +82,51 @@ func TestRequestValidation(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			name: "DropTxnReq: invalid hash",
+			req: &DropTxnReq{
+				Hash: "0x1234",
+			},
+			valid:    false,
+			errorMsg: "invalid DropTxnReq.Hash: value does not match regex pattern",
+		},
+		{
+			name: "DropTxnReq: valid hash",
+			req: &DropTxnReq{
+				Hash: "0x1e5d9afb8a3d5b1d2bdbf4f8a2cb8d04ee50c7b7e7b5c5e0dbdf6a0d1e4b2a39",
+			},
+			valid: true,
+		},
+		{
+			name: "ClearAccountReq: empty address",
+			req: &ClearAccountReq{
+				Address: "",
+			},
+			valid:    false,
+			errorMsg: "invalid ClearAccountReq.Address: value does not match regex pattern",
+		},
+		{
+			name: "ClearAccountReq: valid address",
+			req: &ClearAccountReq{
+				Address: "0x9FC184A287e4BB51Eef4ecA81788eA10EF3f202f",
+			},
+			valid: true,
+		},
+		{
+			name: "ContentFromReq: invalid address",
+			req: &ContentFromReq{
+				Address: "1234",
+			},
+			valid:    false,
+			errorMsg: "invalid ContentFromReq.Address: value does not match regex pattern",
+		},
+		{
+			name: "ContentFromReq: valid address",
+			req: &ContentFromReq{
+				Address: "0x9FC184A287e4BB51Eef4ecA81788eA10EF3f202f",
+			},
+			valid: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,6 +1,10 @@
 package txpool
 
-import "github.com/plingatech/go-plgchain/types"
+import (
+	"sync/atomic"
+
+	"github.com/plingatech/go-plgchain/types"
+)
 
 /* QUERY methods */
 // Used to query the pool for specific state info.
@@ -28,6 +32,12 @@ func (p *TxPool) GetCapacity() (uint64, uint64) {
 	return p.gauge.read(), p.gauge.max
 }
 
+// GetExpiredCount returns the number of transactions
+// removed from the pool after their lifetime passed
+func (p *TxPool) GetExpiredCount() uint64 {
+	return atomic.LoadUint64(&p.expired)
+}
+
 // GetPendingTx returns the transaction by hash in the TxPool (pending txn) [Thread-safe]
 func (p *TxPool) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
 	tx, ok := p.index.get(txHash)
@@ -46,3 +56,14 @@ func (p *TxPool) GetTxs(inclQueued bool) (
 
 	return
 }
+
+// GetAccountTxs returns the pending and queued transactions
+// of the account, each sorted by nonce
+func (p *TxPool) GetAccountTxs(addr types.Address) (pending, queued []*types.Transaction) {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil, nil
+	}
+
+	return account.txs()
+}
@@ -72,6 +72,53 @@ func (q *accountQueue) clear() (removed []*types.Transaction) {
 	return
 }
 
+// removeFrom removes all transactions from the queue
+// with nonce higher than or equal to given.
+func (q *accountQueue) removeFrom(nonce uint64) (removed []*types.Transaction) {
+	kept := make(minNonceQueue, 0, len(q.queue))
+
+	for _, tx := range q.queue {
+		if tx.Nonce >= nonce {
+			removed = append(removed, tx)
+		} else {
+			kept = append(kept, tx)
+		}
+	}
+
+	if len(removed) == 0 {
+		return
+	}
+
+	q.queue = kept
+
+	heap.Init(&q.queue)
+
+	return
+}
+
+// getByNonce returns the transaction with the given nonce
+// and its position in the queue, or nil if there is none.
+func (q *accountQueue) getByNonce(nonce uint64) (*types.Transaction, int) {
+	for i, tx := range q.queue {
+		if tx.Nonce == nonce {
+			return tx, i
+		}
+	}
+
+	return nil, -1
+}
+
+// replace puts the given transaction at the position of the existing one
+// and returns the replaced transaction.
+func (q *accountQueue) replace(i int, tx *types.Transaction) *types.Transaction {
+	replaced := q.queue[i]
+	q.queue[i] = tx
+
+	heap.Fix(&q.queue, i)
+
+	return replaced
+}
+
 // push pushes the given transactions onto the queue.
 func (q *accountQueue) push(tx *types.Transaction) {
 	heap.Push(&q.queue, tx)
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/armon/go-metrics"
-	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/plingatech/go-plgchain/blockchain"
@@ -35,6 +34,9 @@ const (
 
 	pruningCooldown = 5000 * time.Millisecond
 
+	// default period of rewriting the local transactions journal
+	defaultRejournalInterval = time.Hour
+
 	// txPoolMetrics is a prefix used for txpool-related metrics
 	txPoolMetrics = "txpool"
 )
@@ -50,6 +52,8 @@ var (
 	ErrUnderpriced             = errors.New("transaction underpriced")
 	ErrNonceTooLow             = errors.New("nonce too low")
 	ErrInsufficientFunds       = errors.New("insufficient funds for gas * price + value")
+	ErrInsufficientPayerFunds  = errors.New("insufficient fee payer funds for gas * price")
+	ErrInvalidFeePayer         = errors.New("invalid fee payer")
 	ErrInvalidAccountState     = errors.New("invalid account state")
 	ErrAlreadyKnown            = errors.New("already known")
 	ErrOversizedData           = errors.New("oversized data")
@@ -57,6 +61,10 @@ var (
 	ErrRejectFutureTx          = errors.New("rejected future tx due to low slots")
 	ErrSmartContractRestricted = errors.New("smart contract deployment restricted")
 	ErrInvalidTxType           = errors.New("invalid tx type")
+	ErrReplacementUnderpriced  = errors.New("replacement transaction underpriced")
+	ErrBlockedAddress          = errors.New("sender or receiver is blocked")
+	ErrTxNotFound              = errors.New("transaction not found")
+	ErrInvalidLocalSlots       = errors.New("local slots must be lower than max slots")
 )
 
 // indicates origin of a transaction
@@ -84,17 +92,27 @@ type store interface {
 	GetNonce(root types.Hash, addr types.Address) uint64
 	GetBalance(root types.Hash, addr types.Address) (*big.Int, error)
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
+	GetStorage(root types.Hash, addr types.Address, slot types.Hash) types.Hash
 }
 
 type signer interface {
 	Sender(tx *types.Transaction) (types.Address, error)
+	FeePayer(tx *types.Transaction) (types.Address, error)
 }
 
 type Config struct {
 	PriceLimit          uint64
 	MaxSlots            uint64
 	MaxAccountEnqueued  uint64
+	PriceBump           uint64
+	LocalSlots          uint64
+	JournalPath         string
+	RejournalInterval   time.Duration
+	Lifetime            time.Duration
+	AnnounceThreshold   uint64
 	DeploymentWhitelist []types.Address
+	BlockList           bool
+	TxOrdering          *chain.TxOrderingConfig
 }
 
 /* All requests are passed to the main loop
@@ -137,21 +155,33 @@ type promoteRequest struct {
 type TxPool struct {
 	logger hclog.Logger
 	signer signer
-	forks  chain.ForksInTime
+	forks  *chain.Forks
 	store  store
 
 	// map of all accounts registered by the pool
 	accounts accountsMap
 
-	// all the primaries sorted by max gas price
-	executables *pricedQueue
+	// all the primaries sorted by the transaction ordering strategy,
+	// max gas price by default
+	executables executablesQueue
 
 	// lookup map keeping track of all
 	// transactions present in the pool
 	index lookupMap
 
 	// networking stack
-	topic *network.Topic
+	topic         *network.Topic
+	announceTopic *network.Topic
+	localPeerID   peer.ID
+
+	// announceThreshold is the size of the transactions
+	// above which they are announced instead of gossiped
+	announceThreshold uint64
+
+	// fetching of the announced transactions
+	fetcherNetwork fetcherNetwork
+	fetcher        *fetcherService
+	fetches        *fetchTracker
 
 	// gauge for measuring pool capacity
 	gauge slotGauge
@@ -159,6 +189,30 @@ type TxPool struct {
 	// priceLimit is a lower threshold for gas price
 	priceLimit uint64
 
+	// priceBump is the minimum gas price increase in percent
+	// for replacing a transaction with the same nonce
+	priceBump uint64
+
+	// localSlots is the number of slots reserved for local transactions
+	localSlots uint64
+
+	// remote transactions sorted by min gas price,
+	// the cheapest ones are evicted when the pool is full
+	evictables *evictionIndex
+
+	// lifetime is the maximum time a transaction can spend in the pool,
+	// transactions never expire if it is 0
+	lifetime time.Duration
+
+	// journal of the local transactions, nil if disabled
+	journal *journal
+
+	// rejournalInterval is the period of rewriting the journal
+	rejournalInterval time.Duration
+
+	// journalStopCh stops the rejournal loop
+	journalStopCh chan struct{}
+
 	// channels on which the pool's event loop
 	// does dispatching/handling requests.
 	enqueueReqCh chan enqueueRequest
@@ -178,12 +232,19 @@ type TxPool struct {
 	// deploymentWhitelist map
 	deploymentWhitelist deploymentWhitelist
 
+	// blockList indicates if the on-chain transactions block list is enforced
+	blockList bool
+
 	// indicates which txpool operator commands should be implemented
 	proto.UnimplementedTxnPoolOperatorServer
 
 	// pending is the list of pending and ready transactions. This variable
 	// is accessed with atomics
 	pending int64
+
+	// expired is the number of transactions expired since the start.
+	// This variable is accessed with atomics
+	expired uint64
 }
 
 // deploymentWhitelist map which contains all addresses which can deploy contracts
@@ -224,21 +285,33 @@ func newDeploymentWhitelist(deploymentWhitelistRaw []types.Address) deploymentWh
 // NewTxPool returns a new pool for processing incoming transactions.
 func NewTxPool(
 	logger hclog.Logger,
-	forks chain.ForksInTime,
+	forks *chain.Forks,
 	store store,
 	grpcServer *grpc.Server,
 	network *network.Server,
 	config *Config,
 ) (*TxPool, error) {
+	// remote transactions would never be accepted otherwise
+	if config.LocalSlots > 0 && config.LocalSlots >= config.MaxSlots {
+		return nil, ErrInvalidLocalSlots
+	}
+
 	pool := &TxPool{
 		logger:      logger.Named("txpool"),
 		forks:       forks,
 		store:       store,
 		executables: newPricedQueue(),
 		accounts:    accountsMap{maxEnqueuedLimit: config.MaxAccountEnqueued},
-		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
+		index:       newLookupMap(),
 		gauge:       slotGauge{height: 0, max: config.MaxSlots},
 		priceLimit:  config.PriceLimit,
+		priceBump:   config.PriceBump,
+		localSlots:  config.LocalSlots,
+		lifetime:    config.Lifetime,
+		evictables:  newEvictionIndex(),
+		fetches:     newFetchTracker(),
+
+		announceThreshold: config.AnnounceThreshold,
 
 		//	main loop channels
 		enqueueReqCh: make(chan enqueueRequest),
@@ -247,25 +320,38 @@ func NewTxPool(
 		shutdownCh:   make(chan struct{}),
 	}
 
-	// Attach the event manager
-	pool.eventManager = newEventManager(pool.logger)
-
-	if network != nil {
-		// subscribe to the gossip protocol
-		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
+	if config.TxOrdering != nil {
+		executables, err := newExecutablesQueue(config.TxOrdering, pool.index.addedAt)
 		if err != nil {
 			return nil, err
 		}
 
-		if subscribeErr := topic.Subscribe(pool.addGossipTx); subscribeErr != nil {
-			return nil, fmt.Errorf("unable to subscribe to gossip topic, %w", subscribeErr)
+		pool.executables = executables
+	}
+
+	if config.JournalPath != "" {
+		pool.journal = newJournal(config.JournalPath)
+		pool.rejournalInterval = config.RejournalInterval
+
+		if pool.rejournalInterval <= 0 {
+			pool.rejournalInterval = defaultRejournalInterval
 		}
+		pool.journalStopCh = make(chan struct{})
+	}
+
+	// Attach the event manager
+	pool.eventManager = newEventManager(pool.logger)
 
-		pool.topic = topic
+	if network != nil {
+		// subscribe to the gossip protocols
+		if err := pool.setupGossip(network); err != nil {
+			return nil, err
+		}
 	}
 
 	// initialize deployment whitelist
 	pool.deploymentWhitelist = newDeploymentWhitelist(config.DeploymentWhitelist)
+	pool.blockList = config.BlockList
 
 	if grpcServer != nil {
 		proto.RegisterTxnPoolOperatorServer(grpcServer, pool)
@@ -304,6 +390,14 @@ func (p *TxPool) Start() {
 
 	//	run the handler for the tx pipeline
 	go func() {
+		var expiryCh <-chan time.Time
+
+		if ticker := p.expiryTicker(); ticker != nil {
+			defer ticker.Stop()
+
+			expiryCh = ticker.C
+		}
+
 		for {
 			select {
 			case <-p.shutdownCh:
@@ -312,13 +406,30 @@ func (p *TxPool) Start() {
 				go p.handleEnqueueRequest(req)
 			case req := <-p.promoteReqCh:
 				go p.handlePromoteRequest(req)
+			case <-expiryCh:
+				go p.expireTxs()
 			}
 		}
 	}()
+
+	//	replay the local txs from the previous run
+	if p.journal != nil {
+		p.startJournal()
+	}
 }
 
 // Close shuts down the pool's main loop.
 func (p *TxPool) Close() {
+	if p.journal != nil {
+		p.stopJournal()
+	}
+
+	if p.fetcher != nil {
+		if err := p.fetcher.stream.Close(); err != nil {
+			p.logger.Error("failed to close the fetcher stream", "err", err)
+		}
+	}
+
 	p.eventManager.Close()
 	p.shutdownCh <- struct{}{}
 }
@@ -357,30 +468,23 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 		return err
 	}
 
-	// broadcast the transaction only if a topic
-	// subscription is present
-	if p.topic != nil {
-		tx := &proto.Txn{
-			Raw: &any.Any{
-				Value: tx.MarshalRLP(),
-			},
-		}
-
-		if err := p.topic.Publish(tx); err != nil {
-			p.logger.Error("failed to topic tx", "err", err)
+	if p.journal != nil {
+		if err := p.journal.insert(tx); err != nil {
+			p.logger.Error("failed to journal tx", "err", err)
 		}
 	}
 
+	p.publish(tx)
+
 	return nil
 }
 
 // Prepare generates all the transactions
 // ready for execution. (primaries)
 func (p *TxPool) Prepare() {
-	// clear from previous round
-	if p.executables.length() != 0 {
-		p.executables.clear()
-	}
+	// clear from previous round, including the state
+	// the ordering strategy keeps about the drained queue
+	p.executables.clear()
 
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
@@ -391,8 +495,8 @@ func (p *TxPool) Prepare() {
 	}
 }
 
-// Peek returns the best-price selected
-// transaction ready for execution.
+// Peek returns the next transaction ready for execution,
+// selected by the transaction ordering strategy.
 func (p *TxPool) Peek() *types.Transaction {
 	// Popping the executables queue
 	// does not remove the actual tx
@@ -432,6 +536,28 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 	}
 }
 
+// removeFrom removes the given transaction together with the transactions
+// of the same account having a higher nonce, and returns the removed ones.
+func (p *TxPool) removeFrom(tx *types.Transaction) []*types.Transaction {
+	account := p.accounts.get(tx.From)
+	if account == nil {
+		return nil
+	}
+
+	removedPromoted, removedEnqueued := account.removeFrom(tx)
+	removed := append(removedPromoted, removedEnqueued...) //nolint:gocritic
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	p.index.remove(removed...)
+	p.gauge.decrease(slotsRequired(removed...))
+	p.updatePending(-1 * int64(len(removedPromoted)))
+
+	return removed
+}
+
 // Drop clears the entire account associated with the given transaction
 // and reverts its next (expected) nonce.
 func (p *TxPool) Drop(tx *types.Transaction) {
@@ -580,6 +706,14 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidTxType
 	}
 
+	// Grab the forks enabled at the latest block
+	forks := p.forks.At(p.store.Header().Number)
+
+	// Fee delegated transactions are accepted once their fork is enabled
+	if tx.IsFeeDelegated() && !forks.FeeDelegation {
+		return ErrInvalidTxType
+	}
+
 	// Check the transaction size to overcome DOS Attacks
 	if uint64(len(tx.MarshalRLP())) > txMaxSize {
 		return ErrOversizedData
@@ -610,13 +744,25 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		tx.From = from
 	}
 
+	// Check that the fee payer signed the fee delegated transaction
+	if tx.IsFeeDelegated() {
+		payer, err := p.signer.FeePayer(tx)
+		if err != nil {
+			return ErrExtractSignature
+		}
+
+		if tx.FeePayer == types.ZeroAddress || tx.FeePayer != payer {
+			return ErrInvalidFeePayer
+		}
+	}
+
 	// Check if transaction can deploy smart contract
 	if tx.IsContractCreation() {
 		if !p.deploymentWhitelist.allowed(tx.From) {
 			return ErrSmartContractRestricted
 		}
 
-		if p.forks.EIP158 && len(tx.Input) > state.TxPoolMaxInitCodeSize {
+		if forks.EIP158 && len(tx.Input) > state.TxPoolMaxInitCodeSize {
 			return runtime.ErrMaxCodeSizeExceeded
 		}
 	}
@@ -629,6 +775,11 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	// Grab the state root for the latest block
 	stateRoot := p.store.Header().StateRoot
 
+	// Check if the sender or the receiver is blocked
+	if err := p.checkBlockList(stateRoot, tx); err != nil {
+		return err
+	}
+
 	// Check nonce ordering
 	if p.store.GetNonce(stateRoot, tx.From) > tx.Nonce {
 		return ErrNonceTooLow
@@ -639,13 +790,28 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidAccountState
 	}
 
-	// Check if the sender has enough funds to execute the transaction
-	if accountBalance.Cmp(tx.Cost()) < 0 {
+	if tx.IsFeeDelegated() {
+		// Check if the sender has enough funds for the value
+		// and the fee payer has enough funds for the gas
+		if accountBalance.Cmp(tx.Value) < 0 {
+			return ErrInsufficientFunds
+		}
+
+		payerBalance, balanceErr := p.store.GetBalance(stateRoot, tx.FeePayer)
+		if balanceErr != nil {
+			return ErrInvalidAccountState
+		}
+
+		if payerBalance.Cmp(tx.GasCost()) < 0 {
+			return ErrInsufficientPayerFunds
+		}
+	} else if accountBalance.Cmp(tx.Cost()) < 0 {
+		// Check if the sender has enough funds to execute the transaction
 		return ErrInsufficientFunds
 	}
 
 	// Make sure the transaction has more gas than the basic transaction fee
-	intrinsicGas, err := state.TransactionGasCost(tx, p.forks.Homestead, p.forks.Istanbul)
+	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul)
 	if err != nil {
 		return err
 	}
@@ -724,11 +890,6 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		}
 	}
 
-	// check for overflow
-	if slotsRequired(tx) > p.gauge.max-p.gauge.read() {
-		return ErrTxPoolOverflow
-	}
-
 	tx.ComputeHash()
 
 	// add to index
@@ -739,6 +900,47 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 	// initialize account for this address once
 	p.createAccountOnce(tx.From)
 
+	if origin == local {
+		p.index.markLocal(tx.Hash)
+	}
+
+	// resolve the replacement before the capacity check, so an underpriced replacement
+	// is rejected right away and a valid one reserves only its additional slots
+	required := slotsRequired(tx)
+
+	if existing := p.accounts.get(tx.From).getByNonce(tx.Nonce); existing != nil {
+		if !isReplacementPriced(existing, tx, p.priceBump) {
+			p.index.remove(tx)
+
+			return ErrReplacementUnderpriced
+		}
+
+		if replacedSlots := slotsRequired(existing); replacedSlots < required {
+			required -= replacedSlots
+		} else {
+			required = 0
+		}
+	}
+
+	// check for overflow, evicting cheaper remote txs if needed
+	if err := p.ensureCapacity(origin, tx, required); err != nil {
+		p.index.remove(tx)
+
+		return err
+	}
+
+	// replace the account's transaction with the same nonce, if any
+	replaced, err := p.replaceTx(tx)
+	if err != nil {
+		p.index.remove(tx)
+
+		return err
+	}
+
+	if replaced {
+		return nil
+	}
+
 	// send request [BLOCKING]
 	p.enqueueReqCh <- enqueueRequest{tx: tx}
 	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash)
@@ -746,6 +948,42 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 	return nil
 }
 
+// replaceTx swaps the account's enqueued or promoted transaction
+// with the same nonce for the given one, if it is priced high enough.
+// Returns false if the account has no transaction with the nonce.
+func (p *TxPool) replaceTx(tx *types.Transaction) (bool, error) {
+	account := p.accounts.get(tx.From)
+
+	replaced, promoted, err := account.replace(tx, p.priceBump)
+	if err != nil || replaced == nil {
+		return false, err
+	}
+
+	// update pool state
+	p.index.remove(replaced)
+	p.gauge.decrease(slotsRequired(replaced))
+	p.gauge.increase(slotsRequired(tx))
+	p.trackEvictable(tx)
+
+	p.eventManager.signalEvent(proto.EventType_REPLACED, replaced.Hash)
+	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash)
+
+	if promoted {
+		p.eventManager.signalEvent(proto.EventType_PROMOTED, tx.Hash)
+	} else {
+		p.eventManager.signalEvent(proto.EventType_ENQUEUED, tx.Hash)
+	}
+
+	p.logger.Debug("replaced tx",
+		"old", replaced.Hash.String(),
+		"new", tx.Hash.String(),
+		"nonce", tx.Nonce,
+		"promoted", promoted,
+	)
+
+	return true, nil
+}
+
 // handleEnqueueRequest attempts to enqueue the transaction
 // contained in the given request to the associated account.
 // If, afterwards, the account is eligible for promotion,
@@ -769,6 +1007,7 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 	p.logger.Debug("enqueue request", "hash", tx.Hash.String())
 
 	p.gauge.increase(slotsRequired(tx))
+	p.trackEvictable(tx)
 
 	p.eventManager.signalEvent(proto.EventType_ENQUEUED, tx.Hash)
 
@@ -803,8 +1042,8 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 
 // addGossipTx handles receiving transactions
 // gossiped by the network.
-func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
-	if !p.getSealing() {
+func (p *TxPool) addGossipTx(obj interface{}, from peer.ID) {
+	if !p.getSealing() || (from != "" && from == p.localPeerID) {
 		return
 	}
 
@@ -834,6 +1073,7 @@ func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
 	// add tx
 	if err := p.addTx(gossip, tx); err != nil {
 		if errors.Is(err, ErrAlreadyKnown) {
+			metrics.IncrCounter([]string{txPoolMetrics, "duplicate_transactions"}, 1)
 			p.logger.Debug("rejecting known tx (gossip)", "hash", tx.Hash.String())
 
 			return
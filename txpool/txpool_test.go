@@ -27,6 +27,7 @@ const (
 	defaultPriceLimit         uint64 = 1
 	defaultMaxSlots           uint64 = 4096
 	defaultMaxAccountEnqueued uint64 = 128
+	defaultPriceBump          uint64 = 10
 	validGasLimit             uint64 = 4712350
 )
 
@@ -86,7 +87,7 @@ func newTestPoolWithSlots(maxSlots uint64, mockStore ...store) (*TxPool, error)
 
 	return NewTxPool(
 		hclog.NewNullLogger(),
-		forks.At(0),
+		forks,
 		storeToUse,
 		nil,
 		nil,
@@ -94,6 +95,7 @@ func newTestPoolWithSlots(maxSlots uint64, mockStore ...store) (*TxPool, error)
 			PriceLimit:          defaultPriceLimit,
 			MaxSlots:            maxSlots,
 			MaxAccountEnqueued:  defaultMaxAccountEnqueued,
+			PriceBump:           defaultPriceBump,
 			DeploymentWhitelist: []types.Address{},
 		},
 	)
@@ -1443,6 +1445,567 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
 }
 
+func TestReplaceTx(t *testing.T) {
+	t.Parallel()
+
+	newPricedTx := func(nonce, price uint64) *types.Transaction {
+		tx := newTx(addr1, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(price)
+		tx.ComputeHash()
+
+		return tx
+	}
+
+	t.Run(
+		"replace promoted tx",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			sub := pool.eventManager.subscribe([]proto.EventType{proto.EventType_REPLACED})
+			defer pool.eventManager.cancelSubscription(sub.subscriptionID)
+
+			oldTx := newPricedTx(0, 100)
+
+			go func() {
+				assert.NoError(t, pool.addTx(local, oldTx))
+			}()
+			go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+			pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+			// replacement is handled synchronously, without an enqueue request
+			newTx := newPricedTx(0, 110)
+			assert.NoError(t, pool.addTx(local, newTx))
+
+			account := pool.accounts.get(addr1)
+			assert.Equal(t, uint64(1), account.promoted.length())
+			assert.Equal(t, newTx.Hash, account.promoted.peek().Hash)
+			assert.Equal(t, uint64(1), pool.gauge.read())
+			assert.Equal(t, int64(1), pool.pending)
+
+			_, ok := pool.index.get(oldTx.Hash)
+			assert.False(t, ok)
+
+			_, ok = pool.index.get(newTx.Hash)
+			assert.True(t, ok)
+
+			select {
+			case event := <-sub.subscriptionChannel:
+				assert.Equal(t, proto.EventType_REPLACED, event.Type)
+				assert.Equal(t, oldTx.Hash.String(), event.TxHash)
+			case <-time.After(5 * time.Second):
+				t.Fatal("replaced event not received")
+			}
+		},
+	)
+
+	t.Run(
+		"replace enqueued tx",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			go func() {
+				assert.NoError(t, pool.addTx(local, newPricedTx(5, 100)))
+			}()
+			pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+			newTx := newPricedTx(5, 200)
+			assert.NoError(t, pool.addTx(local, newTx))
+
+			account := pool.accounts.get(addr1)
+			assert.Equal(t, uint64(1), account.enqueued.length())
+			assert.Equal(t, newTx.Hash, account.enqueued.peek().Hash)
+			assert.Equal(t, uint64(1), pool.gauge.read())
+		},
+	)
+
+	t.Run(
+		"reject underpriced replacement",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			oldTx := newPricedTx(5, 100)
+
+			go func() {
+				assert.NoError(t, pool.addTx(local, oldTx))
+			}()
+			pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+			// the price bump is 10%
+			newTx := newPricedTx(5, 109)
+			assert.ErrorIs(t, pool.addTx(local, newTx), ErrReplacementUnderpriced)
+
+			account := pool.accounts.get(addr1)
+			assert.Equal(t, oldTx.Hash, account.enqueued.peek().Hash)
+
+			_, ok := pool.index.get(newTx.Hash)
+			assert.False(t, ok)
+		},
+	)
+}
+
+func TestEvictUnderpriced(t *testing.T) {
+	t.Parallel()
+
+	newPricedTx := func(addr types.Address, nonce, price uint64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(price)
+		// the hash doesn't cover the sender, so make it unique per account
+		tx.To = &addr
+		tx.ComputeHash()
+
+		return tx
+	}
+
+	addAndPromote := func(t *testing.T, pool *TxPool, origin txOrigin, tx *types.Transaction) {
+		t.Helper()
+
+		go func() {
+			assert.NoError(t, pool.addTx(origin, tx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	t.Run(
+		"evict cheaper remote tx",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(2)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			sub := pool.eventManager.subscribe([]proto.EventType{proto.EventType_EVICTED})
+			defer pool.eventManager.cancelSubscription(sub.subscriptionID)
+
+			cheapTx := newPricedTx(addr1, 0, 10)
+
+			addAndPromote(t, pool, gossip, cheapTx)
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 20))
+			addAndPromote(t, pool, gossip, newPricedTx(addr3, 0, 15))
+
+			_, ok := pool.index.get(cheapTx.Hash)
+			assert.False(t, ok)
+
+			account := pool.accounts.get(addr1)
+			assert.Equal(t, uint64(0), account.promoted.length())
+			assert.Equal(t, uint64(0), account.getNonce())
+			assert.Equal(t, uint64(2), pool.gauge.read())
+			assert.Equal(t, int64(2), pool.pending)
+
+			select {
+			case event := <-sub.subscriptionChannel:
+				assert.Equal(t, proto.EventType_EVICTED, event.Type)
+				assert.Equal(t, cheapTx.Hash.String(), event.TxHash)
+			case <-time.After(5 * time.Second):
+				t.Fatal("evicted event not received")
+			}
+		},
+	)
+
+	t.Run(
+		"reject tx not paying more than the cheapest one",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(2)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			addAndPromote(t, pool, gossip, newPricedTx(addr1, 0, 10))
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 20))
+
+			tx := newPricedTx(addr3, 0, 10)
+			assert.ErrorIs(t, pool.addTx(gossip, tx), ErrTxPoolOverflow)
+
+			_, ok := pool.index.get(tx.Hash)
+			assert.False(t, ok)
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+			assert.Equal(t, uint64(2), pool.gauge.read())
+		},
+	)
+
+	t.Run(
+		"evict higher nonce txs of the same account",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(3)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			addAndPromote(t, pool, gossip, newPricedTx(addr1, 0, 10))
+			addAndPromote(t, pool, gossip, newPricedTx(addr1, 1, 50))
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 20))
+			addAndPromote(t, pool, gossip, newPricedTx(addr3, 0, 15))
+
+			account := pool.accounts.get(addr1)
+			assert.Equal(t, uint64(0), account.promoted.length())
+			assert.Equal(t, uint64(0), account.enqueued.length())
+			assert.Equal(t, uint64(0), account.getNonce())
+			assert.Equal(t, uint64(2), pool.gauge.read())
+			assert.Equal(t, int64(2), pool.pending)
+		},
+	)
+
+	t.Run(
+		"local txs are not evicted",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(2)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			addAndPromote(t, pool, local, newPricedTx(addr1, 0, 1))
+			addAndPromote(t, pool, local, newPricedTx(addr2, 0, 1))
+
+			assert.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr3, 0, 100)), ErrTxPoolOverflow)
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+			assert.Equal(t, uint64(1), pool.accounts.get(addr2).promoted.length())
+		},
+	)
+
+	t.Run(
+		"accounts without local txs left are evictable",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(3)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			localTx := newPricedTx(addr1, 0, 1)
+
+			addAndPromote(t, pool, local, localTx)
+			addAndPromote(t, pool, gossip, newPricedTx(addr1, 1, 1))
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 5))
+
+			// the remote tx of the account with a local tx is kept
+			addAndPromote(t, pool, gossip, newPricedTx(addr3, 0, 100))
+			assert.Equal(t, uint64(2), pool.accounts.get(addr1).promoted.length())
+			assert.Equal(t, uint64(0), pool.accounts.get(addr2).promoted.length())
+
+			// the local tx is included in a block
+			account := pool.accounts.get(addr1)
+			account.promoted.lock(true)
+			account.promoted.pop()
+			account.promoted.unlock()
+			pool.index.remove(localTx)
+			pool.gauge.decrease(slotsRequired(localTx))
+
+			assert.False(t, account.hasLocal(&pool.index))
+
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 50))
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 1, 60))
+			assert.Equal(t, uint64(0), account.promoted.length())
+			assert.Equal(t, uint64(2), pool.accounts.get(addr2).promoted.length())
+		},
+	)
+
+	t.Run(
+		"remote txs can't use the local slots",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(2)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+			pool.localSlots = 1
+
+			addAndPromote(t, pool, gossip, newPricedTx(addr1, 0, 10))
+
+			assert.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr2, 0, 10)), ErrTxPoolOverflow)
+
+			addAndPromote(t, pool, local, newPricedTx(addr2, 0, 10))
+			assert.Equal(t, uint64(2), pool.gauge.read())
+		},
+	)
+
+	t.Run(
+		"replacements in a full pool don't evict other txs",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPoolWithSlots(2)
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			cheapTx := newPricedTx(addr1, 0, 10)
+
+			addAndPromote(t, pool, gossip, cheapTx)
+			addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 20))
+
+			// the price bump is 10%
+			assert.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr2, 0, 21)), ErrReplacementUnderpriced)
+
+			replacement := newPricedTx(addr2, 0, 100)
+			assert.NoError(t, pool.addTx(gossip, replacement))
+
+			_, ok := pool.index.get(cheapTx.Hash)
+			assert.True(t, ok)
+			assert.Equal(t, replacement.Hash, pool.accounts.get(addr2).promoted.peek().Hash)
+			assert.Equal(t, uint64(2), pool.gauge.read())
+		},
+	)
+}
+
+func TestNewTxPool_LocalSlots(t *testing.T) {
+	t.Parallel()
+
+	newPool := func(maxSlots, localSlots uint64) (*TxPool, error) {
+		return NewTxPool(
+			hclog.NewNullLogger(),
+			forks,
+			defaultMockStore{DefaultHeader: mockHeader},
+			nil,
+			nil,
+			&Config{
+				PriceLimit:          defaultPriceLimit,
+				MaxSlots:            maxSlots,
+				MaxAccountEnqueued:  defaultMaxAccountEnqueued,
+				PriceBump:           defaultPriceBump,
+				LocalSlots:          localSlots,
+				DeploymentWhitelist: []types.Address{},
+			},
+		)
+	}
+
+	t.Run("local slots taking the whole pool are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newPool(4, 512)
+		assert.ErrorIs(t, err, ErrInvalidLocalSlots)
+
+		_, err = newPool(4, 4)
+		assert.ErrorIs(t, err, ErrInvalidLocalSlots)
+	})
+
+	t.Run("remote txs are accepted with a small max slots", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newPool(4, 1)
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		go func() {
+			assert.NoError(t, pool.addTx(gossip, newTx(addr1, 0, 1)))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		assert.Equal(t, uint64(1), pool.gauge.read())
+	})
+}
+
+func TestExpireTxs(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+	pool.lifetime = time.Hour
+
+	sub := pool.eventManager.subscribe([]proto.EventType{proto.EventType_EXPIRED})
+	defer pool.eventManager.cancelSubscription(sub.subscriptionID)
+
+	promote := func(tx *types.Transaction) {
+		go func() {
+			assert.NoError(t, pool.addTx(gossip, tx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	freshTx, staleTx := newTx(addr1, 0, 1), newTx(addr1, 1, 1)
+	promote(freshTx)
+	promote(staleTx)
+
+	// local tx, the hash doesn't cover the sender so make it unique
+	localTx := newTx(addr3, 0, 1)
+	localTx.To = &addr3
+	localTx.ComputeHash()
+
+	go func() {
+		assert.NoError(t, pool.addTx(local, localTx))
+	}()
+	go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	// enqueued tx with a nonce gap
+	gapTx := newTx(addr2, 3, 1)
+
+	go func() {
+		assert.NoError(t, pool.addTx(gossip, gapTx))
+	}()
+	pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+	// age the transactions
+	pool.index.Lock()
+	pool.index.added[staleTx.Hash] = time.Now().Add(-2 * time.Hour)
+	pool.index.added[gapTx.Hash] = time.Now().Add(-2 * time.Hour)
+	pool.index.added[localTx.Hash] = time.Now().Add(-2 * time.Hour)
+	pool.index.Unlock()
+
+	pool.expireTxs()
+
+	// the local tx doesn't expire
+	assert.Equal(t, uint64(1), pool.accounts.get(addr3).promoted.length())
+
+	account1 := pool.accounts.get(addr1)
+	assert.Equal(t, uint64(1), account1.promoted.length())
+	assert.Equal(t, freshTx.Hash, account1.promoted.peek().Hash)
+	assert.Equal(t, uint64(1), account1.getNonce())
+	assert.Equal(t, uint64(0), pool.accounts.get(addr2).enqueued.length())
+
+	_, ok := pool.index.get(staleTx.Hash)
+	assert.False(t, ok)
+
+	assert.Equal(t, uint64(2), pool.gauge.read())
+	assert.Equal(t, int64(2), pool.pending)
+	assert.Equal(t, uint64(2), pool.GetExpiredCount())
+
+	expired := map[string]bool{}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-sub.subscriptionChannel:
+			assert.Equal(t, proto.EventType_EXPIRED, event.Type)
+			expired[event.TxHash] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("expired event not received")
+		}
+	}
+
+	assert.True(t, expired[staleTx.Hash.String()])
+	assert.True(t, expired[gapTx.Hash.String()])
+}
+
+func TestOperatorDropTxn(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	promote := func(tx *types.Transaction) {
+		go func() {
+			assert.NoError(t, pool.addTx(gossip, tx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	txs := []*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 1), newTx(addr1, 2, 1)}
+	for _, tx := range txs {
+		promote(tx)
+	}
+
+	t.Run("unknown transaction", func(t *testing.T) {
+		_, err := pool.DropTxn(context.Background(), &proto.DropTxnReq{
+			Hash: types.StringToHash("0x1").String(),
+		})
+		assert.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		_, err := pool.DropTxn(context.Background(), &proto.DropTxnReq{Hash: "0x1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("drops the higher nonces as well", func(t *testing.T) {
+		resp, err := pool.DropTxn(context.Background(), &proto.DropTxnReq{
+			Hash: txs[1].Hash.String(),
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{txs[1].Hash.String(), txs[2].Hash.String()}, resp.TxHashes)
+
+		account := pool.accounts.get(addr1)
+		assert.Equal(t, uint64(1), account.promoted.length())
+		assert.Equal(t, uint64(1), account.getNonce())
+		assert.Equal(t, uint64(1), pool.gauge.read())
+		assert.Equal(t, int64(1), pool.pending)
+	})
+
+	t.Run("clears the account", func(t *testing.T) {
+		gapTx := newTx(addr1, 5, 1)
+
+		go func() {
+			assert.NoError(t, pool.addTx(gossip, gapTx))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		resp, err := pool.ClearAccount(context.Background(), &proto.ClearAccountReq{
+			Address: addr1.String(),
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{txs[0].Hash.String(), gapTx.Hash.String()}, resp.TxHashes)
+
+		account := pool.accounts.get(addr1)
+		assert.Equal(t, uint64(0), account.promoted.length())
+		assert.Equal(t, uint64(0), account.enqueued.length())
+		assert.Equal(t, uint64(0), account.getNonce())
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.Equal(t, int64(0), pool.pending)
+
+		// nothing left to clear
+		resp, err = pool.ClearAccount(context.Background(), &proto.ClearAccountReq{
+			Address: addr1.String(),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.TxHashes)
+	})
+}
+
+func TestOperatorContentFrom(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pendingTx, queuedTx := newTx(addr1, 0, 1), newTx(addr1, 2, 1)
+
+	go func() {
+		assert.NoError(t, pool.addTx(gossip, pendingTx))
+	}()
+	go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	go func() {
+		assert.NoError(t, pool.addTx(gossip, queuedTx))
+	}()
+	pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+	resp, err := pool.ContentFrom(context.Background(), &proto.ContentFromReq{
+		Address: addr1.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Pending, 1)
+	require.Len(t, resp.Queued, 1)
+	assert.Equal(t, pendingTx.MarshalRLP(), resp.Pending[0])
+	assert.Equal(t, queuedTx.MarshalRLP(), resp.Queued[0])
+
+	resp, err = pool.ContentFrom(context.Background(), &proto.ContentFromReq{
+		Address: addr2.String(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Pending)
+	assert.Empty(t, resp.Queued)
+}
+
 func TestDrop(t *testing.T) {
 	t.Parallel()
 
@@ -1753,7 +2316,9 @@ func TestPermissionSmartContractDeployment(t *testing.T) {
 	t.Run("Input larger than the TxPoolMaxInitCodeSize", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
-		pool.forks.EIP158 = true
+		eip158Forks := *forks
+		eip158Forks.EIP158 = chain.NewFork(0)
+		pool.forks = &eip158Forks
 
 		input := make([]byte, state.TxPoolMaxInitCodeSize+1)
 		_, err := rand.Read(input)
@@ -1772,7 +2337,9 @@ func TestPermissionSmartContractDeployment(t *testing.T) {
 	t.Run("Input the same as TxPoolMaxInitCodeSize", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
-		pool.forks.EIP158 = true
+		eip158Forks := *forks
+		eip158Forks.EIP158 = chain.NewFork(0)
+		pool.forks = &eip158Forks
 
 		input := make([]byte, state.TxPoolMaxInitCodeSize)
 		_, err := rand.Read(input)
@@ -1844,6 +2411,182 @@ func (e *eoa) signTx(t *testing.T, tx *types.Transaction, signer crypto.TxSigner
 
 var signerEIP155 = crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
 
+func TestBlockList(t *testing.T) {
+	t.Parallel()
+
+	poolSigner := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
+	key, addr := tests.GenerateKeyAndAddr(t)
+	blocked := types.StringToAddress("0xb1")
+
+	setupPool := func(enabled bool, blockedAddrs ...types.Address) *TxPool {
+		store := blockListMockStore{
+			defaultMockStore: NewDefaultMockStore(mockHeader),
+			blocked:          map[types.Address]bool{},
+		}
+
+		for _, addr := range blockedAddrs {
+			store.blocked[addr] = true
+		}
+
+		pool, err := newTestPool(store)
+		require.NoError(t, err)
+
+		pool.SetSigner(poolSigner)
+		pool.blockList = enabled
+
+		return pool
+	}
+
+	signTx := func(tx *types.Transaction) *types.Transaction {
+		signedTx, err := poolSigner.SignTx(tx, key)
+		require.NoError(t, err)
+
+		return signedTx
+	}
+
+	t.Run("block list disabled", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(false, addr, blocked)
+
+		tx := newTx(addr, 0, 1)
+		tx.To = &blocked
+
+		assert.NoError(t, pool.validateTx(signTx(tx)))
+	})
+
+	t.Run("not blocked addresses", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(true, blocked)
+
+		assert.NoError(t, pool.validateTx(signTx(newTx(addr, 0, 1))))
+	})
+
+	t.Run("blocked sender", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(true, addr)
+
+		assert.ErrorIs(t, pool.validateTx(signTx(newTx(addr, 0, 1))), ErrBlockedAddress)
+	})
+
+	t.Run("blocked receiver", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(true, blocked)
+
+		tx := newTx(addr, 0, 1)
+		tx.To = &blocked
+
+		assert.ErrorIs(t, pool.validateTx(signTx(tx)), ErrBlockedAddress)
+	})
+}
+
+func TestFeeDelegatedTx(t *testing.T) {
+	t.Parallel()
+
+	poolSigner := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), 100)
+	senderKey, sender := tests.GenerateKeyAndAddr(t)
+	payerKey, payer := tests.GenerateKeyAndAddr(t)
+
+	newFeeDelegatedTx := func() *types.Transaction {
+		tx := newTx(sender, 0, 1)
+		tx.Type = types.FeeDelegatedTx
+		tx.FeePayer = payer
+
+		return tx
+	}
+
+	setupPool := func(forks *chain.Forks, balances map[types.Address]*big.Int) *TxPool {
+		pool, err := newTestPool(balanceMockStore{
+			defaultMockStore: NewDefaultMockStore(mockHeader),
+			balances:         balances,
+		})
+		require.NoError(t, err)
+
+		pool.SetSigner(poolSigner)
+		pool.forks = forks
+
+		return pool
+	}
+
+	signTx := func(tx *types.Transaction) *types.Transaction {
+		signedTx, err := poolSigner.SignTx(tx, senderKey)
+		require.NoError(t, err)
+
+		signedTx, err = poolSigner.SignFeePayerTx(signedTx, payerKey)
+		require.NoError(t, err)
+
+		return signedTx
+	}
+
+	t.Run("fee payer covers the gas", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newFeeDelegatedTx()
+		pool := setupPool(chain.AllForksEnabled, map[types.Address]*big.Int{
+			sender: tx.Value,
+			payer:  tx.GasCost(),
+		})
+
+		assert.NoError(t, pool.validateTx(signTx(tx)))
+	})
+
+	t.Run("fee payer cannot cover the gas", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newFeeDelegatedTx()
+		pool := setupPool(chain.AllForksEnabled, map[types.Address]*big.Int{
+			sender: tx.Cost(),
+			payer:  new(big.Int).Sub(tx.GasCost(), big.NewInt(1)),
+		})
+
+		assert.ErrorIs(t, pool.validateTx(signTx(tx)), ErrInsufficientPayerFunds)
+	})
+
+	t.Run("sender cannot cover the value", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newFeeDelegatedTx()
+		pool := setupPool(chain.AllForksEnabled, map[types.Address]*big.Int{
+			payer: tx.Cost(),
+		})
+
+		assert.ErrorIs(t, pool.validateTx(signTx(tx)), ErrInsufficientFunds)
+	})
+
+	t.Run("fee payer did not sign", func(t *testing.T) {
+		t.Parallel()
+
+		// the sender commits to another fee payer after the fee payer signed
+		tx := signTx(newFeeDelegatedTx())
+		tx.FeePayer = types.StringToAddress("0xfe")
+
+		tx, err := poolSigner.SignTx(tx, senderKey)
+		require.NoError(t, err)
+
+		pool := setupPool(chain.AllForksEnabled, map[types.Address]*big.Int{
+			sender:      tx.Value,
+			tx.FeePayer: tx.GasCost(),
+		})
+
+		assert.ErrorIs(t, pool.validateTx(tx), ErrInvalidFeePayer)
+	})
+
+	t.Run("fork not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newFeeDelegatedTx()
+		pool := setupPool(forks, map[types.Address]*big.Int{
+			sender: tx.Value,
+			payer:  tx.GasCost(),
+		})
+
+		assert.ErrorIs(t, pool.validateTx(signTx(tx)), ErrInvalidTxType)
+	})
+}
+
 func TestResetAccounts_Promoted(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +32,12 @@ type TxRelayer interface {
 	SendTransactionLocal(txn *ethgo.Transaction) (*ethgo.Receipt, error)
 }
 
+// transactionSigner is implemented by the key which signs the whole transaction instead of its digest,
+// e.g. the key delegating signing to the remote signer
+type transactionSigner interface {
+	SignTx(txn *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error)
+}
+
 var _ TxRelayer = (*TxRelayerImpl)(nil)
 
 type TxRelayerImpl struct {
@@ -108,8 +114,13 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 		return ethgo.ZeroHash, err
 	}
 
-	signer := wallet.NewEIP155Signer(chainID.Uint64())
-	if txn, err = signer.SignTx(txn, key); err != nil {
+	if txSigner, ok := key.(transactionSigner); ok {
+		txn, err = txSigner.SignTx(txn, chainID.Uint64())
+	} else {
+		txn, err = wallet.NewEIP155Signer(chainID.Uint64()).SignTx(txn, key)
+	}
+
+	if err != nil {
 		return ethgo.ZeroHash, err
 	}
 
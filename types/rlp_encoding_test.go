@@ -166,6 +166,48 @@ func TestRLPMarshall_And_Unmarshall_TypedTransaction(t *testing.T) {
 	}
 }
 
+func TestRLPMarshall_And_Unmarshall_FeeDelegatedTx(t *testing.T) {
+	t.Parallel()
+
+	addrTo := StringToAddress("11")
+	originalTx := &Transaction{
+		Nonce:    0,
+		GasPrice: big.NewInt(11),
+		Gas:      11,
+		To:       &addrTo,
+		Value:    big.NewInt(1),
+		Input:    []byte{1, 2},
+		V:        big.NewInt(25),
+		S:        big.NewInt(26),
+		R:        big.NewInt(27),
+		Type:     FeeDelegatedTx,
+		FeePayer: StringToAddress("22"),
+		PayerV:   big.NewInt(28),
+		PayerR:   big.NewInt(29),
+		PayerS:   big.NewInt(30),
+	}
+	originalTx.ComputeHash()
+
+	txRLP := originalTx.MarshalRLP()
+	assert.Equal(t, byte(FeeDelegatedTx), txRLP[0])
+
+	unmarshalledTx := new(Transaction)
+	assert.NoError(t, unmarshalledTx.UnmarshalRLP(txRLP))
+
+	unmarshalledTx.ComputeHash()
+	assert.Equal(t, originalTx.Hash, unmarshalledTx.Hash)
+	assert.Equal(t, originalTx.FeePayer, unmarshalledTx.FeePayer)
+	assert.Equal(t, originalTx.PayerV, unmarshalledTx.PayerV)
+	assert.Equal(t, originalTx.PayerR, unmarshalledTx.PayerR)
+	assert.Equal(t, originalTx.PayerS, unmarshalledTx.PayerS)
+
+	// the fee payer fields are required
+	unsignedTx := originalTx.Copy()
+	unsignedTx.Type = LegacyTx
+
+	assert.Error(t, (&Transaction{}).UnmarshalRLP(append([]byte{byte(FeeDelegatedTx)}, unsignedTx.MarshalRLP()...)))
+}
+
 func TestRLPMarshall_Unmarshall_Missing_Data(t *testing.T) {
 	t.Parallel()
 
@@ -247,6 +289,10 @@ func TestRLPMarshall_And_Unmarshall_TxType(t *testing.T) {
 			name:   "LegacyTx",
 			txType: LegacyTx,
 		},
+		{
+			name:   "FeeDelegatedTx",
+			txType: FeeDelegatedTx,
+		},
 		{
 			name:        "undefined type",
 			txType:      TxType(0x09),
@@ -211,5 +211,14 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 		vv.Set(arena.NewBytes((t.From).Bytes()))
 	}
 
+	if t.Type == FeeDelegatedTx {
+		vv.Set(arena.NewBytes((t.FeePayer).Bytes()))
+
+		// fee payer signature values
+		vv.Set(arena.NewBigInt(t.PayerV))
+		vv.Set(arena.NewBigInt(t.PayerR))
+		vv.Set(arena.NewBigInt(t.PayerS))
+	}
+
 	return vv
 }
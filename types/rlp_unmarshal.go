@@ -452,5 +452,34 @@ func (t *Transaction) unmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		}
 	}
 
+	if t.Type == FeeDelegatedTx {
+		if len(elems) < 13 {
+			return fmt.Errorf("incorrect number of elements to decode fee delegated transaction, expected 13 but found %d", len(elems))
+		}
+
+		// fee payer
+		if err = elems[9].GetAddr(t.FeePayer[:]); err != nil {
+			return err
+		}
+
+		// fee payer V
+		t.PayerV = new(big.Int)
+		if err = elems[10].GetBigInt(t.PayerV); err != nil {
+			return err
+		}
+
+		// fee payer R
+		t.PayerR = new(big.Int)
+		if err = elems[11].GetBigInt(t.PayerR); err != nil {
+			return err
+		}
+
+		// fee payer S
+		t.PayerS = new(big.Int)
+		if err = elems[12].GetBigInt(t.PayerS); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -11,8 +11,9 @@ import (
 type TxType byte
 
 const (
-	LegacyTx TxType = 0x0
-	StateTx  TxType = 0x7f
+	LegacyTx       TxType = 0x0
+	FeeDelegatedTx TxType = 0x16
+	StateTx        TxType = 0x7f
 
 	StateTransactionGasLimit = 1000000 // some arbitrary default gas limit for state transactions
 )
@@ -21,7 +22,7 @@ func txTypeFromByte(b byte) (TxType, error) {
 	tt := TxType(b)
 
 	switch tt {
-	case LegacyTx, StateTx:
+	case LegacyTx, FeeDelegatedTx, StateTx:
 		return tt, nil
 	default:
 		return tt, fmt.Errorf("unknown transaction type: %d", b)
@@ -32,6 +33,8 @@ func (t TxType) String() (s string) {
 	switch t {
 	case LegacyTx:
 		return "LegacyTx"
+	case FeeDelegatedTx:
+		return "FeeDelegatedTx"
 	case StateTx:
 		return "StateTx"
 	}
@@ -54,6 +57,12 @@ type Transaction struct {
 
 	Type TxType
 
+	// Fee delegation, the fee payer pays for the gas of a FeeDelegatedTx
+	FeePayer Address
+	PayerV   *big.Int
+	PayerR   *big.Int
+	PayerS   *big.Int
+
 	// Cache
 	size atomic.Value
 }
@@ -101,6 +110,14 @@ func (t *Transaction) Copy() *Transaction {
 		tt.S = big.NewInt(0).SetBits(t.S.Bits())
 	}
 
+	if t.PayerR != nil {
+		tt.PayerR = big.NewInt(0).SetBits(t.PayerR.Bits())
+	}
+
+	if t.PayerS != nil {
+		tt.PayerS = big.NewInt(0).SetBits(t.PayerS.Bits())
+	}
+
 	tt.Input = make([]byte, len(t.Input))
 	copy(tt.Input[:], t.Input[:])
 
@@ -115,6 +132,25 @@ func (t *Transaction) Cost() *big.Int {
 	return total
 }
 
+// IsFeeDelegated checks if the gas of the tx is paid by a fee payer
+func (t *Transaction) IsFeeDelegated() bool {
+	return t.Type == FeeDelegatedTx
+}
+
+// GasPayer returns the account charged for the gas of the transaction
+func (t *Transaction) GasPayer() Address {
+	if t.IsFeeDelegated() {
+		return t.FeePayer
+	}
+
+	return t.From
+}
+
+// GasCost returns gas * gasPrice
+func (t *Transaction) GasCost() *big.Int {
+	return new(big.Int).Mul(t.GasPrice, new(big.Int).SetUint64(t.Gas))
+}
+
 func (t *Transaction) Size() uint64 {
 	if size := t.size.Load(); size != nil {
 		sizeVal, ok := size.(uint64)